@@ -33,6 +33,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/webhook"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/capabilities"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider"
@@ -40,6 +41,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/master/ports"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/token/oidc"
 	forked "github.com/GoogleCloudPlatform/kubernetes/third_party/forked/coreos/go-etcd/etcd"
 	systemd "github.com/coreos/go-systemd/daemon"
 
@@ -79,8 +81,16 @@ type APIServer struct {
 	TokenAuthFile              string
 	ServiceAccountKeyFile      string
 	ServiceAccountLookup       bool
+	AuthenticationTokenWebhook webhook.Config
+	OIDCIssuerURL              string
+	OIDCClientID               string
+	OIDCCAFile                 string
+	OIDCUsernameClaim          string
+	OIDCGroupsClaim            string
 	AuthorizationMode          string
 	AuthorizationPolicyFile    string
+	AuthorizationRBACSuperUser string
+	AuthorizationWebhook       webhook.Config
 	AdmissionControl           string
 	AdmissionControlConfigFile string
 	EtcdServerList             util.StringList
@@ -123,6 +133,10 @@ func NewAPIServer() *APIServer {
 		ClusterName:            "kubernetes",
 		CertDirectory:          "/var/run/kubernetes",
 
+		AuthenticationTokenWebhook: webhook.Config{CacheTTL: 2 * time.Minute},
+		OIDCUsernameClaim:          oidc.DefaultUsernameClaim,
+		AuthorizationWebhook:       webhook.Config{CacheTTL: 5 * time.Minute, DenialCacheTTL: 30 * time.Second},
+
 		RuntimeConfig: make(util.ConfigurationMap),
 		KubeletConfig: client.KubeletConfig{
 			Port:        ports.KubeletPort,
@@ -180,8 +194,22 @@ func (s *APIServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.TokenAuthFile, "token-auth-file", s.TokenAuthFile, "If set, the file that will be used to secure the secure port of the API server via token authentication.")
 	fs.StringVar(&s.ServiceAccountKeyFile, "service-account-key-file", s.ServiceAccountKeyFile, "File containing PEM-encoded x509 RSA private or public key, used to verify ServiceAccount tokens. If unspecified, --tls-private-key-file is used.")
 	fs.BoolVar(&s.ServiceAccountLookup, "service-account-lookup", s.ServiceAccountLookup, "If true, validate ServiceAccount tokens exist in etcd as part of authentication.")
+	fs.StringVar(&s.AuthenticationTokenWebhook.URL, "authentication-token-webhook-url", s.AuthenticationTokenWebhook.URL, "If set, the https URL of a service that bearer tokens are POSTed to for authentication.")
+	fs.StringVar(&s.AuthenticationTokenWebhook.CAFile, "authentication-token-webhook-ca-file", s.AuthenticationTokenWebhook.CAFile, "If set, the certificate authorities used to verify the certificate of --authentication-token-webhook-url.  Defaults to the system roots.")
+	fs.DurationVar(&s.AuthenticationTokenWebhook.CacheTTL, "authentication-token-webhook-cache-ttl", s.AuthenticationTokenWebhook.CacheTTL, "How long to cache the answers of --authentication-token-webhook-url.  Zero disables caching.")
+	fs.DurationVar(&s.AuthenticationTokenWebhook.DenialCacheTTL, "authentication-token-webhook-denial-cache-ttl", s.AuthenticationTokenWebhook.DenialCacheTTL, "How long to cache the answers of --authentication-token-webhook-url rejecting a token, at most --authentication-token-webhook-cache-ttl.  Zero disables caching of rejected tokens.")
+	fs.StringVar(&s.OIDCIssuerURL, "oidc-issuer-url", s.OIDCIssuerURL, "If set, the https URL of an OpenID Connect provider whose ID tokens are accepted for authentication.  Its signing keys are discovered from <url>/.well-known/openid-configuration.")
+	fs.StringVar(&s.OIDCClientID, "oidc-client-id", s.OIDCClientID, "The client ID that ID tokens must be issued for, required if --oidc-issuer-url is set.")
+	fs.StringVar(&s.OIDCCAFile, "oidc-ca-file", s.OIDCCAFile, "If set, the certificate authorities used to verify the certificate of --oidc-issuer-url.  Defaults to the system roots.")
+	fs.StringVar(&s.OIDCUsernameClaim, "oidc-username-claim", s.OIDCUsernameClaim, "The ID token claim used as the user name.")
+	fs.StringVar(&s.OIDCGroupsClaim, "oidc-groups-claim", s.OIDCGroupsClaim, "If set, the ID token claim listing the groups of the user.  The claim may be a string or a list of strings.")
 	fs.StringVar(&s.AuthorizationMode, "authorization-mode", s.AuthorizationMode, "Selects how to do authorization on the secure port.  One of: "+strings.Join(apiserver.AuthorizationModeChoices, ","))
 	fs.StringVar(&s.AuthorizationPolicyFile, "authorization-policy-file", s.AuthorizationPolicyFile, "File with authorization policy in csv format, used with --authorization-mode=ABAC, on the secure port.")
+	fs.StringVar(&s.AuthorizationRBACSuperUser, "authorization-rbac-super-user", s.AuthorizationRBACSuperUser, "If specified, a username which is allowed every action by --authorization-mode=RBAC, used to create the initial roles and role bindings.")
+	fs.StringVar(&s.AuthorizationWebhook.URL, "authorization-webhook-url", s.AuthorizationWebhook.URL, "The https URL of a service that requests are POSTed to for authorization, used with --authorization-mode=Webhook.")
+	fs.StringVar(&s.AuthorizationWebhook.CAFile, "authorization-webhook-ca-file", s.AuthorizationWebhook.CAFile, "If set, the certificate authorities used to verify the certificate of --authorization-webhook-url.  Defaults to the system roots.")
+	fs.DurationVar(&s.AuthorizationWebhook.CacheTTL, "authorization-webhook-cache-ttl", s.AuthorizationWebhook.CacheTTL, "How long to cache the decisions of --authorization-webhook-url.  Zero disables caching.")
+	fs.DurationVar(&s.AuthorizationWebhook.DenialCacheTTL, "authorization-webhook-denial-cache-ttl", s.AuthorizationWebhook.DenialCacheTTL, "How long to cache the decisions of --authorization-webhook-url denying a request, at most --authorization-webhook-cache-ttl.  Zero disables caching of denials.")
 	fs.StringVar(&s.AdmissionControl, "admission-control", s.AdmissionControl, "Ordered list of plug-ins to do admission control of resources into cluster. Comma-delimited list of: "+strings.Join(admission.GetPlugins(), ", "))
 	fs.StringVar(&s.AdmissionControlConfigFile, "admission-control-config-file", s.AdmissionControlConfigFile, "File with admission control configuration.")
 	fs.Var(&s.EtcdServerList, "etcd-servers", "List of etcd servers to watch (http://ip:port), comma separated. Mutually exclusive with -etcd-config")
@@ -323,12 +351,12 @@ func (s *APIServer) Run(_ []string) error {
 			glog.Warning("no RSA key provided, service account token authentication disabled")
 		}
 	}
-	authenticator, err := apiserver.NewAuthenticator(s.BasicAuthFile, s.ClientCAFile, s.TokenAuthFile, s.ServiceAccountKeyFile, s.ServiceAccountLookup, helper)
+	authenticator, err := apiserver.NewAuthenticator(s.BasicAuthFile, s.ClientCAFile, s.TokenAuthFile, s.ServiceAccountKeyFile, s.ServiceAccountLookup, s.AuthenticationTokenWebhook, s.OIDCIssuerURL, s.OIDCClientID, s.OIDCCAFile, s.OIDCUsernameClaim, s.OIDCGroupsClaim, helper)
 	if err != nil {
 		glog.Fatalf("Invalid Authentication Config: %v", err)
 	}
 
-	authorizer, err := apiserver.NewAuthorizerFromAuthorizationConfig(s.AuthorizationMode, s.AuthorizationPolicyFile)
+	authorizer, err := apiserver.NewAuthorizerFromAuthorizationConfig(s.AuthorizationMode, s.AuthorizationPolicyFile, s.AuthorizationRBACSuperUser, s.AuthorizationWebhook, helper)
 	if err != nil {
 		glog.Fatalf("Invalid Authorization Config: %v", err)
 	}
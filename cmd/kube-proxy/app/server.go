@@ -19,6 +19,7 @@ limitations under the License.
 package app
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 	_ "net/http/pprof"
@@ -31,11 +32,13 @@ import (
 	clientcmdapi "github.com/GoogleCloudPlatform/kubernetes/pkg/client/clientcmd/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/proxy"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/proxy/config"
+	proxyiptables "github.com/GoogleCloudPlatform/kubernetes/pkg/proxy/iptables"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/iptables"
 
 	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/pflag"
 )
 
@@ -49,8 +52,16 @@ type ProxyServer struct {
 	Master             string
 	Kubeconfig         string
 	PortRange          util.PortRange
+	ProxyMode          string
+	IptablesSyncPeriod time.Duration
 }
 
+// The values accepted by --proxy-mode.
+const (
+	proxyModeUserspace = "userspace"
+	proxyModeIptables  = "iptables"
+)
+
 // NewProxyServer creates a new ProxyServer object with default parameters
 func NewProxyServer() *ProxyServer {
 	return &ProxyServer{
@@ -59,6 +70,8 @@ func NewProxyServer() *ProxyServer {
 		HealthzBindAddress: util.IP(net.ParseIP("127.0.0.1")),
 		OOMScoreAdj:        -899,
 		ResourceContainer:  "/kube-proxy",
+		ProxyMode:          proxyModeUserspace,
+		IptablesSyncPeriod: 30 * time.Second,
 	}
 }
 
@@ -72,6 +85,8 @@ func (s *ProxyServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.ResourceContainer, "resource-container", s.ResourceContainer, "Absolute name of the resource-only container to create and run the Kube-proxy in (Default: /kube-proxy).")
 	fs.StringVar(&s.Kubeconfig, "kubeconfig", s.Kubeconfig, "Path to kubeconfig file with authorization information (the master location is set by the master flag).")
 	fs.Var(&s.PortRange, "proxy-port-range", "Range of host ports (beginPort-endPort, inclusive) that may be consumed in order to proxy service traffic. If unspecified (0-0) then ports will be randomly chosen.")
+	fs.StringVar(&s.ProxyMode, "proxy-mode", s.ProxyMode, "Which proxy mode to use: 'userspace' (older, stable) or 'iptables' (faster, requires iptables 1.4.11 or newer). If the node cannot run the iptables proxier, the userspace proxier is used instead. The iptables proxier ignores services' loadBalancingPolicy and always picks backends at random.")
+	fs.DurationVar(&s.IptablesSyncPeriod, "iptables-sync-period", s.IptablesSyncPeriod, "How often iptables rules are refreshed in iptables proxy mode (e.g. '5s', '1m', '2h22m').  Must be greater than 0.")
 }
 
 // Run runs the specified ProxyServer.  This should never exit.
@@ -88,6 +103,13 @@ func (s *ProxyServer) Run(_ []string) error {
 		glog.V(2).Infof("Running in resource-only container %q", s.ResourceContainer)
 	}
 
+	if s.ProxyMode != proxyModeUserspace && s.ProxyMode != proxyModeIptables {
+		return fmt.Errorf("unknown proxy mode %q, must be %q or %q", s.ProxyMode, proxyModeUserspace, proxyModeIptables)
+	}
+	if s.ProxyMode == proxyModeIptables && s.IptablesSyncPeriod <= 0 {
+		return fmt.Errorf("--iptables-sync-period must be greater than 0")
+	}
+
 	serviceConfig := config.NewServiceConfig()
 	endpointsConfig := config.NewEndpointsConfig()
 
@@ -95,16 +117,49 @@ func (s *ProxyServer) Run(_ []string) error {
 	if net.IP(s.BindAddress).To4() == nil {
 		protocol = iptables.ProtocolIpv6
 	}
-	loadBalancer := proxy.NewLoadBalancerRR()
-	proxier, err := proxy.NewProxier(loadBalancer, net.IP(s.BindAddress), iptables.New(exec.New(), protocol), s.PortRange)
-	if err != nil {
-		glog.Fatalf("Unable to create proxer: %v", err)
+	execer := exec.New()
+	ipt := iptables.New(execer, protocol)
+
+	useIptables := false
+	if s.ProxyMode == proxyModeIptables {
+		if err := proxyiptables.CanUseIptablesProxier(execer); err != nil {
+			glog.Warningf("Can't use the iptables proxier, falling back to the userspace proxier: %v", err)
+		} else {
+			useIptables = true
+		}
 	}
 
-	// Wire proxier to handle changes to services
-	serviceConfig.RegisterHandler(proxier)
-	// And wire loadBalancer to handle changes to endpoints to services
-	endpointsConfig.RegisterHandler(loadBalancer)
+	var syncLoop func()
+	if useIptables {
+		glog.V(2).Info("Using iptables proxier.")
+		// Remove the userspace proxier's rules, which would otherwise
+		// intercept service traffic before ours.
+		proxy.CleanupLeftovers(ipt)
+		proxier, err := proxyiptables.NewProxier(ipt, s.IptablesSyncPeriod)
+		if err != nil {
+			glog.Fatalf("Unable to create proxier: %v", err)
+		}
+		serviceConfig.RegisterHandler(config.ServiceConfigHandlerFunc(proxier.OnServiceUpdate))
+		endpointsConfig.RegisterHandler(config.EndpointsConfigHandlerFunc(proxier.OnEndpointsUpdate))
+		syncLoop = proxier.SyncLoop
+	} else {
+		glog.V(2).Info("Using userspace proxier.")
+		// Remove any rules left behind by the iptables proxier, which
+		// would otherwise bypass this one.
+		proxyiptables.CleanupLeftovers(ipt)
+		proxy.RegisterMetrics()
+		loadBalancer := proxy.NewLoadBalancerPolicy()
+		proxier, err := proxy.NewProxier(loadBalancer, net.IP(s.BindAddress), ipt, s.PortRange)
+		if err != nil {
+			glog.Fatalf("Unable to create proxer: %v", err)
+		}
+
+		// Wire proxier to handle changes to services
+		serviceConfig.RegisterHandler(proxier)
+		// And wire loadBalancer to handle changes to endpoints to services
+		endpointsConfig.RegisterHandler(loadBalancer)
+		syncLoop = proxier.SyncLoop
+	}
 
 	// Note: RegisterHandler() calls need to happen before creation of Sources because sources
 	// only notify on changes, and the initial update (on process start) may be lost if no handlers
@@ -138,6 +193,7 @@ func (s *ProxyServer) Run(_ []string) error {
 	)
 
 	if s.HealthzPort > 0 {
+		http.Handle("/metrics", prometheus.Handler())
 		go util.Forever(func() {
 			err := http.ListenAndServe(s.HealthzBindAddress.String()+":"+strconv.Itoa(s.HealthzPort), nil)
 			if err != nil {
@@ -147,6 +203,6 @@ func (s *ProxyServer) Run(_ []string) error {
 	}
 
 	// Just loop forever for now...
-	proxier.SyncLoop()
+	syncLoop()
 	return nil
 }
@@ -109,7 +109,7 @@ func runApiServer(etcdClient tools.EtcdClient, addr net.IP, port int, masterServ
 // RunScheduler starts up a scheduler in it's own goroutine
 func runScheduler(cl *client.Client) {
 	// Scheduler
-	schedulerConfigFactory := factory.NewConfigFactory(cl)
+	schedulerConfigFactory := factory.NewConfigFactory(cl, factory.DefaultSchedulerName)
 	schedulerConfig, err := schedulerConfigFactory.Create()
 	if err != nil {
 		glog.Fatalf("Couldn't create scheduler config: %v", err)
@@ -33,6 +33,11 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider/routecontroller"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider/servicecontroller"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/daemon"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/deployment"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/job"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/podautoscaler"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/podautoscaler/metrics"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/healthz"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/namespace"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/resourcequota"
@@ -113,6 +118,15 @@ func (s *CMServer) Run(_ []string) error {
 	controllerManager := controller.NewReplicationManager(kubeClient, controller.BurstReplicas)
 	go controllerManager.Run(s.ConcurrentRCSyncs, util.NeverStop)
 
+	deploymentController := deployment.NewDeploymentController(kubeClient)
+	go deploymentController.Run(s.ConcurrentDeploymentSyncs, util.NeverStop)
+
+	daemonSetsController := daemon.NewDaemonSetsController(kubeClient)
+	go daemonSetsController.Run(s.ConcurrentDaemonSetSyncs, util.NeverStop)
+
+	jobController := job.NewJobController(kubeClient)
+	go jobController.Run(s.ConcurrentJobSyncs, util.NeverStop)
+
 	//TODO(jdef) should eventually support more cloud providers here
 	if s.CloudProvider != mesos.ProviderName {
 		glog.Fatalf("Only provider %v is supported, you specified %v", mesos.ProviderName, s.CloudProvider)
@@ -141,6 +155,9 @@ func (s *CMServer) Run(_ []string) error {
 	resourceQuotaManager := resourcequota.NewResourceQuotaManager(kubeClient)
 	resourceQuotaManager.Run(s.ResourceQuotaSyncPeriod)
 
+	podAutoscaler := podautoscaler.NewHorizontalController(kubeClient, metrics.NewDefaultMetricsClient())
+	podAutoscaler.Run(s.HorizontalPodAutoscalerSyncPeriod)
+
 	namespaceManager := namespace.NewNamespaceManager(kubeClient, s.NamespaceSyncPeriod)
 	namespaceManager.Run()
 
@@ -177,6 +177,14 @@ func (s *KubeletExecutorServer) Run(hks hyperkube.Interface, _ []string) error {
 		RootFreeDiskMB:   s.LowDiskSpaceThresholdMB,
 	}
 
+	evictionThresholds, err := kubelet.ParseEvictionThresholds(s.EvictionHard, s.EvictionSoft, s.EvictionSoftGracePeriod)
+	if err != nil {
+		return err
+	}
+	evictionPolicy := kubelet.EvictionPolicy{
+		Thresholds: evictionThresholds,
+	}
+
 	//TODO(jdef) intentionally NOT initializing a cloud provider here since:
 	//(a) the kubelet doesn't actually use it
 	//(b) we don't need to create N-kubelet connections to zookeeper for no good reason
@@ -246,11 +254,13 @@ func (s *KubeletExecutorServer) Run(hks hyperkube.Interface, _ []string) error {
 		TLSOptions:                     tlsOptions,
 		ImageGCPolicy:                  imageGCPolicy,
 		DiskSpacePolicy:                diskSpacePolicy,
+		EvictionPolicy:                 evictionPolicy,
 		Cloud:                          nil, // TODO(jdef) Cloud, specifying null here because we don't want all kubelets polling mesos-master; need to account for this in the cloudprovider impl
 		NodeStatusUpdateFrequency: s.NodeStatusUpdateFrequency,
 		ResourceContainer:         s.ResourceContainer,
 		CgroupRoot:                s.CgroupRoot,
 		ContainerRuntime:          s.ContainerRuntime,
+		ContainerRuntimeEndpoint:  s.ContainerRuntimeEndpoint,
 		Mounter:                   mounter,
 		DockerDaemonContainer:     s.DockerDaemonContainer,
 		SystemContainer:           s.SystemContainer,
@@ -344,12 +354,14 @@ func (ks *KubeletExecutorServer) createAndInitKubelet(
 		kc.CadvisorInterface,
 		kc.ImageGCPolicy,
 		kc.DiskSpacePolicy,
+		kc.EvictionPolicy,
 		kc.Cloud,
 		kc.NodeStatusUpdateFrequency,
 		kc.ResourceContainer,
 		kc.OSInterface,
 		kc.CgroupRoot,
 		kc.ContainerRuntime,
+		kc.ContainerRuntimeEndpoint,
 		kc.Mounter,
 		kc.DockerDaemonContainer,
 		kc.SystemContainer,
@@ -357,6 +369,8 @@ func (ks *KubeletExecutorServer) createAndInitKubelet(
 		kc.PodCIDR,
 		kc.MaxPods,
 		kc.DockerExecHandler,
+		kc.CgroupsPerQOS,
+		kc.SystemReserved,
 	)
 	if err != nil {
 		return nil, nil, err
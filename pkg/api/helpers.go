@@ -91,6 +91,17 @@ func IsStandardResourceName(str string) bool {
 	return standardResources.Has(str)
 }
 
+// standardQuotaResources are the resources a quota may track on top of the
+// standard ones.
+var standardQuotaResources = util.NewStringSet(
+	string(ResourceLimitsCPU),
+	string(ResourceLimitsMemory))
+
+// IsStandardQuotaResourceName returns true if a quota may track the resource.
+func IsStandardQuotaResourceName(str string) bool {
+	return standardResources.Has(str) || standardQuotaResources.Has(str)
+}
+
 // NewDeleteOptions returns a DeleteOptions indicating the resource should
 // be deleted within the specified grace period. Use zero to indicate
 // immediate deletion. If you would prefer to use the default grace period,
@@ -113,6 +113,51 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 			// only replicas round trips
 			j.Replicas = int(c.RandUint64())
 		},
+		func(j *api.DeploymentSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// revisionHistoryLimit is defaulted when unset, so it must be set to round trip
+			if j.RevisionHistoryLimit == nil {
+				limit := c.Intn(10)
+				j.RevisionHistoryLimit = &limit
+			}
+		},
+		func(j *api.JobSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// completions and parallelism are defaulted when unset, so they must be set to round trip
+			completions := c.Intn(10)
+			parallelism := c.Intn(10)
+			j.Completions = &completions
+			j.Parallelism = &parallelism
+		},
+		func(j *api.HorizontalPodAutoscalerSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// minReplicas and the CPU target are defaulted when unset, so they must be set to round trip
+			minReplicas := c.Intn(10)
+			target := c.Intn(100)
+			j.MinReplicas = &minReplicas
+			j.TargetCPUUtilizationPercentage = &target
+		},
+		func(j *api.DeploymentStrategy, c fuzz.Continue) {
+			// rollingUpdate parameters are defaulted for the RollingUpdate strategy
+			if c.RandBool() {
+				j.Type = api.RecreateDeploymentStrategyType
+				j.RollingUpdate = nil
+			} else {
+				j.Type = api.RollingUpdateDeploymentStrategyType
+				j.RollingUpdate = &api.RollingUpdateDeployment{}
+				c.Fuzz(&j.RollingUpdate.MaxUnavailable)
+				c.Fuzz(&j.RollingUpdate.MaxSurge)
+			}
+		},
+		func(j **util.IntOrString, c fuzz.Continue) {
+			// IntOrString only fuzzes itself through a non-nil pointer
+			if c.RandBool() {
+				*j = &util.IntOrString{}
+				c.Fuzz(*j)
+			} else {
+				*j = nil
+			}
+		},
 		func(j *api.List, c fuzz.Continue) {
 			c.FuzzNoCustom(j) // fuzz self without calling this function again
 			// TODO: uncomment when round trip starts from a versioned object
@@ -149,6 +194,18 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 				c.RandString(): c.RandString(),
 			}
 		},
+		func(r *api.ResourceRequirements, c fuzz.Continue) {
+			c.FuzzNoCustom(r) // fuzz self without calling this function again
+			// Containers' requests default to their limits.
+			for name, limit := range r.Limits {
+				if _, found := r.Requests[name]; !found {
+					if r.Requests == nil {
+						r.Requests = api.ResourceList{}
+					}
+					r.Requests[name] = limit
+				}
+			}
+		},
 		func(q *resource.Quantity, c fuzz.Continue) {
 			// Real Quantity fuzz testing is done elsewhere;
 			// this limited subset of functionality survives
@@ -186,6 +243,14 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 			types := []api.ServiceAffinity{api.ServiceAffinityClientIP, api.ServiceAffinityNone}
 			*p = types[c.Rand.Intn(len(types))]
 		},
+		func(p *api.ServiceLoadBalancingPolicy, c fuzz.Continue) {
+			policies := []api.ServiceLoadBalancingPolicy{api.ServiceLoadBalancingRoundRobin, api.ServiceLoadBalancingLeastConnections, api.ServiceLoadBalancingWeighted, api.ServiceLoadBalancingRandomTwoChoices}
+			*p = policies[c.Rand.Intn(len(policies))]
+		},
+		func(p *api.TolerationOperator, c fuzz.Continue) {
+			operators := []api.TolerationOperator{api.TolerationOpEqual, api.TolerationOpExists}
+			*p = operators[c.Rand.Intn(len(operators))]
+		},
 		func(p *api.ServiceType, c fuzz.Continue) {
 			types := []api.ServiceType{api.ServiceTypeClusterIP, api.ServiceTypeNodePort, api.ServiceTypeLoadBalancer}
 			*p = types[c.Rand.Intn(len(types))]
@@ -655,6 +655,12 @@ type Probe struct {
 	InitialDelaySeconds int64 `json:"initialDelaySeconds,omitempty"`
 	// Length of time before health checking times out.  In seconds.
 	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty"`
+	// How often to perform the probe.  In seconds.
+	PeriodSeconds int64 `json:"periodSeconds,omitempty"`
+	// Minimum consecutive successes for the probe to be considered successful after having failed.
+	SuccessThreshold int `json:"successThreshold,omitempty"`
+	// Minimum consecutive failures for the probe to be considered failed after having succeeded.
+	FailureThreshold int `json:"failureThreshold,omitempty"`
 }
 
 // PullPolicy describes a policy for if/when to pull a container image
@@ -682,12 +688,12 @@ type Capabilities struct {
 
 // ResourceRequirements describes the compute resource requirements.
 type ResourceRequirements struct {
-	// Limits describes the maximum amount of compute resources required.
+	// Limits describes the maximum amount of compute resources allowed.
+	// The kubelet enforces the memory limit; the CPU limit is not enforced yet.
 	Limits ResourceList `json:"limits,omitempty"`
 	// Requests describes the minimum amount of compute resources required.
-	// Note: 'Requests' are honored only for Persistent Volumes as of now.
-	// TODO: Update the scheduler to use 'Requests' in addition to 'Limits'. If Request is omitted for a container,
-	// it defaults to Limits if that is explicitly specified, otherwise to an implementation-defined value
+	// The scheduler reserves them on the node.  If Requests is omitted for a
+	// container, it defaults to Limits if that is explicitly specified.
 	Requests ResourceList `json:"requests,omitempty"`
 }
 
@@ -885,9 +891,191 @@ const (
 	DNSDefault DNSPolicy = "Default"
 )
 
+// A node selector represents the union of the results of one or more label queries
+// over a set of nodes; that is, it represents the OR of the selectors represented
+// by the node selector terms.
+type NodeSelector struct {
+	// Required: A list of node selector terms. The terms are ORed.
+	NodeSelectorTerms []NodeSelectorTerm `json:"nodeSelectorTerms"`
+}
+
+// A null or empty node selector term matches no objects.
+type NodeSelectorTerm struct {
+	// Required: A list of node selector requirements. The requirements are ANDed.
+	MatchExpressions []NodeSelectorRequirement `json:"matchExpressions"`
+}
+
+// A node selector requirement is a selector that contains values, a key, and an operator
+// that relates the key and values.
+type NodeSelectorRequirement struct {
+	// The label key that the selector applies to.
+	Key string `json:"key"`
+	// Represents a key's relationship to a set of values.
+	// Valid operators are In, NotIn, Exists, DoesNotExist, Gt, and Lt.
+	Operator NodeSelectorOperator `json:"operator"`
+	// An array of string values. If the operator is In or NotIn,
+	// the values array must be non-empty. If the operator is Exists or DoesNotExist,
+	// the values array must be empty. If the operator is Gt or Lt, the values
+	// array must have a single element, which will be interpreted as an integer.
+	Values []string `json:"values,omitempty"`
+}
+
+// A node selector operator is the set of operators that can be used in
+// a node selector requirement.
+type NodeSelectorOperator string
+
+const (
+	NodeSelectorOpIn           NodeSelectorOperator = "In"
+	NodeSelectorOpNotIn        NodeSelectorOperator = "NotIn"
+	NodeSelectorOpExists       NodeSelectorOperator = "Exists"
+	NodeSelectorOpDoesNotExist NodeSelectorOperator = "DoesNotExist"
+	NodeSelectorOpGt           NodeSelectorOperator = "Gt"
+	NodeSelectorOpLt           NodeSelectorOperator = "Lt"
+)
+
+// Affinity is a group of affinity scheduling rules.
+type Affinity struct {
+	// Describes node affinity scheduling rules for the pod.
+	NodeAffinity *NodeAffinity `json:"nodeAffinity,omitempty"`
+	// Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).
+	PodAffinity *PodAffinity `json:"podAffinity,omitempty"`
+	// Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).
+	PodAntiAffinity *PodAntiAffinity `json:"podAntiAffinity,omitempty"`
+}
+
+// Node affinity is a group of node affinity scheduling rules.
+type NodeAffinity struct {
+	// If the affinity requirements specified by this field are not met at
+	// scheduling time, the pod will not be scheduled onto the node.
+	// If the affinity requirements specified by this field cease to be met
+	// at some point during pod execution (e.g. due to a node label update),
+	// the pod is not evicted.
+	RequiredDuringSchedulingIgnoredDuringExecution *NodeSelector `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// The scheduler will prefer to schedule pods to nodes that satisfy
+	// the affinity expressions specified by this field, but it may choose
+	// a node that violates one or more of the expressions. The node that is
+	// most preferred is the one with the greatest sum of weights, i.e.
+	// for each node that meets all of the scheduling requirements (resource
+	// request, required affinity expressions, etc.), compute a sum by iterating
+	// through the elements of this field and adding "weight" to the sum if the node
+	// matches the corresponding matchExpressions; the node(s) with the highest sum
+	// are the most preferred.
+	PreferredDuringSchedulingIgnoredDuringExecution []PreferredSchedulingTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// An empty preferred scheduling term matches all objects with implicit weight 0
+// (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+type PreferredSchedulingTerm struct {
+	// Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.
+	Weight int `json:"weight"`
+	// A node selector term, associated with the corresponding weight.
+	Preference NodeSelectorTerm `json:"preference"`
+}
+
+// Pod affinity is a group of inter pod affinity scheduling rules.
+type PodAffinity struct {
+	// If the affinity requirements specified by this field are not met at
+	// scheduling time, the pod will not be scheduled onto the node.
+	// If the affinity requirements specified by this field cease to be met
+	// at some point during pod execution (e.g. due to a pod label update), the
+	// system will not try to eventually evict the pod from its node.
+	// When there are multiple elements, the lists of nodes corresponding to each
+	// podAffinityTerm are intersected, i.e. all terms must be satisfied.
+	RequiredDuringSchedulingIgnoredDuringExecution []PodAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// The scheduler will prefer to schedule pods to nodes that satisfy
+	// the affinity expressions specified by this field, but it may choose
+	// a node that violates one or more of the expressions. The node that is
+	// most preferred is the one with the greatest sum of weights, i.e.
+	// for each node that meets all of the scheduling requirements, compute a sum
+	// by iterating through the elements of this field and adding "weight" to the
+	// sum if the node has pods which match the corresponding podAffinityTerm; the
+	// node(s) with the highest sum are the most preferred.
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPodAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// Pod anti affinity is a group of inter pod anti affinity scheduling rules.
+type PodAntiAffinity struct {
+	// If the anti-affinity requirements specified by this field are not met at
+	// scheduling time, the pod will not be scheduled onto the node.
+	// If the anti-affinity requirements specified by this field cease to be met
+	// at some point during pod execution (e.g. due to a pod label update), the
+	// system will not try to eventually evict the pod from its node.
+	// When there are multiple elements, the lists of nodes corresponding to each
+	// podAffinityTerm are intersected, i.e. all terms must be satisfied.
+	RequiredDuringSchedulingIgnoredDuringExecution []PodAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// The scheduler will prefer to schedule pods to nodes that satisfy
+	// the anti-affinity expressions specified by this field, but it may choose
+	// a node that violates one or more of the expressions. The node that is
+	// most preferred is the one with the greatest sum of weights, i.e.
+	// for each node that meets all of the scheduling requirements, compute a sum
+	// by iterating through the elements of this field and adding "weight" to the
+	// sum if the node has pods which match the corresponding podAffinityTerm; the
+	// node(s) with the highest sum are the least preferred.
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPodAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// The weights of all of the matched WeightedPodAffinityTerm fields are added per-node
+// to find the most preferred node(s).
+type WeightedPodAffinityTerm struct {
+	// Weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
+	Weight int `json:"weight"`
+	// A pod affinity term, associated with the corresponding weight.
+	PodAffinityTerm PodAffinityTerm `json:"podAffinityTerm"`
+}
+
+// Defines a set of pods (namely those matching the selector relative to the
+// given namespace(s)) that this pod should be co-located (affinity) or not
+// co-located (anti-affinity) with, where co-located is defined as running on
+// a node whose value of the label with key <topologyKey> matches that of any
+// node on which a pod of the set of pods is running.
+type PodAffinityTerm struct {
+	// A label query over the set of pods.  An empty selector matches all pods.
+	Selector map[string]string `json:"selector,omitempty"`
+	// Namespaces specifies which namespaces the selector applies to (matches
+	// against); empty means "this pod's namespace".
+	Namespaces []string `json:"namespaces,omitempty"`
+	// This pod should be co-located (affinity) or not co-located (anti-affinity)
+	// with the pods matching the selector in the specified namespaces, where
+	// co-located is defined as running on a node whose value of the label with
+	// key topologyKey matches that of any node on which any of the selected pods
+	// is running.  Use "kubernetes.io/hostname" to mean the same node.
+	TopologyKey string `json:"topologyKey"`
+}
+
+// A toleration operator is the set of operators that can be used in a toleration.
+type TolerationOperator string
+
+const (
+	TolerationOpExists TolerationOperator = "Exists"
+	TolerationOpEqual  TolerationOperator = "Equal"
+)
+
+// The pod this Toleration is attached to tolerates any taint that matches
+// the triple <key,value,effect> using the matching operator <operator>.
+type Toleration struct {
+	// Key is the taint key that the toleration applies to. Empty means match all taint keys.
+	// If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+	Key string `json:"key,omitempty"`
+	// Operator represents a key's relationship to the value.
+	// Valid operators are Exists and Equal. Defaults to Equal.
+	// Exists is equivalent to wildcard for value, so that a pod can
+	// tolerate all taints of a particular category.
+	Operator TolerationOperator `json:"operator,omitempty"`
+	// Value is the taint value the toleration matches to.
+	// If the operator is Exists, the value should be empty, otherwise just a regular string.
+	Value string `json:"value,omitempty"`
+	// Effect indicates the taint effect to match. Empty means match all taint effects.
+	Effect TaintEffect `json:"effect,omitempty"`
+}
+
 // PodSpec is a description of a pod
 type PodSpec struct {
 	Volumes []Volume `json:"volumes"`
+	// Optional: containers run one at a time, in order, each to successful
+	// completion before the next one, and all before the containers of the pod
+	// start. A failing init container is restarted according to the
+	// RestartPolicy of the pod.
+	InitContainers []Container `json:"initContainers,omitempty"`
 	// Required: there must be at least one container in a pod.
 	Containers    []Container   `json:"containers"`
 	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty"`
@@ -905,6 +1093,15 @@ type PodSpec struct {
 	DNSPolicy DNSPolicy `json:"dnsPolicy,omitempty"`
 	// NodeSelector is a selector which must be true for the pod to fit on a node
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Affinity holds the pod's scheduling constraints beyond NodeSelector.
+	Affinity *Affinity `json:"affinity,omitempty"`
+	// Priority is the scheduling priority of the pod.  Higher values are more
+	// important.  When no node fits the pod, the scheduler may preempt pods
+	// with a strictly lower priority to make room for it.  Must be between 0
+	// and 1000000000; the PodPriority admission plugin may lower the limit.
+	Priority int `json:"priority,omitempty"`
+	// If specified, the pod's tolerations of node taints.
+	Tolerations []Toleration `json:"tolerations,omitempty"`
 
 	// ServiceAccountName is the name of the ServiceAccount to use to run this pod
 	// The pod will be allowed to use secrets referenced by the ServiceAccount
@@ -947,6 +1144,8 @@ type PodStatus struct {
 	// TODO: Make real decisions about what our info should look like. Re-enable fuzz test
 	// when we have done this.
 	ContainerStatuses []ContainerStatus `json:"containerStatuses,omitempty"`
+	// The statuses of the init containers of the pod, in the order of the spec.
+	InitContainerStatuses []ContainerStatus `json:"initContainerStatuses,omitempty"`
 }
 
 // PodStatusResult is a wrapper for PodStatus returned by kubelet that can be encode/decoded
@@ -1050,6 +1249,310 @@ type ReplicationControllerList struct {
 	Items []ReplicationController `json:"items"`
 }
 
+// DeploymentSpec is the specification of the desired behavior of a Deployment.
+type DeploymentSpec struct {
+	// Replicas is the number of desired pods.
+	Replicas int `json:"replicas"`
+
+	// Selector is a label query over the pods managed by the deployment.  It
+	// must match the labels of Template.
+	Selector map[string]string `json:"selector"`
+
+	// Template describes the pods that will be created.
+	Template *PodTemplateSpec `json:"template,omitempty"`
+
+	// Strategy is how existing pods are replaced with new ones.
+	Strategy DeploymentStrategy `json:"strategy,omitempty"`
+
+	// RevisionHistoryLimit is the number of old, scaled down replication
+	// controllers to keep around so that the deployment can be rolled back.
+	RevisionHistoryLimit *int `json:"revisionHistoryLimit,omitempty"`
+
+	// RollbackTo, if set, asks the deployment controller to replace Template
+	// with the one of an earlier revision.  It is cleared once the rollback
+	// has been started.
+	RollbackTo *RollbackConfig `json:"rollbackTo,omitempty"`
+}
+
+// RollbackConfig names the revision a deployment should be rolled back to.
+type RollbackConfig struct {
+	// Revision to roll back to.  If zero, roll back to the previous revision.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// DeploymentStrategyType is the kind of strategy used to replace pods.
+type DeploymentStrategyType string
+
+const (
+	// RecreateDeploymentStrategyType kills all existing pods before creating new ones.
+	RecreateDeploymentStrategyType DeploymentStrategyType = "Recreate"
+
+	// RollingUpdateDeploymentStrategyType gradually replaces old pods with new ones.
+	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+)
+
+// DeploymentStrategy describes how to replace existing pods with new ones.
+type DeploymentStrategy struct {
+	// Type of deployment. Can be "Recreate" or "RollingUpdate".
+	Type DeploymentStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate holds the parameters of the RollingUpdate strategy.
+	RollingUpdate *RollingUpdateDeployment `json:"rollingUpdate,omitempty"`
+}
+
+// RollingUpdateDeployment holds the parameters of a rolling update.
+type RollingUpdateDeployment struct {
+	// MaxUnavailable is the maximum number of pods, or percentage of the
+	// desired replicas, that can be unavailable during the update.  Percentages
+	// are rounded down.
+	MaxUnavailable util.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MaxSurge is the maximum number of pods, or percentage of the desired
+	// replicas, that can be created above the desired replicas during the
+	// update.  Percentages are rounded up.
+	MaxSurge util.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// DeploymentStatus is the most recently observed status of a Deployment.
+type DeploymentStatus struct {
+	// Replicas is the total number of pods targeted by the deployment.
+	Replicas int `json:"replicas,omitempty"`
+
+	// UpdatedReplicas is the number of pods created from the current template.
+	UpdatedReplicas int `json:"updatedReplicas,omitempty"`
+
+	// Revision is the revision of the current template.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// Deployment declaratively manages a set of pods through replication
+// controllers, rolling out changes to its template server side.
+type Deployment struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired behavior of the deployment.
+	Spec DeploymentSpec `json:"spec,omitempty"`
+
+	// Status is the most recently observed status of the deployment.
+	Status DeploymentStatus `json:"status,omitempty"`
+}
+
+// DeploymentList is a collection of deployments.
+type DeploymentList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Deployment `json:"items"`
+}
+
+// DaemonSetSpec is the specification of a daemon set.
+type DaemonSetSpec struct {
+	// Selector is a label query over the pods managed by the daemon set.  It
+	// must match the labels of Template.
+	Selector map[string]string `json:"selector"`
+
+	// Template describes the pod that will be run on every eligible node.
+	// Nodes are eligible if their labels match Template.Spec.NodeSelector,
+	// or every node if it is empty.
+	Template *PodTemplateSpec `json:"template,omitempty"`
+}
+
+// DaemonSetStatus represents the current status of a daemon set.
+type DaemonSetStatus struct {
+	// CurrentNumberScheduled is the number of nodes that are running exactly
+	// one daemon pod and are supposed to run it.
+	CurrentNumberScheduled int `json:"currentNumberScheduled"`
+
+	// NumberMisscheduled is the number of nodes that are running a daemon pod
+	// but are not supposed to.
+	NumberMisscheduled int `json:"numberMisscheduled"`
+
+	// DesiredNumberScheduled is the number of nodes that should be running
+	// the daemon pod.
+	DesiredNumberScheduled int `json:"desiredNumberScheduled"`
+
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// DaemonSet runs a copy of a pod on every node whose labels match the pod
+// template's node selector.
+type DaemonSet struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired behavior of the daemon set.
+	Spec DaemonSetSpec `json:"spec,omitempty"`
+
+	// Status is the most recently observed status of the daemon set.
+	Status DaemonSetStatus `json:"status,omitempty"`
+}
+
+// DaemonSetList is a collection of daemon sets.
+type DaemonSetList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []DaemonSet `json:"items"`
+}
+
+// JobSpec describes how the job execution will look like.
+type JobSpec struct {
+	// Parallelism specifies the maximum desired number of pods the job should
+	// run at any given time.  The actual number of pods running in steady state
+	// will be less than this number when the work left to do is less than the
+	// max parallelism.
+	Parallelism *int `json:"parallelism,omitempty"`
+
+	// Completions specifies the desired number of successfully finished pods
+	// the job should be run with.
+	Completions *int `json:"completions,omitempty"`
+
+	// ActiveDeadlineSeconds is the duration in seconds relative to the
+	// startTime that the job may be active before the system tries to
+	// terminate it.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Selector is a label query over pods that should match the pod count.
+	Selector map[string]string `json:"selector"`
+
+	// Template is the object that describes the pod that will be created
+	// when executing a job.  Its restart policy must be OnFailure or Never.
+	Template *PodTemplateSpec `json:"template,omitempty"`
+}
+
+// JobStatus represents the current state of a Job.
+type JobStatus struct {
+	// Conditions represent the latest available observations of an object's current state.
+	Conditions []JobCondition `json:"conditions,omitempty"`
+
+	// StartTime represents time when the job was acknowledged by the Job Manager.
+	StartTime *util.Time `json:"startTime,omitempty"`
+
+	// CompletionTime represents time when the job was completed.
+	CompletionTime *util.Time `json:"completionTime,omitempty"`
+
+	// Active is the number of actively running pods.
+	Active int `json:"active,omitempty"`
+
+	// Succeeded is the number of pods which reached phase Succeeded.
+	Succeeded int `json:"succeeded,omitempty"`
+
+	// Failed is the number of pods which reached phase Failed.
+	Failed int `json:"failed,omitempty"`
+}
+
+type JobConditionType string
+
+// These are valid conditions of a job.
+const (
+	// JobComplete means the job has completed its execution.
+	JobComplete JobConditionType = "Complete"
+	// JobFailed means the job has failed its execution.
+	JobFailed JobConditionType = "Failed"
+)
+
+// JobCondition describes current state of a job.
+type JobCondition struct {
+	Type               JobConditionType `json:"type"`
+	Status             ConditionStatus  `json:"status"`
+	LastProbeTime      util.Time        `json:"lastProbeTime,omitempty"`
+	LastTransitionTime util.Time        `json:"lastTransitionTime,omitempty"`
+	Reason             string           `json:"reason,omitempty"`
+	Message            string           `json:"message,omitempty"`
+}
+
+// Job represents the configuration of a single job, which runs pods until a
+// given number of them have terminated successfully.
+type Job struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is a structure defining the expected behavior of a job.
+	Spec JobSpec `json:"spec,omitempty"`
+
+	// Status is a structure describing current status of a job.
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// JobList is a collection of jobs.
+type JobList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Job `json:"items"`
+}
+
+// ScaleTargetReference names the object whose replica count an autoscaler
+// adjusts.
+type ScaleTargetReference struct {
+	// Kind of the referent.  Only "ReplicationController" is supported.
+	Kind string `json:"kind"`
+
+	// Name of the referent, which must be in the same namespace as the autoscaler.
+	Name string `json:"name"`
+}
+
+// HorizontalPodAutoscalerSpec is the specification of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerSpec struct {
+	// ScaleRef is the object whose replicas are scaled.
+	ScaleRef ScaleTargetReference `json:"scaleRef"`
+
+	// MinReplicas is the lower limit for the number of replicas the autoscaler
+	// can scale down to.
+	MinReplicas *int `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of replicas the autoscaler
+	// can scale up to.  It cannot be smaller than MinReplicas.
+	MaxReplicas int `json:"maxReplicas"`
+
+	// TargetCPUUtilizationPercentage is the target average CPU utilization of
+	// the pods, as a percentage of the CPU they request.
+	TargetCPUUtilizationPercentage *int `json:"targetCPUUtilizationPercentage,omitempty"`
+}
+
+// HorizontalPodAutoscalerStatus is the current status of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerStatus struct {
+	// LastScaleTime is the last time the autoscaler changed the number of
+	// replicas, used to avoid scaling again too soon.
+	LastScaleTime *util.Time `json:"lastScaleTime,omitempty"`
+
+	// CurrentReplicas is the number of replicas last seen by the autoscaler.
+	CurrentReplicas int `json:"currentReplicas"`
+
+	// DesiredReplicas is the number of replicas the autoscaler last asked for.
+	DesiredReplicas int `json:"desiredReplicas"`
+
+	// CurrentCPUUtilizationPercentage is the average CPU utilization of the
+	// pods last seen by the autoscaler, as a percentage of the CPU they request.
+	CurrentCPUUtilizationPercentage *int `json:"currentCPUUtilizationPercentage,omitempty"`
+}
+
+// HorizontalPodAutoscaler automatically adjusts the number of replicas of a
+// replication controller so that its pods use a target share of the CPU they request.
+type HorizontalPodAutoscaler struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the behaviour of the autoscaler.
+	Spec HorizontalPodAutoscalerSpec `json:"spec,omitempty"`
+
+	// Status is the current information about the autoscaler.
+	Status HorizontalPodAutoscalerStatus `json:"status,omitempty"`
+}
+
+// HorizontalPodAutoscalerList is a collection of horizontal pod autoscalers.
+type HorizontalPodAutoscalerList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []HorizontalPodAutoscaler `json:"items"`
+}
+
 const (
 	// ClusterIPNone - do not assign a cluster IP
 	// no proxying required and no environment variables should be created for pods
@@ -1075,6 +1578,24 @@ const (
 	ServiceAffinityNone ServiceAffinity = "None"
 )
 
+// ServiceLoadBalancingPolicy describes how a proxy spreads a service's traffic across its endpoints.
+type ServiceLoadBalancingPolicy string
+
+const (
+	// ServiceLoadBalancingRoundRobin hands out endpoints in turn.
+	ServiceLoadBalancingRoundRobin ServiceLoadBalancingPolicy = "RoundRobin"
+
+	// ServiceLoadBalancingLeastConnections picks the endpoint with the fewest active connections.
+	ServiceLoadBalancingLeastConnections ServiceLoadBalancingPolicy = "LeastConnections"
+
+	// ServiceLoadBalancingWeighted hands out endpoints in proportion to their weights.
+	ServiceLoadBalancingWeighted ServiceLoadBalancingPolicy = "Weighted"
+
+	// ServiceLoadBalancingRandomTwoChoices samples two endpoints at random and picks the
+	// one with fewer active connections.
+	ServiceLoadBalancingRandomTwoChoices ServiceLoadBalancingPolicy = "RandomTwoChoices"
+)
+
 // Service Type string describes ingress methods for a service
 type ServiceType string
 
@@ -1146,6 +1667,11 @@ type ServiceSpec struct {
 
 	// Required: Supports "ClientIP" and "None".  Used to maintain session affinity.
 	SessionAffinity ServiceAffinity `json:"sessionAffinity,omitempty"`
+
+	// Optional: Supports "RoundRobin", "LeastConnections", "Weighted" and "RandomTwoChoices".
+	// Used by the userspace proxy to pick an endpoint for each new connection.  The
+	// iptables proxy ignores it and always picks endpoints at random.
+	LoadBalancingPolicy ServiceLoadBalancingPolicy `json:"loadBalancingPolicy,omitempty"`
 }
 
 type ServicePort struct {
@@ -1294,6 +1820,33 @@ type NodeSpec struct {
 
 	// Unschedulable controls node schedulability of new pods. By default node is schedulable.
 	Unschedulable bool `json:"unschedulable,omitempty"`
+
+	// If specified, the node's taints.  Pods that do not tolerate a taint with
+	// the NoSchedule effect are not scheduled onto the node.
+	Taints []Taint `json:"taints,omitempty"`
+}
+
+// TaintEffect is the effect a taint has on pods that do not tolerate it.
+type TaintEffect string
+
+const (
+	// Do not allow new pods to schedule onto the node unless they tolerate the taint.
+	// Pods already running on the node are not affected.
+	TaintEffectNoSchedule TaintEffect = "NoSchedule"
+	// Like TaintEffectNoSchedule, but the scheduler only tries to avoid placing a pod
+	// that does not tolerate the taint onto the node, rather than prohibiting it.
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+)
+
+// The node this Taint is attached to has the effect "effect" on
+// any pod that does not tolerate the Taint.
+type Taint struct {
+	// Required. The taint key to be applied to a node.
+	Key string `json:"key"`
+	// Required. The taint value corresponding to the taint key.
+	Value string `json:"value,omitempty"`
+	// Required. The effect of the taint on pods that do not tolerate the taint.
+	Effect TaintEffect `json:"effect"`
 }
 
 // NodeSystemInfo is a set of ids/uuids to uniquely identify the node.
@@ -1320,6 +1873,9 @@ type NodeSystemInfo struct {
 type NodeStatus struct {
 	// Capacity represents the available resources of a node.
 	Capacity ResourceList `json:"capacity,omitempty"`
+	// Allocatable represents the resources of a node that are available for scheduling,
+	// i.e. the capacity minus the resources reserved for the system. Defaults to Capacity.
+	Allocatable ResourceList `json:"allocatable,omitempty"`
 	// NodePhase is the current lifecycle phase of the node.
 	Phase NodePhase `json:"phase,omitempty"`
 	// Conditions is an array of current node conditions.
@@ -1350,6 +1906,10 @@ type NodeConditionType string
 const (
 	// NodeReady means kubelet is healthy and ready to accept pods.
 	NodeReady NodeConditionType = "Ready"
+	// NodeMemoryPressure means the kubelet is under pressure due to insufficient available memory.
+	NodeMemoryPressure NodeConditionType = "MemoryPressure"
+	// NodeDiskPressure means the kubelet is under pressure due to insufficient available disk.
+	NodeDiskPressure NodeConditionType = "DiskPressure"
 )
 
 type NodeCondition struct {
@@ -1862,8 +2422,14 @@ type LimitRangeItem struct {
 	Max ResourceList `json:"max,omitempty"`
 	// Min usage constraints on this kind by resource name
 	Min ResourceList `json:"min,omitempty"`
-	// Default usage constraints on this kind by resource name
+	// Default limit on this kind by resource name if omitted
 	Default ResourceList `json:"default,omitempty"`
+	// DefaultRequest is the default request on this kind by resource name if
+	// omitted.  The request defaults to the limit if this is not specified.
+	DefaultRequest ResourceList `json:"defaultRequest,omitempty"`
+	// MaxLimitRequestRatio is the highest ratio of limit to request allowed
+	// on this kind by resource name
+	MaxLimitRequestRatio ResourceList `json:"maxLimitRequestRatio,omitempty"`
 }
 
 // LimitRangeSpec defines a min/max usage limit for resources that match on kind
@@ -1904,6 +2470,10 @@ const (
 	ResourceSecrets ResourceName = "secrets"
 	// ResourcePersistentVolumeClaims, number
 	ResourcePersistentVolumeClaims ResourceName = "persistentvolumeclaims"
+	// ResourceLimitsCPU, CPU limits in cores, while ResourceCPU counts CPU requests
+	ResourceLimitsCPU ResourceName = "limits.cpu"
+	// ResourceLimitsMemory, memory limits in bytes, while ResourceMemory counts memory requests
+	ResourceLimitsMemory ResourceName = "limits.memory"
 )
 
 // ResourceQuotaSpec defines the desired hard limits to enforce for Quota
@@ -2125,3 +2695,115 @@ type RangeAllocation struct {
 	// a single allocated address (the fifth bit on CIDR 10.0.0.0/8 is 10.0.0.4).
 	Data []byte `json:"data"`
 }
+
+// PolicyRule holds information that describes a policy rule, but does not contain information
+// about who the rule applies to or which namespace the rule applies to.
+type PolicyRule struct {
+	// Verbs is a list of verbs that apply to ALL the resources contained in this rule.
+	// VerbAll represents all verbs.
+	Verbs []string `json:"verbs"`
+	// Resources is a list of resources this rule applies to.  ResourceAll represents all resources.
+	Resources []string `json:"resources"`
+}
+
+const (
+	// VerbAll matches every verb in a PolicyRule.
+	VerbAll = "*"
+	// ResourceAll matches every resource in a PolicyRule.
+	ResourceAll = "*"
+)
+
+const (
+	// UserKind is the ObjectReference.Kind of a RoleBinding subject that names a user.
+	UserKind = "User"
+	// GroupKind is the ObjectReference.Kind of a RoleBinding subject that names a group.
+	GroupKind = "Group"
+	// ServiceAccountKind is the ObjectReference.Kind of a RoleBinding subject that names a service account.
+	ServiceAccountKind = "ServiceAccount"
+	// RoleKind is the ObjectReference.Kind of a RoleBinding role reference that names a Role.
+	RoleKind = "Role"
+	// ClusterRoleKind is the ObjectReference.Kind of a RoleBinding role reference that names a ClusterRole.
+	ClusterRoleKind = "ClusterRole"
+)
+
+// Role is a namespaced, logical grouping of PolicyRules that can be referenced as a unit by a RoleBinding.
+type Role struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Rules holds all the PolicyRules for this Role
+	Rules []PolicyRule `json:"rules"`
+}
+
+// RoleList is a collection of Roles
+type RoleList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Role `json:"items"`
+}
+
+// RoleBinding references a role, but does not contain it.  It can reference a Role in the same namespace or a
+// ClusterRole.  It adds who information via Subjects and namespace information by which namespace it exists in.
+// RoleBindings in a given namespace only have effect in that namespace.
+type RoleBinding struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Subjects holds references to the objects the role applies to.  Kind must be one of
+	// User, Group or ServiceAccount.
+	Subjects []ObjectReference `json:"subjects"`
+
+	// RoleRef can reference a Role in the current namespace or a ClusterRole.
+	// If the RoleRef cannot be resolved, the Authorizer must return an error.
+	RoleRef ObjectReference `json:"roleRef"`
+}
+
+// RoleBindingList is a collection of RoleBindings
+type RoleBindingList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []RoleBinding `json:"items"`
+}
+
+// ClusterRole is a cluster level, logical grouping of PolicyRules that can be referenced as a unit by a
+// RoleBinding or ClusterRoleBinding.
+type ClusterRole struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Rules holds all the PolicyRules for this ClusterRole
+	Rules []PolicyRule `json:"rules"`
+}
+
+// ClusterRoleList is a collection of ClusterRoles
+type ClusterRoleList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterRole `json:"items"`
+}
+
+// ClusterRoleBinding references a ClusterRole, but does not contain it.  It adds who information via
+// Subjects and applies the ClusterRole in every namespace and to cluster scoped resources.
+type ClusterRoleBinding struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Subjects holds references to the objects the role applies to.  Kind must be one of
+	// User, Group or ServiceAccount.
+	Subjects []ObjectReference `json:"subjects"`
+
+	// RoleRef can only reference a ClusterRole.
+	// If the RoleRef cannot be resolved, the Authorizer must return an error.
+	RoleRef ObjectReference `json:"roleRef"`
+}
+
+// ClusterRoleBindingList is a collection of ClusterRoleBindings
+type ClusterRoleBindingList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterRoleBinding `json:"items"`
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 )
 
 func addConversionFuncs() {
@@ -29,6 +30,10 @@ func addConversionFuncs() {
 	err := api.Scheme.AddConversionFuncs(
 		convert_v1_ReplicationControllerSpec_To_api_ReplicationControllerSpec,
 		convert_api_ReplicationControllerSpec_To_v1_ReplicationControllerSpec,
+		convert_api_DeploymentSpec_To_v1_DeploymentSpec,
+		convert_v1_DeploymentSpec_To_api_DeploymentSpec,
+		convert_api_RollingUpdateDeployment_To_v1_RollingUpdateDeployment,
+		convert_v1_RollingUpdateDeployment_To_api_RollingUpdateDeployment,
 	)
 	if err != nil {
 		// If one of the conversion functions is malformed, detect it immediately.
@@ -223,3 +228,112 @@ func convert_v1_ReplicationControllerSpec_To_api_ReplicationControllerSpec(in *R
 	}
 	return nil
 }
+
+func convert_api_DeploymentSpec_To_v1_DeploymentSpec(in *api.DeploymentSpec, out *DeploymentSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.DeploymentSpec))(in)
+	}
+	out.Replicas = new(int)
+	*out.Replicas = in.Replicas
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	if in.Template != nil {
+		out.Template = new(PodTemplateSpec)
+		if err := convert_api_PodTemplateSpec_To_v1_PodTemplateSpec(in.Template, out.Template, s); err != nil {
+			return err
+		}
+	} else {
+		out.Template = nil
+	}
+	if err := s.Convert(&in.Strategy, &out.Strategy, 0); err != nil {
+		return err
+	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(RollbackConfig)
+		if err := convert_api_RollbackConfig_To_v1_RollbackConfig(in.RollbackTo, out.RollbackTo, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
+	return nil
+}
+
+func convert_v1_DeploymentSpec_To_api_DeploymentSpec(in *DeploymentSpec, out *api.DeploymentSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*DeploymentSpec))(in)
+	}
+	if in.Replicas != nil {
+		out.Replicas = *in.Replicas
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	if in.Template != nil {
+		out.Template = new(api.PodTemplateSpec)
+		if err := convert_v1_PodTemplateSpec_To_api_PodTemplateSpec(in.Template, out.Template, s); err != nil {
+			return err
+		}
+	} else {
+		out.Template = nil
+	}
+	if err := s.Convert(&in.Strategy, &out.Strategy, 0); err != nil {
+		return err
+	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(api.RollbackConfig)
+		if err := convert_v1_RollbackConfig_To_api_RollbackConfig(in.RollbackTo, out.RollbackTo, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
+	return nil
+}
+
+func convert_api_RollingUpdateDeployment_To_v1_RollingUpdateDeployment(in *api.RollingUpdateDeployment, out *RollingUpdateDeployment, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.RollingUpdateDeployment))(in)
+	}
+	out.MaxUnavailable = new(util.IntOrString)
+	*out.MaxUnavailable = in.MaxUnavailable
+	out.MaxSurge = new(util.IntOrString)
+	*out.MaxSurge = in.MaxSurge
+	return nil
+}
+
+func convert_v1_RollingUpdateDeployment_To_api_RollingUpdateDeployment(in *RollingUpdateDeployment, out *api.RollingUpdateDeployment, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*RollingUpdateDeployment))(in)
+	}
+	if in.MaxUnavailable != nil {
+		out.MaxUnavailable = *in.MaxUnavailable
+	}
+	if in.MaxSurge != nil {
+		out.MaxSurge = *in.MaxSurge
+	}
+	return nil
+}
@@ -44,6 +44,91 @@ func addDefaultingFuncs() {
 				*obj.Spec.Replicas = 1
 			}
 		},
+		func(obj *Deployment) {
+			var labels map[string]string
+			if obj.Spec.Template != nil {
+				labels = obj.Spec.Template.Labels
+			}
+			if labels != nil {
+				if len(obj.Spec.Selector) == 0 {
+					obj.Spec.Selector = labels
+				}
+				if len(obj.Labels) == 0 {
+					obj.Labels = labels
+				}
+			}
+			if obj.Spec.Replicas == nil {
+				obj.Spec.Replicas = new(int)
+				*obj.Spec.Replicas = 1
+			}
+			if obj.Spec.RevisionHistoryLimit == nil {
+				obj.Spec.RevisionHistoryLimit = new(int)
+				*obj.Spec.RevisionHistoryLimit = 2
+			}
+			strategy := &obj.Spec.Strategy
+			if strategy.Type == "" {
+				strategy.Type = RollingUpdateDeploymentStrategyType
+			}
+			if strategy.Type == RollingUpdateDeploymentStrategyType {
+				if strategy.RollingUpdate == nil {
+					strategy.RollingUpdate = &RollingUpdateDeployment{}
+				}
+				if strategy.RollingUpdate.MaxUnavailable == nil {
+					maxUnavailable := util.NewIntOrStringFromInt(1)
+					strategy.RollingUpdate.MaxUnavailable = &maxUnavailable
+				}
+				if strategy.RollingUpdate.MaxSurge == nil {
+					maxSurge := util.NewIntOrStringFromInt(1)
+					strategy.RollingUpdate.MaxSurge = &maxSurge
+				}
+			}
+		},
+		func(obj *DaemonSet) {
+			var labels map[string]string
+			if obj.Spec.Template != nil {
+				labels = obj.Spec.Template.Labels
+			}
+			if labels != nil {
+				if len(obj.Spec.Selector) == 0 {
+					obj.Spec.Selector = labels
+				}
+				if len(obj.Labels) == 0 {
+					obj.Labels = labels
+				}
+			}
+		},
+		func(obj *Job) {
+			var labels map[string]string
+			if obj.Spec.Template != nil {
+				labels = obj.Spec.Template.Labels
+			}
+			if labels != nil {
+				if len(obj.Spec.Selector) == 0 {
+					obj.Spec.Selector = labels
+				}
+				if len(obj.Labels) == 0 {
+					obj.Labels = labels
+				}
+			}
+			if obj.Spec.Completions == nil {
+				completions := 1
+				obj.Spec.Completions = &completions
+			}
+			if obj.Spec.Parallelism == nil {
+				parallelism := 1
+				obj.Spec.Parallelism = &parallelism
+			}
+		},
+		func(obj *HorizontalPodAutoscaler) {
+			if obj.Spec.MinReplicas == nil {
+				minReplicas := 1
+				obj.Spec.MinReplicas = &minReplicas
+			}
+			if obj.Spec.TargetCPUUtilizationPercentage == nil {
+				target := 80
+				obj.Spec.TargetCPUUtilizationPercentage = &target
+			}
+		},
 		func(obj *Volume) {
 			if util.AllPtrFieldsNil(&obj.VolumeSource) {
 				obj.VolumeSource = VolumeSource{
@@ -70,11 +155,23 @@ func addDefaultingFuncs() {
 			if obj.TerminationMessagePath == "" {
 				obj.TerminationMessagePath = TerminationMessagePathDefault
 			}
+			// Requests default to the limits that are set.
+			for name, limit := range obj.Resources.Limits {
+				if _, found := obj.Resources.Requests[name]; !found {
+					if obj.Resources.Requests == nil {
+						obj.Resources.Requests = ResourceList{}
+					}
+					obj.Resources.Requests[name] = limit
+				}
+			}
 		},
 		func(obj *ServiceSpec) {
 			if obj.SessionAffinity == "" {
 				obj.SessionAffinity = ServiceAffinityNone
 			}
+			if obj.LoadBalancingPolicy == "" {
+				obj.LoadBalancingPolicy = ServiceLoadBalancingRoundRobin
+			}
 			if obj.Type == "" {
 				obj.Type = ServiceTypeClusterIP
 			}
@@ -99,10 +196,24 @@ func addDefaultingFuncs() {
 				defaultHostNetworkPorts(&obj.Containers)
 			}
 		},
+		func(obj *Toleration) {
+			if obj.Operator == "" {
+				obj.Operator = TolerationOpEqual
+			}
+		},
 		func(obj *Probe) {
 			if obj.TimeoutSeconds == 0 {
 				obj.TimeoutSeconds = 1
 			}
+			if obj.PeriodSeconds == 0 {
+				obj.PeriodSeconds = 10
+			}
+			if obj.SuccessThreshold == 0 {
+				obj.SuccessThreshold = 1
+			}
+			if obj.FailureThreshold == 0 {
+				obj.FailureThreshold = 3
+			}
 		},
 		func(obj *Secret) {
 			if obj.Type == "" {
@@ -235,6 +235,9 @@ func TestSetDefaultService(t *testing.T) {
 	if svc2.Spec.SessionAffinity != versioned.ServiceAffinityNone {
 		t.Errorf("Expected default session affinity type:%s, got: %s", versioned.ServiceAffinityNone, svc2.Spec.SessionAffinity)
 	}
+	if svc2.Spec.LoadBalancingPolicy != versioned.ServiceLoadBalancingRoundRobin {
+		t.Errorf("Expected default load balancing policy:%s, got: %s", versioned.ServiceLoadBalancingRoundRobin, svc2.Spec.LoadBalancingPolicy)
+	}
 	if svc2.Spec.Type != versioned.ServiceTypeClusterIP {
 		t.Errorf("Expected default type:%s, got: %s", versioned.ServiceTypeClusterIP, svc2.Spec.Type)
 	}
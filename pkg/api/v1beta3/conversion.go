@@ -22,6 +22,7 @@ import (
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 )
 
 func addConversionFuncs() {
@@ -43,6 +44,10 @@ func addConversionFuncs() {
 		convert_api_StatusCause_To_v1beta3_StatusCause,
 		convert_api_ReplicationControllerSpec_To_v1beta3_ReplicationControllerSpec,
 		convert_v1beta3_ReplicationControllerSpec_To_api_ReplicationControllerSpec,
+		convert_api_DeploymentSpec_To_v1beta3_DeploymentSpec,
+		convert_v1beta3_DeploymentSpec_To_api_DeploymentSpec,
+		convert_api_RollingUpdateDeployment_To_v1beta3_RollingUpdateDeployment,
+		convert_v1beta3_RollingUpdateDeployment_To_api_RollingUpdateDeployment,
 	)
 	if err != nil {
 		// If one of the conversion functions is malformed, detect it immediately.
@@ -403,6 +408,7 @@ func convert_v1beta3_ServiceSpec_To_api_ServiceSpec(in *ServiceSpec, out *api.Se
 		out.DeprecatedPublicIPs = nil
 	}
 	out.SessionAffinity = api.ServiceAffinity(in.SessionAffinity)
+	out.LoadBalancingPolicy = api.ServiceLoadBalancingPolicy(in.LoadBalancingPolicy)
 	return nil
 }
 
@@ -444,6 +450,7 @@ func convert_api_ServiceSpec_To_v1beta3_ServiceSpec(in *api.ServiceSpec, out *Se
 		out.PublicIPs = nil
 	}
 	out.SessionAffinity = ServiceAffinity(in.SessionAffinity)
+	out.LoadBalancingPolicy = ServiceLoadBalancingPolicy(in.LoadBalancingPolicy)
 	return nil
 }
 
@@ -461,6 +468,16 @@ func convert_v1beta3_PodSpec_To_api_PodSpec(in *PodSpec, out *api.PodSpec, s con
 	} else {
 		out.Volumes = nil
 	}
+	if in.InitContainers != nil {
+		out.InitContainers = make([]api.Container, len(in.InitContainers))
+		for i := range in.InitContainers {
+			if err := convert_v1beta3_Container_To_api_Container(&in.InitContainers[i], &out.InitContainers[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.InitContainers = nil
+	}
 	if in.Containers != nil {
 		out.Containers = make([]api.Container, len(in.Containers))
 		for i := range in.Containers {
@@ -493,6 +510,25 @@ func convert_v1beta3_PodSpec_To_api_PodSpec(in *PodSpec, out *api.PodSpec, s con
 	} else {
 		out.NodeSelector = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(api.Affinity)
+		if err := convert_v1beta3_Affinity_To_api_Affinity(in.Affinity, out.Affinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	out.Priority = in.Priority
+	if in.Tolerations != nil {
+		out.Tolerations = make([]api.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := convert_v1beta3_Toleration_To_api_Toleration(&in.Tolerations[i], &out.Tolerations[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
 	out.ServiceAccountName = in.ServiceAccount
 	out.NodeName = in.Host
 	out.HostNetwork = in.HostNetwork
@@ -523,6 +559,16 @@ func convert_api_PodSpec_To_v1beta3_PodSpec(in *api.PodSpec, out *PodSpec, s con
 	} else {
 		out.Volumes = nil
 	}
+	if in.InitContainers != nil {
+		out.InitContainers = make([]Container, len(in.InitContainers))
+		for i := range in.InitContainers {
+			if err := convert_api_Container_To_v1beta3_Container(&in.InitContainers[i], &out.InitContainers[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.InitContainers = nil
+	}
 	if in.Containers != nil {
 		out.Containers = make([]Container, len(in.Containers))
 		for i := range in.Containers {
@@ -555,6 +601,25 @@ func convert_api_PodSpec_To_v1beta3_PodSpec(in *api.PodSpec, out *PodSpec, s con
 	} else {
 		out.NodeSelector = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(Affinity)
+		if err := convert_api_Affinity_To_v1beta3_Affinity(in.Affinity, out.Affinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	out.Priority = in.Priority
+	if in.Tolerations != nil {
+		out.Tolerations = make([]Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := convert_api_Toleration_To_v1beta3_Toleration(&in.Tolerations[i], &out.Tolerations[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
 	out.ServiceAccount = in.ServiceAccountName
 	out.Host = in.NodeName
 	out.HostNetwork = in.HostNetwork
@@ -776,3 +841,112 @@ func convert_v1beta3_ReplicationControllerSpec_To_api_ReplicationControllerSpec(
 	}
 	return nil
 }
+
+func convert_api_DeploymentSpec_To_v1beta3_DeploymentSpec(in *api.DeploymentSpec, out *DeploymentSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.DeploymentSpec))(in)
+	}
+	out.Replicas = new(int)
+	*out.Replicas = in.Replicas
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	if in.Template != nil {
+		out.Template = new(PodTemplateSpec)
+		if err := convert_api_PodTemplateSpec_To_v1beta3_PodTemplateSpec(in.Template, out.Template, s); err != nil {
+			return err
+		}
+	} else {
+		out.Template = nil
+	}
+	if err := s.Convert(&in.Strategy, &out.Strategy, 0); err != nil {
+		return err
+	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(RollbackConfig)
+		if err := convert_api_RollbackConfig_To_v1beta3_RollbackConfig(in.RollbackTo, out.RollbackTo, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_DeploymentSpec_To_api_DeploymentSpec(in *DeploymentSpec, out *api.DeploymentSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*DeploymentSpec))(in)
+	}
+	if in.Replicas != nil {
+		out.Replicas = *in.Replicas
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	if in.Template != nil {
+		out.Template = new(api.PodTemplateSpec)
+		if err := convert_v1beta3_PodTemplateSpec_To_api_PodTemplateSpec(in.Template, out.Template, s); err != nil {
+			return err
+		}
+	} else {
+		out.Template = nil
+	}
+	if err := s.Convert(&in.Strategy, &out.Strategy, 0); err != nil {
+		return err
+	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(api.RollbackConfig)
+		if err := convert_v1beta3_RollbackConfig_To_api_RollbackConfig(in.RollbackTo, out.RollbackTo, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
+	return nil
+}
+
+func convert_api_RollingUpdateDeployment_To_v1beta3_RollingUpdateDeployment(in *api.RollingUpdateDeployment, out *RollingUpdateDeployment, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.RollingUpdateDeployment))(in)
+	}
+	out.MaxUnavailable = new(util.IntOrString)
+	*out.MaxUnavailable = in.MaxUnavailable
+	out.MaxSurge = new(util.IntOrString)
+	*out.MaxSurge = in.MaxSurge
+	return nil
+}
+
+func convert_v1beta3_RollingUpdateDeployment_To_api_RollingUpdateDeployment(in *RollingUpdateDeployment, out *api.RollingUpdateDeployment, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*RollingUpdateDeployment))(in)
+	}
+	if in.MaxUnavailable != nil {
+		out.MaxUnavailable = *in.MaxUnavailable
+	}
+	if in.MaxSurge != nil {
+		out.MaxSurge = *in.MaxSurge
+	}
+	return nil
+}
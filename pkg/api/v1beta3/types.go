@@ -629,6 +629,12 @@ type Probe struct {
 	InitialDelaySeconds int64 `json:"initialDelaySeconds,omitempty" description:"number of seconds after the container has started before liveness probes are initiated"`
 	// Length of time before health checking times out.  In seconds.
 	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" description:"number of seconds after which liveness probes timeout; defaults to 1 second"`
+	// How often to perform the probe.  In seconds.
+	PeriodSeconds int64 `json:"periodSeconds,omitempty" description:"how often in seconds to perform the probe; defaults to 10 seconds"`
+	// Minimum consecutive successes for the probe to be considered successful after having failed.
+	SuccessThreshold int `json:"successThreshold,omitempty" description:"minimum consecutive successes for the probe to be considered successful after having failed; defaults to 1; must be 1 for liveness"`
+	// Minimum consecutive failures for the probe to be considered failed after having succeeded.
+	FailureThreshold int `json:"failureThreshold,omitempty" description:"minimum consecutive failures for the probe to be considered failed after having succeeded; defaults to 3"`
 }
 
 // PullPolicy describes a policy for if/when to pull a container image
@@ -656,13 +662,11 @@ type Capabilities struct {
 
 // ResourceRequirements describes the compute resource requirements.
 type ResourceRequirements struct {
-	// Limits describes the maximum amount of compute resources required.
-	Limits ResourceList `json:"limits,omitempty" description:"Maximum amount of compute resources allowed"`
+	// Limits describes the maximum amount of compute resources allowed.
+	Limits ResourceList `json:"limits,omitempty" description:"Maximum amount of compute resources allowed; memory limits are enforced by the kubelet, cpu limits are not enforced yet"`
 	// Requests describes the minimum amount of compute resources required.
-	// Note: 'Requests' are honored only for Persistent Volumes as of now.
-	// TODO: Update the scheduler to use 'Requests' in addition to 'Limits'. If Request is omitted for a container,
-	// it defaults to Limits if that is explicitly specified, otherwise to an implementation-defined value
-	Requests ResourceList `json:"requests,omitempty" description:"Minimum amount of resources requested; requests are honored only for persistent volumes as of now"`
+	// If Requests is omitted for a container, it defaults to Limits if that is explicitly specified.
+	Requests ResourceList `json:"requests,omitempty" description:"Minimum amount of resources requested, reserved by the scheduler; defaults to limits if omitted"`
 }
 
 const (
@@ -860,9 +864,189 @@ const (
 	DNSDefault DNSPolicy = "Default"
 )
 
+// A node selector represents the union of the results of one or more label queries
+// over a set of nodes; that is, it represents the OR of the selectors represented
+// by the node selector terms.
+type NodeSelector struct {
+	// Required: A list of node selector terms. The terms are ORed.
+	NodeSelectorTerms []NodeSelectorTerm `json:"nodeSelectorTerms" description:"required list of node selector terms; the terms are ORed"`
+}
+
+// A null or empty node selector term matches no objects.
+type NodeSelectorTerm struct {
+	// Required: A list of node selector requirements. The requirements are ANDed.
+	MatchExpressions []NodeSelectorRequirement `json:"matchExpressions" description:"list of node selector requirements; the requirements are ANDed"`
+}
+
+// A node selector requirement is a selector that contains values, a key, and an operator
+// that relates the key and values.
+type NodeSelectorRequirement struct {
+	// The label key that the selector applies to.
+	Key string `json:"key" description:"label key that the selector applies to"`
+	// Represents a key's relationship to a set of values.
+	// Valid operators are In, NotIn, Exists, DoesNotExist, Gt, and Lt.
+	Operator NodeSelectorOperator `json:"operator" description:"relationship of the key to the values; must be In, NotIn, Exists, DoesNotExist, Gt or Lt"`
+	// An array of string values. If the operator is In or NotIn,
+	// the values array must be non-empty. If the operator is Exists or DoesNotExist,
+	// the values array must be empty. If the operator is Gt or Lt, the values
+	// array must have a single element, which will be interpreted as an integer.
+	Values []string `json:"values,omitempty" description:"values for In and NotIn, which must be non-empty; empty for Exists and DoesNotExist; a single integer for Gt and Lt"`
+}
+
+// A node selector operator is the set of operators that can be used in
+// a node selector requirement.
+type NodeSelectorOperator string
+
+const (
+	NodeSelectorOpIn           NodeSelectorOperator = "In"
+	NodeSelectorOpNotIn        NodeSelectorOperator = "NotIn"
+	NodeSelectorOpExists       NodeSelectorOperator = "Exists"
+	NodeSelectorOpDoesNotExist NodeSelectorOperator = "DoesNotExist"
+	NodeSelectorOpGt           NodeSelectorOperator = "Gt"
+	NodeSelectorOpLt           NodeSelectorOperator = "Lt"
+)
+
+// Affinity is a group of affinity scheduling rules.
+type Affinity struct {
+	// Describes node affinity scheduling rules for the pod.
+	NodeAffinity *NodeAffinity `json:"nodeAffinity,omitempty" description:"node affinity scheduling rules for the pod"`
+	// Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).
+	PodAffinity *PodAffinity `json:"podAffinity,omitempty" description:"rules for co-locating the pod with other pods in the same topology domain"`
+	// Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).
+	PodAntiAffinity *PodAntiAffinity `json:"podAntiAffinity,omitempty" description:"rules for keeping the pod out of topology domains that run certain other pods"`
+}
+
+// Node affinity is a group of node affinity scheduling rules.
+type NodeAffinity struct {
+	// If the affinity requirements specified by this field are not met at
+	// scheduling time, the pod will not be scheduled onto the node.
+	// If the affinity requirements specified by this field cease to be met
+	// at some point during pod execution (e.g. due to a node label update),
+	// the pod is not evicted.
+	RequiredDuringSchedulingIgnoredDuringExecution *NodeSelector `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty" description:"hard requirements a node must meet for the pod to be scheduled onto it; ignored once the pod is running"`
+	// The scheduler will prefer to schedule pods to nodes that satisfy
+	// the affinity expressions specified by this field, but it may choose
+	// a node that violates one or more of the expressions. The node that is
+	// most preferred is the one with the greatest sum of weights, i.e.
+	// for each node that meets all of the scheduling requirements (resource
+	// request, required affinity expressions, etc.), compute a sum by iterating
+	// through the elements of this field and adding "weight" to the sum if the node
+	// matches the corresponding matchExpressions; the node(s) with the highest sum
+	// are the most preferred.
+	PreferredDuringSchedulingIgnoredDuringExecution []PreferredSchedulingTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty" description:"soft preferences; nodes matching heavier terms are preferred"`
+}
+
+// An empty preferred scheduling term matches all objects with implicit weight 0
+// (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+type PreferredSchedulingTerm struct {
+	// Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.
+	Weight int `json:"weight" description:"weight of matching the preference, in the range 1-100"`
+	// A node selector term, associated with the corresponding weight.
+	Preference NodeSelectorTerm `json:"preference" description:"node selector term that the weight applies to"`
+}
+
+// Pod affinity is a group of inter pod affinity scheduling rules.
+type PodAffinity struct {
+	// If the affinity requirements specified by this field are not met at
+	// scheduling time, the pod will not be scheduled onto the node.
+	// If the affinity requirements specified by this field cease to be met
+	// at some point during pod execution (e.g. due to a pod label update), the
+	// system will not try to eventually evict the pod from its node.
+	// When there are multiple elements, the lists of nodes corresponding to each
+	// podAffinityTerm are intersected, i.e. all terms must be satisfied.
+	RequiredDuringSchedulingIgnoredDuringExecution []PodAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty" description:"hard requirements; every term must be met by some pod in the candidate node's topology domain; ignored once the pod is running"`
+	// The scheduler will prefer to schedule pods to nodes that satisfy
+	// the affinity expressions specified by this field, but it may choose
+	// a node that violates one or more of the expressions. The node that is
+	// most preferred is the one with the greatest sum of weights, i.e.
+	// for each node that meets all of the scheduling requirements, compute a sum
+	// by iterating through the elements of this field and adding "weight" to the
+	// sum if the node has pods which match the corresponding podAffinityTerm; the
+	// node(s) with the highest sum are the most preferred.
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPodAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty" description:"soft preferences; nodes whose topology domain runs pods matching heavier terms are preferred"`
+}
+
+// Pod anti affinity is a group of inter pod anti affinity scheduling rules.
+type PodAntiAffinity struct {
+	// If the anti-affinity requirements specified by this field are not met at
+	// scheduling time, the pod will not be scheduled onto the node.
+	// If the anti-affinity requirements specified by this field cease to be met
+	// at some point during pod execution (e.g. due to a pod label update), the
+	// system will not try to eventually evict the pod from its node.
+	// When there are multiple elements, the lists of nodes corresponding to each
+	// podAffinityTerm are intersected, i.e. all terms must be satisfied.
+	RequiredDuringSchedulingIgnoredDuringExecution []PodAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty" description:"hard requirements; no pod matching any term may run in the candidate node's topology domain; ignored once the pod is running"`
+	// The scheduler will prefer to schedule pods to nodes that satisfy
+	// the anti-affinity expressions specified by this field, but it may choose
+	// a node that violates one or more of the expressions. The node that is
+	// most preferred is the one with the greatest sum of weights, i.e.
+	// for each node that meets all of the scheduling requirements, compute a sum
+	// by iterating through the elements of this field and adding "weight" to the
+	// sum if the node has pods which match the corresponding podAffinityTerm; the
+	// node(s) with the highest sum are the least preferred.
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPodAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty" description:"soft preferences; nodes whose topology domain runs pods matching heavier terms are avoided"`
+}
+
+// The weights of all of the matched WeightedPodAffinityTerm fields are added per-node
+// to find the most preferred node(s).
+type WeightedPodAffinityTerm struct {
+	// Weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
+	Weight int `json:"weight" description:"weight for matching the corresponding podAffinityTerm, in the range 1-100"`
+	// A pod affinity term, associated with the corresponding weight.
+	PodAffinityTerm PodAffinityTerm `json:"podAffinityTerm" description:"the pod affinity term associated with the weight"`
+}
+
+// Defines a set of pods (namely those matching the selector relative to the
+// given namespace(s)) that this pod should be co-located (affinity) or not
+// co-located (anti-affinity) with, where co-located is defined as running on
+// a node whose value of the label with key <topologyKey> matches that of any
+// node on which a pod of the set of pods is running.
+type PodAffinityTerm struct {
+	// A label query over the set of pods.  An empty selector matches all pods.
+	Selector map[string]string `json:"selector,omitempty" description:"label query over the pods to co-locate with or avoid; an empty selector matches all pods"`
+	// Namespaces specifies which namespaces the selector applies to (matches
+	// against); empty means "this pod's namespace".
+	Namespaces []string `json:"namespaces,omitempty" description:"namespaces the selector applies to; empty means the pod's own namespace"`
+	// This pod should be co-located (affinity) or not co-located (anti-affinity)
+	// with the pods matching the selector in the specified namespaces, where
+	// co-located is defined as running on a node whose value of the label with
+	// key topologyKey matches that of any node on which any of the selected pods
+	// is running.  Use "kubernetes.io/hostname" to mean the same node.
+	TopologyKey string `json:"topologyKey" description:"node label key whose value defines a topology domain, e.g. kubernetes.io/hostname for a single node"`
+}
+
+// A toleration operator is the set of operators that can be used in a toleration.
+type TolerationOperator string
+
+const (
+	TolerationOpExists TolerationOperator = "Exists"
+	TolerationOpEqual  TolerationOperator = "Equal"
+)
+
+// The pod this Toleration is attached to tolerates any taint that matches
+// the triple <key,value,effect> using the matching operator <operator>.
+type Toleration struct {
+	// Key is the taint key that the toleration applies to. Empty means match all taint keys.
+	// If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+	Key string `json:"key,omitempty" description:"taint key the toleration applies to; empty matches all keys and requires operator Exists"`
+	// Operator represents a key's relationship to the value.
+	// Valid operators are Exists and Equal. Defaults to Equal.
+	// Exists is equivalent to wildcard for value, so that a pod can
+	// tolerate all taints of a particular category.
+	Operator TolerationOperator `json:"operator,omitempty" description:"relationship of the key to the value; Exists or Equal; defaults to Equal"`
+	// Value is the taint value the toleration matches to.
+	// If the operator is Exists, the value should be empty, otherwise just a regular string.
+	Value string `json:"value,omitempty" description:"taint value the toleration matches; must be empty for operator Exists"`
+	// Effect indicates the taint effect to match. Empty means match all taint effects.
+	Effect TaintEffect `json:"effect,omitempty" description:"taint effect to match; empty matches all effects"`
+}
+
 // PodSpec is a description of a pod
 type PodSpec struct {
 	Volumes []Volume `json:"volumes,omitempty" description:"list of volumes that can be mounted by containers belonging to the pod" patchStrategy:"merge" patchMergeKey:"name"`
+	// Optional: containers run one at a time, in order, to successful completion
+	// before the containers of the pod start.
+	InitContainers []Container `json:"initContainers,omitempty" description:"list of containers run in order, each to successful completion, before the containers of the pod are started; a failing init container is restarted according to the restart policy of the pod; cannot be updated" patchStrategy:"merge" patchMergeKey:"name"`
 	// Required: there must be at least one container in a pod.
 	Containers    []Container   `json:"containers" description:"list of containers belonging to the pod; cannot be updated; containers cannot currently be added or removed; there must be at least one container in a Pod" patchStrategy:"merge" patchMergeKey:"name"`
 	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty" description:"restart policy for all containers within the pod; one of Always, OnFailure, Never; defaults to Always"`
@@ -878,6 +1062,15 @@ type PodSpec struct {
 	DNSPolicy DNSPolicy `json:"dnsPolicy,omitempty" description:"DNS policy for containers within the pod; one of 'ClusterFirst' or 'Default'"`
 	// NodeSelector is a selector which must be true for the pod to fit on a node
 	NodeSelector map[string]string `json:"nodeSelector,omitempty" description:"selector which must match a node's labels for the pod to be scheduled on that node"`
+	// Affinity holds the pod's scheduling constraints beyond NodeSelector.
+	Affinity *Affinity `json:"affinity,omitempty" description:"pod's scheduling constraints beyond nodeSelector; see http://releases.k8s.io/HEAD/docs/user-guide/node-selection/README.md"`
+	// Priority is the scheduling priority of the pod.  Higher values are more
+	// important.  When no node fits the pod, the scheduler may preempt pods
+	// with a strictly lower priority to make room for it.  Must be between 0
+	// and 1000000000; the PodPriority admission plugin may lower the limit.
+	Priority int `json:"priority,omitempty" description:"scheduling priority of the pod; when no node fits, the scheduler may preempt pods of strictly lower priority; must be between 0 and 1000000000; defaults to 0"`
+	// If specified, the pod's tolerations of node taints.
+	Tolerations []Toleration `json:"tolerations,omitempty" description:"taints the pod tolerates; see http://releases.k8s.io/HEAD/docs/user-guide/node-selection/README.md"`
 
 	// ServiceAccount is the name of the ServiceAccount to use to run this pod
 	ServiceAccount string `json:"serviceAccount,omitempty" description:"name of the ServiceAccount to use to run this pod"`
@@ -914,6 +1107,8 @@ type PodStatus struct {
 	// The list has one entry per container in the manifest. Each entry is currently the output
 	// of `docker inspect`.
 	ContainerStatuses []ContainerStatus `json:"containerStatuses,omitempty" description:"list of container statuses"`
+	// The statuses of the init containers of the pod, in the order of the spec.
+	InitContainerStatuses []ContainerStatus `json:"initContainerStatuses,omitempty" description:"list of init container statuses, in the order of the init containers"`
 }
 
 // PodStatusResult is a wrapper for PodStatus returned by kubelet that can be encode/decoded
@@ -1024,6 +1219,303 @@ type ReplicationControllerList struct {
 	Items []ReplicationController `json:"items" description:"list of replication controllers"`
 }
 
+// DeploymentSpec is the specification of the desired behavior of a Deployment.
+type DeploymentSpec struct {
+	// Replicas is the number of desired pods. This is a pointer to distinguish between explicit zero and unspecified.
+	Replicas *int `json:"replicas,omitempty" description:"number of pods desired; defaults to 1"`
+
+	// Selector is a label query over the pods managed by the deployment.
+	// If Selector is empty, it is defaulted to the labels present on the Pod template.
+	Selector map[string]string `json:"selector,omitempty" description:"label keys and values that must match in order to be managed by this deployment, if empty defaulted to labels on Pod template; see http://releases.k8s.io/HEAD/docs/labels.md#label-selectors"`
+
+	// Template describes the pods that will be created.
+	Template *PodTemplateSpec `json:"template,omitempty" description:"object that describes the pods that will be created"`
+
+	// Strategy is how existing pods are replaced with new ones.
+	Strategy DeploymentStrategy `json:"strategy,omitempty" description:"how existing pods are replaced with new ones; defaults to RollingUpdate"`
+
+	// RevisionHistoryLimit is the number of old, scaled down replication
+	// controllers to keep around so that the deployment can be rolled back.
+	RevisionHistoryLimit *int `json:"revisionHistoryLimit,omitempty" description:"number of old replication controllers kept to allow rollback; defaults to 2"`
+
+	// RollbackTo, if set, asks the deployment controller to replace Template
+	// with the one of an earlier revision.
+	RollbackTo *RollbackConfig `json:"rollbackTo,omitempty" description:"revision to roll the template back to; cleared by the system once the rollback has started"`
+}
+
+// RollbackConfig names the revision a deployment should be rolled back to.
+type RollbackConfig struct {
+	// Revision to roll back to.  If zero, roll back to the previous revision.
+	Revision int64 `json:"revision,omitempty" description:"revision to roll back to; if zero, the previous revision"`
+}
+
+// DeploymentStrategyType is the kind of strategy used to replace pods.
+type DeploymentStrategyType string
+
+const (
+	// RecreateDeploymentStrategyType kills all existing pods before creating new ones.
+	RecreateDeploymentStrategyType DeploymentStrategyType = "Recreate"
+
+	// RollingUpdateDeploymentStrategyType gradually replaces old pods with new ones.
+	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+)
+
+// DeploymentStrategy describes how to replace existing pods with new ones.
+type DeploymentStrategy struct {
+	// Type of deployment. Can be "Recreate" or "RollingUpdate".
+	Type DeploymentStrategyType `json:"type,omitempty" description:"type of deployment, Recreate or RollingUpdate; defaults to RollingUpdate"`
+
+	// RollingUpdate holds the parameters of the RollingUpdate strategy.
+	RollingUpdate *RollingUpdateDeployment `json:"rollingUpdate,omitempty" description:"parameters of the RollingUpdate strategy"`
+}
+
+// RollingUpdateDeployment holds the parameters of a rolling update.
+type RollingUpdateDeployment struct {
+	// MaxUnavailable is the maximum number of pods, or percentage of the
+	// desired replicas, that can be unavailable during the update.
+	MaxUnavailable *util.IntOrString `json:"maxUnavailable,omitempty" description:"maximum number of pods, or percentage of desired pods rounded down (e.g. '10%'), that can be unavailable during the update; defaults to 1"`
+
+	// MaxSurge is the maximum number of pods, or percentage of the desired
+	// replicas, that can be created above the desired replicas during the update.
+	MaxSurge *util.IntOrString `json:"maxSurge,omitempty" description:"maximum number of pods, or percentage of desired pods rounded up (e.g. '10%'), that can be created above the desired number during the update; defaults to 1"`
+}
+
+// DeploymentStatus is the most recently observed status of a Deployment.
+type DeploymentStatus struct {
+	// Replicas is the total number of pods targeted by the deployment.
+	Replicas int `json:"replicas,omitempty" description:"total number of pods targeted by this deployment"`
+
+	// UpdatedReplicas is the number of pods created from the current template.
+	UpdatedReplicas int `json:"updatedReplicas,omitempty" description:"number of pods targeted by this deployment that have the current template"`
+
+	// Revision is the revision of the current template.
+	Revision int64 `json:"revision,omitempty" description:"revision of the current template"`
+
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" description:"reflects the generation of the most recently observed deployment"`
+}
+
+// Deployment declaratively manages a set of pods through replication
+// controllers, rolling out changes to its template server side.
+type Deployment struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	// Spec defines the desired behavior of the deployment.
+	Spec DeploymentSpec `json:"spec,omitempty" description:"specification of the desired behavior of the deployment; http://releases.k8s.io/HEAD/docs/api-conventions.md#spec-and-status"`
+
+	// Status is the most recently observed status of the deployment.
+	Status DeploymentStatus `json:"status,omitempty" description:"most recently observed status of the deployment; populated by the system, read-only; http://releases.k8s.io/HEAD/docs/api-conventions.md#spec-and-status"`
+}
+
+// DeploymentList is a collection of deployments.
+type DeploymentList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	Items []Deployment `json:"items" description:"list of deployments"`
+}
+
+// DaemonSetSpec is the specification of a daemon set.
+type DaemonSetSpec struct {
+	// Selector is a label query over the pods managed by the daemon set.
+	// If Selector is empty, it is defaulted to the labels present on the Pod template.
+	Selector map[string]string `json:"selector,omitempty" description:"label keys and values that must match in order to be managed by this daemon set, if empty defaulted to labels on Pod template; see http://releases.k8s.io/HEAD/docs/labels.md#label-selectors"`
+
+	// Template describes the pod that will be run on every eligible node.
+	Template *PodTemplateSpec `json:"template,omitempty" description:"object that describes the pod that will be run on every node whose labels match the template's node selector, or every node if it is empty"`
+}
+
+// DaemonSetStatus represents the current status of a daemon set.
+type DaemonSetStatus struct {
+	// CurrentNumberScheduled is the number of nodes that are running exactly
+	// one daemon pod and are supposed to run it.
+	CurrentNumberScheduled int `json:"currentNumberScheduled" description:"number of nodes that are running the daemon pod and are supposed to"`
+
+	// NumberMisscheduled is the number of nodes that are running a daemon pod
+	// but are not supposed to.
+	NumberMisscheduled int `json:"numberMisscheduled" description:"number of nodes that are running the daemon pod but are not supposed to"`
+
+	// DesiredNumberScheduled is the number of nodes that should be running
+	// the daemon pod.
+	DesiredNumberScheduled int `json:"desiredNumberScheduled" description:"number of nodes that should be running the daemon pod"`
+
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" description:"reflects the generation of the most recently observed daemon set"`
+}
+
+// DaemonSet runs a copy of a pod on every node whose labels match the pod
+// template's node selector.
+type DaemonSet struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	// Spec defines the desired behavior of the daemon set.
+	Spec DaemonSetSpec `json:"spec,omitempty" description:"specification of the desired behavior of the daemon set; http://releases.k8s.io/HEAD/docs/api-conventions.md#spec-and-status"`
+
+	// Status is the most recently observed status of the daemon set.
+	Status DaemonSetStatus `json:"status,omitempty" description:"most recently observed status of the daemon set; populated by the system, read-only; http://releases.k8s.io/HEAD/docs/api-conventions.md#spec-and-status"`
+}
+
+// DaemonSetList is a collection of daemon sets.
+type DaemonSetList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	Items []DaemonSet `json:"items" description:"list of daemon sets"`
+}
+
+// JobSpec describes how the job execution will look like.
+type JobSpec struct {
+	// Parallelism specifies the maximum desired number of pods the job should
+	// run at any given time.
+	Parallelism *int `json:"parallelism,omitempty" description:"maximum desired number of pods the job should run at any given time; defaults to 1"`
+
+	// Completions specifies the desired number of successfully finished pods
+	// the job should be run with.
+	Completions *int `json:"completions,omitempty" description:"desired number of successfully finished pods the job should be run with; defaults to 1"`
+
+	// ActiveDeadlineSeconds is the duration in seconds relative to the
+	// startTime that the job may be active before the system tries to
+	// terminate it.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty" description:"duration in seconds relative to the startTime that the job may be active before it is terminated; value must be a positive integer"`
+
+	// Selector is a label query over pods that should match the pod count.
+	// If Selector is empty, it is defaulted to the labels present on the Pod template.
+	Selector map[string]string `json:"selector,omitempty" description:"label keys and values that must match in order to be counted by this job, if empty defaulted to labels on Pod template; see http://releases.k8s.io/HEAD/docs/labels.md#label-selectors"`
+
+	// Template is the object that describes the pod that will be created
+	// when executing a job.
+	Template *PodTemplateSpec `json:"template,omitempty" description:"object that describes the pod that will be created when executing a job; restart policy must be OnFailure or Never"`
+}
+
+// JobStatus represents the current state of a Job.
+type JobStatus struct {
+	// Conditions represent the latest available observations of an object's current state.
+	Conditions []JobCondition `json:"conditions,omitempty" description:"latest available observations of the job's current state"`
+
+	// StartTime represents time when the job was acknowledged by the Job Manager.
+	StartTime *util.Time `json:"startTime,omitempty" description:"time when the job was acknowledged by the job controller"`
+
+	// CompletionTime represents time when the job was completed.
+	CompletionTime *util.Time `json:"completionTime,omitempty" description:"time when the job was completed"`
+
+	// Active is the number of actively running pods.
+	Active int `json:"active,omitempty" description:"number of actively running pods"`
+
+	// Succeeded is the number of pods which reached phase Succeeded.
+	Succeeded int `json:"succeeded,omitempty" description:"number of pods which reached phase Succeeded"`
+
+	// Failed is the number of pods which reached phase Failed.
+	Failed int `json:"failed,omitempty" description:"number of pods which reached phase Failed"`
+}
+
+type JobConditionType string
+
+// These are valid conditions of a job.
+const (
+	// JobComplete means the job has completed its execution.
+	JobComplete JobConditionType = "Complete"
+	// JobFailed means the job has failed its execution.
+	JobFailed JobConditionType = "Failed"
+)
+
+// JobCondition describes current state of a job.
+type JobCondition struct {
+	Type               JobConditionType `json:"type" description:"type of job condition, Complete or Failed"`
+	Status             ConditionStatus  `json:"status" description:"status of the condition, one of True, False, Unknown"`
+	LastProbeTime      util.Time        `json:"lastProbeTime,omitempty" description:"last time the condition was checked"`
+	LastTransitionTime util.Time        `json:"lastTransitionTime,omitempty" description:"last time the condition transit from one status to another"`
+	Reason             string           `json:"reason,omitempty" description:"(brief) reason for the condition's last transition"`
+	Message            string           `json:"message,omitempty" description:"human readable message indicating details about last transition"`
+}
+
+// Job represents the configuration of a single job, which runs pods until a
+// given number of them have terminated successfully.
+type Job struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	// Spec is a structure defining the expected behavior of a job.
+	Spec JobSpec `json:"spec,omitempty" description:"specification of the desired behavior of the job; http://releases.k8s.io/HEAD/docs/api-conventions.md#spec-and-status"`
+
+	// Status is a structure describing current status of a job.
+	Status JobStatus `json:"status,omitempty" description:"most recently observed status of the job; populated by the system, read-only; http://releases.k8s.io/HEAD/docs/api-conventions.md#spec-and-status"`
+}
+
+// JobList is a collection of jobs.
+type JobList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	Items []Job `json:"items" description:"list of jobs"`
+}
+
+// ScaleTargetReference names the object whose replica count an autoscaler
+// adjusts.
+type ScaleTargetReference struct {
+	// Kind of the referent.  Only "ReplicationController" is supported.
+	Kind string `json:"kind" description:"kind of the referent; only ReplicationController is supported"`
+
+	// Name of the referent, which must be in the same namespace as the autoscaler.
+	Name string `json:"name" description:"name of the referent, in the namespace of the autoscaler"`
+}
+
+// HorizontalPodAutoscalerSpec is the specification of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerSpec struct {
+	// ScaleRef is the object whose replicas are scaled.
+	ScaleRef ScaleTargetReference `json:"scaleRef" description:"reference to the replication controller whose replicas are scaled"`
+
+	// MinReplicas is the lower limit for the number of replicas the autoscaler
+	// can scale down to.
+	MinReplicas *int `json:"minReplicas,omitempty" description:"lower limit for the number of replicas; defaults to 1"`
+
+	// MaxReplicas is the upper limit for the number of replicas the autoscaler
+	// can scale up to.
+	MaxReplicas int `json:"maxReplicas" description:"upper limit for the number of replicas; cannot be smaller than minReplicas"`
+
+	// TargetCPUUtilizationPercentage is the target average CPU utilization of
+	// the pods, as a percentage of the CPU they request.
+	TargetCPUUtilizationPercentage *int `json:"targetCPUUtilizationPercentage,omitempty" description:"target average CPU utilization of the pods, as a percentage of requested CPU; defaults to 80"`
+}
+
+// HorizontalPodAutoscalerStatus is the current status of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerStatus struct {
+	// LastScaleTime is the last time the autoscaler changed the number of replicas.
+	LastScaleTime *util.Time `json:"lastScaleTime,omitempty" description:"last time the autoscaler changed the number of replicas"`
+
+	// CurrentReplicas is the number of replicas last seen by the autoscaler.
+	CurrentReplicas int `json:"currentReplicas" description:"number of replicas last seen by the autoscaler"`
+
+	// DesiredReplicas is the number of replicas the autoscaler last asked for.
+	DesiredReplicas int `json:"desiredReplicas" description:"number of replicas the autoscaler last asked for"`
+
+	// CurrentCPUUtilizationPercentage is the average CPU utilization of the
+	// pods last seen by the autoscaler, as a percentage of the CPU they request.
+	CurrentCPUUtilizationPercentage *int `json:"currentCPUUtilizationPercentage,omitempty" description:"average CPU utilization of the pods last seen by the autoscaler, as a percentage of requested CPU"`
+}
+
+// HorizontalPodAutoscaler automatically adjusts the number of replicas of a
+// replication controller so that its pods use a target share of the CPU they request.
+type HorizontalPodAutoscaler struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	// Spec defines the behaviour of the autoscaler.
+	Spec HorizontalPodAutoscalerSpec `json:"spec,omitempty" description:"specification of the desired behavior of the autoscaler; http://releases.k8s.io/HEAD/docs/api-conventions.md#spec-and-status"`
+
+	// Status is the current information about the autoscaler.
+	Status HorizontalPodAutoscalerStatus `json:"status,omitempty" description:"most recently observed status of the autoscaler; populated by the system, read-only; http://releases.k8s.io/HEAD/docs/api-conventions.md#spec-and-status"`
+}
+
+// HorizontalPodAutoscalerList is a collection of horizontal pod autoscalers.
+type HorizontalPodAutoscalerList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	Items []HorizontalPodAutoscaler `json:"items" description:"list of horizontal pod autoscalers"`
+}
+
 // Session Affinity Type string
 type ServiceAffinity string
 
@@ -1035,6 +1527,24 @@ const (
 	ServiceAffinityNone ServiceAffinity = "None"
 )
 
+// ServiceLoadBalancingPolicy describes how a proxy spreads a service's traffic across its endpoints.
+type ServiceLoadBalancingPolicy string
+
+const (
+	// ServiceLoadBalancingRoundRobin hands out endpoints in turn.
+	ServiceLoadBalancingRoundRobin ServiceLoadBalancingPolicy = "RoundRobin"
+
+	// ServiceLoadBalancingLeastConnections picks the endpoint with the fewest active connections.
+	ServiceLoadBalancingLeastConnections ServiceLoadBalancingPolicy = "LeastConnections"
+
+	// ServiceLoadBalancingWeighted hands out endpoints in proportion to their weights.
+	ServiceLoadBalancingWeighted ServiceLoadBalancingPolicy = "Weighted"
+
+	// ServiceLoadBalancingRandomTwoChoices samples two endpoints at random and picks the
+	// one with fewer active connections.
+	ServiceLoadBalancingRandomTwoChoices ServiceLoadBalancingPolicy = "RandomTwoChoices"
+)
+
 // Service Type string describes ingress methods for a service
 type ServiceType string
 
@@ -1106,6 +1616,10 @@ type ServiceSpec struct {
 
 	// Optional: Supports "ClientIP" and "None".  Used to maintain session affinity.
 	SessionAffinity ServiceAffinity `json:"sessionAffinity,omitempty" description:"enable client IP based session affinity; must be ClientIP or None; defaults to None"`
+
+	// Optional: Supports "RoundRobin", "LeastConnections", "Weighted" and "RandomTwoChoices".
+	// Only the userspace proxy honors it; the iptables proxy always picks endpoints at random.
+	LoadBalancingPolicy ServiceLoadBalancingPolicy `json:"loadBalancingPolicy,omitempty" description:"how the userspace proxy picks an endpoint for each new connection; must be RoundRobin, LeastConnections, Weighted or RandomTwoChoices; defaults to RoundRobin; ignored by the iptables proxy"`
 }
 
 type ServicePort struct {
@@ -1262,6 +1776,32 @@ type NodeSpec struct {
 	ProviderID string `json:"providerID,omitempty" description:"ID of the node assigned by the cloud provider in the format: <ProviderName>://<ProviderSpecificNodeID>"`
 	// Unschedulable controls node schedulability of new pods. By default node is schedulable.
 	Unschedulable bool `json:"unschedulable,omitempty" description:"disable pod scheduling on the node"`
+	// If specified, the node's taints.  Pods that do not tolerate a taint with
+	// the NoSchedule effect are not scheduled onto the node.
+	Taints []Taint `json:"taints,omitempty" description:"taints that repel pods which do not tolerate them; see http://releases.k8s.io/HEAD/docs/user-guide/node-selection/README.md"`
+}
+
+// TaintEffect is the effect a taint has on pods that do not tolerate it.
+type TaintEffect string
+
+const (
+	// Do not allow new pods to schedule onto the node unless they tolerate the taint.
+	// Pods already running on the node are not affected.
+	TaintEffectNoSchedule TaintEffect = "NoSchedule"
+	// Like TaintEffectNoSchedule, but the scheduler only tries to avoid placing a pod
+	// that does not tolerate the taint onto the node, rather than prohibiting it.
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+)
+
+// The node this Taint is attached to has the effect "effect" on
+// any pod that does not tolerate the Taint.
+type Taint struct {
+	// Required. The taint key to be applied to a node.
+	Key string `json:"key" description:"taint key to be applied to a node"`
+	// Required. The taint value corresponding to the taint key.
+	Value string `json:"value,omitempty" description:"taint value corresponding to the taint key"`
+	// Required. The effect of the taint on pods that do not tolerate the taint.
+	Effect TaintEffect `json:"effect" description:"effect of the taint on pods that do not tolerate it; NoSchedule or PreferNoSchedule"`
 }
 
 // NodeSystemInfo is a set of ids/uuids to uniquely identify the node.
@@ -1289,6 +1829,9 @@ type NodeStatus struct {
 	// Capacity represents the available resources of a node.
 	// see http://releases.k8s.io/HEAD/docs/resources.md for more details.
 	Capacity ResourceList `json:"capacity,omitempty" description:"compute resource capacity of the node; http://releases.k8s.io/HEAD/docs/resources.md"`
+	// Allocatable represents the resources of a node that are available for scheduling.
+	// Defaults to Capacity.
+	Allocatable ResourceList `json:"allocatable,omitempty" description:"compute resources of the node available for scheduling, i.e. the capacity minus the resources reserved for the system; defaults to capacity"`
 	// NodePhase is the current lifecycle phase of the node.
 	Phase NodePhase `json:"phase,omitempty" description:"most recently observed lifecycle phase of the node"`
 	// Conditions is an array of current node conditions.
@@ -1319,10 +1862,14 @@ type NodeConditionType string
 const (
 	// NodeReady means kubelet is healthy and ready to accept pods.
 	NodeReady NodeConditionType = "Ready"
+	// NodeMemoryPressure means the kubelet is under pressure due to insufficient available memory.
+	NodeMemoryPressure NodeConditionType = "MemoryPressure"
+	// NodeDiskPressure means the kubelet is under pressure due to insufficient available disk.
+	NodeDiskPressure NodeConditionType = "DiskPressure"
 )
 
 type NodeCondition struct {
-	Type               NodeConditionType `json:"type" description:"type of node condition, currently only Ready"`
+	Type               NodeConditionType `json:"type" description:"type of node condition, one of Ready, MemoryPressure or DiskPressure"`
 	Status             ConditionStatus   `json:"status" description:"status of the condition, one of True, False, Unknown"`
 	LastHeartbeatTime  util.Time         `json:"lastHeartbeatTime,omitempty" description:"last time we got an update on a given condition"`
 	LastTransitionTime util.Time         `json:"lastTransitionTime,omitempty" description:"last time the condition transit from one status to another"`
@@ -1767,8 +2314,12 @@ type LimitRangeItem struct {
 	Max ResourceList `json:"max,omitempty" description:"max usage constraints on this kind by resource name"`
 	// Min usage constraints on this kind by resource name
 	Min ResourceList `json:"min,omitempty" description:"min usage constraints on this kind by resource name"`
-	// Default usage constraints on this kind by resource name
-	Default ResourceList `json:"default,omitempty" description:"default values on this kind by resource name if omitted"`
+	// Default limit on this kind by resource name if omitted
+	Default ResourceList `json:"default,omitempty" description:"default limit on this kind by resource name if omitted"`
+	// DefaultRequest is the default request on this kind by resource name if omitted
+	DefaultRequest ResourceList `json:"defaultRequest,omitempty" description:"default request on this kind by resource name if omitted; defaults to the default limit"`
+	// MaxLimitRequestRatio is the highest ratio of limit to request allowed on this kind by resource name
+	MaxLimitRequestRatio ResourceList `json:"maxLimitRequestRatio,omitempty" description:"highest ratio of limit to request allowed on this kind by resource name"`
 }
 
 // LimitRangeSpec defines a min/max usage limit for resources that match on kind
@@ -1809,6 +2360,10 @@ const (
 	ResourceSecrets ResourceName = "secrets"
 	// ResourcePersistentVolumeClaims, number
 	ResourcePersistentVolumeClaims ResourceName = "persistentvolumeclaims"
+	// ResourceLimitsCPU, CPU limits in cores, while ResourceCPU counts CPU requests
+	ResourceLimitsCPU ResourceName = "limits.cpu"
+	// ResourceLimitsMemory, memory limits in bytes, while ResourceMemory counts memory requests
+	ResourceLimitsMemory ResourceName = "limits.memory"
 )
 
 // ResourceQuotaSpec defines the desired hard limits to enforce for Quota
@@ -1980,3 +2535,89 @@ type RangeAllocation struct {
 	Range string `json:"range" description:"a range string that identifies the range represented by 'data'; required"`
 	Data  []byte `json:"data" description:"a bit array containing all allocated addresses in the previous segment"`
 }
+
+// PolicyRule holds information that describes a policy rule, but does not contain information
+// about who the rule applies to or which namespace the rule applies to.
+type PolicyRule struct {
+	// Verbs is a list of verbs that apply to ALL the resources contained in this rule.
+	Verbs []string `json:"verbs" description:"list of verbs that apply to all the resources in this rule; '*' represents all verbs"`
+	// Resources is a list of resources this rule applies to.
+	Resources []string `json:"resources" description:"list of resources this rule applies to; '*' represents all resources"`
+}
+
+// Role is a namespaced, logical grouping of PolicyRules that can be referenced as a unit by a RoleBinding.
+type Role struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	// Rules holds all the PolicyRules for this Role
+	Rules []PolicyRule `json:"rules" description:"all the policy rules for this role"`
+}
+
+// RoleList is a collection of Roles
+type RoleList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	Items []Role `json:"items" description:"list of roles"`
+}
+
+// RoleBinding references a role, but does not contain it.  It can reference a Role in the same namespace or a
+// ClusterRole.  RoleBindings in a given namespace only have effect in that namespace.
+type RoleBinding struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	// Subjects holds references to the objects the role applies to.
+	Subjects []ObjectReference `json:"subjects" description:"references to the users, groups and service accounts the role applies to"`
+
+	// RoleRef can reference a Role in the current namespace or a ClusterRole.
+	RoleRef ObjectReference `json:"roleRef" description:"reference to a Role in the current namespace or to a ClusterRole"`
+}
+
+// RoleBindingList is a collection of RoleBindings
+type RoleBindingList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	Items []RoleBinding `json:"items" description:"list of role bindings"`
+}
+
+// ClusterRole is a cluster level, logical grouping of PolicyRules that can be referenced as a unit by a
+// RoleBinding or ClusterRoleBinding.
+type ClusterRole struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	// Rules holds all the PolicyRules for this ClusterRole
+	Rules []PolicyRule `json:"rules" description:"all the policy rules for this cluster role"`
+}
+
+// ClusterRoleList is a collection of ClusterRoles
+type ClusterRoleList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	Items []ClusterRole `json:"items" description:"list of cluster roles"`
+}
+
+// ClusterRoleBinding references a ClusterRole, but does not contain it.  It applies the ClusterRole in
+// every namespace and to cluster scoped resources.
+type ClusterRoleBinding struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	// Subjects holds references to the objects the role applies to.
+	Subjects []ObjectReference `json:"subjects" description:"references to the users, groups and service accounts the role applies to"`
+
+	// RoleRef can only reference a ClusterRole.
+	RoleRef ObjectReference `json:"roleRef" description:"reference to a ClusterRole"`
+}
+
+// ClusterRoleBindingList is a collection of ClusterRoleBindings
+type ClusterRoleBindingList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://releases.k8s.io/HEAD/docs/api-conventions.md#metadata"`
+
+	Items []ClusterRoleBinding `json:"items" description:"list of cluster role bindings"`
+}
@@ -23,6 +23,7 @@ import (
 	"path"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
@@ -53,6 +54,9 @@ var portNameErrorMsg string = fmt.Sprintf(`must be an IANA_SVC_NAME (at most 15
 
 const totalAnnotationSizeLimitB int = 64 * (1 << 10) // 64 kB
 
+// MaxPodPriority is the highest priority a pod may ask for.
+const MaxPodPriority = 1000000000
+
 // ValidateLabels validates that a set of labels are correctly defined.
 func ValidateLabels(labels map[string]string, field string) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -112,6 +116,37 @@ func ValidateReplicationControllerName(name string, prefix bool) (bool, string)
 	return nameIsDNSSubdomain(name, prefix)
 }
 
+// ValidateDeploymentName can be used to check whether the given deployment
+// name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateDeploymentName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateDaemonSetName can be used to check whether the given daemon set
+// name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateDaemonSetName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateJobName can be used to check whether the given job name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateJobName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateHorizontalPodAutoscalerName can be used to check whether the given
+// autoscaler name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateHorizontalPodAutoscalerName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
 // ValidateServiceName can be used to check whether the given service name is valid.
 // Prefix indicates this name will be used as part of generation, in which case
 // trailing dashes are allowed.
@@ -162,6 +197,21 @@ func ValidateServiceAccountName(name string, prefix bool) (bool, string) {
 	return nameIsDNSSubdomain(name, prefix)
 }
 
+// ValidateRoleName can be used to check whether the given role or cluster role name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateRoleName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateRoleBindingName can be used to check whether the given role binding or cluster
+// role binding name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateRoleBindingName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
 // ValidateEndpointsName can be used to check whether the given endpoints name is valid.
 // Prefix indicates this name will be used as part of generation, in which case
 // trailing dashes are allowed.
@@ -711,6 +761,23 @@ func validateProbe(probe *api.Probe) errs.ValidationErrorList {
 	if probe.TimeoutSeconds < 0 {
 		allErrs = append(allErrs, errs.NewFieldInvalid("timeout", probe.TimeoutSeconds, "may not be less than zero"))
 	}
+	if probe.PeriodSeconds < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("periodSeconds", probe.PeriodSeconds, "may not be less than zero"))
+	}
+	if probe.SuccessThreshold < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("successThreshold", probe.SuccessThreshold, "may not be less than zero"))
+	}
+	if probe.FailureThreshold < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("failureThreshold", probe.FailureThreshold, "may not be less than zero"))
+	}
+	return allErrs
+}
+
+func validateLivenessProbe(probe *api.Probe) errs.ValidationErrorList {
+	allErrs := validateProbe(probe)
+	if probe != nil && probe.SuccessThreshold > 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("successThreshold", probe.SuccessThreshold, "must be 1 for liveness probes"))
+	}
 	return allErrs
 }
 
@@ -853,7 +920,7 @@ func validateContainers(containers []api.Container, volumes util.StringSet) errs
 		if ctr.Lifecycle != nil {
 			cErrs = append(cErrs, validateLifecycle(ctr.Lifecycle).Prefix("lifecycle")...)
 		}
-		cErrs = append(cErrs, validateProbe(ctr.LivenessProbe).Prefix("livenessProbe")...)
+		cErrs = append(cErrs, validateLivenessProbe(ctr.LivenessProbe).Prefix("livenessProbe")...)
 		cErrs = append(cErrs, validateProbe(ctr.ReadinessProbe).Prefix("readinessProbe")...)
 		cErrs = append(cErrs, validatePorts(ctr.Ports).Prefix("ports")...)
 		cErrs = append(cErrs, validateEnv(ctr.Env).Prefix("env")...)
@@ -869,6 +936,44 @@ func validateContainers(containers []api.Container, volumes util.StringSet) errs
 	return allErrs
 }
 
+// validateInitContainers validates the init containers of a pod. Init containers
+// share the name space of the pod's containers and, since they run to completion
+// before any other container starts, may not expose ports, lifecycle hooks or probes.
+func validateInitContainers(containers, otherContainers []api.Container, volumes util.StringSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	if len(containers) == 0 {
+		return allErrs
+	}
+	allErrs = append(allErrs, validateContainers(containers, volumes)...)
+
+	otherNames := util.StringSet{}
+	for _, ctr := range otherContainers {
+		otherNames.Insert(ctr.Name)
+	}
+	for i, ctr := range containers {
+		cErrs := errs.ValidationErrorList{}
+		if otherNames.Has(ctr.Name) {
+			cErrs = append(cErrs, errs.NewFieldDuplicate("name", ctr.Name))
+		}
+		if len(ctr.Ports) > 0 {
+			cErrs = append(cErrs, errs.NewFieldForbidden("ports", ctr.Ports))
+		}
+		if ctr.Lifecycle != nil {
+			cErrs = append(cErrs, errs.NewFieldForbidden("lifecycle", ctr.Lifecycle))
+		}
+		if ctr.LivenessProbe != nil {
+			cErrs = append(cErrs, errs.NewFieldForbidden("livenessProbe", ctr.LivenessProbe))
+		}
+		if ctr.ReadinessProbe != nil {
+			cErrs = append(cErrs, errs.NewFieldForbidden("readinessProbe", ctr.ReadinessProbe))
+		}
+		allErrs = append(allErrs, cErrs.PrefixIndex(i)...)
+	}
+
+	return allErrs
+}
+
 func validateRestartPolicy(restartPolicy *api.RestartPolicy) errs.ValidationErrorList {
 	allErrors := errs.ValidationErrorList{}
 	switch *restartPolicy {
@@ -926,6 +1031,168 @@ func validateImagePullSecrets(imagePullSecrets []api.LocalObjectReference) errs.
 	return allErrors
 }
 
+// validateAffinity checks that the pod's scheduling constraints are well formed.
+func validateAffinity(affinity *api.Affinity) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if affinity.NodeAffinity != nil {
+		allErrs = append(allErrs, validateNodeAffinity(affinity.NodeAffinity).Prefix("nodeAffinity")...)
+	}
+	if affinity.PodAffinity != nil {
+		allErrs = append(allErrs, validatePodAffinityTerms(affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution).Prefix("podAffinity.requiredDuringSchedulingIgnoredDuringExecution")...)
+		allErrs = append(allErrs, validateWeightedPodAffinityTerms(affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution).Prefix("podAffinity.preferredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	if affinity.PodAntiAffinity != nil {
+		allErrs = append(allErrs, validatePodAffinityTerms(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).Prefix("podAntiAffinity.requiredDuringSchedulingIgnoredDuringExecution")...)
+		allErrs = append(allErrs, validateWeightedPodAffinityTerms(affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution).Prefix("podAntiAffinity.preferredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	return allErrs
+}
+
+func validatePodAffinityTerms(terms []api.PodAffinityTerm) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, term := range terms {
+		allErrs = append(allErrs, validatePodAffinityTerm(term).PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+func validateWeightedPodAffinityTerms(terms []api.WeightedPodAffinityTerm) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, term := range terms {
+		termErrs := errs.ValidationErrorList{}
+		if term.Weight < 1 || term.Weight > 100 {
+			termErrs = append(termErrs, errs.NewFieldInvalid("weight", term.Weight, "must be in the range 1-100"))
+		}
+		termErrs = append(termErrs, validatePodAffinityTerm(term.PodAffinityTerm).Prefix("podAffinityTerm")...)
+		allErrs = append(allErrs, termErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+func validatePodAffinityTerm(term api.PodAffinityTerm) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateLabels(term.Selector, "selector")...)
+	for _, namespace := range term.Namespaces {
+		if ok, msg := ValidateNamespaceName(namespace, false); !ok {
+			allErrs = append(allErrs, errs.NewFieldInvalid("namespaces", namespace, msg))
+		}
+	}
+	if len(term.TopologyKey) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("topologyKey"))
+	} else if !util.IsQualifiedName(term.TopologyKey) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("topologyKey", term.TopologyKey, qualifiedNameErrorMsg))
+	}
+	return allErrs
+}
+
+func validateNodeAffinity(nodeAffinity *api.NodeAffinity) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if required := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+		allErrs = append(allErrs, validateNodeSelector(required).Prefix("requiredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	for i, term := range nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		termErrs := errs.ValidationErrorList{}
+		if term.Weight < 1 || term.Weight > 100 {
+			termErrs = append(termErrs, errs.NewFieldInvalid("weight", term.Weight, "must be in the range 1-100"))
+		}
+		termErrs = append(termErrs, validateNodeSelectorTerm(term.Preference).Prefix("preference")...)
+		allErrs = append(allErrs, termErrs.PrefixIndex(i).Prefix("preferredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	return allErrs
+}
+
+func validateNodeSelector(nodeSelector *api.NodeSelector) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		return append(allErrs, errs.NewFieldRequired("nodeSelectorTerms"))
+	}
+	for i, term := range nodeSelector.NodeSelectorTerms {
+		allErrs = append(allErrs, validateNodeSelectorTerm(term).PrefixIndex(i).Prefix("nodeSelectorTerms")...)
+	}
+	return allErrs
+}
+
+func validateNodeSelectorTerm(term api.NodeSelectorTerm) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(term.MatchExpressions) == 0 {
+		return append(allErrs, errs.NewFieldRequired("matchExpressions"))
+	}
+	for i, requirement := range term.MatchExpressions {
+		allErrs = append(allErrs, validateNodeSelectorRequirement(requirement).PrefixIndex(i).Prefix("matchExpressions")...)
+	}
+	return allErrs
+}
+
+var supportedNodeSelectorOperators = util.NewStringSet(
+	string(api.NodeSelectorOpIn), string(api.NodeSelectorOpNotIn),
+	string(api.NodeSelectorOpExists), string(api.NodeSelectorOpDoesNotExist),
+	string(api.NodeSelectorOpGt), string(api.NodeSelectorOpLt))
+
+func validateNodeSelectorRequirement(requirement api.NodeSelectorRequirement) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if !util.IsQualifiedName(requirement.Key) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("key", requirement.Key, qualifiedNameErrorMsg))
+	}
+	switch requirement.Operator {
+	case api.NodeSelectorOpIn, api.NodeSelectorOpNotIn:
+		if len(requirement.Values) == 0 {
+			allErrs = append(allErrs, errs.NewFieldRequired("values"))
+		}
+		for _, value := range requirement.Values {
+			if !util.IsValidLabelValue(value) {
+				allErrs = append(allErrs, errs.NewFieldInvalid("values", value, labelValueErrorMsg))
+			}
+		}
+	case api.NodeSelectorOpExists, api.NodeSelectorOpDoesNotExist:
+		if len(requirement.Values) != 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("values", requirement.Values, "must be empty for operator "+string(requirement.Operator)))
+		}
+	case api.NodeSelectorOpGt, api.NodeSelectorOpLt:
+		if len(requirement.Values) != 1 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("values", requirement.Values, "must have a single element for operator "+string(requirement.Operator)))
+		} else if _, err := strconv.ParseInt(requirement.Values[0], 10, 64); err != nil {
+			allErrs = append(allErrs, errs.NewFieldInvalid("values", requirement.Values[0], "must be an integer for operator "+string(requirement.Operator)))
+		}
+	default:
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("operator", requirement.Operator, supportedNodeSelectorOperators.List()))
+	}
+	return allErrs
+}
+
+var supportedTaintEffects = util.NewStringSet(string(api.TaintEffectNoSchedule), string(api.TaintEffectPreferNoSchedule))
+
+var supportedTolerationOperators = util.NewStringSet(string(api.TolerationOpEqual), string(api.TolerationOpExists))
+
+func validateTolerations(tolerations []api.Toleration) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, toleration := range tolerations {
+		tolerationErrs := errs.ValidationErrorList{}
+		if len(toleration.Key) > 0 && !util.IsQualifiedName(toleration.Key) {
+			tolerationErrs = append(tolerationErrs, errs.NewFieldInvalid("key", toleration.Key, qualifiedNameErrorMsg))
+		}
+		switch toleration.Operator {
+		case api.TolerationOpEqual, "":
+			if len(toleration.Key) == 0 {
+				tolerationErrs = append(tolerationErrs, errs.NewFieldInvalid("operator", toleration.Operator, "must be Exists when key is empty"))
+			}
+			if !util.IsValidLabelValue(toleration.Value) {
+				tolerationErrs = append(tolerationErrs, errs.NewFieldInvalid("value", toleration.Value, labelValueErrorMsg))
+			}
+		case api.TolerationOpExists:
+			if len(toleration.Value) > 0 {
+				tolerationErrs = append(tolerationErrs, errs.NewFieldInvalid("value", toleration.Value, "must be empty when operator is Exists"))
+			}
+		default:
+			tolerationErrs = append(tolerationErrs, errs.NewFieldValueNotSupported("operator", toleration.Operator, supportedTolerationOperators.List()))
+		}
+		if len(toleration.Effect) > 0 && !supportedTaintEffects.Has(string(toleration.Effect)) {
+			tolerationErrs = append(tolerationErrs, errs.NewFieldValueNotSupported("effect", toleration.Effect, supportedTaintEffects.List()))
+		}
+		allErrs = append(allErrs, tolerationErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
 // ValidatePod tests if required fields in the pod are set.
 func ValidatePod(pod *api.Pod) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -945,9 +1212,14 @@ func ValidatePodSpec(spec *api.PodSpec) errs.ValidationErrorList {
 	allVolumes, vErrs := validateVolumes(spec.Volumes)
 	allErrs = append(allErrs, vErrs.Prefix("volumes")...)
 	allErrs = append(allErrs, validateContainers(spec.Containers, allVolumes).Prefix("containers")...)
+	allErrs = append(allErrs, validateInitContainers(spec.InitContainers, spec.Containers, allVolumes).Prefix("initContainers")...)
 	allErrs = append(allErrs, validateRestartPolicy(&spec.RestartPolicy).Prefix("restartPolicy")...)
 	allErrs = append(allErrs, validateDNSPolicy(&spec.DNSPolicy).Prefix("dnsPolicy")...)
 	allErrs = append(allErrs, ValidateLabels(spec.NodeSelector, "nodeSelector")...)
+	if spec.Affinity != nil {
+		allErrs = append(allErrs, validateAffinity(spec.Affinity).Prefix("affinity")...)
+	}
+	allErrs = append(allErrs, validateTolerations(spec.Tolerations).Prefix("tolerations")...)
 	allErrs = append(allErrs, validateHostNetwork(spec.HostNetwork, spec.Containers).Prefix("hostNetwork")...)
 	allErrs = append(allErrs, validateImagePullSecrets(spec.ImagePullSecrets).Prefix("imagePullSecrets")...)
 	if len(spec.ServiceAccountName) > 0 {
@@ -961,6 +1233,11 @@ func ValidatePodSpec(spec *api.PodSpec) errs.ValidationErrorList {
 			allErrs = append(allErrs, errs.NewFieldInvalid("activeDeadlineSeconds", spec.ActiveDeadlineSeconds, "activeDeadlineSeconds must be a positive integer greater than 0"))
 		}
 	}
+	if spec.Priority < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("priority", spec.Priority, isNegativeErrorMsg))
+	} else if spec.Priority > MaxPodPriority {
+		allErrs = append(allErrs, errs.NewFieldInvalid("priority", spec.Priority, fmt.Sprintf("must be no more than %d", MaxPodPriority)))
+	}
 	return allErrs
 }
 
@@ -1028,6 +1305,7 @@ func ValidatePodTemplateUpdate(newPod, oldPod *api.PodTemplate) errs.ValidationE
 }
 
 var supportedSessionAffinityType = util.NewStringSet(string(api.ServiceAffinityClientIP), string(api.ServiceAffinityNone))
+var supportedLoadBalancingPolicies = util.NewStringSet(string(api.ServiceLoadBalancingRoundRobin), string(api.ServiceLoadBalancingLeastConnections), string(api.ServiceLoadBalancingWeighted), string(api.ServiceLoadBalancingRandomTwoChoices))
 var supportedServiceType = util.NewStringSet(string(api.ServiceTypeClusterIP), string(api.ServiceTypeNodePort),
 	string(api.ServiceTypeLoadBalancer))
 
@@ -1062,6 +1340,10 @@ func ValidateService(service *api.Service) errs.ValidationErrorList {
 		allErrs = append(allErrs, errs.NewFieldValueNotSupported("spec.sessionAffinity", service.Spec.SessionAffinity, supportedSessionAffinityType.List()))
 	}
 
+	if service.Spec.LoadBalancingPolicy != "" && !supportedLoadBalancingPolicies.Has(string(service.Spec.LoadBalancingPolicy)) {
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("spec.loadBalancingPolicy", service.Spec.LoadBalancingPolicy, supportedLoadBalancingPolicies.List()))
+	}
+
 	if api.IsServiceIPSet(service) {
 		if ip := net.ParseIP(service.Spec.ClusterIP); ip == nil {
 			allErrs = append(allErrs, errs.NewFieldInvalid("spec.clusterIP", service.Spec.ClusterIP, "clusterIP should be empty, 'None', or a valid IP address"))
@@ -1211,6 +1493,261 @@ func ValidateReplicationControllerSpec(spec *api.ReplicationControllerSpec) errs
 	return allErrs
 }
 
+// ValidateDeployment tests if required fields in the deployment are set.
+func ValidateDeployment(deployment *api.Deployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&deployment.ObjectMeta, true, ValidateDeploymentName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDeploymentSpec(&deployment.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDeploymentUpdate tests if required fields in the deployment are set.
+func ValidateDeploymentUpdate(oldDeployment, deployment *api.Deployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&deployment.ObjectMeta, &oldDeployment.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDeploymentSpec(&deployment.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDeploymentSpec tests if required fields in the deployment spec are set.
+func ValidateDeploymentSpec(spec *api.DeploymentSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	}
+	if spec.Replicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("replicas", spec.Replicas, isNegativeErrorMsg))
+	}
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("revisionHistoryLimit", *spec.RevisionHistoryLimit, isNegativeErrorMsg))
+	}
+	if spec.RollbackTo != nil && spec.RollbackTo.Revision < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("rollbackTo.revision", spec.RollbackTo.Revision, isNegativeErrorMsg))
+	}
+
+	if spec.Template == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("template"))
+	} else {
+		labels := labels.Set(spec.Template.Labels)
+		if !selector.Matches(labels) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+		}
+		allErrs = append(allErrs, ValidatePodTemplateSpec(spec.Template, spec.Replicas).Prefix("template")...)
+		// RestartPolicy has already been first-order validated as per ValidatePodTemplateSpec().
+		if spec.Template.Spec.RestartPolicy != api.RestartPolicyAlways {
+			allErrs = append(allErrs, errs.NewFieldValueNotSupported("template.spec.restartPolicy", spec.Template.Spec.RestartPolicy, []string{string(api.RestartPolicyAlways)}))
+		}
+	}
+	allErrs = append(allErrs, validateDeploymentStrategy(&spec.Strategy).Prefix("strategy")...)
+	return allErrs
+}
+
+func validateDeploymentStrategy(strategy *api.DeploymentStrategy) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	switch strategy.Type {
+	case api.RecreateDeploymentStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, errs.NewFieldForbidden("rollingUpdate", "may not be specified when strategy type is Recreate"))
+		}
+	case api.RollingUpdateDeploymentStrategyType:
+		if strategy.RollingUpdate == nil {
+			allErrs = append(allErrs, errs.NewFieldRequired("rollingUpdate"))
+			break
+		}
+		allErrs = append(allErrs, validateRollingUpdateDeployment(strategy.RollingUpdate).Prefix("rollingUpdate")...)
+	case "":
+		allErrs = append(allErrs, errs.NewFieldRequired("type"))
+	default:
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("type", strategy.Type, []string{string(api.RecreateDeploymentStrategyType), string(api.RollingUpdateDeploymentStrategyType)}))
+	}
+	return allErrs
+}
+
+func validateRollingUpdateDeployment(rollingUpdate *api.RollingUpdateDeployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	maxUnavailable, unavailableErrs := validateIntOrPercent(rollingUpdate.MaxUnavailable, "maxUnavailable")
+	allErrs = append(allErrs, unavailableErrs...)
+	maxSurge, surgeErrs := validateIntOrPercent(rollingUpdate.MaxSurge, "maxSurge")
+	allErrs = append(allErrs, surgeErrs...)
+	if len(allErrs) == 0 && maxUnavailable == 0 && maxSurge == 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("maxUnavailable", rollingUpdate.MaxUnavailable.String(), "may not be 0 when maxSurge is 0"))
+	}
+	return allErrs
+}
+
+// validateIntOrPercent checks that value is a non-negative integer or a
+// percentage between 0% and 100%, and returns the number it holds.
+func validateIntOrPercent(value util.IntOrString, field string) (int, errs.ValidationErrorList) {
+	allErrs := errs.ValidationErrorList{}
+	v, isPercent, err := util.GetIntOrPercentValue(value)
+	if err != nil {
+		allErrs = append(allErrs, errs.NewFieldInvalid(field, value.String(), "must be an integer or a percentage (e.g. 25%)"))
+		return 0, allErrs
+	}
+	if v < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid(field, value.String(), isNegativeErrorMsg))
+	}
+	if isPercent && v > 100 {
+		allErrs = append(allErrs, errs.NewFieldInvalid(field, value.String(), "must not be greater than 100%"))
+	}
+	return v, allErrs
+}
+
+// ValidateDaemonSet tests if required fields in the daemon set are set.
+func ValidateDaemonSet(daemonSet *api.DaemonSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&daemonSet.ObjectMeta, true, ValidateDaemonSetName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDaemonSetSpec(&daemonSet.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDaemonSetUpdate tests if required fields in the daemon set are set.
+func ValidateDaemonSetUpdate(oldDaemonSet, daemonSet *api.DaemonSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&daemonSet.ObjectMeta, &oldDaemonSet.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDaemonSetSpec(&daemonSet.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDaemonSetSpec tests if required fields in the daemon set spec are set.
+func ValidateDaemonSetSpec(spec *api.DaemonSetSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	}
+
+	if spec.Template == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("template"))
+		return allErrs
+	}
+	labels := labels.Set(spec.Template.Labels)
+	if !selector.Matches(labels) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+	}
+	allErrs = append(allErrs, ValidatePodTemplateSpec(spec.Template, 0).Prefix("template")...)
+	// Daemon pods run on many nodes at once, so the same restrictions as for
+	// replicated pods apply to their disks.
+	allErrs = append(allErrs, ValidateReadOnlyPersistentDisks(spec.Template.Spec.Volumes).Prefix("template.spec.volumes")...)
+	// RestartPolicy has already been first-order validated as per ValidatePodTemplateSpec().
+	if spec.Template.Spec.RestartPolicy != api.RestartPolicyAlways {
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("template.spec.restartPolicy", spec.Template.Spec.RestartPolicy, []string{string(api.RestartPolicyAlways)}))
+	}
+	if spec.Template.Spec.NodeName != "" {
+		allErrs = append(allErrs, errs.NewFieldForbidden("template.spec.nodeName", "nodes are assigned by the daemon set controller"))
+	}
+	return allErrs
+}
+
+// ValidateJob tests if required fields in the job are set.
+func ValidateJob(job *api.Job) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&job.ObjectMeta, true, ValidateJobName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateJobSpec(&job.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateJobUpdate tests if required fields in the job are set, and that
+// nothing but parallelism has changed in its spec.
+func ValidateJobUpdate(oldJob, job *api.Job) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&job.ObjectMeta, &oldJob.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateJobSpec(&job.Spec).Prefix("spec")...)
+
+	// Only parallelism may be changed once a job exists; the rest of the spec
+	// describes work that may already be partially done.
+	oldSpec := oldJob.Spec
+	oldSpec.Parallelism = job.Spec.Parallelism
+	if !api.Semantic.DeepEqual(oldSpec, job.Spec) {
+		allErrs = append(allErrs, errs.NewFieldForbidden("spec", "updates to job spec for fields other than 'parallelism' are forbidden"))
+	}
+	return allErrs
+}
+
+// ValidateJobSpec tests if required fields in the job spec are set.
+func ValidateJobSpec(spec *api.JobSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	if spec.Parallelism != nil && *spec.Parallelism < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("parallelism", *spec.Parallelism, isNegativeErrorMsg))
+	}
+	if spec.Completions != nil && *spec.Completions < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("completions", *spec.Completions, isNegativeErrorMsg))
+	}
+	if spec.ActiveDeadlineSeconds != nil && *spec.ActiveDeadlineSeconds <= 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("activeDeadlineSeconds", *spec.ActiveDeadlineSeconds, "activeDeadlineSeconds must be a positive integer greater than 0"))
+	}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	}
+
+	if spec.Template == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("template"))
+		return allErrs
+	}
+	labels := labels.Set(spec.Template.Labels)
+	if !selector.Matches(labels) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+	}
+	allErrs = append(allErrs, ValidatePodTemplateSpec(spec.Template, 0).Prefix("template")...)
+	// RestartPolicy has already been first-order validated as per ValidatePodTemplateSpec().
+	if spec.Template.Spec.RestartPolicy != api.RestartPolicyOnFailure &&
+		spec.Template.Spec.RestartPolicy != api.RestartPolicyNever {
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("template.spec.restartPolicy",
+			spec.Template.Spec.RestartPolicy,
+			[]string{string(api.RestartPolicyOnFailure), string(api.RestartPolicyNever)}))
+	}
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscaler tests if required fields in the autoscaler are set.
+func ValidateHorizontalPodAutoscaler(autoscaler *api.HorizontalPodAutoscaler) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&autoscaler.ObjectMeta, true, ValidateHorizontalPodAutoscalerName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateHorizontalPodAutoscalerSpec(&autoscaler.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscalerUpdate tests if required fields in the autoscaler are set.
+func ValidateHorizontalPodAutoscalerUpdate(oldAutoscaler, autoscaler *api.HorizontalPodAutoscaler) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&autoscaler.ObjectMeta, &oldAutoscaler.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateHorizontalPodAutoscalerSpec(&autoscaler.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscalerSpec tests if required fields in the autoscaler spec are set.
+func ValidateHorizontalPodAutoscalerSpec(spec *api.HorizontalPodAutoscalerSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if spec.ScaleRef.Kind != "ReplicationController" {
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("scaleRef.kind", spec.ScaleRef.Kind, []string{"ReplicationController"}))
+	}
+	if len(spec.ScaleRef.Name) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("scaleRef.name"))
+	} else if ok, msg := ValidateReplicationControllerName(spec.ScaleRef.Name, false); !ok {
+		allErrs = append(allErrs, errs.NewFieldInvalid("scaleRef.name", spec.ScaleRef.Name, msg))
+	}
+	minReplicas := 1
+	if spec.MinReplicas != nil {
+		minReplicas = *spec.MinReplicas
+		if minReplicas < 1 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("minReplicas", minReplicas, "must be at least 1"))
+		}
+	}
+	if spec.MaxReplicas < minReplicas {
+		allErrs = append(allErrs, errs.NewFieldInvalid("maxReplicas", spec.MaxReplicas, "must not be smaller than minReplicas"))
+	}
+	if spec.TargetCPUUtilizationPercentage != nil && *spec.TargetCPUUtilizationPercentage < 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("targetCPUUtilizationPercentage", *spec.TargetCPUUtilizationPercentage, "must be at least 1"))
+	}
+	return allErrs
+}
+
 // ValidatePodTemplateSpec validates the spec of a pod template
 func ValidatePodTemplateSpec(spec *api.PodTemplateSpec, replicas int) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -1248,10 +1785,41 @@ func ValidateNode(node *api.Node) errs.ValidationErrorList {
 		allErrs = append(allErrs, errs.NewFieldRequired("spec.ExternalID"))
 	}
 
+	allErrs = append(allErrs, validateTaints(node.Spec.Taints).Prefix("spec.taints")...)
+
 	// TODO(rjnagal): Ignore PodCIDR till its completely implemented.
 	return allErrs
 }
 
+func validateTaints(taints []api.Taint) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	seen := util.StringSet{}
+	for i, taint := range taints {
+		taintErrs := errs.ValidationErrorList{}
+		if len(taint.Key) == 0 {
+			taintErrs = append(taintErrs, errs.NewFieldRequired("key"))
+		} else if !util.IsQualifiedName(taint.Key) {
+			taintErrs = append(taintErrs, errs.NewFieldInvalid("key", taint.Key, qualifiedNameErrorMsg))
+		}
+		if !util.IsValidLabelValue(taint.Value) {
+			taintErrs = append(taintErrs, errs.NewFieldInvalid("value", taint.Value, labelValueErrorMsg))
+		}
+		if len(taint.Effect) == 0 {
+			taintErrs = append(taintErrs, errs.NewFieldRequired("effect"))
+		} else if !supportedTaintEffects.Has(string(taint.Effect)) {
+			taintErrs = append(taintErrs, errs.NewFieldValueNotSupported("effect", taint.Effect, supportedTaintEffects.List()))
+		}
+		// A node may carry at most one taint per key and effect.
+		id := taint.Key + ":" + string(taint.Effect)
+		if seen.Has(id) {
+			taintErrs = append(taintErrs, errs.NewFieldDuplicate("key", taint.Key))
+		}
+		seen.Insert(id)
+		allErrs = append(allErrs, taintErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
 // ValidateNodeUpdate tests to make sure a node update can be applied.  Modifies oldNode.
 func ValidateNodeUpdate(oldNode *api.Node, node *api.Node) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -1281,6 +1849,9 @@ func ValidateNodeUpdate(oldNode *api.Node, node *api.Node) errs.ValidationErrorL
 	oldNode.Spec.PodCIDR = node.Spec.PodCIDR
 	// Allow users to unschedule node
 	oldNode.Spec.Unschedulable = node.Spec.Unschedulable
+	// Allow users to taint the node
+	allErrs = append(allErrs, validateTaints(node.Spec.Taints).Prefix("spec.taints")...)
+	oldNode.Spec.Taints = node.Spec.Taints
 	// Clear status
 	oldNode.Status = node.Status
 
@@ -1310,6 +1881,15 @@ func validateResourceName(value string, field string) errs.ValidationErrorList {
 	return errs.ValidationErrorList{}
 }
 
+// validateResourceQuotaResourceName validates the name of a resource that a
+// quota tracks, which may also be one of the quota specific names.
+func validateResourceQuotaResourceName(value string, field string) errs.ValidationErrorList {
+	if api.IsStandardQuotaResourceName(value) {
+		return errs.ValidationErrorList{}
+	}
+	return validateResourceName(value, field)
+}
+
 // ValidateLimitRange tests if required fields in the LimitRange are set.
 func ValidateLimitRange(limitRange *api.LimitRange) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -1348,6 +1928,26 @@ func ValidateLimitRange(limitRange *api.LimitRange) errs.ValidationErrorList {
 			q := limit.Default[k]
 			defaults[string(k)] = q.Value()
 		}
+		for k, q := range limit.DefaultRequest {
+			field := fmt.Sprintf("spec.limits[%d].defaultRequest[%s]", i, k)
+			allErrs = append(allErrs, validateResourceName(string(k), field)...)
+			if minQuantity, found := limit.Min[k]; found && minQuantity.MilliValue() > q.MilliValue() {
+				allErrs = append(allErrs, errs.NewFieldInvalid(field, q.String(), fmt.Sprintf("min value %s is greater than default request value %s", minQuantity.String(), q.String())))
+			}
+			if maxQuantity, found := limit.Max[k]; found && q.MilliValue() > maxQuantity.MilliValue() {
+				allErrs = append(allErrs, errs.NewFieldInvalid(field, q.String(), fmt.Sprintf("default request value %s is greater than max value %s", q.String(), maxQuantity.String())))
+			}
+			if defaultQuantity, found := limit.Default[k]; found && q.MilliValue() > defaultQuantity.MilliValue() {
+				allErrs = append(allErrs, errs.NewFieldInvalid(field, q.String(), fmt.Sprintf("default request value %s is greater than default limit value %s", q.String(), defaultQuantity.String())))
+			}
+		}
+		for k, q := range limit.MaxLimitRequestRatio {
+			field := fmt.Sprintf("spec.limits[%d].maxLimitRequestRatio[%s]", i, k)
+			allErrs = append(allErrs, validateResourceName(string(k), field)...)
+			if q.MilliValue() < 1000 {
+				allErrs = append(allErrs, errs.NewFieldInvalid(field, q.String(), "ratio of limit to request may not be less than 1"))
+			}
+		}
 
 		for k := range keys {
 			minValue, minValueFound := min[k]
@@ -1490,6 +2090,12 @@ func ValidateResourceRequirements(requirements *api.ResourceRequirements) errs.V
 		}
 		allErrs = append(allErrs, errs...)
 	}
+	// The limit, if any, must cover the request.
+	for resourceName, quantity := range requirements.Requests {
+		if limit, found := requirements.Limits[resourceName]; found && quantity.MilliValue() > limit.MilliValue() {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("requests[%s]", resourceName), quantity.String(), fmt.Sprintf("must be less than or equal to the limit %s", limit.String())))
+		}
+	}
 	return allErrs
 }
 
@@ -1499,13 +2105,13 @@ func ValidateResourceQuota(resourceQuota *api.ResourceQuota) errs.ValidationErro
 	allErrs = append(allErrs, ValidateObjectMeta(&resourceQuota.ObjectMeta, true, ValidateResourceQuotaName).Prefix("metadata")...)
 
 	for k := range resourceQuota.Spec.Hard {
-		allErrs = append(allErrs, validateResourceName(string(k), string(resourceQuota.TypeMeta.Kind))...)
+		allErrs = append(allErrs, validateResourceQuotaResourceName(string(k), string(resourceQuota.TypeMeta.Kind))...)
 	}
 	for k := range resourceQuota.Status.Hard {
-		allErrs = append(allErrs, validateResourceName(string(k), string(resourceQuota.TypeMeta.Kind))...)
+		allErrs = append(allErrs, validateResourceQuotaResourceName(string(k), string(resourceQuota.TypeMeta.Kind))...)
 	}
 	for k := range resourceQuota.Status.Used {
-		allErrs = append(allErrs, validateResourceName(string(k), string(resourceQuota.TypeMeta.Kind))...)
+		allErrs = append(allErrs, validateResourceQuotaResourceName(string(k), string(resourceQuota.TypeMeta.Kind))...)
 	}
 	return allErrs
 }
@@ -1516,7 +2122,7 @@ func ValidateResourceQuotaUpdate(newResourceQuota, oldResourceQuota *api.Resourc
 	allErrs := errs.ValidationErrorList{}
 	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newResourceQuota.ObjectMeta, &oldResourceQuota.ObjectMeta).Prefix("metadata")...)
 	for k := range newResourceQuota.Spec.Hard {
-		allErrs = append(allErrs, validateResourceName(string(k), string(newResourceQuota.TypeMeta.Kind))...)
+		allErrs = append(allErrs, validateResourceQuotaResourceName(string(k), string(newResourceQuota.TypeMeta.Kind))...)
 	}
 	newResourceQuota.Status = oldResourceQuota.Status
 	return allErrs
@@ -1531,10 +2137,10 @@ func ValidateResourceQuotaStatusUpdate(newResourceQuota, oldResourceQuota *api.R
 		allErrs = append(allErrs, errs.NewFieldRequired("resourceVersion"))
 	}
 	for k := range newResourceQuota.Status.Hard {
-		allErrs = append(allErrs, validateResourceName(string(k), string(newResourceQuota.TypeMeta.Kind))...)
+		allErrs = append(allErrs, validateResourceQuotaResourceName(string(k), string(newResourceQuota.TypeMeta.Kind))...)
 	}
 	for k := range newResourceQuota.Status.Used {
-		allErrs = append(allErrs, validateResourceName(string(k), string(newResourceQuota.TypeMeta.Kind))...)
+		allErrs = append(allErrs, validateResourceQuotaResourceName(string(k), string(newResourceQuota.TypeMeta.Kind))...)
 	}
 	newResourceQuota.Spec = oldResourceQuota.Spec
 	return allErrs
@@ -1711,3 +2317,128 @@ func ValidateSecurityContext(sc *api.SecurityContext) errs.ValidationErrorList {
 	}
 	return allErrs
 }
+
+// validatePolicyRules tests that every rule names at least one verb and one resource.
+func validatePolicyRules(rules []api.PolicyRule) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, rule := range rules {
+		ruleErrs := errs.ValidationErrorList{}
+		if len(rule.Verbs) == 0 {
+			ruleErrs = append(ruleErrs, errs.NewFieldRequired("verbs"))
+		}
+		if len(rule.Resources) == 0 {
+			ruleErrs = append(ruleErrs, errs.NewFieldRequired("resources"))
+		}
+		allErrs = append(allErrs, ruleErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+var supportedSubjectKinds = util.NewStringSet(api.UserKind, api.GroupKind, api.ServiceAccountKind)
+
+// validateRoleBindingSubjects tests that every subject of a binding is a user, group or service account.
+func validateRoleBindingSubjects(subjects []api.ObjectReference) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, subject := range subjects {
+		subjectErrs := errs.ValidationErrorList{}
+		if len(subject.Name) == 0 {
+			subjectErrs = append(subjectErrs, errs.NewFieldRequired("name"))
+		}
+		if !supportedSubjectKinds.Has(subject.Kind) {
+			subjectErrs = append(subjectErrs, errs.NewFieldValueNotSupported("kind", subject.Kind, supportedSubjectKinds.List()))
+		}
+		if subject.Kind == api.ServiceAccountKind && len(subject.Namespace) == 0 {
+			subjectErrs = append(subjectErrs, errs.NewFieldRequired("namespace"))
+		}
+		allErrs = append(allErrs, subjectErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+// validateRoleRef tests that a role reference names a role of one of the given kinds.
+func validateRoleRef(roleRef *api.ObjectReference, kinds util.StringSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(roleRef.Name) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("name"))
+	} else if ok, msg := ValidateRoleName(roleRef.Name, false); !ok {
+		allErrs = append(allErrs, errs.NewFieldInvalid("name", roleRef.Name, msg))
+	}
+	if !kinds.Has(roleRef.Kind) {
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("kind", roleRef.Kind, kinds.List()))
+	}
+	return allErrs
+}
+
+// ValidateRole tests if required fields in the Role are set.
+func ValidateRole(role *api.Role) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&role.ObjectMeta, true, ValidateRoleName).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+// ValidateRoleUpdate tests to make sure a role update can be applied.
+func ValidateRoleUpdate(oldRole, newRole *api.Role) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newRole.ObjectMeta, &oldRole.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(newRole.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+// ValidateClusterRole tests if required fields in the ClusterRole are set.
+func ValidateClusterRole(role *api.ClusterRole) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&role.ObjectMeta, false, ValidateRoleName).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+// ValidateClusterRoleUpdate tests to make sure a cluster role update can be applied.
+func ValidateClusterRoleUpdate(oldRole, newRole *api.ClusterRole) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newRole.ObjectMeta, &oldRole.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(newRole.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+// ValidateRoleBinding tests if required fields in the RoleBinding are set.
+func ValidateRoleBinding(binding *api.RoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&binding.ObjectMeta, true, ValidateRoleBindingName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleBindingSubjects(binding.Subjects).Prefix("subjects")...)
+	allErrs = append(allErrs, validateRoleRef(&binding.RoleRef, util.NewStringSet(api.RoleKind, api.ClusterRoleKind)).Prefix("roleRef")...)
+	return allErrs
+}
+
+// ValidateRoleBindingUpdate tests to make sure a role binding update can be applied.
+// The role a binding refers to cannot be changed.
+func ValidateRoleBindingUpdate(oldBinding, newBinding *api.RoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newBinding.ObjectMeta, &oldBinding.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleBindingSubjects(newBinding.Subjects).Prefix("subjects")...)
+	if newBinding.RoleRef != oldBinding.RoleRef {
+		allErrs = append(allErrs, errs.NewFieldInvalid("roleRef", newBinding.RoleRef, "field is immutable"))
+	}
+	return allErrs
+}
+
+// ValidateClusterRoleBinding tests if required fields in the ClusterRoleBinding are set.
+func ValidateClusterRoleBinding(binding *api.ClusterRoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&binding.ObjectMeta, false, ValidateRoleBindingName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleBindingSubjects(binding.Subjects).Prefix("subjects")...)
+	allErrs = append(allErrs, validateRoleRef(&binding.RoleRef, util.NewStringSet(api.ClusterRoleKind)).Prefix("roleRef")...)
+	return allErrs
+}
+
+// ValidateClusterRoleBindingUpdate tests to make sure a cluster role binding update can be applied.
+// The role a binding refers to cannot be changed.
+func ValidateClusterRoleBindingUpdate(oldBinding, newBinding *api.ClusterRoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newBinding.ObjectMeta, &oldBinding.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleBindingSubjects(newBinding.Subjects).Prefix("subjects")...)
+	if newBinding.RoleRef != oldBinding.RoleRef {
+		allErrs = append(allErrs, errs.NewFieldInvalid("roleRef", newBinding.RoleRef, "field is immutable"))
+	}
+	return allErrs
+}
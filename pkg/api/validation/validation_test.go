@@ -691,6 +691,7 @@ func TestValidateProbe(t *testing.T) {
 		nil,
 		{TimeoutSeconds: 10, InitialDelaySeconds: 0, Handler: handler},
 		{TimeoutSeconds: 0, InitialDelaySeconds: 10, Handler: handler},
+		{TimeoutSeconds: 1, PeriodSeconds: 5, SuccessThreshold: 2, FailureThreshold: 3, Handler: handler},
 	}
 	for _, p := range successCases {
 		if errs := validateProbe(p); len(errs) != 0 {
@@ -703,12 +704,20 @@ func TestValidateProbe(t *testing.T) {
 		{TimeoutSeconds: 10, InitialDelaySeconds: -10, Handler: handler},
 		{TimeoutSeconds: -10, InitialDelaySeconds: 10, Handler: handler},
 		{TimeoutSeconds: -10, InitialDelaySeconds: -10, Handler: handler},
+		{PeriodSeconds: -1, Handler: handler},
+		{SuccessThreshold: -1, Handler: handler},
+		{FailureThreshold: -1, Handler: handler},
 	}
 	for _, p := range errorCases {
 		if errs := validateProbe(p); len(errs) == 0 {
 			t.Errorf("expected failure for %v", p)
 		}
 	}
+
+	liveness := &api.Probe{SuccessThreshold: 2, Handler: handler}
+	if errs := validateLivenessProbe(liveness); len(errs) == 0 {
+		t.Errorf("expected failure for liveness probe with success threshold %d", liveness.SuccessThreshold)
+	}
 }
 
 func TestValidateHandler(t *testing.T) {
@@ -988,6 +997,17 @@ func TestValidateContainers(t *testing.T) {
 				ImagePullPolicy: "IfNotPresent",
 			},
 		},
+		"Resource Requests exceed Limits": {
+			{
+				Name:  "abc-123",
+				Image: "image",
+				Resources: api.ResourceRequirements{
+					Requests: getResourceLimits("20", "0"),
+					Limits:   getResourceLimits("10", "0"),
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
 	}
 	for k, v := range errorCases {
 		if errs := validateContainers(v, volumes); len(errs) == 0 {
@@ -1066,6 +1086,19 @@ func TestValidatePodSpec(t *testing.T) {
 			RestartPolicy: api.RestartPolicyAlways,
 			DNSPolicy:     api.DNSClusterFirst,
 		},
+		{ // Populate InitContainers.
+			Volumes:        []api.Volume{{Name: "vol", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}}},
+			InitContainers: []api.Container{{Name: "init", Image: "image", ImagePullPolicy: "IfNotPresent", VolumeMounts: []api.VolumeMount{{Name: "vol", MountPath: "/data"}}}},
+			Containers:     []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy:  api.RestartPolicyOnFailure,
+			DNSPolicy:      api.DNSClusterFirst,
+		},
+		{ // Populate Priority.
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Priority:      MaxPodPriority,
+		},
 	}
 	for i := range successCases {
 		if errs := ValidatePodSpec(&successCases[i]); len(errs) != 0 {
@@ -1129,6 +1162,42 @@ func TestValidatePodSpec(t *testing.T) {
 			DNSPolicy:             api.DNSClusterFirst,
 			ActiveDeadlineSeconds: &activeDeadlineSeconds,
 		},
+		"bad init container": {
+			InitContainers: []api.Container{{}},
+			Containers:     []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy:  api.RestartPolicyAlways,
+			DNSPolicy:      api.DNSClusterFirst,
+		},
+		"init container name collides with container": {
+			InitContainers: []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			Containers:     []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy:  api.RestartPolicyAlways,
+			DNSPolicy:      api.DNSClusterFirst,
+		},
+		"init container with ports": {
+			InitContainers: []api.Container{{Name: "init", Image: "image", ImagePullPolicy: "IfNotPresent", Ports: []api.ContainerPort{{ContainerPort: 80, Protocol: "TCP"}}}},
+			Containers:     []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy:  api.RestartPolicyAlways,
+			DNSPolicy:      api.DNSClusterFirst,
+		},
+		"init container with readiness probe": {
+			InitContainers: []api.Container{{Name: "init", Image: "image", ImagePullPolicy: "IfNotPresent", ReadinessProbe: &api.Probe{Handler: api.Handler{Exec: &api.ExecAction{Command: []string{"true"}}}}}},
+			Containers:     []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy:  api.RestartPolicyAlways,
+			DNSPolicy:      api.DNSClusterFirst,
+		},
+		"negative priority": {
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Priority:      -1,
+		},
+		"priority too high": {
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Priority:      MaxPodPriority + 1,
+		},
 	}
 	for k, v := range failureCases {
 		if errs := ValidatePodSpec(&v); len(errs) == 0 {
@@ -1137,6 +1206,100 @@ func TestValidatePodSpec(t *testing.T) {
 	}
 }
 
+func TestValidateAffinity(t *testing.T) {
+	requirement := func(key string, op api.NodeSelectorOperator, values ...string) api.NodeSelectorTerm {
+		return api.NodeSelectorTerm{MatchExpressions: []api.NodeSelectorRequirement{{Key: key, Operator: op, Values: values}}}
+	}
+	required := func(terms ...api.NodeSelectorTerm) *api.Affinity {
+		return &api.Affinity{NodeAffinity: &api.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{NodeSelectorTerms: terms},
+		}}
+	}
+	preferred := func(weight int, term api.NodeSelectorTerm) *api.Affinity {
+		return &api.Affinity{NodeAffinity: &api.NodeAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []api.PreferredSchedulingTerm{{Weight: weight, Preference: term}},
+		}}
+	}
+
+	successCases := []*api.Affinity{
+		{},
+		{NodeAffinity: &api.NodeAffinity{}},
+		required(requirement("zone", api.NodeSelectorOpIn, "us-east1-a", "us-east1-b"), requirement("gpu", api.NodeSelectorOpExists)),
+		required(requirement("example.com/rack", api.NodeSelectorOpNotIn, "r1")),
+		required(requirement("spot", api.NodeSelectorOpDoesNotExist)),
+		required(requirement("cores", api.NodeSelectorOpGt, "8")),
+		preferred(1, requirement("disk", api.NodeSelectorOpIn, "ssd")),
+		preferred(100, requirement("memory-gb", api.NodeSelectorOpLt, "64")),
+	}
+	for i, affinity := range successCases {
+		if errs := validateAffinity(affinity); len(errs) != 0 {
+			t.Errorf("case %d: expected success: %v", i, errs)
+		}
+	}
+
+	failureCases := map[string]*api.Affinity{
+		"no required terms":         required(),
+		"empty required term":       required(api.NodeSelectorTerm{}),
+		"bad key":                   required(requirement("bad key", api.NodeSelectorOpExists)),
+		"unknown operator":          required(requirement("zone", "Near", "us-east1-a")),
+		"In without values":         required(requirement("zone", api.NodeSelectorOpIn)),
+		"NotIn with bad value":      required(requirement("zone", api.NodeSelectorOpNotIn, "not a label value")),
+		"Exists with values":        required(requirement("gpu", api.NodeSelectorOpExists, "true")),
+		"DoesNotExist with values":  required(requirement("spot", api.NodeSelectorOpDoesNotExist, "true")),
+		"Gt with two values":        required(requirement("cores", api.NodeSelectorOpGt, "8", "16")),
+		"Lt with non-integer value": required(requirement("cores", api.NodeSelectorOpLt, "many")),
+		"zero weight":               preferred(0, requirement("disk", api.NodeSelectorOpIn, "ssd")),
+		"weight above 100":          preferred(101, requirement("disk", api.NodeSelectorOpIn, "ssd")),
+		"empty preference":          preferred(10, api.NodeSelectorTerm{}),
+	}
+	for k, v := range failureCases {
+		if errs := validateAffinity(v); len(errs) == 0 {
+			t.Errorf("expected failure for %q", k)
+		}
+	}
+}
+
+func TestValidatePodAffinity(t *testing.T) {
+	term := func(selector map[string]string, topologyKey string, namespaces ...string) api.PodAffinityTerm {
+		return api.PodAffinityTerm{Selector: selector, Namespaces: namespaces, TopologyKey: topologyKey}
+	}
+	affinity := func(terms ...api.PodAffinityTerm) *api.Affinity {
+		return &api.Affinity{PodAffinity: &api.PodAffinity{RequiredDuringSchedulingIgnoredDuringExecution: terms}}
+	}
+	antiAffinity := func(weight int, term api.PodAffinityTerm) *api.Affinity {
+		return &api.Affinity{PodAntiAffinity: &api.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{{Weight: weight, PodAffinityTerm: term}},
+		}}
+	}
+
+	successCases := []*api.Affinity{
+		{PodAffinity: &api.PodAffinity{}, PodAntiAffinity: &api.PodAntiAffinity{}},
+		affinity(term(map[string]string{"app": "web"}, "kubernetes.io/hostname")),
+		affinity(term(nil, "zone", "default", "kube-system")),
+		antiAffinity(1, term(map[string]string{"app": "db"}, "example.com/rack")),
+		antiAffinity(100, term(map[string]string{"app": "db"}, "zone")),
+	}
+	for i, affinity := range successCases {
+		if errs := validateAffinity(affinity); len(errs) != 0 {
+			t.Errorf("case %d: expected success: %v", i, errs)
+		}
+	}
+
+	failureCases := map[string]*api.Affinity{
+		"missing topology key": affinity(term(map[string]string{"app": "web"}, "")),
+		"bad topology key":     affinity(term(map[string]string{"app": "web"}, "bad key")),
+		"bad selector":         affinity(term(map[string]string{"app": "not a label value"}, "zone")),
+		"bad namespace":        affinity(term(map[string]string{"app": "web"}, "zone", "Bad_Namespace")),
+		"zero weight":          antiAffinity(0, term(map[string]string{"app": "db"}, "zone")),
+		"weight above 100":     antiAffinity(101, term(map[string]string{"app": "db"}, "zone")),
+		"bad weighted term":    antiAffinity(10, term(map[string]string{"app": "db"}, "")),
+	}
+	for k, v := range failureCases {
+		if errs := validateAffinity(v); len(errs) == 0 {
+			t.Errorf("expected failure for %q", k)
+		}
+	}
+}
 func TestValidatePod(t *testing.T) {
 	successCases := []api.Pod{
 		{ // Basic fields.
@@ -1532,6 +1695,13 @@ func TestValidateService(t *testing.T) {
 			},
 			numErrs: 1,
 		},
+		{
+			name: "invalid load balancing policy",
+			tweakSvc: func(s *api.Service) {
+				s.Spec.LoadBalancingPolicy = "Fastest"
+			},
+			numErrs: 1,
+		},
 		{
 			name: "missing type",
 			tweakSvc: func(s *api.Service) {
@@ -1699,6 +1869,13 @@ func TestValidateService(t *testing.T) {
 			},
 			numErrs: 0,
 		},
+		{
+			name: "valid load balancing policy",
+			tweakSvc: func(s *api.Service) {
+				s.Spec.LoadBalancingPolicy = api.ServiceLoadBalancingLeastConnections
+			},
+			numErrs: 0,
+		},
 		{
 			name: "valid type - cluster",
 			tweakSvc: func(s *api.Service) {
@@ -2237,6 +2414,438 @@ func TestValidateReplicationController(t *testing.T) {
 	}
 }
 
+func validDeployment() api.Deployment {
+	validSelector := map[string]string{"a": "b"}
+	maxUnavailable := util.NewIntOrStringFromInt(1)
+	return api.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+		Spec: api.DeploymentSpec{
+			Replicas: 3,
+			Selector: validSelector,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: validSelector,
+				},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSClusterFirst,
+					Containers:    []api.Container{{Name: "abc", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+				},
+			},
+			Strategy: api.DeploymentStrategy{
+				Type: api.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &api.RollingUpdateDeployment{
+					MaxUnavailable: maxUnavailable,
+					MaxSurge:       util.NewIntOrStringFromString("25%"),
+				},
+			},
+		},
+	}
+}
+
+func TestValidateDeployment(t *testing.T) {
+	successCases := []api.Deployment{validDeployment()}
+	recreate := validDeployment()
+	recreate.Spec.Strategy = api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType}
+	successCases = append(successCases, recreate)
+	noSurge := validDeployment()
+	noSurge.Spec.Strategy.RollingUpdate.MaxSurge = util.NewIntOrStringFromInt(0)
+	successCases = append(successCases, noSurge)
+	rollback := validDeployment()
+	rollback.Spec.RollbackTo = &api.RollbackConfig{Revision: 0}
+	successCases = append(successCases, rollback)
+	for _, successCase := range successCases {
+		if errs := ValidateDeployment(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	errorCases := map[string]struct {
+		deployment api.Deployment
+		field      string
+	}{}
+	d := validDeployment()
+	d.Name = ""
+	errorCases["missing name"] = struct {
+		deployment api.Deployment
+		field      string
+	}{d, "metadata.name"}
+	addCase := func(name, field string, mutate func(d *api.Deployment)) {
+		d := validDeployment()
+		mutate(&d)
+		errorCases[name] = struct {
+			deployment api.Deployment
+			field      string
+		}{d, field}
+	}
+	addCase("empty selector", "spec.selector", func(d *api.Deployment) {
+		d.Spec.Selector = nil
+	})
+	addCase("selector doesn't match", "spec.template.labels", func(d *api.Deployment) {
+		d.Spec.Selector = map[string]string{"foo": "bar"}
+	})
+	addCase("missing template", "spec.template", func(d *api.Deployment) {
+		d.Spec.Template = nil
+	})
+	addCase("negative replicas", "spec.replicas", func(d *api.Deployment) {
+		d.Spec.Replicas = -1
+	})
+	addCase("negative revision history limit", "spec.revisionHistoryLimit", func(d *api.Deployment) {
+		limit := -1
+		d.Spec.RevisionHistoryLimit = &limit
+	})
+	addCase("negative rollback revision", "spec.rollbackTo.revision", func(d *api.Deployment) {
+		d.Spec.RollbackTo = &api.RollbackConfig{Revision: -1}
+	})
+	addCase("invalid restart policy", "spec.template.spec.restartPolicy", func(d *api.Deployment) {
+		d.Spec.Template.Spec.RestartPolicy = api.RestartPolicyNever
+	})
+	addCase("missing strategy type", "spec.strategy.type", func(d *api.Deployment) {
+		d.Spec.Strategy.Type = ""
+	})
+	addCase("unknown strategy type", "spec.strategy.type", func(d *api.Deployment) {
+		d.Spec.Strategy.Type = "Sideways"
+	})
+	addCase("rolling update parameters with recreate", "spec.strategy.rollingUpdate", func(d *api.Deployment) {
+		d.Spec.Strategy.Type = api.RecreateDeploymentStrategyType
+	})
+	addCase("missing rolling update parameters", "spec.strategy.rollingUpdate", func(d *api.Deployment) {
+		d.Spec.Strategy.RollingUpdate = nil
+	})
+	addCase("zero surge and unavailable", "spec.strategy.rollingUpdate.maxUnavailable", func(d *api.Deployment) {
+		d.Spec.Strategy.RollingUpdate.MaxUnavailable = util.NewIntOrStringFromString("0%")
+		d.Spec.Strategy.RollingUpdate.MaxSurge = util.NewIntOrStringFromInt(0)
+	})
+	addCase("negative surge", "spec.strategy.rollingUpdate.maxSurge", func(d *api.Deployment) {
+		d.Spec.Strategy.RollingUpdate.MaxSurge = util.NewIntOrStringFromInt(-1)
+	})
+	addCase("unavailable over 100%", "spec.strategy.rollingUpdate.maxUnavailable", func(d *api.Deployment) {
+		d.Spec.Strategy.RollingUpdate.MaxUnavailable = util.NewIntOrStringFromString("101%")
+	})
+	addCase("malformed surge", "spec.strategy.rollingUpdate.maxSurge", func(d *api.Deployment) {
+		d.Spec.Strategy.RollingUpdate.MaxSurge = util.NewIntOrStringFromString("ten")
+	})
+	for k, v := range errorCases {
+		errs := ValidateDeployment(&v.deployment)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+			continue
+		}
+		found := false
+		for i := range errs {
+			if errs[i].(*errors.ValidationError).Field == v.field {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: expected an error for %s, got %v", k, v.field, errs)
+		}
+	}
+}
+
+func TestValidateDeploymentUpdate(t *testing.T) {
+	old := validDeployment()
+	old.ResourceVersion = "1"
+	update := validDeployment()
+	update.ResourceVersion = "1"
+	update.Spec.Replicas = 5
+	if errs := ValidateDeploymentUpdate(&old, &update); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+	update.Name = "def"
+	if errs := ValidateDeploymentUpdate(&old, &update); len(errs) == 0 {
+		t.Errorf("expected failure when changing the name")
+	}
+}
+
+func validDaemonSet() api.DaemonSet {
+	validSelector := map[string]string{"a": "b"}
+	return api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+		Spec: api.DaemonSetSpec{
+			Selector: validSelector,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: validSelector,
+				},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSClusterFirst,
+					Containers:    []api.Container{{Name: "abc", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+					NodeSelector:  map[string]string{"role": "logging"},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateDaemonSet(t *testing.T) {
+	successCases := []api.DaemonSet{validDaemonSet()}
+	allNodes := validDaemonSet()
+	allNodes.Spec.Template.Spec.NodeSelector = nil
+	successCases = append(successCases, allNodes)
+	for _, successCase := range successCases {
+		if errs := ValidateDaemonSet(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	type errorCase struct {
+		daemonSet api.DaemonSet
+		field     string
+	}
+	errorCases := map[string]errorCase{}
+	addCase := func(name, field string, mutate func(ds *api.DaemonSet)) {
+		ds := validDaemonSet()
+		mutate(&ds)
+		errorCases[name] = errorCase{ds, field}
+	}
+	addCase("missing name", "metadata.name", func(ds *api.DaemonSet) {
+		ds.Name = ""
+	})
+	addCase("missing namespace", "metadata.namespace", func(ds *api.DaemonSet) {
+		ds.Namespace = ""
+	})
+	addCase("empty selector", "spec.selector", func(ds *api.DaemonSet) {
+		ds.Spec.Selector = nil
+	})
+	addCase("selector doesn't match", "spec.template.labels", func(ds *api.DaemonSet) {
+		ds.Spec.Selector = map[string]string{"foo": "bar"}
+	})
+	addCase("missing template", "spec.template", func(ds *api.DaemonSet) {
+		ds.Spec.Template = nil
+	})
+	addCase("invalid restart policy", "spec.template.spec.restartPolicy", func(ds *api.DaemonSet) {
+		ds.Spec.Template.Spec.RestartPolicy = api.RestartPolicyOnFailure
+	})
+	addCase("node name set", "spec.template.spec.nodeName", func(ds *api.DaemonSet) {
+		ds.Spec.Template.Spec.NodeName = "node-1"
+	})
+	addCase("read-write GCE PD", "spec.template.spec.volumes.GCEPersistentDisk.ReadOnly", func(ds *api.DaemonSet) {
+		ds.Spec.Template.Spec.Volumes = []api.Volume{
+			{Name: "gce", VolumeSource: api.VolumeSource{GCEPersistentDisk: &api.GCEPersistentDiskVolumeSource{PDName: "my-PD", FSType: "ext4"}}},
+		}
+	})
+	for k, v := range errorCases {
+		errs := ValidateDaemonSet(&v.daemonSet)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+			continue
+		}
+		found := false
+		for i := range errs {
+			if errs[i].(*errors.ValidationError).Field == v.field {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: expected an error for %s, got %v", k, v.field, errs)
+		}
+	}
+}
+
+func TestValidateDaemonSetUpdate(t *testing.T) {
+	old := validDaemonSet()
+	old.ResourceVersion = "1"
+	update := validDaemonSet()
+	update.ResourceVersion = "1"
+	update.Spec.Template.Spec.NodeSelector = map[string]string{"role": "monitoring"}
+	if errs := ValidateDaemonSetUpdate(&old, &update); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+	update.Name = "def"
+	if errs := ValidateDaemonSetUpdate(&old, &update); len(errs) == 0 {
+		t.Errorf("expected failure when changing the name")
+	}
+}
+
+func validJob() api.Job {
+	validSelector := map[string]string{"a": "b"}
+	completions, parallelism := 5, 2
+	return api.Job{
+		ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+		Spec: api.JobSpec{
+			Completions: &completions,
+			Parallelism: &parallelism,
+			Selector:    validSelector,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: validSelector,
+				},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicyOnFailure,
+					DNSPolicy:     api.DNSClusterFirst,
+					Containers:    []api.Container{{Name: "abc", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateJob(t *testing.T) {
+	successCases := []api.Job{validJob()}
+	never := validJob()
+	never.Spec.Template.Spec.RestartPolicy = api.RestartPolicyNever
+	deadline := int64(60)
+	never.Spec.ActiveDeadlineSeconds = &deadline
+	successCases = append(successCases, never)
+	for _, successCase := range successCases {
+		if errs := ValidateJob(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	type errorCase struct {
+		job   api.Job
+		field string
+	}
+	errorCases := map[string]errorCase{}
+	addCase := func(name, field string, mutate func(job *api.Job)) {
+		job := validJob()
+		mutate(&job)
+		errorCases[name] = errorCase{job, field}
+	}
+	addCase("missing name", "metadata.name", func(job *api.Job) {
+		job.Name = ""
+	})
+	addCase("negative parallelism", "spec.parallelism", func(job *api.Job) {
+		parallelism := -1
+		job.Spec.Parallelism = &parallelism
+	})
+	addCase("negative completions", "spec.completions", func(job *api.Job) {
+		completions := -1
+		job.Spec.Completions = &completions
+	})
+	addCase("zero active deadline", "spec.activeDeadlineSeconds", func(job *api.Job) {
+		deadline := int64(0)
+		job.Spec.ActiveDeadlineSeconds = &deadline
+	})
+	addCase("empty selector", "spec.selector", func(job *api.Job) {
+		job.Spec.Selector = nil
+	})
+	addCase("selector doesn't match", "spec.template.labels", func(job *api.Job) {
+		job.Spec.Selector = map[string]string{"foo": "bar"}
+	})
+	addCase("missing template", "spec.template", func(job *api.Job) {
+		job.Spec.Template = nil
+	})
+	addCase("restart policy always", "spec.template.spec.restartPolicy", func(job *api.Job) {
+		job.Spec.Template.Spec.RestartPolicy = api.RestartPolicyAlways
+	})
+	for k, v := range errorCases {
+		errs := ValidateJob(&v.job)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+			continue
+		}
+		found := false
+		for i := range errs {
+			if errs[i].(*errors.ValidationError).Field == v.field {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: expected an error for %s, got %v", k, v.field, errs)
+		}
+	}
+}
+
+func TestValidateJobUpdate(t *testing.T) {
+	old := validJob()
+	old.ResourceVersion = "1"
+	update := validJob()
+	update.ResourceVersion = "1"
+	parallelism := 10
+	update.Spec.Parallelism = &parallelism
+	if errs := ValidateJobUpdate(&old, &update); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+	completions := 10
+	update.Spec.Completions = &completions
+	if errs := ValidateJobUpdate(&old, &update); len(errs) == 0 {
+		t.Errorf("expected failure when changing completions")
+	}
+	update = validJob()
+	update.ResourceVersion = "1"
+	update.Spec.Template.Spec.Containers[0].Image = "other"
+	if errs := ValidateJobUpdate(&old, &update); len(errs) == 0 {
+		t.Errorf("expected failure when changing the template")
+	}
+}
+
+func validHorizontalPodAutoscaler() api.HorizontalPodAutoscaler {
+	minReplicas, target := 2, 70
+	return api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ScaleTargetReference{Kind: "ReplicationController", Name: "frontend"},
+			MinReplicas:                    &minReplicas,
+			MaxReplicas:                    5,
+			TargetCPUUtilizationPercentage: &target,
+		},
+	}
+}
+
+func TestValidateHorizontalPodAutoscaler(t *testing.T) {
+	successCases := []api.HorizontalPodAutoscaler{validHorizontalPodAutoscaler()}
+	unset := validHorizontalPodAutoscaler()
+	unset.Spec.MinReplicas = nil
+	unset.Spec.TargetCPUUtilizationPercentage = nil
+	successCases = append(successCases, unset)
+	for _, successCase := range successCases {
+		if errs := ValidateHorizontalPodAutoscaler(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	type errorCase struct {
+		autoscaler api.HorizontalPodAutoscaler
+		field      string
+	}
+	errorCases := map[string]errorCase{}
+	addCase := func(name, field string, mutate func(hpa *api.HorizontalPodAutoscaler)) {
+		hpa := validHorizontalPodAutoscaler()
+		mutate(&hpa)
+		errorCases[name] = errorCase{hpa, field}
+	}
+	addCase("missing name", "metadata.name", func(hpa *api.HorizontalPodAutoscaler) {
+		hpa.Name = ""
+	})
+	addCase("unsupported kind", "spec.scaleRef.kind", func(hpa *api.HorizontalPodAutoscaler) {
+		hpa.Spec.ScaleRef.Kind = "Pod"
+	})
+	addCase("missing target name", "spec.scaleRef.name", func(hpa *api.HorizontalPodAutoscaler) {
+		hpa.Spec.ScaleRef.Name = ""
+	})
+	addCase("zero min replicas", "spec.minReplicas", func(hpa *api.HorizontalPodAutoscaler) {
+		minReplicas := 0
+		hpa.Spec.MinReplicas = &minReplicas
+	})
+	addCase("max below min", "spec.maxReplicas", func(hpa *api.HorizontalPodAutoscaler) {
+		hpa.Spec.MaxReplicas = 1
+	})
+	addCase("zero target", "spec.targetCPUUtilizationPercentage", func(hpa *api.HorizontalPodAutoscaler) {
+		target := 0
+		hpa.Spec.TargetCPUUtilizationPercentage = &target
+	})
+	for k, v := range errorCases {
+		errs := ValidateHorizontalPodAutoscaler(&v.autoscaler)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+			continue
+		}
+		found := false
+		for i := range errs {
+			if errs[i].(*errors.ValidationError).Field == v.field {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: expected an error for %s, got %v", k, v.field, errs)
+		}
+	}
+}
+
 func TestValidateNode(t *testing.T) {
 	validSelector := map[string]string{"a": "b"}
 	invalidSelector := map[string]string{"NoUppercaseOrSpecialCharsLike=Equals": "b"}
@@ -2518,6 +3127,30 @@ func TestValidateNodeUpdate(t *testing.T) {
 				},
 			},
 		}, true},
+		{api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+		}, api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}},
+			},
+		}, true},
+		{api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+		}, api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{{Key: "dedicated", Value: "infra", Effect: "NoExecute"}},
+			},
+		}, false},
 	}
 	for i, test := range tests {
 		test.oldNode.ObjectMeta.ResourceVersion = "1"
@@ -2533,6 +3166,69 @@ func TestValidateNodeUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateTaints(t *testing.T) {
+	successCases := [][]api.Taint{
+		nil,
+		{{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}},
+		{{Key: "example.com/maintenance", Effect: api.TaintEffectPreferNoSchedule}},
+		{
+			{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule},
+			{Key: "dedicated", Value: "infra", Effect: api.TaintEffectPreferNoSchedule},
+		},
+	}
+	for i, taints := range successCases {
+		if errs := validateTaints(taints); len(errs) != 0 {
+			t.Errorf("case %d: expected success: %v", i, errs)
+		}
+	}
+
+	failureCases := map[string][]api.Taint{
+		"missing key":    {{Value: "infra", Effect: api.TaintEffectNoSchedule}},
+		"bad key":        {{Key: "bad key", Effect: api.TaintEffectNoSchedule}},
+		"bad value":      {{Key: "dedicated", Value: "not a label value", Effect: api.TaintEffectNoSchedule}},
+		"missing effect": {{Key: "dedicated", Value: "infra"}},
+		"unknown effect": {{Key: "dedicated", Value: "infra", Effect: "NoExecute"}},
+		"duplicate key and effect": {
+			{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule},
+			{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule},
+		},
+	}
+	for k, v := range failureCases {
+		if errs := validateTaints(v); len(errs) == 0 {
+			t.Errorf("expected failure for %q", k)
+		}
+	}
+}
+
+func TestValidateTolerations(t *testing.T) {
+	successCases := [][]api.Toleration{
+		nil,
+		{{Key: "dedicated", Operator: api.TolerationOpEqual, Value: "infra", Effect: api.TaintEffectNoSchedule}},
+		{{Key: "dedicated", Value: "infra"}},
+		{{Key: "example.com/maintenance", Operator: api.TolerationOpExists}},
+		{{Operator: api.TolerationOpExists}},
+	}
+	for i, tolerations := range successCases {
+		if errs := validateTolerations(tolerations); len(errs) != 0 {
+			t.Errorf("case %d: expected success: %v", i, errs)
+		}
+	}
+
+	failureCases := map[string][]api.Toleration{
+		"bad key":              {{Key: "bad key", Operator: api.TolerationOpExists}},
+		"empty key with Equal": {{Operator: api.TolerationOpEqual, Value: "infra"}},
+		"bad value":            {{Key: "dedicated", Operator: api.TolerationOpEqual, Value: "not a label value"}},
+		"value with Exists":    {{Key: "dedicated", Operator: api.TolerationOpExists, Value: "infra"}},
+		"unknown operator":     {{Key: "dedicated", Operator: "In", Value: "infra"}},
+		"unknown effect":       {{Key: "dedicated", Value: "infra", Effect: "NoExecute"}},
+	}
+	for k, v := range failureCases {
+		if errs := validateTolerations(v); len(errs) == 0 {
+			t.Errorf("expected failure for %q", k)
+		}
+	}
+}
+
 func TestValidateServiceUpdate(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -2751,6 +3447,31 @@ func TestValidateLimitRange(t *testing.T) {
 		},
 	}
 
+	invalidSpecDefaultRequestAboveDefault := api.LimitRangeSpec{
+		Limits: []api.LimitRangeItem{
+			{
+				Type: api.LimitTypeContainer,
+				Default: api.ResourceList{
+					api.ResourceCPU: resource.MustParse("100"),
+				},
+				DefaultRequest: api.ResourceList{
+					api.ResourceCPU: resource.MustParse("200"),
+				},
+			},
+		},
+	}
+
+	invalidSpecMaxLimitRequestRatioLessThanOne := api.LimitRangeSpec{
+		Limits: []api.LimitRangeItem{
+			{
+				Type: api.LimitTypeContainer,
+				MaxLimitRequestRatio: api.ResourceList{
+					api.ResourceCPU: resource.MustParse("500m"),
+				},
+			},
+		},
+	}
+
 	successCases := []api.LimitRange{
 		{
 			ObjectMeta: api.ObjectMeta{
@@ -2799,6 +3520,14 @@ func TestValidateLimitRange(t *testing.T) {
 			api.LimitRange{ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: "foo"}, Spec: invalidSpecRangeDefaultOutsideRange},
 			"default value 2k is greater than max value 1k",
 		},
+		"default request above default limit": {
+			api.LimitRange{ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: "foo"}, Spec: invalidSpecDefaultRequestAboveDefault},
+			"default request value 200 is greater than default limit value 100",
+		},
+		"max limit to request ratio less than one": {
+			api.LimitRange{ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: "foo"}, Spec: invalidSpecMaxLimitRequestRatioLessThanOne},
+			"ratio of limit to request may not be less than 1",
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateLimitRange(&v.R)
@@ -3542,3 +4271,145 @@ func fakeValidSecurityContext(priv bool) *api.SecurityContext {
 		Privileged: &priv,
 	}
 }
+
+func TestValidateRole(t *testing.T) {
+	successCases := map[string]api.Role{
+		"simple role": {
+			ObjectMeta: api.ObjectMeta{Name: "pod-reader", Namespace: "namespace"},
+			Rules:      []api.PolicyRule{{Verbs: []string{"get", "list"}, Resources: []string{"pods"}}},
+		},
+		"no rules": {
+			ObjectMeta: api.ObjectMeta{Name: "nothing", Namespace: "namespace"},
+		},
+	}
+	for k, v := range successCases {
+		if errs := ValidateRole(&v); len(errs) != 0 {
+			t.Errorf("Expected success for %s, got %v", k, errs)
+		}
+	}
+
+	errorCases := map[string]struct {
+		role      api.Role
+		errorType fielderrors.ValidationErrorType
+		field     string
+	}{
+		"missing namespace": {
+			role:      api.Role{ObjectMeta: api.ObjectMeta{Name: "pod-reader"}},
+			errorType: "FieldValueRequired",
+			field:     "metadata.namespace",
+		},
+		"missing verbs": {
+			role: api.Role{
+				ObjectMeta: api.ObjectMeta{Name: "pod-reader", Namespace: "namespace"},
+				Rules:      []api.PolicyRule{{Resources: []string{"pods"}}},
+			},
+			errorType: "FieldValueRequired",
+			field:     "rules[0].verbs",
+		},
+		"missing resources": {
+			role: api.Role{
+				ObjectMeta: api.ObjectMeta{Name: "pod-reader", Namespace: "namespace"},
+				Rules:      []api.PolicyRule{{Verbs: []string{"get"}}},
+			},
+			errorType: "FieldValueRequired",
+			field:     "rules[0].resources",
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateRole(&v.role)
+		if len(errs) == 0 {
+			t.Errorf("Expected failure for %s", k)
+			continue
+		}
+		if err := errs[0].(*errors.ValidationError); err.Type != v.errorType || err.Field != v.field {
+			t.Errorf("%s: expected error type %s on %s, got %v", k, v.errorType, v.field, errs)
+		}
+	}
+
+	// Cluster roles share the rule validation but have no namespace.
+	clusterRole := api.ClusterRole{
+		ObjectMeta: api.ObjectMeta{Name: "cluster-admin"},
+		Rules:      []api.PolicyRule{{Verbs: []string{api.VerbAll}, Resources: []string{api.ResourceAll}}},
+	}
+	if errs := ValidateClusterRole(&clusterRole); len(errs) != 0 {
+		t.Errorf("Expected success for cluster role, got %v", errs)
+	}
+}
+
+func TestValidateRoleBinding(t *testing.T) {
+	validBinding := func() api.RoleBinding {
+		return api.RoleBinding{
+			ObjectMeta: api.ObjectMeta{Name: "readers", Namespace: "namespace"},
+			Subjects: []api.ObjectReference{
+				{Kind: api.UserKind, Name: "bob"},
+				{Kind: api.GroupKind, Name: "readers"},
+				{Kind: api.ServiceAccountKind, Name: "builder", Namespace: "ci"},
+			},
+			RoleRef: api.ObjectReference{Kind: api.RoleKind, Name: "pod-reader"},
+		}
+	}
+	valid := validBinding()
+	if errs := ValidateRoleBinding(&valid); len(errs) != 0 {
+		t.Errorf("Expected success, got %v", errs)
+	}
+
+	var (
+		badSubjectKind      = validBinding()
+		missingSubjectName  = validBinding()
+		serviceAccountNoNs  = validBinding()
+		badRoleRefKind      = validBinding()
+		missingRoleRefName  = validBinding()
+		clusterRoleRefValid = validBinding()
+	)
+	badSubjectKind.Subjects[0].Kind = "Pod"
+	missingSubjectName.Subjects[1].Name = ""
+	serviceAccountNoNs.Subjects[2].Namespace = ""
+	badRoleRefKind.RoleRef.Kind = "Pod"
+	missingRoleRefName.RoleRef.Name = ""
+	clusterRoleRefValid.RoleRef.Kind = api.ClusterRoleKind
+
+	if errs := ValidateRoleBinding(&clusterRoleRefValid); len(errs) != 0 {
+		t.Errorf("Expected success binding a cluster role, got %v", errs)
+	}
+
+	errorCases := map[string]struct {
+		binding api.RoleBinding
+		field   string
+	}{
+		"bad subject kind":             {badSubjectKind, "subjects[0].kind"},
+		"missing subject name":         {missingSubjectName, "subjects[1].name"},
+		"service account no namespace": {serviceAccountNoNs, "subjects[2].namespace"},
+		"bad role ref kind":            {badRoleRefKind, "roleRef.kind"},
+		"missing role ref name":        {missingRoleRefName, "roleRef.name"},
+	}
+	for k, v := range errorCases {
+		errs := ValidateRoleBinding(&v.binding)
+		if len(errs) == 0 {
+			t.Errorf("Expected failure for %s", k)
+			continue
+		}
+		if field := errs[0].(*errors.ValidationError).Field; field != v.field {
+			t.Errorf("%s: expected error on %s, got %v", k, v.field, errs)
+		}
+	}
+
+	// Cluster role bindings may only refer to cluster roles.
+	clusterBinding := api.ClusterRoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "admins"},
+		Subjects:   []api.ObjectReference{{Kind: api.GroupKind, Name: "admins"}},
+		RoleRef:    api.ObjectReference{Kind: api.RoleKind, Name: "admin"},
+	}
+	if errs := ValidateClusterRoleBinding(&clusterBinding); len(errs) == 0 {
+		t.Errorf("Expected failure for a cluster role binding referring to a role")
+	}
+
+	// The role a binding refers to is immutable.
+	updated := validBinding()
+	updated.ResourceVersion = "1"
+	old := validBinding()
+	old.ResourceVersion = "1"
+	updated.RoleRef.Name = "pod-writer"
+	if errs := ValidateRoleBindingUpdate(&old, &updated); len(errs) == 0 {
+		t.Errorf("Expected failure changing the role of a binding")
+	}
+}
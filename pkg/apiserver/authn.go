@@ -18,9 +18,13 @@ package apiserver
 
 import (
 	"crypto/rsa"
+	"crypto/tls"
+	"net/http"
+	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authenticator"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authenticator/bearertoken"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/webhook"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -28,11 +32,15 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/request/basicauth"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/request/union"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/request/x509"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/token/oidc"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/token/tokenfile"
+	tokenwebhook "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/token/webhook"
 )
 
-// NewAuthenticator returns an authenticator.Request or an error
-func NewAuthenticator(basicAuthFile, clientCAFile, tokenFile, serviceAccountKeyFile string, serviceAccountLookup bool, helper tools.EtcdHelper) (authenticator.Request, error) {
+// NewAuthenticator returns an authenticator.Request or an error.  Bearer tokens
+// are checked with tokenWebhook if its URL is set, and accepted as OpenID Connect
+// ID tokens if oidcIssuerURL is set.
+func NewAuthenticator(basicAuthFile, clientCAFile, tokenFile, serviceAccountKeyFile string, serviceAccountLookup bool, tokenWebhook webhook.Config, oidcIssuerURL, oidcClientID, oidcCAFile, oidcUsernameClaim, oidcGroupsClaim string, helper tools.EtcdHelper) (authenticator.Request, error) {
 	var authenticators []authenticator.Request
 
 	if len(basicAuthFile) > 0 {
@@ -67,6 +75,22 @@ func NewAuthenticator(basicAuthFile, clientCAFile, tokenFile, serviceAccountKeyF
 		authenticators = append(authenticators, serviceAccountAuth)
 	}
 
+	if len(oidcIssuerURL) > 0 {
+		oidcAuth, err := newAuthenticatorFromOIDCIssuerURL(oidcIssuerURL, oidcClientID, oidcCAFile, oidcUsernameClaim, oidcGroupsClaim)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, oidcAuth)
+	}
+
+	if len(tokenWebhook.URL) > 0 {
+		webhookAuth, err := newWebhookTokenAuthenticator(tokenWebhook)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, webhookAuth)
+	}
+
 	switch len(authenticators) {
 	case 0:
 		return nil, nil
@@ -121,6 +145,43 @@ func newServiceAccountAuthenticator(keyfile string, lookup bool, helper tools.Et
 	return bearertoken.New(tokenAuthenticator), nil
 }
 
+// oidcFetchTimeout bounds each request to an OIDC issuer, so that a hung issuer
+// cannot hang every request carrying a token signed with an unknown key.
+const oidcFetchTimeout = 10 * time.Second
+
+// newAuthenticatorFromOIDCIssuerURL returns an authenticator.Request or an error
+func newAuthenticatorFromOIDCIssuerURL(issuerURL, clientID, caFile, usernameClaim, groupsClaim string) (authenticator.Request, error) {
+	tlsConfig := &tls.Config{}
+	if len(caFile) > 0 {
+		roots, err := util.CertPoolFromFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = roots
+	}
+	client := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		Timeout:   oidcFetchTimeout,
+	}
+
+	tokenAuthenticator, err := oidc.New(issuerURL, clientID, usernameClaim, groupsClaim, oidc.NewJWKSKeySource(issuerURL, client))
+	if err != nil {
+		return nil, err
+	}
+
+	return bearertoken.New(tokenAuthenticator), nil
+}
+
+// newWebhookTokenAuthenticator returns an authenticator.Request or an error
+func newWebhookTokenAuthenticator(config webhook.Config) (authenticator.Request, error) {
+	tokenAuthenticator, err := tokenwebhook.New(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return bearertoken.New(tokenAuthenticator), nil
+}
+
 // newAuthenticatorFromClientCAFile returns an authenticator.Request or an error
 func newAuthenticatorFromClientCAFile(clientCAFile string) (authenticator.Request, error) {
 	roots, err := util.CertPoolFromFile(clientCAFile)
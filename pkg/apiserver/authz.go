@@ -21,6 +21,10 @@ import (
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer/abac"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer/rbac"
+	authorizerwebhook "github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer/webhook"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/webhook"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
 )
 
 // Attributes implements authorizer.Attributes interface.
@@ -54,21 +58,32 @@ func NewAlwaysDenyAuthorizer() authorizer.Authorizer {
 	return new(alwaysDenyAuthorizer)
 }
 
+// Authorization modes accepted by NewAuthorizerFromAuthorizationConfig.
 const (
 	ModeAlwaysAllow string = "AlwaysAllow"
 	ModeAlwaysDeny  string = "AlwaysDeny"
 	ModeABAC        string = "ABAC"
+	ModeRBAC        string = "RBAC"
+	ModeWebhook     string = "Webhook"
 )
 
 // Keep this list in sync with constant list above.
-var AuthorizationModeChoices = []string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC}
+var AuthorizationModeChoices = []string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC, ModeRBAC, ModeWebhook}
 
 // NewAuthorizerFromAuthorizationConfig returns the right sort of authorizer.Authorizer
 // based on the authorizationMode xor an error.  authorizationMode should be one of AuthorizationModeChoices.
-func NewAuthorizerFromAuthorizationConfig(authorizationMode string, authorizationPolicyFile string) (authorizer.Authorizer, error) {
+// helper is used to read roles and role bindings in RBAC mode, and webhookConfig
+// describes the remote service consulted in Webhook mode.
+func NewAuthorizerFromAuthorizationConfig(authorizationMode string, authorizationPolicyFile string, rbacSuperUser string, webhookConfig webhook.Config, helper tools.EtcdHelper) (authorizer.Authorizer, error) {
 	if authorizationPolicyFile != "" && authorizationMode != "ABAC" {
 		return nil, errors.New("Cannot specify --authorization_policy_file without mode ABAC")
 	}
+	if rbacSuperUser != "" && authorizationMode != "RBAC" {
+		return nil, errors.New("Cannot specify --authorization-rbac-super-user without mode RBAC")
+	}
+	if webhookConfig.URL != "" && authorizationMode != "Webhook" {
+		return nil, errors.New("Cannot specify --authorization-webhook-url without mode Webhook")
+	}
 	// Keep cases in sync with constant list above.
 	switch authorizationMode {
 	case ModeAlwaysAllow:
@@ -77,6 +92,10 @@ func NewAuthorizerFromAuthorizationConfig(authorizationMode string, authorizatio
 		return NewAlwaysDenyAuthorizer(), nil
 	case ModeABAC:
 		return abac.NewFromFile(authorizationPolicyFile)
+	case ModeRBAC:
+		return rbac.New(rbac.NewGetterFromEtcdHelper(helper), rbacSuperUser), nil
+	case ModeWebhook:
+		return authorizerwebhook.New(webhookConfig)
 	default:
 		return nil, errors.New("Unknown authorization mode")
 	}
@@ -18,6 +18,9 @@ package apiserver
 
 import (
 	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/webhook"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
 )
 
 // NewAlwaysAllowAuthorizer must return a struct which implements authorizer.Authorizer
@@ -42,27 +45,49 @@ func TestNewAlwaysDenyAuthorizer(t *testing.T) {
 // validates that errors are returned only when proper.
 func TestNewAuthorizerFromAuthorizationConfig(t *testing.T) {
 	// Unknown modes should return errors
-	if _, err := NewAuthorizerFromAuthorizationConfig("DoesNotExist", ""); err == nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig("DoesNotExist", "", "", webhook.Config{}, tools.EtcdHelper{}); err == nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig using a fake mode should have returned an error")
 	}
 
 	// ModeAlwaysAllow and ModeAlwaysDeny should return without authorizationPolicyFile
 	// but error if one is given
 	for _, config := range []string{ModeAlwaysAllow, ModeAlwaysDeny} {
-		if _, err := NewAuthorizerFromAuthorizationConfig(config, ""); err != nil {
+		if _, err := NewAuthorizerFromAuthorizationConfig(config, "", "", webhook.Config{}, tools.EtcdHelper{}); err != nil {
 			t.Errorf("NewAuthorizerFromAuthorizationConfig with %s returned an error: %s", err, config)
 		}
-		if _, err := NewAuthorizerFromAuthorizationConfig(config, "shoulderror"); err == nil {
+		if _, err := NewAuthorizerFromAuthorizationConfig(config, "shoulderror", "", webhook.Config{}, tools.EtcdHelper{}); err == nil {
 			t.Errorf("NewAuthorizerFromAuthorizationConfig with %s should have returned an error", config)
 		}
 	}
 
 	// ModeABAC requires a policy file
-	if _, err := NewAuthorizerFromAuthorizationConfig(ModeABAC, ""); err == nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig(ModeABAC, "", "", webhook.Config{}, tools.EtcdHelper{}); err == nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig using a fake mode should have returned an error")
 	}
 	// ModeABAC should not error if a valid policy path is provided
-	if _, err := NewAuthorizerFromAuthorizationConfig(ModeABAC, "../auth/authorizer/abac/example_policy_file.jsonl"); err != nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig(ModeABAC, "../auth/authorizer/abac/example_policy_file.jsonl", "", webhook.Config{}, tools.EtcdHelper{}); err != nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig errored while using a valid policy file: %s", err)
 	}
+
+	// ModeRBAC should not error without a policy file
+	if _, err := NewAuthorizerFromAuthorizationConfig(ModeRBAC, "", "admin", webhook.Config{}, tools.EtcdHelper{}); err != nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig with %s returned an error: %s", ModeRBAC, err)
+	}
+	// Only ModeRBAC accepts a super user
+	if _, err := NewAuthorizerFromAuthorizationConfig(ModeAlwaysAllow, "", "admin", webhook.Config{}, tools.EtcdHelper{}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig with %s and an RBAC super user should have returned an error", ModeAlwaysAllow)
+	}
+
+	// ModeWebhook requires an https url
+	webhookConfig := webhook.Config{URL: "https://127.0.0.1/authorize"}
+	if _, err := NewAuthorizerFromAuthorizationConfig(ModeWebhook, "", "", webhookConfig, tools.EtcdHelper{}); err != nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig with %s returned an error: %s", ModeWebhook, err)
+	}
+	if _, err := NewAuthorizerFromAuthorizationConfig(ModeWebhook, "", "", webhook.Config{URL: "http://127.0.0.1/authorize"}, tools.EtcdHelper{}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig with %s and a plain http url should have returned an error", ModeWebhook)
+	}
+	// Only ModeWebhook accepts a webhook url
+	if _, err := NewAuthorizerFromAuthorizationConfig(ModeAlwaysAllow, "", "", webhookConfig, tools.EtcdHelper{}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig with %s and a webhook url should have returned an error", ModeAlwaysAllow)
+	}
 }
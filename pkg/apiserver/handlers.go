@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"regexp"
 	"runtime/debug"
+	"strconv"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
@@ -207,9 +208,14 @@ func (r *requestAttributeGetter) GetAttribs(req *http.Request) authorizer.Attrib
 
 	apiRequestInfo, _ := r.apiRequestInfoResolver.GetAPIRequestInfo(req)
 
+	attribs.Verb = apiRequestInfo.Verb
+
 	// If a path follows the conventions of the REST object store, then
-	// we can extract the resource.  Otherwise, not.
+	// we can extract the resource, subresource and name.  Otherwise, not.
 	attribs.Resource = apiRequestInfo.Resource
+	attribs.Subresource = apiRequestInfo.Subresource
+	attribs.Name = apiRequestInfo.Name
+	attribs.APIVersion = apiRequestInfo.APIVersion
 
 	// If the request specifies a namespace, then the namespace is filled in.
 	// Assumes there is no empty string namespace.  Unspecified results
@@ -366,6 +372,13 @@ func (r *APIRequestInfoResolver) GetAPIRequestInfo(req *http.Request) (APIReques
 		requestInfo.Verb = "list"
 	}
 
+	// a list or get with the watch parameter set is a watch, just like the /watch/ prefix
+	if requestInfo.Verb == "list" || requestInfo.Verb == "get" {
+		if watch, err := strconv.ParseBool(req.URL.Query().Get("watch")); err == nil && watch {
+			requestInfo.Verb = "watch"
+		}
+	}
+
 	// if we have a resource, we have a good shot at being able to determine kind
 	if len(requestInfo.Resource) > 0 {
 		_, requestInfo.Kind, _ = r.RestMapper.VersionAndKindForResource(requestInfo.Resource)
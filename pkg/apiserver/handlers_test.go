@@ -30,6 +30,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 )
 
@@ -183,9 +184,14 @@ func TestGetAPIRequestInfo(t *testing.T) {
 		{"GET", pathWithPrefix("watch", "pods", "", ""), "watch", testapi.Version(), api.NamespaceAll, "pods", "", "Pod", "", []string{"pods"}},
 		{"GET", pathWithPrefix("redirect", "pods", "", ""), "redirect", testapi.Version(), api.NamespaceAll, "pods", "", "Pod", "", []string{"pods"}},
 		{"GET", pathWithPrefix("watch", "pods", "other", ""), "watch", testapi.Version(), "other", "pods", "", "Pod", "", []string{"pods"}},
+		{"GET", getPath("pods", "other", "") + "?watch=true", "watch", testapi.Version(), "other", "pods", "", "Pod", "", []string{"pods"}},
+		{"GET", getPath("pods", "other", "") + "?watch=false", "list", testapi.Version(), "other", "pods", "", "Pod", "", []string{"pods"}},
 
 		// subresource identification
 		{"GET", "/namespaces/other/pods/foo/status", "get", "", "other", "pods", "status", "Pod", "foo", []string{"pods", "foo", "status"}},
+		{"GET", "/namespaces/other/pods/foo/log", "get", "", "other", "pods", "log", "Pod", "foo", []string{"pods", "foo", "log"}},
+		{"POST", "/namespaces/other/pods/foo/exec", "create", "", "other", "pods", "exec", "Pod", "foo", []string{"pods", "foo", "exec"}},
+		{"POST", "/namespaces/other/pods/foo/binding", "create", "", "other", "pods", "binding", "Pod", "foo", []string{"pods", "foo", "binding"}},
 		{"PUT", "/namespaces/other/finalize", "update", "", "other", "finalize", "", "", "", []string{"finalize"}},
 	}
 
@@ -240,3 +246,76 @@ func TestGetAPIRequestInfo(t *testing.T) {
 		}
 	}
 }
+
+func TestGetAttribs(t *testing.T) {
+	r := NewRequestAttributeGetter(api.NewRequestContextMapper(), latest.RESTMapper, "api")
+
+	testcases := map[string]struct {
+		verb               string
+		path               string
+		expectedAttributes authorizer.Attributes
+	}{
+		"non-resource root": {
+			verb: "GET",
+			path: "/",
+			expectedAttributes: &authorizer.AttributesRecord{
+				ReadOnly: true,
+			},
+		},
+		"namespaced list": {
+			verb: "GET",
+			path: "/api/" + testapi.Version() + "/namespaces/other/pods",
+			expectedAttributes: &authorizer.AttributesRecord{
+				Verb:       "list",
+				ReadOnly:   true,
+				Namespace:  "other",
+				Resource:   "pods",
+				APIVersion: testapi.Version(),
+			},
+		},
+		"namespaced watch": {
+			verb: "GET",
+			path: "/api/" + testapi.Version() + "/namespaces/other/pods?watch=true",
+			expectedAttributes: &authorizer.AttributesRecord{
+				Verb:       "watch",
+				ReadOnly:   true,
+				Namespace:  "other",
+				Resource:   "pods",
+				APIVersion: testapi.Version(),
+			},
+		},
+		"namespaced subresource get": {
+			verb: "GET",
+			path: "/api/" + testapi.Version() + "/namespaces/other/pods/foo/log",
+			expectedAttributes: &authorizer.AttributesRecord{
+				Verb:        "get",
+				ReadOnly:    true,
+				Namespace:   "other",
+				Resource:    "pods",
+				Subresource: "log",
+				Name:        "foo",
+				APIVersion:  testapi.Version(),
+			},
+		},
+		"namespaced subresource create": {
+			verb: "POST",
+			path: "/api/" + testapi.Version() + "/namespaces/other/pods/foo/exec",
+			expectedAttributes: &authorizer.AttributesRecord{
+				Verb:        "create",
+				Namespace:   "other",
+				Resource:    "pods",
+				Subresource: "exec",
+				Name:        "foo",
+				APIVersion:  testapi.Version(),
+			},
+		},
+	}
+
+	for k, tc := range testcases {
+		req, _ := http.NewRequest(tc.verb, tc.path, nil)
+		attribs := r.GetAttribs(req)
+		if !reflect.DeepEqual(attribs, tc.expectedAttributes) {
+			t.Errorf("%s: expected\n\t%#v\ngot\n\t%#v", k, tc.expectedAttributes, attribs)
+		}
+	}
+}
@@ -55,6 +55,14 @@ type policy struct {
 	Resource  string `json:"resource,omitempty"`
 	Namespace string `json:"namespace,omitempty"`
 
+	// Verb, Subresource, Name and APIVersion narrow a policy to a single
+	// kind of request, e.g. reading pods/log without being able to exec.
+	// Like the fields above, an empty value matches anything.
+	Verb        string `json:"verb,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+	Name        string `json:"name,omitempty"`
+	APIVersion  string `json:"apiVersion,omitempty"`
+
 	// TODO: "expires" string in RFC3339 format.
 
 	// TODO: want a way to allow some users to restart containers of a pod but
@@ -102,7 +110,7 @@ func (p policy) matches(a authorizer.Attributes) bool {
 		if p.Readonly == false || (p.Readonly == a.IsReadOnly()) {
 			if p.Resource == "" || (p.Resource == a.GetResource()) {
 				if p.Namespace == "" || (p.Namespace == a.GetNamespace()) {
-					return true
+					return p.requestMatches(a)
 				}
 			}
 		}
@@ -110,6 +118,15 @@ func (p policy) matches(a authorizer.Attributes) bool {
 	return false
 }
 
+// requestMatches returns true if the verb, subresource, name and API version
+// of the request are all allowed by the policy.
+func (p policy) requestMatches(a authorizer.Attributes) bool {
+	return (p.Verb == "" || p.Verb == a.GetVerb()) &&
+		(p.Subresource == "" || p.Subresource == a.GetSubresource()) &&
+		(p.Name == "" || p.Name == a.GetName()) &&
+		(p.APIVersion == "" || p.APIVersion == a.GetAPIVersion())
+}
+
 func (p policy) subjectMatches(a authorizer.Attributes) bool {
 	if p.User != "" {
 		// Require user match
@@ -336,6 +336,81 @@ func TestPolicy(t *testing.T) {
 			matches: false,
 			name:    "resource mis-match",
 		},
+		{
+			policy: policy{
+				Verb: "get",
+			},
+			attr: authorizer.AttributesRecord{
+				Verb: "list",
+			},
+			matches: false,
+			name:    "verb mis-match",
+		},
+		{
+			policy: policy{
+				Resource:    "pods",
+				Subresource: "log",
+			},
+			attr: authorizer.AttributesRecord{
+				Verb:     "get",
+				Resource: "pods",
+				Name:     "foo",
+			},
+			matches: false,
+			name:    "subresource mis-match",
+		},
+		{
+			policy: policy{
+				Resource:    "pods",
+				Subresource: "log",
+			},
+			attr: authorizer.AttributesRecord{
+				Verb:        "get",
+				Resource:    "pods",
+				Subresource: "log",
+				Name:        "foo",
+			},
+			matches: true,
+			name:    "subresource match",
+		},
+		{
+			policy: policy{
+				Name: "foo",
+			},
+			attr: authorizer.AttributesRecord{
+				Name: "bar",
+			},
+			matches: false,
+			name:    "name mis-match",
+		},
+		{
+			policy: policy{
+				APIVersion: "v1",
+			},
+			attr: authorizer.AttributesRecord{
+				APIVersion: "v1beta3",
+			},
+			matches: false,
+			name:    "api version mis-match",
+		},
+		{
+			policy: policy{
+				Verb:        "get",
+				Resource:    "pods",
+				Subresource: "log",
+				Name:        "foo",
+				APIVersion:  "v1",
+			},
+			attr: authorizer.AttributesRecord{
+				Verb:        "get",
+				Resource:    "pods",
+				Subresource: "log",
+				Name:        "foo",
+				APIVersion:  "v1",
+			},
+			matches: true,
+			name:    "full request match",
+		},
 	}
 	for _, test := range tests {
 		matches := test.policy.matches(test.attr)
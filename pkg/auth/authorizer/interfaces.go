@@ -36,11 +36,25 @@ type Attributes interface {
 	// caching, logging, and other incidentals.
 	IsReadOnly() bool
 
+	// The kube verb associated with the request, for example get, list,
+	// watch, create, update or delete.  Empty for non-API requests.
+	GetVerb() string
+
 	// The namespace of the object, if a request is for a REST object.
 	GetNamespace() string
 
 	// The kind of object, if a request is for a REST object.
 	GetResource() string
+
+	// The subresource being requested, if present, for example "status",
+	// "log", "exec" or "binding" in a request for pods/{name}/{subresource}.
+	GetSubresource() string
+
+	// The name of the object, if a request names a single REST object.
+	GetName() string
+
+	// The API version of the request, if a request is for a REST object.
+	GetAPIVersion() string
 }
 
 // Authorizer makes an authorization decision based on information gained by making
@@ -58,10 +72,14 @@ func (f AuthorizerFunc) Authorize(a Attributes) error {
 
 // AttributesRecord implements Attributes interface.
 type AttributesRecord struct {
-	User      user.Info
-	ReadOnly  bool
-	Namespace string
-	Resource  string
+	User        user.Info
+	Verb        string
+	ReadOnly    bool
+	Namespace   string
+	Resource    string
+	Subresource string
+	Name        string
+	APIVersion  string
 }
 
 func (a AttributesRecord) GetUserName() string {
@@ -76,6 +94,10 @@ func (a AttributesRecord) IsReadOnly() bool {
 	return a.ReadOnly
 }
 
+func (a AttributesRecord) GetVerb() string {
+	return a.Verb
+}
+
 func (a AttributesRecord) GetNamespace() string {
 	return a.Namespace
 }
@@ -83,3 +105,15 @@ func (a AttributesRecord) GetNamespace() string {
 func (a AttributesRecord) GetResource() string {
 	return a.Resource
 }
+
+func (a AttributesRecord) GetSubresource() string {
+	return a.Subresource
+}
+
+func (a AttributesRecord) GetName() string {
+	return a.Name
+}
+
+func (a AttributesRecord) GetAPIVersion() string {
+	return a.APIVersion
+}
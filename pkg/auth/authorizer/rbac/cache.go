@@ -0,0 +1,141 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrole"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrolebinding"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/role"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/rolebinding"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+const namespaceIndex = "namespace"
+
+// cachingGetter implements PolicyGetter from local stores that reflectors keep
+// in sync with the registries, so that authorizing a request does not read
+// from storage.
+type cachingGetter struct {
+	roles               cache.Store
+	roleBindings        cache.Indexer
+	clusterRoles        cache.Store
+	clusterRoleBindings cache.Store
+}
+
+// NewCachingGetterFromRegistries returns a PolicyGetter that serves roles and
+// role bindings from caches populated by watching the specified registries
+// until stopCh is closed.
+func NewCachingGetterFromRegistries(roles role.Registry, roleBindings rolebinding.Registry, clusterRoles clusterrole.Registry, clusterRoleBindings clusterrolebinding.Registry, stopCh <-chan struct{}) PolicyGetter {
+	ctx := api.WithNamespace(api.NewContext(), api.NamespaceAll)
+	return newCachingGetter(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return roles.ListRoles(ctx, labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return roles.WatchRoles(ctx, labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return roleBindings.ListRoleBindings(ctx, labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return roleBindings.WatchRoleBindings(ctx, labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return clusterRoles.ListClusterRoles(ctx, labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return clusterRoles.WatchClusterRoles(ctx, labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return clusterRoleBindings.ListClusterRoleBindings(ctx, labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return clusterRoleBindings.WatchClusterRoleBindings(ctx, labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		stopCh,
+	)
+}
+
+func newCachingGetter(roles, roleBindings, clusterRoles, clusterRoleBindings cache.ListerWatcher, stopCh <-chan struct{}) *cachingGetter {
+	g := &cachingGetter{
+		roles:               cache.NewStore(cache.MetaNamespaceKeyFunc),
+		roleBindings:        cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{namespaceIndex: cache.MetaNamespaceIndexFunc}),
+		clusterRoles:        cache.NewStore(cache.MetaNamespaceKeyFunc),
+		clusterRoleBindings: cache.NewStore(cache.MetaNamespaceKeyFunc),
+	}
+	cache.NewReflector(roles, &api.Role{}, g.roles, 0).RunUntil(stopCh)
+	cache.NewReflector(roleBindings, &api.RoleBinding{}, g.roleBindings, 0).RunUntil(stopCh)
+	cache.NewReflector(clusterRoles, &api.ClusterRole{}, g.clusterRoles, 0).RunUntil(stopCh)
+	cache.NewReflector(clusterRoleBindings, &api.ClusterRoleBinding{}, g.clusterRoleBindings, 0).RunUntil(stopCh)
+	return g
+}
+
+func (g *cachingGetter) GetRole(namespace, name string) (*api.Role, error) {
+	obj, exists, err := g.roles.GetByKey(namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound("role", name)
+	}
+	return obj.(*api.Role), nil
+}
+
+func (g *cachingGetter) ListRoleBindings(namespace string) (*api.RoleBindingList, error) {
+	objs, err := g.roleBindings.Index(namespaceIndex, &api.RoleBinding{ObjectMeta: api.ObjectMeta{Namespace: namespace}})
+	if err != nil {
+		return nil, err
+	}
+	list := &api.RoleBindingList{}
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*api.RoleBinding))
+	}
+	return list, nil
+}
+
+func (g *cachingGetter) GetClusterRole(name string) (*api.ClusterRole, error) {
+	obj, exists, err := g.clusterRoles.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound("clusterRole", name)
+	}
+	return obj.(*api.ClusterRole), nil
+}
+
+func (g *cachingGetter) ListClusterRoleBindings() (*api.ClusterRoleBindingList, error) {
+	list := &api.ClusterRoleBindingList{}
+	for _, obj := range g.clusterRoleBindings.List() {
+		list.Items = append(list.Items, *obj.(*api.ClusterRoleBinding))
+	}
+	return list, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/wait"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+func fakeListWatch(list runtime.Object, w watch.Interface) *cache.ListWatch {
+	return &cache.ListWatch{
+		ListFunc:  func() (runtime.Object, error) { return list, nil },
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) { return w, nil },
+	}
+}
+
+func TestCachingGetter(t *testing.T) {
+	roleBindingWatch := watch.NewFake()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	getter := newCachingGetter(
+		fakeListWatch(&api.RoleList{Items: []api.Role{
+			{ObjectMeta: api.ObjectMeta{Namespace: "ns1", Name: "reader"}},
+		}}, watch.NewFake()),
+		fakeListWatch(&api.RoleBindingList{Items: []api.RoleBinding{
+			{ObjectMeta: api.ObjectMeta{Namespace: "ns1", Name: "alice"}},
+			{ObjectMeta: api.ObjectMeta{Namespace: "ns2", Name: "bob"}},
+		}}, roleBindingWatch),
+		fakeListWatch(&api.ClusterRoleList{Items: []api.ClusterRole{
+			{ObjectMeta: api.ObjectMeta{Name: "admin"}},
+		}}, watch.NewFake()),
+		fakeListWatch(&api.ClusterRoleBindingList{Items: []api.ClusterRoleBinding{
+			{ObjectMeta: api.ObjectMeta{Name: "admins"}},
+		}}, watch.NewFake()),
+		stopCh,
+	)
+
+	err := wait.Poll(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		bindings, err := getter.ListClusterRoleBindings()
+		if err != nil {
+			return false, err
+		}
+		if _, err := getter.GetClusterRole("admin"); err != nil {
+			return false, nil
+		}
+		if _, err := getter.GetRole("ns1", "reader"); err != nil {
+			return false, nil
+		}
+		return len(bindings.Items) == 1, nil
+	})
+	if err != nil {
+		t.Fatalf("cache was not populated: %v", err)
+	}
+	if _, err := getter.GetRole("ns2", "reader"); err == nil {
+		t.Errorf("expected an error getting a role from another namespace")
+	}
+
+	bindings, err := getter.ListRoleBindings("ns1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(bindings.Items) != 1 || bindings.Items[0].Name != "alice" {
+		t.Errorf("expected only the binding in ns1, got %#v", bindings.Items)
+	}
+
+	// Changes observed by the watch are served without listing again.
+	roleBindingWatch.Add(&api.RoleBinding{ObjectMeta: api.ObjectMeta{Namespace: "ns1", Name: "carol"}})
+	err = wait.Poll(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		bindings, err := getter.ListRoleBindings("ns1")
+		return err == nil && len(bindings.Items) == 2, err
+	})
+	if err != nil {
+		t.Errorf("watched role binding was not cached: %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrole"
+	clusterroleetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrole/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrolebinding"
+	clusterrolebindingetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrolebinding/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/role"
+	roleetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/role/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/rolebinding"
+	rolebindingetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/rolebinding/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// PolicyGetter defines functions to retrieve the roles and role bindings an RBACAuthorizer evaluates
+type PolicyGetter interface {
+	GetRole(namespace, name string) (*api.Role, error)
+	ListRoleBindings(namespace string) (*api.RoleBindingList, error)
+	GetClusterRole(name string) (*api.ClusterRole, error)
+	ListClusterRoleBindings() (*api.ClusterRoleBindingList, error)
+}
+
+// registryGetter implements PolicyGetter using the role and role binding registries
+type registryGetter struct {
+	roles               role.Registry
+	roleBindings        rolebinding.Registry
+	clusterRoles        clusterrole.Registry
+	clusterRoleBindings clusterrolebinding.Registry
+}
+
+// NewGetterFromRegistries returns a PolicyGetter that uses the specified
+// registries to retrieve roles and role bindings.
+func NewGetterFromRegistries(roles role.Registry, roleBindings rolebinding.Registry, clusterRoles clusterrole.Registry, clusterRoleBindings clusterrolebinding.Registry) PolicyGetter {
+	return &registryGetter{roles, roleBindings, clusterRoles, clusterRoleBindings}
+}
+func (r *registryGetter) GetRole(namespace, name string) (*api.Role, error) {
+	ctx := api.WithNamespace(api.NewContext(), namespace)
+	return r.roles.GetRole(ctx, name)
+}
+func (r *registryGetter) ListRoleBindings(namespace string) (*api.RoleBindingList, error) {
+	ctx := api.WithNamespace(api.NewContext(), namespace)
+	return r.roleBindings.ListRoleBindings(ctx, labels.Everything())
+}
+func (r *registryGetter) GetClusterRole(name string) (*api.ClusterRole, error) {
+	return r.clusterRoles.GetClusterRole(api.NewContext(), name)
+}
+func (r *registryGetter) ListClusterRoleBindings() (*api.ClusterRoleBindingList, error) {
+	return r.clusterRoleBindings.ListClusterRoleBindings(api.NewContext(), labels.Everything())
+}
+
+// NewGetterFromEtcdHelper returns a PolicyGetter that uses the specified
+// helper to watch roles and role bindings and serves them from a local cache.
+// Reading directly from etcd avoids authorizing the authorizer's own requests.
+func NewGetterFromEtcdHelper(helper tools.EtcdHelper) PolicyGetter {
+	return NewCachingGetterFromRegistries(
+		role.NewRegistry(roleetcd.NewStorage(helper)),
+		rolebinding.NewRegistry(rolebindingetcd.NewStorage(helper)),
+		clusterrole.NewRegistry(clusterroleetcd.NewStorage(helper)),
+		clusterrolebinding.NewRegistry(clusterrolebindingetcd.NewStorage(helper)),
+		util.NeverStop,
+	)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rbac implements an authorizer.Authorizer that grants access based on
+// Role, ClusterRole, RoleBinding and ClusterRoleBinding objects stored in the API.
+package rbac
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
+
+	"github.com/golang/glog"
+)
+
+// RBACAuthorizer authorizes requests by resolving the roles bound to the requesting
+// user and checking whether any of their rules cover the request.
+type RBACAuthorizer struct {
+	superUser string
+	getter    PolicyGetter
+}
+
+// New returns an RBACAuthorizer that reads policy through getter.  Requests from
+// superUser are always allowed, so that an initial set of roles can be created.
+func New(getter PolicyGetter, superUser string) *RBACAuthorizer {
+	return &RBACAuthorizer{superUser: superUser, getter: getter}
+}
+
+// Authorize implements authorizer.Authorizer
+func (r *RBACAuthorizer) Authorize(a authorizer.Attributes) error {
+	if len(r.superUser) > 0 && a.GetUserName() == r.superUser {
+		return nil
+	}
+
+	clusterBindings, err := r.getter.ListClusterRoleBindings()
+	if err != nil {
+		return err
+	}
+	for _, binding := range clusterBindings.Items {
+		if !appliesTo(a, binding.Subjects) {
+			continue
+		}
+		rules, err := r.rulesFor(binding.RoleRef, "")
+		if err != nil {
+			glog.Errorf("Unable to resolve role for cluster role binding %s: %v", binding.Name, err)
+			continue
+		}
+		if rulesAllow(a, rules) {
+			return nil
+		}
+	}
+
+	// Namespaced bindings only ever grant access within their own namespace.
+	if namespace := a.GetNamespace(); len(namespace) > 0 {
+		bindings, err := r.getter.ListRoleBindings(namespace)
+		if err != nil {
+			return err
+		}
+		for _, binding := range bindings.Items {
+			if !appliesTo(a, binding.Subjects) {
+				continue
+			}
+			rules, err := r.rulesFor(binding.RoleRef, namespace)
+			if err != nil {
+				glog.Errorf("Unable to resolve role for role binding %s/%s: %v", namespace, binding.Name, err)
+				continue
+			}
+			if rulesAllow(a, rules) {
+				return nil
+			}
+		}
+	}
+
+	return errors.New("No role binding allows this request.")
+}
+
+// rulesFor returns the rules of the role referenced by roleRef.  Roles are
+// resolved in namespace; cluster roles ignore it.
+func (r *RBACAuthorizer) rulesFor(roleRef api.ObjectReference, namespace string) ([]api.PolicyRule, error) {
+	switch roleRef.Kind {
+	case api.RoleKind:
+		if len(namespace) == 0 {
+			return nil, fmt.Errorf("role %q can only be bound in a namespace", roleRef.Name)
+		}
+		role, err := r.getter.GetRole(namespace, roleRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		return role.Rules, nil
+	case api.ClusterRoleKind:
+		role, err := r.getter.GetClusterRole(roleRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		return role.Rules, nil
+	default:
+		return nil, fmt.Errorf("unsupported role reference kind %q", roleRef.Kind)
+	}
+}
+
+// appliesTo returns true if any of subjects names the user making the request,
+// one of its groups, or the service account it authenticated as.
+func appliesTo(a authorizer.Attributes, subjects []api.ObjectReference) bool {
+	for _, subject := range subjects {
+		switch subject.Kind {
+		case api.UserKind:
+			if subject.Name == a.GetUserName() {
+				return true
+			}
+		case api.GroupKind:
+			for _, group := range a.GetGroups() {
+				if subject.Name == group {
+					return true
+				}
+			}
+		case api.ServiceAccountKind:
+			if serviceaccount.MakeUsername(subject.Namespace, subject.Name) == a.GetUserName() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rulesAllow returns true if any of rules covers the verb and resource of the request.
+func rulesAllow(a authorizer.Attributes, rules []api.PolicyRule) bool {
+	for _, rule := range rules {
+		if ruleAllows(a, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleAllows returns true if rule covers the request.  Subresources must be
+// named explicitly, e.g. "pods/log", so that access to pods does not imply
+// access to pods/exec.
+func ruleAllows(a authorizer.Attributes, rule api.PolicyRule) bool {
+	resource := a.GetResource()
+	if subresource := a.GetSubresource(); len(subresource) > 0 {
+		resource = resource + "/" + subresource
+	}
+	return has(rule.Verbs, a.GetVerb(), api.VerbAll) && has(rule.Resources, resource, api.ResourceAll)
+}
+
+// has returns true if items contains value or the wildcard.
+func has(items []string, value, wildcard string) bool {
+	for _, item := range items {
+		if item == value || item == wildcard {
+			return true
+		}
+	}
+	return false
+}
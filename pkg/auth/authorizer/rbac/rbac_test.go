@@ -0,0 +1,183 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+)
+
+type fakePolicyGetter struct {
+	roles               []api.Role
+	roleBindings        []api.RoleBinding
+	clusterRoles        []api.ClusterRole
+	clusterRoleBindings []api.ClusterRoleBinding
+}
+
+func (f *fakePolicyGetter) GetRole(namespace, name string) (*api.Role, error) {
+	for i := range f.roles {
+		if f.roles[i].Namespace == namespace && f.roles[i].Name == name {
+			return &f.roles[i], nil
+		}
+	}
+	return nil, fmt.Errorf("role %s/%s not found", namespace, name)
+}
+
+func (f *fakePolicyGetter) ListRoleBindings(namespace string) (*api.RoleBindingList, error) {
+	list := &api.RoleBindingList{}
+	for _, binding := range f.roleBindings {
+		if binding.Namespace == namespace {
+			list.Items = append(list.Items, binding)
+		}
+	}
+	return list, nil
+}
+
+func (f *fakePolicyGetter) GetClusterRole(name string) (*api.ClusterRole, error) {
+	for i := range f.clusterRoles {
+		if f.clusterRoles[i].Name == name {
+			return &f.clusterRoles[i], nil
+		}
+	}
+	return nil, fmt.Errorf("cluster role %s not found", name)
+}
+
+func (f *fakePolicyGetter) ListClusterRoleBindings() (*api.ClusterRoleBindingList, error) {
+	return &api.ClusterRoleBindingList{Items: f.clusterRoleBindings}, nil
+}
+
+func TestAuthorize(t *testing.T) {
+	getter := &fakePolicyGetter{
+		roles: []api.Role{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "pod-reader", Namespace: "projectCaribou"},
+				Rules: []api.PolicyRule{
+					{Verbs: []string{"get", "list", "watch"}, Resources: []string{"pods"}},
+					{Verbs: []string{"get"}, Resources: []string{"pods/log"}},
+				},
+			},
+		},
+		roleBindings: []api.RoleBinding{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "bob-reads-pods", Namespace: "projectCaribou"},
+				Subjects:   []api.ObjectReference{{Kind: api.UserKind, Name: "bob"}},
+				RoleRef:    api.ObjectReference{Kind: api.RoleKind, Name: "pod-reader"},
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "editors", Namespace: "projectCaribou"},
+				Subjects:   []api.ObjectReference{{Kind: api.GroupKind, Name: "caribou-editors"}},
+				RoleRef:    api.ObjectReference{Kind: api.ClusterRoleKind, Name: "edit"},
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "builder", Namespace: "projectCaribou"},
+				Subjects:   []api.ObjectReference{{Kind: api.ServiceAccountKind, Name: "builder", Namespace: "ci"}},
+				RoleRef:    api.ObjectReference{Kind: api.RoleKind, Name: "pod-reader"},
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "dangling", Namespace: "projectCaribou"},
+				Subjects:   []api.ObjectReference{{Kind: api.UserKind, Name: "chuck"}},
+				RoleRef:    api.ObjectReference{Kind: api.RoleKind, Name: "does-not-exist"},
+			},
+		},
+		clusterRoles: []api.ClusterRole{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "edit"},
+				Rules:      []api.PolicyRule{{Verbs: []string{api.VerbAll}, Resources: []string{"pods", "services"}}},
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "cluster-admin"},
+				Rules:      []api.PolicyRule{{Verbs: []string{api.VerbAll}, Resources: []string{api.ResourceAll}}},
+			},
+		},
+		clusterRoleBindings: []api.ClusterRoleBinding{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "admins"},
+				Subjects:   []api.ObjectReference{{Kind: api.UserKind, Name: "alice"}},
+				RoleRef:    api.ObjectReference{Kind: api.ClusterRoleKind, Name: "cluster-admin"},
+			},
+		},
+	}
+	a := New(getter, "root")
+
+	uAlice := &user.DefaultInfo{Name: "alice"}
+	uBob := &user.DefaultInfo{Name: "bob"}
+	uChuck := &user.DefaultInfo{Name: "chuck"}
+	uEditor := &user.DefaultInfo{Name: "dave", Groups: []string{"caribou-editors"}}
+	uBuilder := &user.DefaultInfo{Name: "system:serviceaccount:ci:builder"}
+	uRoot := &user.DefaultInfo{Name: "root"}
+
+	testCases := []struct {
+		User        user.Info
+		Verb        string
+		Resource    string
+		Subresource string
+		NS          string
+		ExpectAllow bool
+	}{
+		// Alice can do anything anywhere through her cluster role binding.
+		{User: uAlice, Verb: "delete", Resource: "nodes", NS: "", ExpectAllow: true},
+		{User: uAlice, Verb: "create", Resource: "pods", NS: "ns1", ExpectAllow: true},
+
+		// Bob can read pods in projectCaribou.
+		{User: uBob, Verb: "get", Resource: "pods", NS: "projectCaribou", ExpectAllow: true},
+		{User: uBob, Verb: "watch", Resource: "pods", NS: "projectCaribou", ExpectAllow: true},
+		// .. but not write them, read other resources, or read pods elsewhere.
+		{User: uBob, Verb: "update", Resource: "pods", NS: "projectCaribou", ExpectAllow: false},
+		{User: uBob, Verb: "get", Resource: "secrets", NS: "projectCaribou", ExpectAllow: false},
+		{User: uBob, Verb: "get", Resource: "pods", NS: "ns1", ExpectAllow: false},
+		{User: uBob, Verb: "list", Resource: "pods", NS: "", ExpectAllow: false},
+		// Bob can read pod logs, but access to pods does not extend to other subresources.
+		{User: uBob, Verb: "get", Resource: "pods", Subresource: "log", NS: "projectCaribou", ExpectAllow: true},
+		{User: uBob, Verb: "create", Resource: "pods", Subresource: "exec", NS: "projectCaribou", ExpectAllow: false},
+		{User: uEditor, Verb: "create", Resource: "pods", Subresource: "exec", NS: "projectCaribou", ExpectAllow: false},
+		{User: uAlice, Verb: "create", Resource: "pods", Subresource: "exec", NS: "ns1", ExpectAllow: true},
+
+		// Editors get the edit cluster role, but only in projectCaribou.
+		{User: uEditor, Verb: "create", Resource: "services", NS: "projectCaribou", ExpectAllow: true},
+		{User: uEditor, Verb: "create", Resource: "services", NS: "ns1", ExpectAllow: false},
+		{User: uEditor, Verb: "create", Resource: "secrets", NS: "projectCaribou", ExpectAllow: false},
+
+		// Service accounts are matched by namespace and name.
+		{User: uBuilder, Verb: "list", Resource: "pods", NS: "projectCaribou", ExpectAllow: true},
+		{User: uBuilder, Verb: "list", Resource: "pods", NS: "ci", ExpectAllow: false},
+
+		// Bindings to missing roles grant nothing.
+		{User: uChuck, Verb: "get", Resource: "pods", NS: "projectCaribou", ExpectAllow: false},
+
+		// The super user can do anything.
+		{User: uRoot, Verb: "delete", Resource: "namespaces", NS: "", ExpectAllow: true},
+	}
+	for i, tc := range testCases {
+		attr := authorizer.AttributesRecord{
+			User:        tc.User,
+			Verb:        tc.Verb,
+			Resource:    tc.Resource,
+			Subresource: tc.Subresource,
+			Namespace:   tc.NS,
+		}
+		err := a.Authorize(attr)
+		actualAllow := bool(err == nil)
+		if tc.ExpectAllow != actualAllow {
+			t.Errorf("%d: Expected allowed=%v but actually allowed=%v\n\t%v",
+				i, tc.ExpectAllow, actualAllow, tc)
+		}
+	}
+}
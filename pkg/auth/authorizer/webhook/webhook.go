@@ -0,0 +1,102 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements an authorizer.Authorizer that asks a remote
+// service whether a request should be allowed.
+package webhook
+
+import (
+	"errors"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/webhook"
+)
+
+// AccessReview is POSTed to the webhook for every request that needs
+// authorizing.  It carries all of authorizer.Attributes.
+type AccessReview struct {
+	// User and Groups identify who is making the request.
+	User   string   `json:"user,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+	// Verb is the API verb, e.g. "get" or "create".
+	Verb string `json:"verb,omitempty"`
+	// Readonly is true for requests that do not modify state.
+	Readonly bool `json:"readonly,omitempty"`
+	// Namespace, Resource, Subresource and Name describe the object being
+	// accessed.  Any of them may be empty, e.g. for cluster-scoped resources
+	// or lists.
+	Namespace   string `json:"namespace,omitempty"`
+	Resource    string `json:"resource,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+	Name        string `json:"name,omitempty"`
+	// APIVersion is the version of the API the request was made against.
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// AccessReviewResponse is the answer expected back from the webhook.  Reason
+// is optional and is reported when the request is denied.
+type AccessReviewResponse struct {
+	// Allowed is true if the request may proceed.
+	Allowed bool `json:"allowed"`
+	// Reason explains a denial to the client.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Denied implements webhook.Denial.
+func (r *AccessReviewResponse) Denied() bool {
+	return !r.Allowed
+}
+
+// WebhookAuthorizer delegates authorization decisions to a remote service.
+type WebhookAuthorizer struct {
+	webhook *webhook.GenericWebhook
+}
+
+// New returns a WebhookAuthorizer that reviews requests with the webhook
+// described by config.
+func New(config webhook.Config) (*WebhookAuthorizer, error) {
+	w, err := webhook.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookAuthorizer{w}, nil
+}
+
+// Authorize implements authorizer.Authorizer
+func (w *WebhookAuthorizer) Authorize(a authorizer.Attributes) error {
+	review := &AccessReview{
+		User:        a.GetUserName(),
+		Groups:      a.GetGroups(),
+		Verb:        a.GetVerb(),
+		Readonly:    a.IsReadOnly(),
+		Namespace:   a.GetNamespace(),
+		Resource:    a.GetResource(),
+		Subresource: a.GetSubresource(),
+		Name:        a.GetName(),
+		APIVersion:  a.GetAPIVersion(),
+	}
+	response := &AccessReviewResponse{}
+	if err := w.webhook.Review(review, response); err != nil {
+		return err
+	}
+	if !response.Allowed {
+		if len(response.Reason) > 0 {
+			return errors.New(response.Reason)
+		}
+		return errors.New("The authorization webhook denied this request.")
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/webhook"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// policyServer records the last review it saw, and lets alice read logs but
+// not exec into pods.
+type policyServer struct {
+	last AccessReview
+}
+
+func (s *policyServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var review AccessReview
+	if err := json.NewDecoder(req.Body).Decode(&review); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.last = review
+	switch {
+	case review.User == "broken":
+		w.WriteHeader(http.StatusInternalServerError)
+	case review.User == "alice" && review.Subresource == "log":
+		json.NewEncoder(w).Encode(AccessReviewResponse{Allowed: true})
+	case review.User == "alice":
+		json.NewEncoder(w).Encode(AccessReviewResponse{Allowed: false, Reason: "alice may only read logs"})
+	default:
+		json.NewEncoder(w).Encode(AccessReviewResponse{Allowed: false})
+	}
+}
+
+// newTLSServer starts an https server for handler with a freshly generated
+// self-signed certificate, and returns it with the path of that certificate.
+func newTLSServer(t *testing.T, dir string, handler http.Handler) (*httptest.Server, string) {
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	if err := util.GenerateSelfSignedCert("127.0.0.1", certFile, keyFile, []net.IP{net.ParseIP("127.0.0.1")}, nil); err != nil {
+		t.Fatalf("unexpected error generating certificate: %v", err)
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("unexpected error loading certificate: %v", err)
+	}
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	server.StartTLS()
+	return server, certFile
+}
+
+func TestAuthorize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	policy := &policyServer{}
+	server, caFile := newTLSServer(t, dir, policy)
+	defer server.Close()
+
+	a, err := New(webhook.Config{URL: server.URL, CAFile: caFile})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	uAlice := &user.DefaultInfo{Name: "alice", Groups: []string{"ci"}}
+	testCases := []struct {
+		Attributes  authorizer.AttributesRecord
+		ExpectAllow bool
+		ExpectErr   string
+	}{
+		{
+			Attributes:  authorizer.AttributesRecord{User: uAlice, Verb: "get", ReadOnly: true, Namespace: "ns1", Resource: "pods", Subresource: "log", Name: "foo", APIVersion: "v1"},
+			ExpectAllow: true,
+		},
+		{
+			Attributes: authorizer.AttributesRecord{User: uAlice, Verb: "create", Namespace: "ns1", Resource: "pods", Subresource: "exec", Name: "foo", APIVersion: "v1"},
+			ExpectErr:  "alice may only read logs",
+		},
+		{
+			Attributes: authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "bob"}, Verb: "get", Resource: "pods"},
+			ExpectErr:  "The authorization webhook denied this request.",
+		},
+		{
+			Attributes: authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "broken"}, Verb: "get", Resource: "pods"},
+		},
+	}
+	for i, tc := range testCases {
+		err := a.Authorize(tc.Attributes)
+		if tc.ExpectAllow != (err == nil) {
+			t.Errorf("%d: expected allowed=%v, got %v", i, tc.ExpectAllow, err)
+		}
+		if len(tc.ExpectErr) > 0 && (err == nil || err.Error() != tc.ExpectErr) {
+			t.Errorf("%d: expected error %q, got %v", i, tc.ExpectErr, err)
+		}
+		expected := AccessReview{
+			User:        tc.Attributes.User.GetName(),
+			Groups:      tc.Attributes.User.GetGroups(),
+			Verb:        tc.Attributes.Verb,
+			Readonly:    tc.Attributes.ReadOnly,
+			Namespace:   tc.Attributes.Namespace,
+			Resource:    tc.Attributes.Resource,
+			Subresource: tc.Attributes.Subresource,
+			Name:        tc.Attributes.Name,
+			APIVersion:  tc.Attributes.APIVersion,
+		}
+		if !reflect.DeepEqual(expected, policy.last) {
+			t.Errorf("%d: expected review %#v, got %#v", i, expected, policy.last)
+		}
+	}
+}
+
+func TestAuthorizeCachesDecisions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	policy := &policyServer{}
+	server, caFile := newTLSServer(t, dir, policy)
+	defer server.Close()
+
+	a, err := New(webhook.Config{URL: server.URL, CAFile: caFile, CacheTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	attr := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "alice"}, Verb: "get", Resource: "pods", Subresource: "log"}
+	if err := a.Authorize(attr); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	policy.last = AccessReview{}
+	if err := a.Authorize(attr); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(AccessReview{}, policy.last) {
+		t.Errorf("expected the second decision to be served from the cache, got %#v", policy.last)
+	}
+}
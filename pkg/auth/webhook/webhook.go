@@ -0,0 +1,182 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements the plumbing shared by the webhook authenticator
+// and authorizer: POSTing a JSON review to a remote HTTPS service and caching
+// its answer for a while.
+package webhook
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/groupcache/lru"
+)
+
+const (
+	// defaultTimeout bounds how long a single review may take, so that a hung
+	// webhook cannot hang every request to the apiserver.
+	defaultTimeout = 10 * time.Second
+	// maxCachedResponses bounds the memory used by the cache, since anyone can
+	// make the apiserver send reviews, e.g. by presenting made up tokens.
+	maxCachedResponses = 4096
+)
+
+// Config describes how to reach a webhook.
+type Config struct {
+	// URL is the https endpoint reviews are POSTed to.
+	URL string
+	// CAFile, if set, holds the certificate authorities used to verify the
+	// webhook's serving certificate.  If empty, the system roots are used.
+	CAFile string
+	// CacheTTL is how long an answer is reused for an identical review.  Zero
+	// disables caching.
+	CacheTTL time.Duration
+	// DenialCacheTTL is how long an answer that denies the review is reused,
+	// for responses implementing Denial.  It is capped by CacheTTL, and zero
+	// disables caching of denials.
+	DenialCacheTTL time.Duration
+}
+
+// Denial is implemented by responses that may deny a review, so that denials
+// can be cached for a shorter time than other answers.
+type Denial interface {
+	// Denied returns true if the answer denies the review.
+	Denied() bool
+}
+
+// GenericWebhook POSTs reviews to a remote service.
+type GenericWebhook struct {
+	url            string
+	client         *http.Client
+	cacheTTL       time.Duration
+	denialCacheTTL time.Duration
+	clock          util.Clock
+
+	// responses maps the hash of a serialized review to its cachedResponse,
+	// evicting the least recently used answers beyond maxCachedResponses.
+	lock      sync.Mutex
+	responses *lru.Cache
+}
+
+// cachedResponse is the object stored in GenericWebhook.responses.
+type cachedResponse struct {
+	body    []byte
+	expires time.Time
+}
+
+// New returns a GenericWebhook for config, or an error if config is invalid.
+func New(config Config) (*GenericWebhook, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("webhook url %q must use https", config.URL)
+	}
+
+	tlsConfig := &tls.Config{}
+	if len(config.CAFile) > 0 {
+		roots, err := util.CertPoolFromFile(config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = roots
+	}
+
+	return &GenericWebhook{
+		url: config.URL,
+		client: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			Timeout:   defaultTimeout,
+		},
+		cacheTTL:       config.CacheTTL,
+		denialCacheTTL: config.DenialCacheTTL,
+		clock:          util.RealClock{},
+		responses:      lru.New(maxCachedResponses),
+	}, nil
+}
+
+// Review POSTs request to the webhook as JSON and decodes the answer into
+// response.  Answers to identical requests are reused until the cache TTL
+// expires, or the denial cache TTL for denials; failed reviews are never
+// cached.
+func (g *GenericWebhook) Review(request, response interface{}) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	// Reviews may carry credentials, so only a hash of them is kept around.
+	hash := sha256.Sum256(data)
+	key := hex.EncodeToString(hash[:])
+
+	if body, found := g.getCachedResponse(key); found {
+		return json.Unmarshal(body, response)
+	}
+
+	resp, err := g.client.Post(g.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("webhook %s returned %d: %s", g.url, resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, response); err != nil {
+		return fmt.Errorf("unable to decode response from webhook %s: %v", g.url, err)
+	}
+
+	ttl := g.cacheTTL
+	if denial, ok := response.(Denial); ok && denial.Denied() && g.denialCacheTTL < ttl {
+		ttl = g.denialCacheTTL
+	}
+	if ttl > 0 {
+		g.lock.Lock()
+		defer g.lock.Unlock()
+		g.responses.Add(key, &cachedResponse{body: body, expires: g.clock.Now().Add(ttl)})
+	}
+	return nil
+}
+
+// getCachedResponse returns the cached answer for key, unless it has expired.
+func (g *GenericWebhook) getCachedResponse(key string) ([]byte, bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	obj, found := g.responses.Get(key)
+	if !found {
+		return nil, false
+	}
+	cached := obj.(*cachedResponse)
+	if !g.clock.Now().Before(cached.expires) {
+		g.responses.Remove(key)
+		return nil, false
+	}
+	return cached.body, true
+}
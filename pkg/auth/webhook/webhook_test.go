@@ -0,0 +1,268 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+type echoRequest struct {
+	Value string `json:"value"`
+}
+
+type echoResponse struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// echoServer answers every request with its value and the number of requests
+// served so far.
+type echoServer struct {
+	lock   sync.Mutex
+	count  int
+	status int
+}
+
+func (s *echoServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.count++
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	var in echoRequest
+	if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	json.NewEncoder(w).Encode(echoResponse{Value: in.Value, Count: s.count})
+}
+
+// newTLSServer starts an https server for handler with a freshly generated
+// self-signed certificate, and returns it with the path of that certificate.
+func newTLSServer(t *testing.T, dir string, handler http.Handler) (*httptest.Server, string) {
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	if err := util.GenerateSelfSignedCert("127.0.0.1", certFile, keyFile, []net.IP{net.ParseIP("127.0.0.1")}, nil); err != nil {
+		t.Fatalf("unexpected error generating certificate: %v", err)
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("unexpected error loading certificate: %v", err)
+	}
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	server.StartTLS()
+	return server, certFile
+}
+
+func TestNewRequiresHTTPS(t *testing.T) {
+	if _, err := New(Config{URL: "http://127.0.0.1/review"}); err == nil {
+		t.Errorf("expected an error for a plain http url")
+	}
+	if _, err := New(Config{URL: "https://127.0.0.1/review"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := New(Config{URL: "https://127.0.0.1/review", CAFile: "/does/not/exist"}); err == nil {
+		t.Errorf("expected an error for a missing CA file")
+	}
+}
+
+func TestReview(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	handler := &echoServer{}
+	server, caFile := newTLSServer(t, dir, handler)
+	defer server.Close()
+
+	// Without the CA the server's certificate is not trusted.
+	untrusted, err := New(Config{URL: server.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := untrusted.Review(&echoRequest{Value: "foo"}, &echoResponse{}); err == nil {
+		t.Errorf("expected an error reviewing against an untrusted server")
+	}
+
+	w, err := New(Config{URL: server.URL, CAFile: caFile, CacheTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	handler.count = 0
+	for i, tc := range []struct {
+		value string
+		count int
+	}{
+		{"foo", 1},
+		// Identical reviews are answered from the cache.
+		{"foo", 1},
+		{"bar", 2},
+		{"bar", 2},
+	} {
+		response := &echoResponse{}
+		if err := w.Review(&echoRequest{Value: tc.value}, response); err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+		if response.Value != tc.value || response.Count != tc.count {
+			t.Errorf("%d: expected %s/%d, got %#v", i, tc.value, tc.count, response)
+		}
+	}
+
+	// Errors are reported and not cached.
+	handler.status = http.StatusInternalServerError
+	if err := w.Review(&echoRequest{Value: "baz"}, &echoResponse{}); err == nil {
+		t.Errorf("expected an error from a failing webhook")
+	}
+	handler.status = 0
+	response := &echoResponse{}
+	if err := w.Review(&echoRequest{Value: "baz"}, response); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if response.Count != 4 {
+		t.Errorf("expected the failed review to be retried, got %#v", response)
+	}
+}
+
+func TestReviewWithoutCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	handler := &echoServer{}
+	server, caFile := newTLSServer(t, dir, handler)
+	defer server.Close()
+
+	w, err := New(Config{URL: server.URL, CAFile: caFile})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 1; i <= 2; i++ {
+		response := &echoResponse{}
+		if err := w.Review(&echoRequest{Value: "foo"}, response); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if response.Count != i {
+			t.Errorf("expected every review to reach the server, got %#v", response)
+		}
+	}
+}
+
+// deniedEchoResponse is an echoResponse denying reviews of the value "deny".
+type deniedEchoResponse struct {
+	echoResponse
+}
+
+func (r *deniedEchoResponse) Denied() bool {
+	return r.Value == "deny"
+}
+
+func TestReviewCacheExpiry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	handler := &echoServer{}
+	server, caFile := newTLSServer(t, dir, handler)
+	defer server.Close()
+
+	w, err := New(Config{URL: server.URL, CAFile: caFile, CacheTTL: time.Minute, DenialCacheTTL: time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clock := &util.FakeClock{Time: time.Now()}
+	w.clock = clock
+
+	review := func(value string) int {
+		response := &deniedEchoResponse{}
+		if err := w.Review(&echoRequest{Value: value}, response); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return response.Count
+	}
+	if count := review("allow"); count != 1 {
+		t.Errorf("expected the first review to reach the server, got count %d", count)
+	}
+	if count := review("deny"); count != 2 {
+		t.Errorf("expected the first denial to reach the server, got count %d", count)
+	}
+
+	// Denials expire sooner than other answers.
+	clock.Time = clock.Time.Add(2 * time.Second)
+	if count := review("allow"); count != 1 {
+		t.Errorf("expected the answer to be cached, got count %d", count)
+	}
+	if count := review("deny"); count != 3 {
+		t.Errorf("expected the cached denial to expire, got count %d", count)
+	}
+
+	clock.Time = clock.Time.Add(time.Minute)
+	if count := review("allow"); count != 4 {
+		t.Errorf("expected the cached answer to expire, got count %d", count)
+	}
+}
+
+func TestReviewCacheSize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	handler := &echoServer{}
+	server, caFile := newTLSServer(t, dir, handler)
+	defer server.Close()
+
+	w, err := New(Config{URL: server.URL, CAFile: caFile, CacheTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 2*maxCachedResponses; i++ {
+		if err := w.Review(&echoRequest{Value: strconv.Itoa(i)}, &echoResponse{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if size := w.responses.Len(); size != maxCachedResponses {
+		t.Errorf("expected the cache to hold %d answers, got %d", maxCachedResponses, size)
+	}
+
+	// The least recently used answers were dropped.
+	response := &echoResponse{}
+	if err := w.Review(&echoRequest{Value: "0"}, response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.Count != 2*maxCachedResponses+1 {
+		t.Errorf("expected the oldest answer to be evicted, got %#v", response)
+	}
+}
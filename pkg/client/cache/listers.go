@@ -225,6 +225,117 @@ func (s *StoreToControllerLister) GetPodControllers(pod *api.Pod) (controllers [
 	return
 }
 
+// StoreToDeploymentLister gives a store List and GetDeploymentsForLabels
+// methods. The store must contain only Deployments.
+type StoreToDeploymentLister struct {
+	Store
+}
+
+// List lists all deployments in the store.
+func (s *StoreToDeploymentLister) List() (deployments []api.Deployment, err error) {
+	for _, d := range s.Store.List() {
+		deployments = append(deployments, *(d.(*api.Deployment)))
+	}
+	return deployments, nil
+}
+
+// GetDeploymentsForLabels returns the deployments in the given namespace whose
+// selector matches the given labels, e.g. the labels of a pod or of the pod
+// template of a replication controller.
+func (s *StoreToDeploymentLister) GetDeploymentsForLabels(namespace string, labelSet labels.Set) (deployments []api.Deployment, err error) {
+	for _, m := range s.Store.List() {
+		d := *m.(*api.Deployment)
+		if d.Namespace != namespace {
+			continue
+		}
+		selector := labels.Set(d.Spec.Selector).AsSelector()
+		// A deployment with an empty selector should match nothing, not everything.
+		if selector.Empty() || !selector.Matches(labelSet) {
+			continue
+		}
+		deployments = append(deployments, d)
+	}
+	return deployments, nil
+}
+
+// StoreToDaemonSetLister gives a store List and GetPodDaemonSets methods.
+// The store must contain only DaemonSets.
+type StoreToDaemonSetLister struct {
+	Store
+}
+
+// List lists all daemon sets in the store.
+func (s *StoreToDaemonSetLister) List() (daemonSets []api.DaemonSet, err error) {
+	for _, m := range s.Store.List() {
+		daemonSets = append(daemonSets, *(m.(*api.DaemonSet)))
+	}
+	return daemonSets, nil
+}
+
+// GetPodDaemonSets returns the daemon sets in the pod's namespace whose
+// selector matches the pod's labels.
+func (s *StoreToDaemonSetLister) GetPodDaemonSets(pod *api.Pod) (daemonSets []api.DaemonSet, err error) {
+	if len(pod.Labels) == 0 {
+		err = fmt.Errorf("No daemon sets found for pod %v because it has no labels", pod.Name)
+		return
+	}
+	for _, m := range s.Store.List() {
+		ds := *m.(*api.DaemonSet)
+		if ds.Namespace != pod.Namespace {
+			continue
+		}
+		selector := labels.Set(ds.Spec.Selector).AsSelector()
+		// A daemon set with an empty selector should match nothing, not everything.
+		if selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		daemonSets = append(daemonSets, ds)
+	}
+	if len(daemonSets) == 0 {
+		err = fmt.Errorf("Could not find daemon set for pod %s in namespace %s with labels: %v", pod.Name, pod.Namespace, pod.Labels)
+	}
+	return
+}
+
+// StoreToJobLister gives a store List and GetPodJobs methods.
+// The store must contain only Jobs.
+type StoreToJobLister struct {
+	Store
+}
+
+// List lists all jobs in the store.
+func (s *StoreToJobLister) List() (jobs []api.Job, err error) {
+	for _, m := range s.Store.List() {
+		jobs = append(jobs, *(m.(*api.Job)))
+	}
+	return jobs, nil
+}
+
+// GetPodJobs returns the jobs in the pod's namespace whose
+// selector matches the pod's labels.
+func (s *StoreToJobLister) GetPodJobs(pod *api.Pod) (jobs []api.Job, err error) {
+	if len(pod.Labels) == 0 {
+		err = fmt.Errorf("No jobs found for pod %v because it has no labels", pod.Name)
+		return
+	}
+	for _, m := range s.Store.List() {
+		job := *m.(*api.Job)
+		if job.Namespace != pod.Namespace {
+			continue
+		}
+		selector := labels.Set(job.Spec.Selector).AsSelector()
+		// A job with an empty selector should match nothing, not everything.
+		if selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	if len(jobs) == 0 {
+		err = fmt.Errorf("Could not find job for pod %s in namespace %s with labels: %v", pod.Name, pod.Namespace, pod.Labels)
+	}
+	return
+}
+
 // StoreToServiceLister makes a Store that has the List method of the client.ServiceInterface
 // The Store must contain (only) Services.
 type StoreToServiceLister struct {
@@ -155,6 +155,141 @@ func TestStoreToControllerLister(t *testing.T) {
 	}
 }
 
+func TestStoreToDeploymentLister(t *testing.T) {
+	store := NewStore(MetaNamespaceKeyFunc)
+	deployments := []*api.Deployment{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "ns"},
+			Spec:       api.DeploymentSpec{Selector: map[string]string{"app": "foo"}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "other-ns", Namespace: "other"},
+			Spec:       api.DeploymentSpec{Selector: map[string]string{"app": "foo"}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "ns"},
+			Spec:       api.DeploymentSpec{Selector: map[string]string{"app": "bar"}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "empty", Namespace: "ns"},
+		},
+	}
+	for _, d := range deployments {
+		store.Add(d)
+	}
+	lister := StoreToDeploymentLister{store}
+
+	all, err := lister.List()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(all) != len(deployments) {
+		t.Errorf("expected %d deployments, got %d", len(deployments), len(all))
+	}
+
+	matched, err := lister.GetDeploymentsForLabels("ns", labels.Set{"app": "foo", "version": "1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matched) != 1 || matched[0].Name != "foo" {
+		t.Errorf("expected only deployment foo to match, got %#v", matched)
+	}
+}
+
+func TestStoreToDaemonSetLister(t *testing.T) {
+	store := NewStore(MetaNamespaceKeyFunc)
+	daemonSets := []*api.DaemonSet{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "ns"},
+			Spec:       api.DaemonSetSpec{Selector: map[string]string{"app": "foo"}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "other-ns", Namespace: "other"},
+			Spec:       api.DaemonSetSpec{Selector: map[string]string{"app": "foo"}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "ns"},
+			Spec:       api.DaemonSetSpec{Selector: map[string]string{"app": "bar"}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "empty", Namespace: "ns"},
+		},
+	}
+	for _, ds := range daemonSets {
+		store.Add(ds)
+	}
+	lister := StoreToDaemonSetLister{store}
+
+	all, err := lister.List()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(all) != len(daemonSets) {
+		t.Errorf("expected %d daemon sets, got %d", len(daemonSets), len(all))
+	}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: "ns", Labels: map[string]string{"app": "foo", "version": "1"}}}
+	matched, err := lister.GetPodDaemonSets(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matched) != 1 || matched[0].Name != "foo" {
+		t.Errorf("expected only daemon set foo to match, got %#v", matched)
+	}
+
+	pod.Labels = map[string]string{"app": "baz"}
+	if _, err := lister.GetPodDaemonSets(pod); err == nil {
+		t.Errorf("expected an error for a pod no daemon set selects")
+	}
+}
+
+func TestStoreToJobLister(t *testing.T) {
+	store := NewStore(MetaNamespaceKeyFunc)
+	jobs := []*api.Job{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "ns"},
+			Spec:       api.JobSpec{Selector: map[string]string{"app": "foo"}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "other-ns", Namespace: "other"},
+			Spec:       api.JobSpec{Selector: map[string]string{"app": "foo"}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "ns"},
+			Spec:       api.JobSpec{Selector: map[string]string{"app": "bar"}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "empty", Namespace: "ns"},
+		},
+	}
+	for _, job := range jobs {
+		store.Add(job)
+	}
+	lister := StoreToJobLister{store}
+
+	all, err := lister.List()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(all) != len(jobs) {
+		t.Errorf("expected %d jobs, got %d", len(jobs), len(all))
+	}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: "ns", Labels: map[string]string{"app": "foo", "version": "1"}}}
+	matched, err := lister.GetPodJobs(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matched) != 1 || matched[0].Name != "foo" {
+		t.Errorf("expected only job foo to match, got %#v", matched)
+	}
+
+	pod.Labels = map[string]string{"app": "baz"}
+	if _, err := lister.GetPodJobs(pod); err == nil {
+		t.Errorf("expected an error for a pod no job selects")
+	}
+}
+
 func TestStoreToPodLister(t *testing.T) {
 	store := NewStore(MetaNamespaceKeyFunc)
 	ids := []string{"foo", "bar", "baz"}
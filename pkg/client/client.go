@@ -33,6 +33,10 @@ type Interface interface {
 	PodsNamespacer
 	PodTemplatesNamespacer
 	ReplicationControllersNamespacer
+	DeploymentsNamespacer
+	DaemonSetsNamespacer
+	JobsNamespacer
+	HorizontalPodAutoscalersNamespacer
 	ServicesNamespacer
 	EndpointsNamespacer
 	VersionInterface
@@ -52,6 +56,22 @@ func (c *Client) ReplicationControllers(namespace string) ReplicationControllerI
 	return newReplicationControllers(c, namespace)
 }
 
+func (c *Client) Deployments(namespace string) DeploymentInterface {
+	return newDeployments(c, namespace)
+}
+
+func (c *Client) DaemonSets(namespace string) DaemonSetInterface {
+	return newDaemonSets(c, namespace)
+}
+
+func (c *Client) Jobs(namespace string) JobInterface {
+	return newJobs(c, namespace)
+}
+
+func (c *Client) HorizontalPodAutoscalers(namespace string) HorizontalPodAutoscalerInterface {
+	return newHorizontalPodAutoscalers(c, namespace)
+}
+
 func (c *Client) Nodes() NodeInterface {
 	return newNodes(c)
 }
@@ -0,0 +1,95 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// DaemonSetsNamespacer has methods to work with DaemonSet resources in a namespace
+type DaemonSetsNamespacer interface {
+	DaemonSets(namespace string) DaemonSetInterface
+}
+
+// DaemonSetInterface has methods to work with DaemonSet resources.
+type DaemonSetInterface interface {
+	List(selector labels.Selector) (*api.DaemonSetList, error)
+	Get(name string) (*api.DaemonSet, error)
+	Create(daemonSet *api.DaemonSet) (*api.DaemonSet, error)
+	Update(daemonSet *api.DaemonSet) (*api.DaemonSet, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// daemonSets implements DaemonSetsNamespacer interface
+type daemonSets struct {
+	r  *Client
+	ns string
+}
+
+// newDaemonSets returns a daemonSets
+func newDaemonSets(c *Client, namespace string) *daemonSets {
+	return &daemonSets{c, namespace}
+}
+
+// List takes a selector, and returns the list of daemon sets that match that selector.
+func (c *daemonSets) List(selector labels.Selector) (result *api.DaemonSetList, err error) {
+	result = &api.DaemonSetList{}
+	err = c.r.Get().Namespace(c.ns).Resource("daemonsets").LabelsSelectorParam(selector).Do().Into(result)
+	return
+}
+
+// Get returns information about a particular daemon set.
+func (c *daemonSets) Get(name string) (result *api.DaemonSet, err error) {
+	result = &api.DaemonSet{}
+	err = c.r.Get().Namespace(c.ns).Resource("daemonsets").Name(name).Do().Into(result)
+	return
+}
+
+// Create creates a new daemon set.
+func (c *daemonSets) Create(daemonSet *api.DaemonSet) (result *api.DaemonSet, err error) {
+	result = &api.DaemonSet{}
+	err = c.r.Post().Namespace(c.ns).Resource("daemonsets").Body(daemonSet).Do().Into(result)
+	return
+}
+
+// Update updates an existing daemon set.
+func (c *daemonSets) Update(daemonSet *api.DaemonSet) (result *api.DaemonSet, err error) {
+	result = &api.DaemonSet{}
+	err = c.r.Put().Namespace(c.ns).Resource("daemonsets").Name(daemonSet.Name).Body(daemonSet).Do().Into(result)
+	return
+}
+
+// Delete deletes an existing daemon set.
+func (c *daemonSets) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("daemonsets").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested daemon sets.
+func (c *daemonSets) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("daemonsets").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
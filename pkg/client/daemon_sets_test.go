@@ -0,0 +1,110 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func getDaemonSetsResourceName() string {
+	return "daemonsets"
+}
+
+// newTestDaemonSet returns a daemon set whose fields are all set, so that it
+// is unchanged by defaulting when decoded.
+func newTestDaemonSet() *api.DaemonSet {
+	return &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{
+			Name: "foo",
+			Labels: map[string]string{
+				"foo":  "bar",
+				"name": "baz",
+			},
+		},
+		Spec: api.DaemonSetSpec{
+			Selector: map[string]string{"name": "baz"},
+			Template: &api.PodTemplateSpec{},
+		},
+	}
+}
+
+func TestListDaemonSets(t *testing.T) {
+	ns := api.NamespaceAll
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.ResourcePath(getDaemonSetsResourceName(), ns, ""),
+		},
+		Response: Response{StatusCode: 200,
+			Body: &api.DaemonSetList{
+				Items: []api.DaemonSet{*newTestDaemonSet()},
+			},
+		},
+	}
+	receivedDaemonSetList, err := c.Setup().DaemonSets(ns).List(labels.Everything())
+	c.Validate(t, receivedDaemonSetList, err)
+}
+
+func TestGetDaemonSet(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: testapi.ResourcePath(getDaemonSetsResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: newTestDaemonSet()},
+	}
+	receivedDaemonSet, err := c.Setup().DaemonSets(ns).Get("foo")
+	c.Validate(t, receivedDaemonSet, err)
+}
+
+func TestUpdateDaemonSet(t *testing.T) {
+	ns := api.NamespaceDefault
+	requestDaemonSet := &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: testapi.ResourcePath(getDaemonSetsResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: newTestDaemonSet()},
+	}
+	receivedDaemonSet, err := c.Setup().DaemonSets(ns).Update(requestDaemonSet)
+	c.Validate(t, receivedDaemonSet, err)
+}
+
+func TestDeleteDaemonSet(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: testapi.ResourcePath(getDaemonSetsResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().DaemonSets(ns).Delete("foo")
+	c.Validate(t, nil, err)
+}
+
+func TestCreateDaemonSet(t *testing.T) {
+	ns := api.NamespaceDefault
+	requestDaemonSet := &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "POST", Path: testapi.ResourcePath(getDaemonSetsResourceName(), ns, ""), Body: requestDaemonSet, Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: newTestDaemonSet()},
+	}
+	receivedDaemonSet, err := c.Setup().DaemonSets(ns).Create(requestDaemonSet)
+	c.Validate(t, receivedDaemonSet, err)
+}
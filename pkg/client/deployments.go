@@ -0,0 +1,95 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// DeploymentsNamespacer has methods to work with Deployment resources in a namespace
+type DeploymentsNamespacer interface {
+	Deployments(namespace string) DeploymentInterface
+}
+
+// DeploymentInterface has methods to work with Deployment resources.
+type DeploymentInterface interface {
+	List(selector labels.Selector) (*api.DeploymentList, error)
+	Get(name string) (*api.Deployment, error)
+	Create(deployment *api.Deployment) (*api.Deployment, error)
+	Update(deployment *api.Deployment) (*api.Deployment, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// deployments implements DeploymentsNamespacer interface
+type deployments struct {
+	r  *Client
+	ns string
+}
+
+// newDeployments returns a deployments
+func newDeployments(c *Client, namespace string) *deployments {
+	return &deployments{c, namespace}
+}
+
+// List takes a selector, and returns the list of deployments that match that selector.
+func (c *deployments) List(selector labels.Selector) (result *api.DeploymentList, err error) {
+	result = &api.DeploymentList{}
+	err = c.r.Get().Namespace(c.ns).Resource("deployments").LabelsSelectorParam(selector).Do().Into(result)
+	return
+}
+
+// Get returns information about a particular deployment.
+func (c *deployments) Get(name string) (result *api.Deployment, err error) {
+	result = &api.Deployment{}
+	err = c.r.Get().Namespace(c.ns).Resource("deployments").Name(name).Do().Into(result)
+	return
+}
+
+// Create creates a new deployment.
+func (c *deployments) Create(deployment *api.Deployment) (result *api.Deployment, err error) {
+	result = &api.Deployment{}
+	err = c.r.Post().Namespace(c.ns).Resource("deployments").Body(deployment).Do().Into(result)
+	return
+}
+
+// Update updates an existing deployment.
+func (c *deployments) Update(deployment *api.Deployment) (result *api.Deployment, err error) {
+	result = &api.Deployment{}
+	err = c.r.Put().Namespace(c.ns).Resource("deployments").Name(deployment.Name).Body(deployment).Do().Into(result)
+	return
+}
+
+// Delete deletes an existing deployment.
+func (c *deployments) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("deployments").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested deployments.
+func (c *deployments) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("deployments").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
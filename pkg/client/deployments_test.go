@@ -0,0 +1,113 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func getDeploymentsResourceName() string {
+	return "deployments"
+}
+
+// newTestDeployment returns a deployment whose fields are all set, so that it
+// is unchanged by defaulting when decoded.
+func newTestDeployment() *api.Deployment {
+	revisionHistoryLimit := 2
+	return &api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name: "foo",
+			Labels: map[string]string{
+				"foo":  "bar",
+				"name": "baz",
+			},
+		},
+		Spec: api.DeploymentSpec{
+			Replicas:             2,
+			Template:             &api.PodTemplateSpec{},
+			Strategy:             api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType},
+			RevisionHistoryLimit: &revisionHistoryLimit,
+		},
+	}
+}
+
+func TestListDeployments(t *testing.T) {
+	ns := api.NamespaceAll
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.ResourcePath(getDeploymentsResourceName(), ns, ""),
+		},
+		Response: Response{StatusCode: 200,
+			Body: &api.DeploymentList{
+				Items: []api.Deployment{*newTestDeployment()},
+			},
+		},
+	}
+	receivedDeploymentList, err := c.Setup().Deployments(ns).List(labels.Everything())
+	c.Validate(t, receivedDeploymentList, err)
+}
+
+func TestGetDeployment(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: testapi.ResourcePath(getDeploymentsResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: newTestDeployment()},
+	}
+	receivedDeployment, err := c.Setup().Deployments(ns).Get("foo")
+	c.Validate(t, receivedDeployment, err)
+}
+
+func TestUpdateDeployment(t *testing.T) {
+	ns := api.NamespaceDefault
+	requestDeployment := &api.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: testapi.ResourcePath(getDeploymentsResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: newTestDeployment()},
+	}
+	receivedDeployment, err := c.Setup().Deployments(ns).Update(requestDeployment)
+	c.Validate(t, receivedDeployment, err)
+}
+
+func TestDeleteDeployment(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: testapi.ResourcePath(getDeploymentsResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().Deployments(ns).Delete("foo")
+	c.Validate(t, nil, err)
+}
+
+func TestCreateDeployment(t *testing.T) {
+	ns := api.NamespaceDefault
+	requestDeployment := &api.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "POST", Path: testapi.ResourcePath(getDeploymentsResourceName(), ns, ""), Body: requestDeployment, Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: newTestDeployment()},
+	}
+	receivedDeployment, err := c.Setup().Deployments(ns).Create(requestDeployment)
+	c.Validate(t, receivedDeployment, err)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// HorizontalPodAutoscalersNamespacer has methods to work with HorizontalPodAutoscaler resources in a namespace
+type HorizontalPodAutoscalersNamespacer interface {
+	HorizontalPodAutoscalers(namespace string) HorizontalPodAutoscalerInterface
+}
+
+// HorizontalPodAutoscalerInterface has methods to work with HorizontalPodAutoscaler resources.
+type HorizontalPodAutoscalerInterface interface {
+	List(selector labels.Selector) (*api.HorizontalPodAutoscalerList, error)
+	Get(name string) (*api.HorizontalPodAutoscaler, error)
+	Create(autoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error)
+	Update(autoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// horizontalPodAutoscalers implements HorizontalPodAutoscalersNamespacer interface
+type horizontalPodAutoscalers struct {
+	r  *Client
+	ns string
+}
+
+// newHorizontalPodAutoscalers returns a horizontalPodAutoscalers
+func newHorizontalPodAutoscalers(c *Client, namespace string) *horizontalPodAutoscalers {
+	return &horizontalPodAutoscalers{c, namespace}
+}
+
+// List takes a selector, and returns the list of horizontal pod autoscalers that match that selector.
+func (c *horizontalPodAutoscalers) List(selector labels.Selector) (result *api.HorizontalPodAutoscalerList, err error) {
+	result = &api.HorizontalPodAutoscalerList{}
+	err = c.r.Get().Namespace(c.ns).Resource("horizontalpodautoscalers").LabelsSelectorParam(selector).Do().Into(result)
+	return
+}
+
+// Get returns information about a particular horizontal pod autoscaler.
+func (c *horizontalPodAutoscalers) Get(name string) (result *api.HorizontalPodAutoscaler, err error) {
+	result = &api.HorizontalPodAutoscaler{}
+	err = c.r.Get().Namespace(c.ns).Resource("horizontalpodautoscalers").Name(name).Do().Into(result)
+	return
+}
+
+// Create creates a new horizontal pod autoscaler.
+func (c *horizontalPodAutoscalers) Create(autoscaler *api.HorizontalPodAutoscaler) (result *api.HorizontalPodAutoscaler, err error) {
+	result = &api.HorizontalPodAutoscaler{}
+	err = c.r.Post().Namespace(c.ns).Resource("horizontalpodautoscalers").Body(autoscaler).Do().Into(result)
+	return
+}
+
+// Update updates an existing horizontal pod autoscaler.
+func (c *horizontalPodAutoscalers) Update(autoscaler *api.HorizontalPodAutoscaler) (result *api.HorizontalPodAutoscaler, err error) {
+	result = &api.HorizontalPodAutoscaler{}
+	err = c.r.Put().Namespace(c.ns).Resource("horizontalpodautoscalers").Name(autoscaler.Name).Body(autoscaler).Do().Into(result)
+	return
+}
+
+// Delete deletes an existing horizontal pod autoscaler.
+func (c *horizontalPodAutoscalers) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("horizontalpodautoscalers").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested horizontal pod autoscalers.
+func (c *horizontalPodAutoscalers) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("horizontalpodautoscalers").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func getHorizontalPodAutoscalersResourceName() string {
+	return "horizontalpodautoscalers"
+}
+
+// newTestHorizontalPodAutoscaler returns an autoscaler whose fields are all
+// set, so that it is unchanged by defaulting when decoded.
+func newTestHorizontalPodAutoscaler() *api.HorizontalPodAutoscaler {
+	minReplicas, target := 1, 80
+	return &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:   "foo",
+			Labels: map[string]string{"name": "baz"},
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ScaleTargetReference{Kind: "ReplicationController", Name: "baz"},
+			MinReplicas:                    &minReplicas,
+			MaxReplicas:                    10,
+			TargetCPUUtilizationPercentage: &target,
+		},
+	}
+}
+
+func TestListHorizontalPodAutoscalers(t *testing.T) {
+	ns := api.NamespaceAll
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, ""),
+		},
+		Response: Response{StatusCode: 200,
+			Body: &api.HorizontalPodAutoscalerList{
+				Items: []api.HorizontalPodAutoscaler{*newTestHorizontalPodAutoscaler()},
+			},
+		},
+	}
+	receivedList, err := c.Setup().HorizontalPodAutoscalers(ns).List(labels.Everything())
+	c.Validate(t, receivedList, err)
+}
+
+func TestGetHorizontalPodAutoscaler(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: newTestHorizontalPodAutoscaler()},
+	}
+	receivedAutoscaler, err := c.Setup().HorizontalPodAutoscalers(ns).Get("foo")
+	c.Validate(t, receivedAutoscaler, err)
+}
+
+func TestUpdateHorizontalPodAutoscaler(t *testing.T) {
+	ns := api.NamespaceDefault
+	requestAutoscaler := &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: newTestHorizontalPodAutoscaler()},
+	}
+	receivedAutoscaler, err := c.Setup().HorizontalPodAutoscalers(ns).Update(requestAutoscaler)
+	c.Validate(t, receivedAutoscaler, err)
+}
+
+func TestDeleteHorizontalPodAutoscaler(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().HorizontalPodAutoscalers(ns).Delete("foo")
+	c.Validate(t, nil, err)
+}
+
+func TestCreateHorizontalPodAutoscaler(t *testing.T) {
+	ns := api.NamespaceDefault
+	requestAutoscaler := &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "POST", Path: testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, ""), Body: requestAutoscaler, Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: newTestHorizontalPodAutoscaler()},
+	}
+	receivedAutoscaler, err := c.Setup().HorizontalPodAutoscalers(ns).Create(requestAutoscaler)
+	c.Validate(t, receivedAutoscaler, err)
+}
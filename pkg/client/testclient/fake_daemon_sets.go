@@ -0,0 +1,70 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testclient
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeDaemonSets implements DaemonSetInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakeDaemonSets struct {
+	Fake      *Fake
+	Namespace string
+}
+
+const (
+	GetDaemonSetAction    = "get-daemonSet"
+	UpdateDaemonSetAction = "update-daemonSet"
+	WatchDaemonSetAction  = "watch-daemonSet"
+	DeleteDaemonSetAction = "delete-daemonSet"
+	ListDaemonSetAction   = "list-daemonSets"
+	CreateDaemonSetAction = "create-daemonSet"
+)
+
+func (c *FakeDaemonSets) List(selector labels.Selector) (*api.DaemonSetList, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: ListDaemonSetAction}, &api.DaemonSetList{})
+	return obj.(*api.DaemonSetList), err
+}
+
+func (c *FakeDaemonSets) Get(name string) (*api.DaemonSet, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: GetDaemonSetAction, Value: name}, &api.DaemonSet{})
+	return obj.(*api.DaemonSet), err
+}
+
+func (c *FakeDaemonSets) Create(daemonSet *api.DaemonSet) (*api.DaemonSet, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: CreateDaemonSetAction, Value: daemonSet}, &api.DaemonSet{})
+	return obj.(*api.DaemonSet), err
+}
+
+func (c *FakeDaemonSets) Update(daemonSet *api.DaemonSet) (*api.DaemonSet, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: UpdateDaemonSetAction, Value: daemonSet}, &api.DaemonSet{})
+	return obj.(*api.DaemonSet), err
+}
+
+func (c *FakeDaemonSets) Delete(name string) error {
+	_, err := c.Fake.Invokes(FakeAction{Action: DeleteDaemonSetAction, Value: name}, &api.DaemonSet{})
+	return err
+}
+
+func (c *FakeDaemonSets) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: WatchDaemonSetAction, Value: resourceVersion})
+	return c.Fake.Watch, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testclient
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeHorizontalPodAutoscalers implements HorizontalPodAutoscalerInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakeHorizontalPodAutoscalers struct {
+	Fake      *Fake
+	Namespace string
+}
+
+const (
+	GetHorizontalPodAutoscalerAction    = "get-horizontalPodAutoscaler"
+	UpdateHorizontalPodAutoscalerAction = "update-horizontalPodAutoscaler"
+	WatchHorizontalPodAutoscalerAction  = "watch-horizontalPodAutoscaler"
+	DeleteHorizontalPodAutoscalerAction = "delete-horizontalPodAutoscaler"
+	ListHorizontalPodAutoscalerAction   = "list-horizontalPodAutoscalers"
+	CreateHorizontalPodAutoscalerAction = "create-horizontalPodAutoscaler"
+)
+
+func (c *FakeHorizontalPodAutoscalers) List(selector labels.Selector) (*api.HorizontalPodAutoscalerList, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: ListHorizontalPodAutoscalerAction}, &api.HorizontalPodAutoscalerList{})
+	return obj.(*api.HorizontalPodAutoscalerList), err
+}
+
+func (c *FakeHorizontalPodAutoscalers) Get(name string) (*api.HorizontalPodAutoscaler, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: GetHorizontalPodAutoscalerAction, Value: name}, &api.HorizontalPodAutoscaler{})
+	return obj.(*api.HorizontalPodAutoscaler), err
+}
+
+func (c *FakeHorizontalPodAutoscalers) Create(autoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: CreateHorizontalPodAutoscalerAction, Value: autoscaler}, &api.HorizontalPodAutoscaler{})
+	return obj.(*api.HorizontalPodAutoscaler), err
+}
+
+func (c *FakeHorizontalPodAutoscalers) Update(autoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: UpdateHorizontalPodAutoscalerAction, Value: autoscaler}, &api.HorizontalPodAutoscaler{})
+	return obj.(*api.HorizontalPodAutoscaler), err
+}
+
+func (c *FakeHorizontalPodAutoscalers) Delete(name string) error {
+	_, err := c.Fake.Invokes(FakeAction{Action: DeleteHorizontalPodAutoscalerAction, Value: name}, &api.HorizontalPodAutoscaler{})
+	return err
+}
+
+func (c *FakeHorizontalPodAutoscalers) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: WatchHorizontalPodAutoscalerAction, Value: resourceVersion})
+	return c.Fake.Watch, nil
+}
@@ -70,38 +70,36 @@ type RCExpectationsManager interface {
 	DeletionObserved(rc *api.ReplicationController)
 }
 
-// RCExpectations is a ttl cache mapping rcs to what they expect to see before being woken up for a sync.
-type RCExpectations struct {
+// ControllerExpectations is a ttl cache mapping controller keys to what they expect to see
+// before being woken up for a sync. Unlike RCExpectations it is keyed by string, so it can
+// be shared by controllers of any kind.
+type ControllerExpectations struct {
 	cache.Store
 }
 
-// GetExpectations returns the PodExpectations of the given rc.
-func (r *RCExpectations) GetExpectations(rc *api.ReplicationController) (*PodExpectations, bool, error) {
-	rcKey, err := rcKeyFunc(rc)
-	if err != nil {
-		return nil, false, err
-	}
-	if podExp, exists, err := r.GetByKey(rcKey); err == nil && exists {
+// GetExpectations returns the PodExpectations of the controller with the given key.
+func (r *ControllerExpectations) GetExpectations(controllerKey string) (*PodExpectations, bool, error) {
+	if podExp, exists, err := r.GetByKey(controllerKey); err == nil && exists {
 		return podExp.(*PodExpectations), true, nil
 	} else {
 		return nil, false, err
 	}
 }
 
-// DeleteExpectations deletes the expectations of the given RC from the TTLStore.
-func (r *RCExpectations) DeleteExpectations(rcKey string) {
-	if podExp, exists, err := r.GetByKey(rcKey); err == nil && exists {
+// DeleteExpectations deletes the expectations of the given controller from the TTLStore.
+func (r *ControllerExpectations) DeleteExpectations(controllerKey string) {
+	if podExp, exists, err := r.GetByKey(controllerKey); err == nil && exists {
 		if err := r.Delete(podExp); err != nil {
-			glog.V(2).Infof("Error deleting expectations for rc %v: %v", rcKey, err)
+			glog.V(2).Infof("Error deleting expectations for controller %v: %v", controllerKey, err)
 		}
 	}
 }
 
-// SatisfiedExpectations returns true if the replication manager has observed the required adds/dels
-// for the given rc. Add/del counts are established by the rc at sync time, and updated as pods
-// are observed by the replication manager's podController.
-func (r *RCExpectations) SatisfiedExpectations(rc *api.ReplicationController) bool {
-	if podExp, exists, err := r.GetExpectations(rc); exists {
+// SatisfiedExpectations returns true if the required adds/dels for the given controller have been observed.
+// Add/del counts are established by the controller at sync time, and updated as pods are observed by the
+// controller manager.
+func (r *ControllerExpectations) SatisfiedExpectations(controllerKey string) bool {
+	if podExp, exists, err := r.GetExpectations(controllerKey); exists {
 		if podExp.Fulfilled() {
 			return true
 		} else {
@@ -111,27 +109,100 @@ func (r *RCExpectations) SatisfiedExpectations(rc *api.ReplicationController) bo
 	} else if err != nil {
 		glog.V(2).Infof("Error encountered while checking expectations %#v, forcing sync", err)
 	} else {
-		// When a new rc is created, it doesn't have expectations.
+		// When a new controller is created, it doesn't have expectations.
 		// When it doesn't see expected watch events for > TTL, the expectations expire.
 		//	- In this case it wakes up, creates/deletes pods, and sets expectations again.
 		// When it has satisfied expectations and no pods need to be created/destroyed > TTL, the expectations expire.
 		//	- In this case it continues without setting expectations till it needs to create/delete pods.
-		glog.V(4).Infof("Controller %v either never recorded expectations, or the ttl expired.", rc.Name)
+		glog.V(4).Infof("Controller %v either never recorded expectations, or the ttl expired.", controllerKey)
 	}
 	// Trigger a sync if we either encountered and error (which shouldn't happen since we're
-	// getting from local store) or this rc hasn't established expectations.
+	// getting from local store) or this controller hasn't established expectations.
 	return true
 }
 
+// SetExpectations registers new expectations for the given controller. Forgets existing expectations.
+func (r *ControllerExpectations) SetExpectations(controllerKey string, add, del int) error {
+	podExp := &PodExpectations{add: int64(add), del: int64(del), key: controllerKey}
+	glog.V(4).Infof("Setting expectations %+v", podExp)
+	return r.Add(podExp)
+}
+
+func (r *ControllerExpectations) ExpectCreations(controllerKey string, adds int) error {
+	return r.SetExpectations(controllerKey, adds, 0)
+}
+
+func (r *ControllerExpectations) ExpectDeletions(controllerKey string, dels int) error {
+	return r.SetExpectations(controllerKey, 0, dels)
+}
+
+// LowerExpectations decrements the expectation counts of the given controller.
+func (r *ControllerExpectations) LowerExpectations(controllerKey string, add, del int) {
+	if podExp, exists, err := r.GetExpectations(controllerKey); err == nil && exists {
+		podExp.Seen(int64(add), int64(del))
+		// The expectations might've been modified since the update on the previous line.
+		glog.V(4).Infof("Lowering expectations %+v", podExp)
+	}
+}
+
+// CreationObserved atomically decrements the `add` expecation count of the given controller.
+func (r *ControllerExpectations) CreationObserved(controllerKey string) {
+	r.LowerExpectations(controllerKey, 1, 0)
+}
+
+// DeletionObserved atomically decrements the `del` expectation count of the given controller.
+func (r *ControllerExpectations) DeletionObserved(controllerKey string) {
+	r.LowerExpectations(controllerKey, 0, 1)
+}
+
+// NewControllerExpectations returns a store for PodExpectations keyed by controller.
+func NewControllerExpectations() *ControllerExpectations {
+	return &ControllerExpectations{cache.NewTTLStore(expKeyFunc, ExpectationsTimeout)}
+}
+
+// RCExpectations is a ttl cache mapping rcs to what they expect to see before being woken up for a sync.
+type RCExpectations struct {
+	cache.Store
+}
+
+// keyed returns the string keyed view of the same store.
+func (r *RCExpectations) keyed() *ControllerExpectations {
+	return (*ControllerExpectations)(r)
+}
+
+// GetExpectations returns the PodExpectations of the given rc.
+func (r *RCExpectations) GetExpectations(rc *api.ReplicationController) (*PodExpectations, bool, error) {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return nil, false, err
+	}
+	return r.keyed().GetExpectations(rcKey)
+}
+
+// DeleteExpectations deletes the expectations of the given RC from the TTLStore.
+func (r *RCExpectations) DeleteExpectations(rcKey string) {
+	r.keyed().DeleteExpectations(rcKey)
+}
+
+// SatisfiedExpectations returns true if the replication manager has observed the required adds/dels
+// for the given rc. Add/del counts are established by the rc at sync time, and updated as pods
+// are observed by the replication manager's podController.
+func (r *RCExpectations) SatisfiedExpectations(rc *api.ReplicationController) bool {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		glog.V(2).Infof("Error encountered while checking expectations %#v, forcing sync", err)
+		return true
+	}
+	return r.keyed().SatisfiedExpectations(rcKey)
+}
+
 // setExpectations registers new expectations for the given rc. Forgets existing expectations.
 func (r *RCExpectations) setExpectations(rc *api.ReplicationController, add, del int) error {
 	rcKey, err := rcKeyFunc(rc)
 	if err != nil {
 		return err
 	}
-	podExp := &PodExpectations{add: int64(add), del: int64(del), key: rcKey}
-	glog.V(4).Infof("Setting expectations %+v", podExp)
-	return r.Add(podExp)
+	return r.keyed().SetExpectations(rcKey, add, del)
 }
 
 func (r *RCExpectations) ExpectCreations(rc *api.ReplicationController, adds int) error {
@@ -144,10 +215,8 @@ func (r *RCExpectations) ExpectDeletions(rc *api.ReplicationController, dels int
 
 // Decrements the expectation counts of the given rc.
 func (r *RCExpectations) lowerExpectations(rc *api.ReplicationController, add, del int) {
-	if podExp, exists, err := r.GetExpectations(rc); err == nil && exists {
-		podExp.Seen(int64(add), int64(del))
-		// The expectations might've been modified since the update on the previous line.
-		glog.V(4).Infof("Lowering expectations %+v", podExp)
+	if rcKey, err := rcKeyFunc(rc); err == nil {
+		r.keyed().LowerExpectations(rcKey, add, del)
 	}
 }
 
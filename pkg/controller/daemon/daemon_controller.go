@@ -0,0 +1,669 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/framework"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/workqueue"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+	"github.com/golang/glog"
+)
+
+const (
+	// Daemon sets will periodically check that their daemon pods are running as expected.
+	FullDaemonSetResyncPeriod = 30 * time.Second
+
+	// If a watch misdelivers info about a pod, it'll take at least this long
+	// to rectify the number of daemon pods.
+	PodRelistPeriod = 5 * time.Minute
+
+	// Nodes don't need relisting often, the daemon set resync picks up
+	// anything a dropped node event would have triggered.
+	NodeRelistPeriod = 5 * time.Minute
+
+	// The number of daemon pods a daemon set creates or deletes in a single
+	// sync before waiting to observe them.
+	BurstReplicas = 250
+
+	// We must avoid counting pods until the pod and node stores have synced.
+	// If they haven't synced, to avoid a hot loop, we'll wait this long
+	// between checks.
+	StoreSyncedPollPeriod = 100 * time.Millisecond
+
+	// updateRetries is the number of extra attempts made to update the
+	// status of a daemon set.
+	updateRetries = 1
+)
+
+var keyFunc = framework.DeletionHandlingMetaNamespaceKeyFunc
+
+// DaemonSetsController is responsible for synchronizing DaemonSet objects stored
+// in the system with actual running pods.
+type DaemonSetsController struct {
+	kubeClient client.Interface
+	podControl podControlInterface
+
+	// A daemon set is temporarily suspended after creating/deleting these many pods.
+	// It resumes normal action after observing the watch events for them.
+	burstReplicas int
+
+	// To allow injection of syncDaemonSet for testing.
+	syncHandler func(dsKey string) error
+	// storesSynced returns true once the pod and node stores have been synced
+	// at least once. Added as a member to the struct to allow injection for testing.
+	storesSynced func() bool
+
+	// A TTLCache of pod creates/deletes each daemon set expects to see
+	expectations *controller.ControllerExpectations
+	// A store of daemon sets, populated by the dsController
+	dsStore cache.StoreToDaemonSetLister
+	// A store of pods, populated by the podController
+	podStore cache.StoreToPodLister
+	// A store of nodes, populated by the nodeController
+	nodeStore cache.StoreToNodeLister
+	// Watches changes to all daemon sets
+	dsController *framework.Controller
+	// Watches changes to all pods
+	podController *framework.Controller
+	// Watches changes to all nodes
+	nodeController *framework.Controller
+	// Daemon sets that need to be synced
+	queue *workqueue.Type
+}
+
+// NewDaemonSetsController creates a new DaemonSetsController.
+func NewDaemonSetsController(kubeClient client.Interface) *DaemonSetsController {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	eventBroadcaster.StartRecordingToSink(kubeClient.Events(""))
+
+	dsc := &DaemonSetsController{
+		kubeClient: kubeClient,
+		podControl: realPodControl{
+			kubeClient: kubeClient,
+			recorder:   eventBroadcaster.NewRecorder(api.EventSource{Component: "daemon-set-controller"}),
+		},
+		burstReplicas: BurstReplicas,
+		expectations:  controller.NewControllerExpectations(),
+		queue:         workqueue.New(),
+	}
+
+	dsc.dsStore.Store, dsc.dsController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dsc.kubeClient.DaemonSets(api.NamespaceAll).List(labels.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dsc.kubeClient.DaemonSets(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.DaemonSet{},
+		FullDaemonSetResyncPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: dsc.enqueueDaemonSet,
+			UpdateFunc: func(old, cur interface{}) {
+				dsc.enqueueDaemonSet(cur)
+			},
+			DeleteFunc: dsc.enqueueDaemonSet,
+		},
+	)
+
+	dsc.podStore.Store, dsc.podController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dsc.kubeClient.Pods(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dsc.kubeClient.Pods(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Pod{},
+		PodRelistPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc:    dsc.addPod,
+			UpdateFunc: dsc.updatePod,
+			DeleteFunc: dsc.deletePod,
+		},
+	)
+
+	dsc.nodeStore.Store, dsc.nodeController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dsc.kubeClient.Nodes().List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dsc.kubeClient.Nodes().Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Node{},
+		NodeRelistPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc:    dsc.addNode,
+			UpdateFunc: dsc.updateNode,
+			// Daemon pods left on a deleted node are cleaned up by the next
+			// sync of their daemon set.
+			DeleteFunc: func(obj interface{}) {
+				dsc.enqueueAllDaemonSets()
+			},
+		},
+	)
+
+	dsc.syncHandler = dsc.syncDaemonSet
+	dsc.storesSynced = func() bool {
+		return dsc.podController.HasSynced() && dsc.nodeController.HasSynced()
+	}
+	return dsc
+}
+
+// Run begins watching and syncing daemon sets.
+func (dsc *DaemonSetsController) Run(workers int, stopCh <-chan struct{}) {
+	defer util.HandleCrash()
+	go dsc.dsController.Run(stopCh)
+	go dsc.podController.Run(stopCh)
+	go dsc.nodeController.Run(stopCh)
+	for i := 0; i < workers; i++ {
+		go util.Until(dsc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+	glog.Infof("Shutting down daemon set controller")
+	dsc.queue.ShutDown()
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and marks them done.
+// It enforces that the syncHandler is never invoked concurrently with the same key.
+func (dsc *DaemonSetsController) worker() {
+	for {
+		func() {
+			key, quit := dsc.queue.Get()
+			if quit {
+				return
+			}
+			defer dsc.queue.Done(key)
+			if err := dsc.syncHandler(key.(string)); err != nil {
+				glog.Errorf("Error syncing daemon set %v: %v", key, err)
+			}
+		}()
+	}
+}
+
+// obj could be an *api.DaemonSet, or a DeletionFinalStateUnknown marker item.
+func (dsc *DaemonSetsController) enqueueDaemonSet(obj interface{}) {
+	key, err := keyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	dsc.queue.Add(key)
+}
+
+func (dsc *DaemonSetsController) enqueueAllDaemonSets() {
+	daemonSets, err := dsc.dsStore.List()
+	if err != nil {
+		glog.Errorf("Error listing daemon sets: %v", err)
+		return
+	}
+	for i := range daemonSets {
+		dsc.enqueueDaemonSet(&daemonSets[i])
+	}
+}
+
+// getPodDaemonSet returns the daemon set managing the given pod.
+// TODO: Surface that we are ignoring multiple daemon sets for a single pod.
+func (dsc *DaemonSetsController) getPodDaemonSet(pod *api.Pod) *api.DaemonSet {
+	daemonSets, err := dsc.dsStore.GetPodDaemonSets(pod)
+	if err != nil {
+		glog.V(4).Infof("No daemon sets found for pod %v, daemon set controller will avoid syncing", pod.Name)
+		return nil
+	}
+	sort.Sort(byCreationTimestamp(daemonSets))
+	return &daemonSets[0]
+}
+
+// When a pod is created, enqueue the daemon set that manages it and update its expectations.
+func (dsc *DaemonSetsController) addPod(obj interface{}) {
+	pod := obj.(*api.Pod)
+	if ds := dsc.getPodDaemonSet(pod); ds != nil {
+		dsKey, err := keyFunc(ds)
+		if err != nil {
+			glog.Errorf("Couldn't get key for object %+v: %v", ds, err)
+			return
+		}
+		dsc.expectations.CreationObserved(dsKey)
+		dsc.enqueueDaemonSet(ds)
+	}
+}
+
+// When a pod is updated, figure out what daemon sets manage it and wake them
+// up. If the labels of the pod have changed we need to awaken both the old
+// and new daemon set. old and cur must be *api.Pod types.
+func (dsc *DaemonSetsController) updatePod(old, cur interface{}) {
+	if api.Semantic.DeepEqual(old, cur) {
+		// A periodic relist will send update events for all known pods.
+		return
+	}
+	curPod := cur.(*api.Pod)
+	if ds := dsc.getPodDaemonSet(curPod); ds != nil {
+		dsc.enqueueDaemonSet(ds)
+	}
+	oldPod := old.(*api.Pod)
+	if !reflect.DeepEqual(curPod.Labels, oldPod.Labels) {
+		if oldDS := dsc.getPodDaemonSet(oldPod); oldDS != nil {
+			dsc.enqueueDaemonSet(oldDS)
+		}
+	}
+}
+
+// When a pod is deleted, enqueue the daemon set that manages the pod and update its expectations.
+// obj could be an *api.Pod, or a DeletionFinalStateUnknown marker item.
+func (dsc *DaemonSetsController) deletePod(obj interface{}) {
+	pod, ok := obj.(*api.Pod)
+	// When a delete is dropped, the relist will notice a pod in the store not
+	// in the list, leading to the insertion of a tombstone object which contains
+	// the deleted key/value. Note that this value might be stale.
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			glog.Errorf("Couldn't get object from tombstone %+v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*api.Pod)
+		if !ok {
+			glog.Errorf("Tombstone contained object that is not a pod %+v", obj)
+			return
+		}
+	}
+	if ds := dsc.getPodDaemonSet(pod); ds != nil {
+		dsKey, err := keyFunc(ds)
+		if err != nil {
+			glog.Errorf("Couldn't get key for object %+v: %v", ds, err)
+			return
+		}
+		dsc.expectations.DeletionObserved(dsKey)
+		dsc.enqueueDaemonSet(ds)
+	}
+}
+
+// When a node joins, wake up the daemon sets that should run on it.
+func (dsc *DaemonSetsController) addNode(obj interface{}) {
+	node := obj.(*api.Node)
+	daemonSets, err := dsc.dsStore.List()
+	if err != nil {
+		glog.Errorf("Error listing daemon sets: %v", err)
+		return
+	}
+	for i := range daemonSets {
+		if nodeShouldRunDaemonPod(node, &daemonSets[i]) {
+			dsc.enqueueDaemonSet(&daemonSets[i])
+		}
+	}
+}
+
+// When the labels of a node change, wake up the daemon sets that should
+// start or stop running on it.
+func (dsc *DaemonSetsController) updateNode(old, cur interface{}) {
+	oldNode := old.(*api.Node)
+	curNode := cur.(*api.Node)
+	if reflect.DeepEqual(oldNode.Labels, curNode.Labels) {
+		// The daemon sets only care about node labels.
+		return
+	}
+	daemonSets, err := dsc.dsStore.List()
+	if err != nil {
+		glog.Errorf("Error listing daemon sets: %v", err)
+		return
+	}
+	for i := range daemonSets {
+		ds := &daemonSets[i]
+		if nodeShouldRunDaemonPod(oldNode, ds) != nodeShouldRunDaemonPod(curNode, ds) {
+			dsc.enqueueDaemonSet(ds)
+		}
+	}
+}
+
+// nodeShouldRunDaemonPod returns true if the node's labels match the node
+// selector and required node affinity of the daemon set's pod template.
+func nodeShouldRunDaemonPod(node *api.Node, ds *api.DaemonSet) bool {
+	if ds.Spec.Template == nil {
+		return false
+	}
+	if !labels.SelectorFromSet(ds.Spec.Template.Spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	return predicates.PodMatchesNodeAffinity(&api.Pod{Spec: ds.Spec.Template.Spec}, node)
+}
+
+// getNodesToDaemonPods returns the active pods of the daemon set, grouped by
+// the node they are bound to.
+func (dsc *DaemonSetsController) getNodesToDaemonPods(ds *api.DaemonSet) (map[string][]*api.Pod, error) {
+	nodeToDaemonPods := make(map[string][]*api.Pod)
+	podList, err := dsc.podStore.Pods(ds.Namespace).List(labels.Set(ds.Spec.Selector).AsSelector())
+	if err != nil {
+		return nodeToDaemonPods, err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase == api.PodSucceeded || pod.Status.Phase == api.PodFailed {
+			continue
+		}
+		nodeToDaemonPods[pod.Spec.NodeName] = append(nodeToDaemonPods[pod.Spec.NodeName], pod)
+	}
+	return nodeToDaemonPods, nil
+}
+
+// manage creates daemon pods on the nodes that should run one but don't, and
+// deletes the daemon pods on nodes that shouldn't run one, that no longer
+// exist, or that run more than one.
+func (dsc *DaemonSetsController) manage(ds *api.DaemonSet) error {
+	dsKey, err := keyFunc(ds)
+	if err != nil {
+		return err
+	}
+	nodeToDaemonPods, err := dsc.getNodesToDaemonPods(ds)
+	if err != nil {
+		return fmt.Errorf("error getting pods for daemon set %q: %v", dsKey, err)
+	}
+	nodeList, err := dsc.nodeStore.List()
+	if err != nil {
+		return fmt.Errorf("error listing nodes: %v", err)
+	}
+
+	var nodesNeedingDaemonPods []string
+	var podsToDelete []*api.Pod
+	knownNodes := make(map[string]bool)
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		knownNodes[node.Name] = true
+		daemonPods := nodeToDaemonPods[node.Name]
+		shouldRun := nodeShouldRunDaemonPod(node, ds)
+		switch {
+		case shouldRun && len(daemonPods) == 0:
+			nodesNeedingDaemonPods = append(nodesNeedingDaemonPods, node.Name)
+		case shouldRun && len(daemonPods) > 1:
+			// Keep the oldest daemon pod on the node and delete the rest.
+			sort.Sort(podsByCreationTimestamp(daemonPods))
+			podsToDelete = append(podsToDelete, daemonPods[1:]...)
+		case !shouldRun && len(daemonPods) > 0:
+			podsToDelete = append(podsToDelete, daemonPods...)
+		}
+	}
+	// Pods bound to nodes that have left the cluster, or not bound at all,
+	// are not pods this controller would have created.
+	for nodeName, daemonPods := range nodeToDaemonPods {
+		if !knownNodes[nodeName] {
+			podsToDelete = append(podsToDelete, daemonPods...)
+		}
+	}
+
+	if len(nodesNeedingDaemonPods) > dsc.burstReplicas {
+		nodesNeedingDaemonPods = nodesNeedingDaemonPods[:dsc.burstReplicas]
+	}
+	if len(podsToDelete) > dsc.burstReplicas {
+		podsToDelete = podsToDelete[:dsc.burstReplicas]
+	}
+	if len(nodesNeedingDaemonPods) == 0 && len(podsToDelete) == 0 {
+		return nil
+	}
+	if err := dsc.expectations.SetExpectations(dsKey, len(nodesNeedingDaemonPods), len(podsToDelete)); err != nil {
+		return err
+	}
+
+	glog.V(2).Infof("Daemon set %q needs %d new pods and %d deletions", dsKey, len(nodesNeedingDaemonPods), len(podsToDelete))
+	wait := sync.WaitGroup{}
+	wait.Add(len(nodesNeedingDaemonPods) + len(podsToDelete))
+	for _, nodeName := range nodesNeedingDaemonPods {
+		go func(nodeName string) {
+			defer wait.Done()
+			if err := dsc.podControl.createPodOnNode(nodeName, ds); err != nil {
+				// Decrement the expected number of creates because the informer won't observe this pod
+				glog.V(2).Infof("Failed creation, decrementing expectations for daemon set %q", dsKey)
+				dsc.expectations.CreationObserved(dsKey)
+				util.HandleError(err)
+			}
+		}(nodeName)
+	}
+	for _, pod := range podsToDelete {
+		go func(pod *api.Pod) {
+			defer wait.Done()
+			if err := dsc.podControl.deletePod(pod.Namespace, pod.Name, ds); err != nil {
+				// Decrement the expected number of deletes because the informer won't observe this deletion
+				glog.V(2).Infof("Failed deletion, decrementing expectations for daemon set %q", dsKey)
+				dsc.expectations.DeletionObserved(dsKey)
+				util.HandleError(err)
+			}
+		}(pod)
+	}
+	wait.Wait()
+	return nil
+}
+
+// updateDaemonSetStatus recomputes the status of the daemon set from the
+// local stores and writes it back if it changed.
+func (dsc *DaemonSetsController) updateDaemonSetStatus(ds *api.DaemonSet) error {
+	nodeToDaemonPods, err := dsc.getNodesToDaemonPods(ds)
+	if err != nil {
+		return err
+	}
+	nodeList, err := dsc.nodeStore.List()
+	if err != nil {
+		return err
+	}
+
+	var desired, current, misscheduled int
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		scheduled := len(nodeToDaemonPods[node.Name]) > 0
+		if nodeShouldRunDaemonPod(node, ds) {
+			desired++
+			if scheduled {
+				current++
+			}
+		} else if scheduled {
+			misscheduled++
+		}
+	}
+
+	status := api.DaemonSetStatus{
+		DesiredNumberScheduled: desired,
+		CurrentNumberScheduled: current,
+		NumberMisscheduled:     misscheduled,
+		ObservedGeneration:     ds.Generation,
+	}
+	if reflect.DeepEqual(ds.Status, status) {
+		return nil
+	}
+
+	dsClient := dsc.kubeClient.DaemonSets(ds.Namespace)
+	var updateErr error
+	for i, toUpdate := 0, *ds; ; i++ {
+		toUpdate.Status = status
+		if _, updateErr = dsClient.Update(&toUpdate); updateErr == nil || i >= updateRetries {
+			return updateErr
+		}
+		// Update the daemon set with the latest resource version for the next attempt.
+		latestDS, getErr := dsClient.Get(ds.Name)
+		if getErr != nil {
+			return getErr
+		}
+		toUpdate = *latestDS
+	}
+}
+
+// syncDaemonSet will sync the daemon set with the given key if it has had its expectations
+// fulfilled, meaning it did not expect to see any more of its pods created or deleted. This
+// function is not meant to be invoked concurrently with the same key.
+func (dsc *DaemonSetsController) syncDaemonSet(key string) error {
+	startTime := time.Now()
+	defer func() {
+		glog.V(4).Infof("Finished syncing daemon set %q (%v)", key, time.Now().Sub(startTime))
+	}()
+
+	obj, exists, err := dsc.dsStore.Store.GetByKey(key)
+	if err != nil {
+		glog.Infof("Unable to retrieve daemon set %v from store: %v", key, err)
+		dsc.queue.Add(key)
+		return err
+	}
+	if !exists {
+		glog.V(3).Infof("Daemon set has been deleted %v", key)
+		dsc.expectations.DeleteExpectations(key)
+		return nil
+	}
+	ds := obj.(*api.DaemonSet)
+	if !dsc.storesSynced() {
+		// Sleep so we give the pod and node reflector goroutines a chance to run.
+		time.Sleep(StoreSyncedPollPeriod)
+		glog.Infof("Waiting for pods and nodes to sync, requeuing daemon set %v", key)
+		dsc.enqueueDaemonSet(ds)
+		return nil
+	}
+
+	// Check the expectations of the daemon set before counting its pods, otherwise a new pod
+	// can sneak in and update the expectations after we've retrieved the pods from the store.
+	if dsc.expectations.SatisfiedExpectations(key) {
+		if err := dsc.manage(ds); err != nil {
+			return err
+		}
+	}
+
+	// Always update the status as daemon pods come up or die.
+	if err := dsc.updateDaemonSetStatus(ds); err != nil {
+		glog.V(2).Infof("Failed to update status for daemon set %v, requeuing: %v", key, err)
+		dsc.enqueueDaemonSet(ds)
+	}
+	return nil
+}
+
+// podControlInterface knows how to add or delete daemon pods, created as an
+// interface to allow testing.
+type podControlInterface interface {
+	// createPodOnNode creates a daemon pod bound to the given node.
+	createPodOnNode(nodeName string, ds *api.DaemonSet) error
+	// deletePod deletes the named daemon pod.
+	deletePod(namespace, name string, ds *api.DaemonSet) error
+}
+
+// realPodControl is the default implementation of podControlInterface.
+type realPodControl struct {
+	kubeClient client.Interface
+	recorder   record.EventRecorder
+}
+
+func (r realPodControl) createPodOnNode(nodeName string, ds *api.DaemonSet) error {
+	desiredLabels := make(labels.Set)
+	for k, v := range ds.Spec.Template.Labels {
+		desiredLabels[k] = v
+	}
+	desiredAnnotations := make(labels.Set)
+	for k, v := range ds.Spec.Template.Annotations {
+		desiredAnnotations[k] = v
+	}
+
+	createdByRef, err := api.GetReference(ds)
+	if err != nil {
+		return fmt.Errorf("unable to get daemon set reference: %v", err)
+	}
+	createdByRefJson, err := latest.Codec.Encode(&api.SerializedReference{
+		Reference: *createdByRef,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to serialize daemon set reference: %v", err)
+	}
+	desiredAnnotations[controller.CreatedByAnnotation] = string(createdByRefJson)
+
+	// use the dash (if the name isn't too long) to make the pod name a bit prettier
+	prefix := fmt.Sprintf("%s-", ds.Name)
+	if ok, _ := validation.ValidatePodName(prefix, true); !ok {
+		prefix = ds.Name
+	}
+
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Labels:       desiredLabels,
+			Annotations:  desiredAnnotations,
+			GenerateName: prefix,
+		},
+	}
+	if err := api.Scheme.Convert(&ds.Spec.Template.Spec, &pod.Spec); err != nil {
+		return fmt.Errorf("unable to convert pod template: %v", err)
+	}
+	// Daemon pods are bound directly, the scheduler never sees them.
+	pod.Spec.NodeName = nodeName
+	if labels.Set(pod.Labels).AsSelector().Empty() {
+		return fmt.Errorf("unable to create daemon pod, no labels")
+	}
+	newPod, err := r.kubeClient.Pods(ds.Namespace).Create(pod)
+	if err != nil {
+		r.recorder.Eventf(ds, "failedCreate", "Error creating pod on node %v: %v", nodeName, err)
+		return fmt.Errorf("unable to create daemon pod: %v", err)
+	}
+	glog.V(4).Infof("Daemon set %v created pod %v on node %v", ds.Name, newPod.Name, nodeName)
+	r.recorder.Eventf(ds, "successfulCreate", "Created pod: %v", newPod.Name)
+	return nil
+}
+
+func (r realPodControl) deletePod(namespace, name string, ds *api.DaemonSet) error {
+	if err := r.kubeClient.Pods(namespace).Delete(name, nil); err != nil {
+		r.recorder.Eventf(ds, "failedDelete", "Error deleting pod %v: %v", name, err)
+		return fmt.Errorf("unable to delete daemon pod: %v", err)
+	}
+	r.recorder.Eventf(ds, "successfulDelete", "Deleted pod: %v", name)
+	return nil
+}
+
+// byCreationTimestamp sorts a list of daemon sets by creation timestamp, using their names as a tie breaker.
+type byCreationTimestamp []api.DaemonSet
+
+func (o byCreationTimestamp) Len() int      { return len(o) }
+func (o byCreationTimestamp) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+
+func (o byCreationTimestamp) Less(i, j int) bool {
+	if o[i].CreationTimestamp.Equal(o[j].CreationTimestamp) {
+		return o[i].Name < o[j].Name
+	}
+	return o[i].CreationTimestamp.Before(o[j].CreationTimestamp)
+}
+
+// podsByCreationTimestamp sorts a list of pods by creation timestamp, using their names as a tie breaker.
+type podsByCreationTimestamp []*api.Pod
+
+func (o podsByCreationTimestamp) Len() int      { return len(o) }
+func (o podsByCreationTimestamp) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+
+func (o podsByCreationTimestamp) Less(i, j int) bool {
+	if o[i].CreationTimestamp.Equal(o[j].CreationTimestamp) {
+		return o[i].Name < o[j].Name
+	}
+	return o[i].CreationTimestamp.Before(o[j].CreationTimestamp)
+}
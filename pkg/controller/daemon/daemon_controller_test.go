@@ -0,0 +1,366 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+var simpleDaemonSetLabel = map[string]string{"name": "simple-daemon", "type": "production"}
+
+func init() {
+	api.ForTesting_ReferencesAllowBlankSelfLinks = true
+}
+
+type fakePodControl struct {
+	lock        sync.Mutex
+	createNodes []string
+	deletePods  []string
+	err         error
+}
+
+func (f *fakePodControl) createPodOnNode(nodeName string, ds *api.DaemonSet) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.createNodes = append(f.createNodes, nodeName)
+	return nil
+}
+
+func (f *fakePodControl) deletePod(namespace, name string, ds *api.DaemonSet) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.deletePods = append(f.deletePods, name)
+	return nil
+}
+
+func (f *fakePodControl) clear() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.createNodes = nil
+	f.deletePods = nil
+}
+
+// newFakeClient returns a fake client that echoes back the objects it is sent.
+func newFakeClient() *testclient.Fake {
+	return &testclient.Fake{
+		ReactFn: func(action testclient.FakeAction) (runtime.Object, error) {
+			if obj, ok := action.Value.(runtime.Object); ok {
+				return obj, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+func newTestController() (*DaemonSetsController, *fakePodControl, *testclient.Fake) {
+	client := newFakeClient()
+	manager := NewDaemonSetsController(client)
+	manager.storesSynced = func() bool { return true }
+	podControl := &fakePodControl{}
+	manager.podControl = podControl
+	return manager, podControl, client
+}
+
+func newDaemonSet(name string) *api.DaemonSet {
+	return &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec: api.DaemonSetSpec{
+			Selector: simpleDaemonSetLabel,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: simpleDaemonSetLabel,
+				},
+				Spec: api.PodSpec{
+					Containers:    []api.Container{{Name: "foo", Image: "foo/bar"}},
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+		},
+	}
+}
+
+func newNode(name string, labels map[string]string) *api.Node {
+	return &api.Node{
+		ObjectMeta: api.ObjectMeta{Name: name, Labels: labels},
+	}
+}
+
+func addNodes(nodeStore cache.Store, startIndex, numNodes int, labels map[string]string) {
+	for i := startIndex; i < startIndex+numNodes; i++ {
+		nodeStore.Add(newNode(fmt.Sprintf("node-%d", i), labels))
+	}
+}
+
+func newPod(name, nodeName string, labels map[string]string) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: api.NamespaceDefault,
+			Labels:    labels,
+		},
+		Spec: api.PodSpec{
+			NodeName: nodeName,
+		},
+		Status: api.PodStatus{Phase: api.PodRunning},
+	}
+}
+
+func syncAndValidate(t *testing.T, manager *DaemonSetsController, ds *api.DaemonSet, podControl *fakePodControl, expectedCreates, expectedDeletes int) {
+	key, err := keyFunc(ds)
+	if err != nil {
+		t.Fatalf("unexpected error getting key for %v: %v", ds.Name, err)
+	}
+	if err := manager.syncHandler(key); err != nil {
+		t.Fatalf("unexpected error syncing %v: %v", key, err)
+	}
+	if len(podControl.createNodes) != expectedCreates {
+		t.Errorf("unexpected number of creates. Expected %d, saw %d: %v", expectedCreates, len(podControl.createNodes), podControl.createNodes)
+	}
+	if len(podControl.deletePods) != expectedDeletes {
+		t.Errorf("unexpected number of deletes. Expected %d, saw %d: %v", expectedDeletes, len(podControl.deletePods), podControl.deletePods)
+	}
+}
+
+// DaemonSets without node selectors should launch pods on every node.
+func TestSimpleDaemonSetLaunchesPods(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	addNodes(manager.nodeStore.Store, 0, 5, nil)
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	syncAndValidate(t, manager, ds, podControl, 5, 0)
+}
+
+// DaemonSets should not launch pods on nodes that don't match their node selector.
+func TestNodeSelectorDaemonLaunchesPods(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	addNodes(manager.nodeStore.Store, 0, 4, nil)
+	addNodes(manager.nodeStore.Store, 4, 3, map[string]string{"role": "logging"})
+	ds := newDaemonSet("foo")
+	ds.Spec.Template.Spec.NodeSelector = map[string]string{"role": "logging"}
+	manager.dsStore.Add(ds)
+	syncAndValidate(t, manager, ds, podControl, 3, 0)
+
+	sort.Strings(podControl.createNodes)
+	expected := []string{"node-4", "node-5", "node-6"}
+	for i := range expected {
+		if podControl.createNodes[i] != expected[i] {
+			t.Errorf("expected pods on %v, got %v", expected, podControl.createNodes)
+			break
+		}
+	}
+}
+
+// DaemonSets should not launch pods on nodes that don't match their required node affinity.
+func TestNodeAffinityDaemonLaunchesPods(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	addNodes(manager.nodeStore.Store, 0, 4, nil)
+	addNodes(manager.nodeStore.Store, 4, 3, map[string]string{"role": "logging"})
+	ds := newDaemonSet("foo")
+	ds.Spec.Template.Spec.Affinity = &api.Affinity{NodeAffinity: &api.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+			NodeSelectorTerms: []api.NodeSelectorTerm{{
+				MatchExpressions: []api.NodeSelectorRequirement{{Key: "role", Operator: api.NodeSelectorOpDoesNotExist}},
+			}},
+		},
+	}}
+	manager.dsStore.Add(ds)
+	syncAndValidate(t, manager, ds, podControl, 4, 0)
+}
+
+// Nodes that already run a daemon pod don't get another one.
+func TestDaemonSetSkipsNodesWithPods(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	addNodes(manager.nodeStore.Store, 0, 3, nil)
+	manager.podStore.Add(newPod("pod-0", "node-0", simpleDaemonSetLabel))
+	manager.podStore.Add(newPod("pod-1", "node-1", simpleDaemonSetLabel))
+	// A terminated pod doesn't count as running the daemon.
+	failed := newPod("pod-2", "node-2", simpleDaemonSetLabel)
+	failed.Status.Phase = api.PodFailed
+	manager.podStore.Add(failed)
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	syncAndValidate(t, manager, ds, podControl, 1, 0)
+	if podControl.createNodes[0] != "node-2" {
+		t.Errorf("expected a pod on node-2, got %v", podControl.createNodes)
+	}
+}
+
+// Only the oldest daemon pod on a node is kept.
+func TestDeleteExtraDaemonPods(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	addNodes(manager.nodeStore.Store, 0, 1, nil)
+	older := newPod("pod-old", "node-0", simpleDaemonSetLabel)
+	older.CreationTimestamp = util.NewTime(time.Now().Add(-time.Hour))
+	newer := newPod("pod-new", "node-0", simpleDaemonSetLabel)
+	newer.CreationTimestamp = util.Now()
+	manager.podStore.Add(newer)
+	manager.podStore.Add(older)
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	syncAndValidate(t, manager, ds, podControl, 0, 1)
+	if podControl.deletePods[0] != "pod-new" {
+		t.Errorf("expected pod-new to be deleted, got %v", podControl.deletePods)
+	}
+}
+
+// Daemon pods are removed from nodes that no longer match the node selector,
+// and from nodes that have left the cluster.
+func TestDeleteMisscheduledAndOrphanedPods(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	addNodes(manager.nodeStore.Store, 0, 2, map[string]string{"role": "logging"})
+	addNodes(manager.nodeStore.Store, 2, 2, nil)
+	manager.podStore.Add(newPod("pod-0", "node-0", simpleDaemonSetLabel))
+	manager.podStore.Add(newPod("pod-1", "node-1", simpleDaemonSetLabel))
+	manager.podStore.Add(newPod("pod-2", "node-2", simpleDaemonSetLabel))
+	manager.podStore.Add(newPod("pod-gone", "node-gone", simpleDaemonSetLabel))
+	// Pods in other namespaces or with other labels are left alone.
+	other := newPod("other", "node-3", simpleDaemonSetLabel)
+	other.Namespace = "other"
+	manager.podStore.Add(other)
+	manager.podStore.Add(newPod("unrelated", "node-3", map[string]string{"name": "unrelated"}))
+
+	ds := newDaemonSet("foo")
+	ds.Spec.Template.Spec.NodeSelector = map[string]string{"role": "logging"}
+	manager.dsStore.Add(ds)
+	syncAndValidate(t, manager, ds, podControl, 0, 2)
+
+	sort.Strings(podControl.deletePods)
+	if podControl.deletePods[0] != "pod-2" || podControl.deletePods[1] != "pod-gone" {
+		t.Errorf("expected pod-2 and pod-gone to be deleted, got %v", podControl.deletePods)
+	}
+}
+
+// A daemon set doesn't act again until it has observed the pods it created.
+func TestExpectationsPreventDuplicateCreates(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	addNodes(manager.nodeStore.Store, 0, 2, nil)
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	syncAndValidate(t, manager, ds, podControl, 2, 0)
+
+	podControl.clear()
+	syncAndValidate(t, manager, ds, podControl, 0, 0)
+
+	// Observing the creations lets the daemon set sync again.
+	for i := 0; i < 2; i++ {
+		pod := newPod(fmt.Sprintf("pod-%d", i), fmt.Sprintf("node-%d", i), simpleDaemonSetLabel)
+		manager.podStore.Add(pod)
+		manager.addPod(pod)
+	}
+	addNodes(manager.nodeStore.Store, 2, 1, nil)
+	syncAndValidate(t, manager, ds, podControl, 1, 0)
+}
+
+// Failed creations lower the expectations so the next sync retries them.
+func TestFailedCreatesAreRetried(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	addNodes(manager.nodeStore.Store, 0, 2, nil)
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	podControl.err = fmt.Errorf("fake error")
+	syncAndValidate(t, manager, ds, podControl, 0, 0)
+
+	podControl.err = nil
+	syncAndValidate(t, manager, ds, podControl, 2, 0)
+}
+
+func TestStatusUpdate(t *testing.T) {
+	manager, podControl, client := newTestController()
+	addNodes(manager.nodeStore.Store, 0, 2, map[string]string{"role": "logging"})
+	addNodes(manager.nodeStore.Store, 2, 2, nil)
+	manager.podStore.Add(newPod("pod-0", "node-0", simpleDaemonSetLabel))
+	manager.podStore.Add(newPod("pod-2", "node-2", simpleDaemonSetLabel))
+	ds := newDaemonSet("foo")
+	ds.Generation = 3
+	ds.Spec.Template.Spec.NodeSelector = map[string]string{"role": "logging"}
+	manager.dsStore.Add(ds)
+	syncAndValidate(t, manager, ds, podControl, 1, 1)
+
+	var updated *api.DaemonSet
+	for _, action := range client.Actions {
+		if action.Action == testclient.UpdateDaemonSetAction {
+			updated = action.Value.(*api.DaemonSet)
+		}
+	}
+	if updated == nil {
+		t.Fatalf("expected a status update, got actions %v", client.Actions)
+	}
+	expected := api.DaemonSetStatus{
+		DesiredNumberScheduled: 2,
+		CurrentNumberScheduled: 1,
+		NumberMisscheduled:     1,
+		ObservedGeneration:     3,
+	}
+	if updated.Status != expected {
+		t.Errorf("expected status %+v, got %+v", expected, updated.Status)
+	}
+
+	// An unchanged status is not written again.
+	ds.Status = expected
+	client.Actions = nil
+	podControl.clear()
+	manager.expectations.DeleteExpectations(api.NamespaceDefault + "/foo")
+	manager.podStore.Add(newPod("pod-1", "node-1", simpleDaemonSetLabel))
+	manager.podStore.Delete(newPod("pod-2", "node-2", simpleDaemonSetLabel))
+	ds.Status.CurrentNumberScheduled = 2
+	ds.Status.NumberMisscheduled = 0
+	syncAndValidate(t, manager, ds, podControl, 0, 0)
+	for _, action := range client.Actions {
+		if action.Action == testclient.UpdateDaemonSetAction {
+			t.Errorf("unexpected status update: %v", action)
+		}
+	}
+}
+
+func TestNodeEventsEnqueueDaemonSets(t *testing.T) {
+	manager, _, _ := newTestController()
+	ds := newDaemonSet("foo")
+	ds.Spec.Template.Spec.NodeSelector = map[string]string{"role": "logging"}
+	manager.dsStore.Add(ds)
+
+	manager.addNode(newNode("node-0", nil))
+	if manager.queue.Len() != 0 {
+		t.Errorf("expected a non-matching node not to enqueue the daemon set")
+	}
+	manager.updateNode(newNode("node-0", nil), newNode("node-0", map[string]string{"role": "logging"}))
+	if manager.queue.Len() != 1 {
+		t.Errorf("expected a node that started matching to enqueue the daemon set")
+	}
+	key, _ := manager.queue.Get()
+	manager.queue.Done(key)
+	manager.addNode(newNode("node-1", map[string]string{"role": "logging"}))
+	if manager.queue.Len() != 1 {
+		t.Errorf("expected a matching node to enqueue the daemon set")
+	}
+}
@@ -0,0 +1,705 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+	"hash/adler32"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/framework"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/workqueue"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/golang/glog"
+)
+
+const (
+	// FullDeploymentResyncPeriod is how often every deployment is synced, even
+	// if nothing related to it has been observed to change.
+	FullDeploymentResyncPeriod = 30 * time.Second
+
+	// PodRelistPeriod is how often the pods watched for readiness are relisted.
+	PodRelistPeriod = 5 * time.Minute
+
+	// StoreSyncedPollPeriod is how long a sync waits before retrying when the
+	// local stores have not been populated yet.
+	StoreSyncedPollPeriod = 100 * time.Millisecond
+
+	// PodTemplateHashLabelKey is added to the selector and pod template of every
+	// replication controller created for a deployment. Its value is a hash of the
+	// deployment's pod template, which keeps the pods of different revisions apart.
+	PodTemplateHashLabelKey = "deployment.kubernetes.io/podTemplateHash"
+
+	// RevisionAnnotation records the deployment revision a replication
+	// controller was last rolled out as.
+	RevisionAnnotation = "deployment.kubernetes.io/revision"
+)
+
+var keyFunc = framework.DeletionHandlingMetaNamespaceKeyFunc
+
+// DeploymentController is responsible for synchronizing Deployment objects stored
+// in the system with the replication controllers that run their pods.
+type DeploymentController struct {
+	kubeClient client.Interface
+	recorder   record.EventRecorder
+
+	// To allow injection of syncDeployment for testing.
+	syncHandler func(key string) error
+
+	// storesSynced returns true once the rc and pod stores have been synced at
+	// least once. Added as a member to the struct to allow injection for testing.
+	storesSynced func() bool
+
+	// A store of deployments, populated by the dController
+	dStore cache.StoreToDeploymentLister
+	// A store of replication controllers, populated by the rcController
+	rcStore cache.StoreToControllerLister
+	// A store of pods, populated by the podController
+	podStore cache.StoreToPodLister
+
+	// Watches changes to all deployments
+	dController *framework.Controller
+	// Watches changes to all replication controllers
+	rcController *framework.Controller
+	// Watches changes to all pods
+	podController *framework.Controller
+
+	// Deployments that need to be synced
+	queue *workqueue.Type
+}
+
+// NewDeploymentController creates a new DeploymentController.
+func NewDeploymentController(kubeClient client.Interface) *DeploymentController {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	eventBroadcaster.StartRecordingToSink(kubeClient.Events(""))
+
+	dc := &DeploymentController{
+		kubeClient: kubeClient,
+		recorder:   eventBroadcaster.NewRecorder(api.EventSource{Component: "deployment-controller"}),
+		queue:      workqueue.New(),
+	}
+
+	dc.dStore.Store, dc.dController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dc.kubeClient.Deployments(api.NamespaceAll).List(labels.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dc.kubeClient.Deployments(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Deployment{},
+		FullDeploymentResyncPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: dc.enqueueDeployment,
+			UpdateFunc: func(old, cur interface{}) {
+				dc.enqueueDeployment(cur)
+			},
+			DeleteFunc: dc.enqueueDeployment,
+		},
+	)
+
+	dc.rcStore.Store, dc.rcController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dc.kubeClient.ReplicationControllers(api.NamespaceAll).List(labels.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dc.kubeClient.ReplicationControllers(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.ReplicationController{},
+		FullDeploymentResyncPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: dc.enqueueForController,
+			UpdateFunc: func(old, cur interface{}) {
+				dc.enqueueForController(cur)
+			},
+			DeleteFunc: dc.enqueueForController,
+		},
+	)
+
+	dc.podStore.Store, dc.podController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dc.kubeClient.Pods(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dc.kubeClient.Pods(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Pod{},
+		PodRelistPeriod,
+		framework.ResourceEventHandlerFuncs{
+			// Rolling updates progress as pods become ready, so every pod change
+			// wakes up the deployments that select it.
+			AddFunc: dc.enqueueForPod,
+			UpdateFunc: func(old, cur interface{}) {
+				if api.Semantic.DeepEqual(old, cur) {
+					// A periodic relist will send update events for all known pods.
+					return
+				}
+				dc.enqueueForPod(cur)
+			},
+			DeleteFunc: dc.enqueueForPod,
+		},
+	)
+
+	dc.syncHandler = dc.syncDeployment
+	dc.storesSynced = func() bool {
+		return dc.rcController.HasSynced() && dc.podController.HasSynced()
+	}
+	return dc
+}
+
+// Run begins watching and syncing.
+func (dc *DeploymentController) Run(workers int, stopCh <-chan struct{}) {
+	defer util.HandleCrash()
+	go dc.dController.Run(stopCh)
+	go dc.rcController.Run(stopCh)
+	go dc.podController.Run(stopCh)
+	for i := 0; i < workers; i++ {
+		go util.Until(dc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+	glog.Infof("Shutting down deployment controller")
+	dc.queue.ShutDown()
+}
+
+// obj could be an *api.Deployment, or a DeletionFinalStateUnknown marker item.
+func (dc *DeploymentController) enqueueDeployment(obj interface{}) {
+	key, err := keyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	dc.queue.Add(key)
+}
+
+// enqueueForController enqueues the deployments whose selector matches the pod
+// template of the given replication controller. obj could be an
+// *api.ReplicationController, or a DeletionFinalStateUnknown marker item.
+func (dc *DeploymentController) enqueueForController(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	rc, ok := obj.(*api.ReplicationController)
+	if !ok || rc.Spec.Template == nil {
+		return
+	}
+	dc.enqueueForLabels(rc.Namespace, labels.Set(rc.Spec.Template.Labels))
+}
+
+// enqueueForPod enqueues the deployments whose selector matches the given pod.
+// obj could be an *api.Pod, or a DeletionFinalStateUnknown marker item.
+func (dc *DeploymentController) enqueueForPod(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pod, ok := obj.(*api.Pod)
+	if !ok {
+		return
+	}
+	dc.enqueueForLabels(pod.Namespace, labels.Set(pod.Labels))
+}
+
+func (dc *DeploymentController) enqueueForLabels(namespace string, labelSet labels.Set) {
+	deployments, err := dc.dStore.GetDeploymentsForLabels(namespace, labelSet)
+	if err != nil {
+		glog.V(4).Infof("No deployments found for labels %v: %v", labelSet, err)
+		return
+	}
+	for i := range deployments {
+		dc.enqueueDeployment(&deployments[i])
+	}
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and marks them done.
+// It enforces that the syncHandler is never invoked concurrently with the same key.
+func (dc *DeploymentController) worker() {
+	for {
+		func() {
+			key, quit := dc.queue.Get()
+			if quit {
+				return
+			}
+			defer dc.queue.Done(key)
+			if err := dc.syncHandler(key.(string)); err != nil {
+				glog.Errorf("Error syncing deployment %v: %v", key, err)
+			}
+		}()
+	}
+}
+
+// syncDeployment brings the replication controllers of the deployment with the
+// given key one step closer to the deployment's spec. This function is not meant
+// to be invoked concurrently with the same key.
+func (dc *DeploymentController) syncDeployment(key string) error {
+	startTime := time.Now()
+	defer func() {
+		glog.V(4).Infof("Finished syncing deployment %q (%v)", key, time.Now().Sub(startTime))
+	}()
+
+	obj, exists, err := dc.dStore.Store.GetByKey(key)
+	if err != nil {
+		glog.Infof("Unable to retrieve deployment %v from store: %v", key, err)
+		dc.queue.Add(key)
+		return err
+	}
+	if !exists {
+		glog.Infof("Deployment has been deleted %v", key)
+		return nil
+	}
+	if !dc.storesSynced() {
+		// Sleep so we give the rc and pod reflector goroutines a chance to run.
+		time.Sleep(StoreSyncedPollPeriod)
+		glog.Infof("Waiting for rc and pod controllers to sync, requeuing deployment %v", key)
+		dc.queue.Add(key)
+		return nil
+	}
+
+	// The object in the store is shared with the informer, so work on a copy.
+	copied, err := api.Scheme.Copy(obj.(*api.Deployment))
+	if err != nil {
+		return err
+	}
+	deployment := copied.(*api.Deployment)
+	if deployment.Spec.Template == nil {
+		return fmt.Errorf("deployment %v has no pod template", key)
+	}
+
+	allRCs := dc.getReplicationControllers(deployment)
+	hash := podTemplateHash(deployment.Spec.Template)
+	newRC, oldRCs := splitControllers(allRCs, hash)
+
+	if deployment.Spec.RollbackTo != nil {
+		return dc.rollback(deployment, newRC, oldRCs)
+	}
+
+	if newRC, err = dc.getOrCreateNewRC(deployment, hash, newRC, oldRCs); err != nil {
+		return err
+	}
+
+	switch deployment.Spec.Strategy.Type {
+	case api.RecreateDeploymentStrategyType:
+		err = dc.syncRecreate(deployment, newRC, oldRCs)
+	case api.RollingUpdateDeploymentStrategyType:
+		err = dc.syncRollingUpdate(deployment, newRC, oldRCs)
+	default:
+		err = fmt.Errorf("unknown deployment strategy type %q", deployment.Spec.Strategy.Type)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := dc.cleanupOldControllers(deployment, oldRCs); err != nil {
+		return err
+	}
+	return dc.updateStatus(deployment, newRC, oldRCs)
+}
+
+// getReplicationControllers returns the replication controllers created for the
+// given deployment: those carrying a pod template hash whose pod template is
+// selected by the deployment.
+func (dc *DeploymentController) getReplicationControllers(deployment *api.Deployment) []api.ReplicationController {
+	selector := labels.Set(deployment.Spec.Selector).AsSelector()
+	if selector.Empty() {
+		return nil
+	}
+	controllers, _ := dc.rcStore.List()
+	var result []api.ReplicationController
+	for _, rc := range controllers {
+		if rc.Namespace != deployment.Namespace || rc.Spec.Template == nil {
+			continue
+		}
+		if _, ok := rc.Spec.Selector[PodTemplateHashLabelKey]; !ok {
+			continue
+		}
+		if selector.Matches(labels.Set(rc.Spec.Template.Labels)) {
+			result = append(result, rc)
+		}
+	}
+	return result
+}
+
+// splitControllers returns the controller running the pod template with the
+// given hash, if any, and all other controllers.
+func splitControllers(controllers []api.ReplicationController, hash string) (*api.ReplicationController, []api.ReplicationController) {
+	var newRC *api.ReplicationController
+	var oldRCs []api.ReplicationController
+	for i := range controllers {
+		if controllers[i].Spec.Selector[PodTemplateHashLabelKey] == hash {
+			newRC = &controllers[i]
+			continue
+		}
+		oldRCs = append(oldRCs, controllers[i])
+	}
+	return newRC, oldRCs
+}
+
+// podTemplateHash returns the value of PodTemplateHashLabelKey for the given template.
+func podTemplateHash(template *api.PodTemplateSpec) string {
+	hasher := adler32.New()
+	util.DeepHashObject(hasher, template)
+	return strconv.FormatUint(uint64(hasher.Sum32()), 10)
+}
+
+// getRevision returns the revision recorded on the given controller, or 0.
+func getRevision(rc *api.ReplicationController) int64 {
+	revision, err := strconv.ParseInt(rc.Annotations[RevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+func maxRevision(controllers []api.ReplicationController) int64 {
+	max := int64(0)
+	for i := range controllers {
+		if revision := getRevision(&controllers[i]); revision > max {
+			max = revision
+		}
+	}
+	return max
+}
+
+// getOrCreateNewRC makes sure a controller for the deployment's current pod
+// template exists and carries the newest revision.
+func (dc *DeploymentController) getOrCreateNewRC(deployment *api.Deployment, hash string, newRC *api.ReplicationController, oldRCs []api.ReplicationController) (*api.ReplicationController, error) {
+	revision := maxRevision(oldRCs) + 1
+	if newRC != nil {
+		if getRevision(newRC) >= revision {
+			return newRC, nil
+		}
+		// The template of an older revision has been rolled out again.
+		if newRC.Annotations == nil {
+			newRC.Annotations = map[string]string{}
+		}
+		newRC.Annotations[RevisionAnnotation] = strconv.FormatInt(revision, 10)
+		return dc.kubeClient.ReplicationControllers(newRC.Namespace).Update(newRC)
+	}
+
+	copied, err := api.Scheme.DeepCopy(deployment.Spec.Template)
+	if err != nil {
+		return nil, err
+	}
+	template := copied.(*api.PodTemplateSpec)
+	template.Labels = addLabel(template.Labels, PodTemplateHashLabelKey, hash)
+	rc := &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%s", deployment.Name, hash),
+			Namespace:   deployment.Namespace,
+			Labels:      template.Labels,
+			Annotations: map[string]string{RevisionAnnotation: strconv.FormatInt(revision, 10)},
+		},
+		Spec: api.ReplicationControllerSpec{
+			Replicas: 0,
+			Selector: addLabel(deployment.Spec.Selector, PodTemplateHashLabelKey, hash),
+			Template: template,
+		},
+	}
+	created, err := dc.kubeClient.ReplicationControllers(rc.Namespace).Create(rc)
+	if err != nil {
+		dc.recorder.Eventf(deployment, "failedCreate", "Error creating replication controller: %v", err)
+		return nil, err
+	}
+	dc.recorder.Eventf(deployment, "successfulCreate", "Created replication controller %s for revision %d", created.Name, revision)
+	return created, nil
+}
+
+// addLabel returns a copy of the given labels with key set to value.
+func addLabel(in map[string]string, key, value string) map[string]string {
+	out := map[string]string{}
+	for k, v := range in {
+		out[k] = v
+	}
+	out[key] = value
+	return out
+}
+
+// rollback replaces the pod template of the deployment with the one of the
+// revision requested in spec.rollbackTo, and clears the request.
+func (dc *DeploymentController) rollback(deployment *api.Deployment, newRC *api.ReplicationController, oldRCs []api.ReplicationController) error {
+	revision := deployment.Spec.RollbackTo.Revision
+	if revision == 0 {
+		// Roll back to the revision before the current one.
+		revision = maxRevision(oldRCs)
+	}
+	var target *api.ReplicationController
+	for i := range oldRCs {
+		if revision != 0 && getRevision(&oldRCs[i]) == revision {
+			target = &oldRCs[i]
+			break
+		}
+	}
+	switch {
+	case target != nil:
+		copied, err := api.Scheme.DeepCopy(target.Spec.Template)
+		if err != nil {
+			return err
+		}
+		template := copied.(*api.PodTemplateSpec)
+		delete(template.Labels, PodTemplateHashLabelKey)
+		deployment.Spec.Template = template
+		dc.recorder.Eventf(deployment, "rollback", "Rolled back to revision %d", revision)
+	case newRC != nil && revision == getRevision(newRC):
+		dc.recorder.Eventf(deployment, "rollbackTemplateUnchanged", "Revision %d is already the current revision", revision)
+	default:
+		dc.recorder.Eventf(deployment, "rollbackRevisionNotFound", "Unable to find revision %d to roll back to", deployment.Spec.RollbackTo.Revision)
+	}
+	deployment.Spec.RollbackTo = nil
+	_, err := dc.kubeClient.Deployments(deployment.Namespace).Update(deployment)
+	return err
+}
+
+// syncRecreate scales all old controllers down to zero, and only scales the
+// new controller up once all of their pods are gone.
+func (dc *DeploymentController) syncRecreate(deployment *api.Deployment, newRC *api.ReplicationController, oldRCs []api.ReplicationController) error {
+	scaledDown := false
+	for i := range oldRCs {
+		if oldRCs[i].Spec.Replicas == 0 {
+			continue
+		}
+		if err := dc.scaleController(deployment, &oldRCs[i], 0); err != nil {
+			return err
+		}
+		scaledDown = true
+	}
+	if scaledDown {
+		return nil
+	}
+	for i := range oldRCs {
+		if len(dc.getActivePods(&oldRCs[i])) > 0 {
+			// Wait for the old pods to terminate; their deletion requeues the deployment.
+			return nil
+		}
+	}
+	if newRC.Spec.Replicas != deployment.Spec.Replicas {
+		return dc.scaleController(deployment, newRC, deployment.Spec.Replicas)
+	}
+	return nil
+}
+
+// syncRollingUpdate scales the new controller up and the old controllers down,
+// keeping the total number of pods below the desired count plus maxSurge, and
+// the number of ready pods above the desired count minus maxUnavailable.
+func (dc *DeploymentController) syncRollingUpdate(deployment *api.Deployment, newRC *api.ReplicationController, oldRCs []api.ReplicationController) error {
+	if deployment.Spec.Strategy.RollingUpdate == nil {
+		return fmt.Errorf("deployment %s/%s has no rolling update parameters", deployment.Namespace, deployment.Name)
+	}
+	maxSurge, maxUnavailable, err := resolveFenceposts(deployment.Spec.Strategy.RollingUpdate, deployment.Spec.Replicas)
+	if err != nil {
+		return err
+	}
+	replicas := deployment.Spec.Replicas
+
+	// Scale up the new controller as far as maxSurge allows.
+	total := newRC.Spec.Replicas
+	for i := range oldRCs {
+		total += oldRCs[i].Spec.Replicas
+	}
+	if newRC.Spec.Replicas > replicas {
+		if err := dc.scaleController(deployment, newRC, replicas); err != nil {
+			return err
+		}
+	} else if scaleUp := minInt(replicas+maxSurge-total, replicas-newRC.Spec.Replicas); scaleUp > 0 {
+		if err := dc.scaleController(deployment, newRC, newRC.Spec.Replicas+scaleUp); err != nil {
+			return err
+		}
+	}
+
+	// Pods of old controllers that are not ready add nothing to availability,
+	// so they can always be removed.
+	sort.Sort(byRevision(oldRCs))
+	for i := range oldRCs {
+		rc := &oldRCs[i]
+		unready := rc.Spec.Replicas - countReady(dc.getActivePods(rc))
+		if rc.Spec.Replicas == 0 || unready <= 0 {
+			continue
+		}
+		if err := dc.scaleController(deployment, rc, rc.Spec.Replicas-unready); err != nil {
+			return err
+		}
+	}
+
+	// Scale down the old controllers, oldest first, as far as maxUnavailable allows.
+	ready := countReady(dc.getActivePods(newRC))
+	for i := range oldRCs {
+		ready += countReady(dc.getActivePods(&oldRCs[i]))
+	}
+	excess := ready - (replicas - maxUnavailable)
+	for i := range oldRCs {
+		if excess <= 0 {
+			break
+		}
+		rc := &oldRCs[i]
+		scaleDown := minInt(rc.Spec.Replicas, excess)
+		if scaleDown <= 0 {
+			continue
+		}
+		if err := dc.scaleController(deployment, rc, rc.Spec.Replicas-scaleDown); err != nil {
+			return err
+		}
+		excess -= scaleDown
+	}
+	return nil
+}
+
+// resolveFenceposts returns the absolute maxSurge and maxUnavailable for the
+// given number of replicas. Percentages of maxSurge are rounded up, and those
+// of maxUnavailable down.
+func resolveFenceposts(rollingUpdate *api.RollingUpdateDeployment, replicas int) (int, int, error) {
+	maxSurge, err := resolveIntOrPercent(rollingUpdate.MaxSurge, replicas, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	maxUnavailable, err := resolveIntOrPercent(rollingUpdate.MaxUnavailable, replicas, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	if maxSurge == 0 && maxUnavailable == 0 {
+		// Validation rejects both being zero, but small percentages can still
+		// round down to zero; the rollout could not make progress then.
+		maxUnavailable = 1
+	}
+	return maxSurge, maxUnavailable, nil
+}
+
+func resolveIntOrPercent(value util.IntOrString, total int, roundUp bool) (int, error) {
+	v, isPercent, err := util.GetIntOrPercentValue(value)
+	if err != nil || !isPercent {
+		return v, err
+	}
+	if roundUp {
+		return (v*total + 99) / 100, nil
+	}
+	return v * total / 100, nil
+}
+
+// cleanupOldControllers deletes the oldest scaled down controllers of the
+// deployment beyond its revision history limit.
+func (dc *DeploymentController) cleanupOldControllers(deployment *api.Deployment, oldRCs []api.ReplicationController) error {
+	if deployment.Spec.RevisionHistoryLimit == nil {
+		return nil
+	}
+	diff := len(oldRCs) - *deployment.Spec.RevisionHistoryLimit
+	if diff <= 0 {
+		return nil
+	}
+	sort.Sort(byRevision(oldRCs))
+	for i := 0; i < len(oldRCs) && diff > 0; i++ {
+		rc := &oldRCs[i]
+		// Only controllers that have finished scaling down are deleted.
+		if rc.Spec.Replicas != 0 || rc.Status.Replicas != 0 {
+			continue
+		}
+		if err := dc.kubeClient.ReplicationControllers(rc.Namespace).Delete(rc.Name); err != nil {
+			return err
+		}
+		glog.V(2).Infof("Deleted replication controller %s/%s of deployment %s (revision %d)", rc.Namespace, rc.Name, deployment.Name, getRevision(rc))
+		diff--
+	}
+	return nil
+}
+
+func (dc *DeploymentController) scaleController(deployment *api.Deployment, rc *api.ReplicationController, replicas int) error {
+	previous := rc.Spec.Replicas
+	rc.Spec.Replicas = replicas
+	updated, err := dc.kubeClient.ReplicationControllers(rc.Namespace).Update(rc)
+	if err != nil {
+		rc.Spec.Replicas = previous
+		return err
+	}
+	*rc = *updated
+	dc.recorder.Eventf(deployment, "scalingReplicationController", "Scaled replication controller %s from %d to %d", rc.Name, previous, replicas)
+	return nil
+}
+
+// updateStatus records the observed state of the deployment's controllers in its status.
+func (dc *DeploymentController) updateStatus(deployment *api.Deployment, newRC *api.ReplicationController, oldRCs []api.ReplicationController) error {
+	status := api.DeploymentStatus{
+		Replicas:           newRC.Status.Replicas,
+		UpdatedReplicas:    newRC.Status.Replicas,
+		Revision:           getRevision(newRC),
+		ObservedGeneration: deployment.Generation,
+	}
+	for i := range oldRCs {
+		status.Replicas += oldRCs[i].Status.Replicas
+	}
+	if reflect.DeepEqual(deployment.Status, status) {
+		return nil
+	}
+	deployment.Status = status
+	_, err := dc.kubeClient.Deployments(deployment.Namespace).Update(deployment)
+	return err
+}
+
+// getActivePods returns the pods of the given controller that have not terminated.
+func (dc *DeploymentController) getActivePods(rc *api.ReplicationController) []*api.Pod {
+	podList, err := dc.podStore.Pods(rc.Namespace).List(labels.Set(rc.Spec.Selector).AsSelector())
+	if err != nil {
+		glog.Errorf("Error getting pods for rc %s/%s: %v", rc.Namespace, rc.Name, err)
+		return nil
+	}
+	var active []*api.Pod
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase != api.PodSucceeded && pod.Status.Phase != api.PodFailed {
+			active = append(active, pod)
+		}
+	}
+	return active
+}
+
+func countReady(pods []*api.Pod) int {
+	ready := 0
+	for _, pod := range pods {
+		if api.IsPodReady(pod) {
+			ready++
+		}
+	}
+	return ready
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// byRevision sorts controllers by their revision, oldest first.
+type byRevision []api.ReplicationController
+
+func (o byRevision) Len() int      { return len(o) }
+func (o byRevision) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+
+func (o byRevision) Less(i, j int) bool {
+	return getRevision(&o[i]) < getRevision(&o[j])
+}
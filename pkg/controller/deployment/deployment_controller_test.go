@@ -0,0 +1,406 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// newFakeClient returns a fake client that echoes back the objects it is sent.
+func newFakeClient() *testclient.Fake {
+	return &testclient.Fake{
+		ReactFn: func(action testclient.FakeAction) (runtime.Object, error) {
+			if obj, ok := action.Value.(runtime.Object); ok {
+				return obj, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+func newTestController(client *testclient.Fake) *DeploymentController {
+	dc := NewDeploymentController(client)
+	dc.recorder = &record.FakeRecorder{}
+	dc.storesSynced = func() bool { return true }
+	return dc
+}
+
+func newTemplate(image string) *api.PodTemplateSpec {
+	return &api.PodTemplateSpec{
+		ObjectMeta: api.ObjectMeta{
+			Labels: map[string]string{"app": "foo"},
+		},
+		Spec: api.PodSpec{
+			Containers:    []api.Container{{Name: "foo", Image: image}},
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+		},
+	}
+}
+
+func newDeployment(replicas int, image string) *api.Deployment {
+	limit := 2
+	return &api.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
+		Spec: api.DeploymentSpec{
+			Replicas: replicas,
+			Selector: map[string]string{"app": "foo"},
+			Template: newTemplate(image),
+			Strategy: api.DeploymentStrategy{
+				Type: api.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &api.RollingUpdateDeployment{
+					MaxUnavailable: util.NewIntOrStringFromInt(1),
+					MaxSurge:       util.NewIntOrStringFromInt(1),
+				},
+			},
+			RevisionHistoryLimit: &limit,
+		},
+	}
+}
+
+// newController returns a replication controller as the deployment controller
+// would have created it for a template running the given image.
+func newController(image, revision string, replicas int) *api.ReplicationController {
+	template := newTemplate(image)
+	hash := podTemplateHash(template)
+	template.Labels = addLabel(template.Labels, PodTemplateHashLabelKey, hash)
+	return &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{
+			Name:        "foo-" + hash,
+			Namespace:   api.NamespaceDefault,
+			Annotations: map[string]string{RevisionAnnotation: revision},
+		},
+		Spec: api.ReplicationControllerSpec{
+			Replicas: replicas,
+			Selector: addLabel(map[string]string{"app": "foo"}, PodTemplateHashLabelKey, hash),
+			Template: template,
+		},
+		Status: api.ReplicationControllerStatus{Replicas: replicas},
+	}
+}
+
+// addPods adds count pods of the given controller to the pod store.
+func addPods(dc *DeploymentController, rc *api.ReplicationController, count int, ready bool) {
+	status := api.ConditionFalse
+	if ready {
+		status = api.ConditionTrue
+	}
+	for i := 0; i < count; i++ {
+		dc.podStore.Store.Add(&api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				Name:      rc.Name + "-" + string('a'+rune(len(dc.podStore.Store.List()))),
+				Namespace: rc.Namespace,
+				Labels:    rc.Spec.Template.Labels,
+			},
+			Status: api.PodStatus{
+				Phase:      api.PodRunning,
+				Conditions: []api.PodCondition{{Type: api.PodReady, Status: status}},
+			},
+		})
+	}
+}
+
+// sync runs a single sync of the given deployment.
+func sync(t *testing.T, dc *DeploymentController, deployment *api.Deployment) {
+	dc.dStore.Store.Add(deployment)
+	key, err := keyFunc(deployment)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := dc.syncHandler(key); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// actionValues returns the values of all recorded actions of the given kind.
+func actionValues(client *testclient.Fake, action string) []interface{} {
+	var values []interface{}
+	for _, a := range client.Actions {
+		if a.Action == action {
+			values = append(values, a.Value)
+		}
+	}
+	return values
+}
+
+// scaledReplicas returns the replica count each controller was last scaled to.
+func scaledReplicas(client *testclient.Fake) map[string]int {
+	scaled := map[string]int{}
+	for _, v := range actionValues(client, testclient.UpdateControllerAction) {
+		rc := v.(*api.ReplicationController)
+		scaled[rc.Name] = rc.Spec.Replicas
+	}
+	return scaled
+}
+
+func TestResolveFenceposts(t *testing.T) {
+	tests := []struct {
+		maxSurge, maxUnavailable util.IntOrString
+		replicas                 int
+		expectSurge              int
+		expectUnavailable        int
+	}{
+		{util.NewIntOrStringFromInt(2), util.NewIntOrStringFromInt(1), 10, 2, 1},
+		{util.NewIntOrStringFromString("25%"), util.NewIntOrStringFromString("25%"), 10, 3, 2},
+		{util.NewIntOrStringFromString("0%"), util.NewIntOrStringFromString("10%"), 5, 0, 1},
+		{util.NewIntOrStringFromString("100%"), util.NewIntOrStringFromInt(0), 3, 3, 0},
+	}
+	for i, test := range tests {
+		surge, unavailable, err := resolveFenceposts(&api.RollingUpdateDeployment{MaxSurge: test.maxSurge, MaxUnavailable: test.maxUnavailable}, test.replicas)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if surge != test.expectSurge || unavailable != test.expectUnavailable {
+			t.Errorf("%d: expected surge %d and unavailable %d, got %d and %d", i, test.expectSurge, test.expectUnavailable, surge, unavailable)
+		}
+	}
+}
+
+func TestSyncDeploymentCreatesController(t *testing.T) {
+	client := newFakeClient()
+	dc := newTestController(client)
+	deployment := newDeployment(3, "foo:v1")
+	sync(t, dc, deployment)
+
+	created := actionValues(client, testclient.CreateControllerAction)
+	if len(created) != 1 {
+		t.Fatalf("expected one controller to be created, got %d", len(created))
+	}
+	rc := created[0].(*api.ReplicationController)
+	hash := podTemplateHash(deployment.Spec.Template)
+	if rc.Name != "foo-"+hash {
+		t.Errorf("unexpected controller name %q", rc.Name)
+	}
+	if rc.Spec.Selector[PodTemplateHashLabelKey] != hash || rc.Spec.Template.Labels[PodTemplateHashLabelKey] != hash {
+		t.Errorf("expected selector and template to carry the template hash, got %#v", rc.Spec)
+	}
+	if _, ok := deployment.Spec.Template.Labels[PodTemplateHashLabelKey]; ok {
+		t.Errorf("the deployment's own template should not be modified")
+	}
+	if rc.Annotations[RevisionAnnotation] != "1" {
+		t.Errorf("expected revision 1, got %q", rc.Annotations[RevisionAnnotation])
+	}
+	if scaled := scaledReplicas(client); scaled[rc.Name] != 3 {
+		t.Errorf("expected the new controller to be scaled to 3, got %v", scaled)
+	}
+	updated := actionValues(client, testclient.UpdateDeploymentAction)
+	if len(updated) != 1 || updated[0].(*api.Deployment).Status.Revision != 1 {
+		t.Errorf("expected the deployment status to record revision 1, got %#v", updated)
+	}
+}
+
+func TestSyncRollingUpdate(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxSurge       util.IntOrString
+		maxUnavailable util.IntOrString
+		// the old controller runs 3 replicas; this many of its pods are ready
+		oldReady int
+		// the new controller already exists with this many ready replicas
+		newReplicas   int
+		expectNew     int
+		expectOld     int
+		expectOldSeen bool
+	}{
+		{
+			name:     "surge only",
+			maxSurge: util.NewIntOrStringFromInt(1), maxUnavailable: util.NewIntOrStringFromInt(0),
+			oldReady: 3, expectNew: 1, expectOld: 3,
+		},
+		{
+			name:     "unavailable only",
+			maxSurge: util.NewIntOrStringFromInt(0), maxUnavailable: util.NewIntOrStringFromInt(1),
+			oldReady: 3, expectNew: 0, expectOld: 2, expectOldSeen: true,
+		},
+		{
+			name:     "new pods ready",
+			maxSurge: util.NewIntOrStringFromInt(1), maxUnavailable: util.NewIntOrStringFromInt(0),
+			oldReady: 3, newReplicas: 1, expectNew: 1, expectOld: 2, expectOldSeen: true,
+		},
+		{
+			name:     "unready old pods are removed",
+			maxSurge: util.NewIntOrStringFromInt(1), maxUnavailable: util.NewIntOrStringFromInt(0),
+			oldReady: 1, expectNew: 1, expectOld: 1, expectOldSeen: true,
+		},
+	}
+	for _, test := range tests {
+		client := newFakeClient()
+		dc := newTestController(client)
+		deployment := newDeployment(3, "foo:v2")
+		deployment.Spec.Strategy.RollingUpdate.MaxSurge = test.maxSurge
+		deployment.Spec.Strategy.RollingUpdate.MaxUnavailable = test.maxUnavailable
+
+		oldRC := newController("foo:v1", "1", 3)
+		dc.rcStore.Store.Add(oldRC)
+		addPods(dc, oldRC, test.oldReady, true)
+		addPods(dc, oldRC, 3-test.oldReady, false)
+		newRC := newController("foo:v2", "2", test.newReplicas)
+		if test.newReplicas > 0 {
+			dc.rcStore.Store.Add(newRC)
+			addPods(dc, newRC, test.newReplicas, true)
+		}
+
+		sync(t, dc, deployment)
+
+		scaled := scaledReplicas(client)
+		newCount := test.newReplicas
+		if n, ok := scaled[newRC.Name]; ok {
+			newCount = n
+		}
+		if newCount != test.expectNew {
+			t.Errorf("%s: expected new controller at %d replicas, got %d", test.name, test.expectNew, newCount)
+		}
+		oldCount, ok := scaled[oldRC.Name]
+		if ok != test.expectOldSeen {
+			t.Errorf("%s: expected old controller scaled: %v, got %v", test.name, test.expectOldSeen, scaled)
+		}
+		if ok && oldCount != test.expectOld {
+			t.Errorf("%s: expected old controller at %d replicas, got %d", test.name, test.expectOld, oldCount)
+		}
+	}
+}
+
+func TestSyncRecreate(t *testing.T) {
+	client := newFakeClient()
+	dc := newTestController(client)
+	deployment := newDeployment(3, "foo:v2")
+	deployment.Spec.Strategy = api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType}
+	oldRC := newController("foo:v1", "1", 2)
+	dc.rcStore.Store.Add(oldRC)
+	addPods(dc, oldRC, 2, true)
+
+	sync(t, dc, deployment)
+	scaled := scaledReplicas(client)
+	newName := "foo-" + podTemplateHash(deployment.Spec.Template)
+	if scaled[oldRC.Name] != 0 {
+		t.Errorf("expected the old controller to be scaled to 0, got %v", scaled)
+	}
+	if _, ok := scaled[newName]; ok {
+		t.Errorf("expected the new controller not to be scaled while old pods remain, got %v", scaled)
+	}
+
+	// Once the old pods are gone the new controller is scaled up.
+	client = newFakeClient()
+	dc = newTestController(client)
+	oldRC.Spec.Replicas = 0
+	dc.rcStore.Store.Add(oldRC)
+	dc.rcStore.Store.Add(newController("foo:v2", "2", 0))
+	sync(t, dc, deployment)
+	if scaled := scaledReplicas(client); scaled[newName] != 3 {
+		t.Errorf("expected the new controller to be scaled to 3, got %v", scaled)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	tests := []struct {
+		revision    int64
+		expectImage string
+	}{
+		// 0 rolls back to the previous revision
+		{0, "foo:v2"},
+		{1, "foo:v1"},
+		// unknown revisions leave the template alone
+		{5, "foo:v3"},
+	}
+	for _, test := range tests {
+		client := newFakeClient()
+		dc := newTestController(client)
+		deployment := newDeployment(3, "foo:v3")
+		deployment.Spec.RollbackTo = &api.RollbackConfig{Revision: test.revision}
+		dc.rcStore.Store.Add(newController("foo:v1", "1", 0))
+		dc.rcStore.Store.Add(newController("foo:v2", "2", 0))
+		dc.rcStore.Store.Add(newController("foo:v3", "3", 3))
+
+		sync(t, dc, deployment)
+
+		updated := actionValues(client, testclient.UpdateDeploymentAction)
+		if len(updated) != 1 {
+			t.Errorf("revision %d: expected one deployment update, got %d", test.revision, len(updated))
+			continue
+		}
+		d := updated[0].(*api.Deployment)
+		if d.Spec.RollbackTo != nil {
+			t.Errorf("revision %d: expected rollbackTo to be cleared", test.revision)
+		}
+		if image := d.Spec.Template.Spec.Containers[0].Image; image != test.expectImage {
+			t.Errorf("revision %d: expected image %s, got %s", test.revision, test.expectImage, image)
+		}
+		if _, ok := d.Spec.Template.Labels[PodTemplateHashLabelKey]; ok {
+			t.Errorf("revision %d: the template hash label should not be copied into the deployment", test.revision)
+		}
+		if scaled := scaledReplicas(client); len(scaled) != 0 {
+			t.Errorf("revision %d: expected no scaling during rollback, got %v", test.revision, scaled)
+		}
+	}
+}
+
+func TestRevisionBumpedWhenTemplateReused(t *testing.T) {
+	client := newFakeClient()
+	dc := newTestController(client)
+	deployment := newDeployment(3, "foo:v1")
+	dc.rcStore.Store.Add(newController("foo:v1", "1", 0))
+	dc.rcStore.Store.Add(newController("foo:v2", "2", 3))
+
+	sync(t, dc, deployment)
+
+	updates := actionValues(client, testclient.UpdateControllerAction)
+	if len(updates) == 0 {
+		t.Fatalf("expected controller updates")
+	}
+	rc := updates[0].(*api.ReplicationController)
+	if rc.Name != "foo-"+podTemplateHash(deployment.Spec.Template) || rc.Annotations[RevisionAnnotation] != "3" {
+		t.Errorf("expected the reused controller to become revision 3, got %s at %q", rc.Name, rc.Annotations[RevisionAnnotation])
+	}
+}
+
+func TestCleanupOldControllers(t *testing.T) {
+	client := newFakeClient()
+	dc := newTestController(client)
+	deployment := newDeployment(3, "foo:v5")
+	limit := 1
+	deployment.Spec.RevisionHistoryLimit = &limit
+	v1 := newController("foo:v1", "1", 0)
+	v2 := newController("foo:v2", "2", 0)
+	// still scaling down, so it is kept
+	v3 := newController("foo:v3", "3", 0)
+	v3.Status.Replicas = 1
+	v4 := newController("foo:v4", "4", 0)
+	current := newController("foo:v5", "5", 3)
+	for _, rc := range []*api.ReplicationController{v1, v2, v3, v4, current} {
+		dc.rcStore.Store.Add(rc)
+	}
+
+	sync(t, dc, deployment)
+
+	deleted := actionValues(client, testclient.DeleteControllerAction)
+	expected := []interface{}{v1.Name, v2.Name, v4.Name}
+	if len(deleted) != len(expected) {
+		t.Fatalf("expected %v to be deleted, got %v", expected, deleted)
+	}
+	for i := range expected {
+		if deleted[i] != expected[i] {
+			t.Errorf("expected %v to be deleted, got %v", expected, deleted)
+		}
+	}
+}
@@ -0,0 +1,640 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/framework"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/workqueue"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/golang/glog"
+)
+
+const (
+	// Jobs are periodically resynced, which is also how an active deadline
+	// that passes without any pod event is noticed.
+	FullJobResyncPeriod = 30 * time.Second
+
+	// If a watch misdelivers info about a pod, it'll take at least this long
+	// to rectify the number of job pods.
+	PodRelistPeriod = 5 * time.Minute
+
+	// The number of pods a job creates or deletes in a single sync before
+	// waiting to observe them.
+	BurstReplicas = 500
+
+	// We must avoid counting pods until the pod store has synced. If it
+	// hasn't synced, to avoid a hot loop, we'll wait this long between checks.
+	PodStoreSyncedPollPeriod = 100 * time.Millisecond
+
+	// updateRetries is the number of extra attempts made to update the
+	// status of a job.
+	updateRetries = 1
+)
+
+var keyFunc = framework.DeletionHandlingMetaNamespaceKeyFunc
+
+// JobController is responsible for synchronizing Job objects stored in the
+// system with the pods that run them.
+type JobController struct {
+	kubeClient client.Interface
+	podControl podControlInterface
+
+	// A job is temporarily suspended after creating/deleting these many pods.
+	// It resumes normal action after observing the watch events for them.
+	burstReplicas int
+
+	// To allow injection of syncJob for testing.
+	syncHandler func(jobKey string) error
+	// podStoreSynced returns true if the pod store has been synced at least once.
+	// Added as a member to the struct to allow injection for testing.
+	podStoreSynced func() bool
+	// now returns the current time. Added as a member to the struct to allow
+	// injection for testing.
+	now func() util.Time
+
+	// A TTLCache of pod creates/deletes each job expects to see
+	expectations *controller.ControllerExpectations
+	// A store of jobs, populated by the jobController
+	jobStore cache.StoreToJobLister
+	// A store of pods, populated by the podController
+	podStore cache.StoreToPodLister
+	// Watches changes to all jobs
+	jobController *framework.Controller
+	// Watches changes to all pods
+	podController *framework.Controller
+	// Jobs that need to be synced
+	queue *workqueue.Type
+}
+
+// NewJobController creates a new JobController.
+func NewJobController(kubeClient client.Interface) *JobController {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	eventBroadcaster.StartRecordingToSink(kubeClient.Events(""))
+
+	jm := &JobController{
+		kubeClient: kubeClient,
+		podControl: realPodControl{
+			kubeClient: kubeClient,
+			recorder:   eventBroadcaster.NewRecorder(api.EventSource{Component: "job-controller"}),
+		},
+		burstReplicas: BurstReplicas,
+		now:           util.Now,
+		expectations:  controller.NewControllerExpectations(),
+		queue:         workqueue.New(),
+	}
+
+	jm.jobStore.Store, jm.jobController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return jm.kubeClient.Jobs(api.NamespaceAll).List(labels.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return jm.kubeClient.Jobs(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Job{},
+		FullJobResyncPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: jm.enqueueJob,
+			UpdateFunc: func(old, cur interface{}) {
+				jm.enqueueJob(cur)
+			},
+			DeleteFunc: jm.enqueueJob,
+		},
+	)
+
+	jm.podStore.Store, jm.podController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return jm.kubeClient.Pods(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return jm.kubeClient.Pods(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Pod{},
+		PodRelistPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc:    jm.addPod,
+			UpdateFunc: jm.updatePod,
+			DeleteFunc: jm.deletePod,
+		},
+	)
+
+	jm.syncHandler = jm.syncJob
+	jm.podStoreSynced = jm.podController.HasSynced
+	return jm
+}
+
+// Run begins watching and syncing jobs.
+func (jm *JobController) Run(workers int, stopCh <-chan struct{}) {
+	defer util.HandleCrash()
+	go jm.jobController.Run(stopCh)
+	go jm.podController.Run(stopCh)
+	for i := 0; i < workers; i++ {
+		go util.Until(jm.worker, time.Second, stopCh)
+	}
+	<-stopCh
+	glog.Infof("Shutting down job controller")
+	jm.queue.ShutDown()
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and marks them done.
+// It enforces that the syncHandler is never invoked concurrently with the same key.
+func (jm *JobController) worker() {
+	for {
+		func() {
+			key, quit := jm.queue.Get()
+			if quit {
+				return
+			}
+			defer jm.queue.Done(key)
+			if err := jm.syncHandler(key.(string)); err != nil {
+				glog.Errorf("Error syncing job %v: %v", key, err)
+			}
+		}()
+	}
+}
+
+// obj could be an *api.Job, or a DeletionFinalStateUnknown marker item.
+func (jm *JobController) enqueueJob(obj interface{}) {
+	key, err := keyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	jm.queue.Add(key)
+}
+
+// getPodJob returns the job managing the given pod.
+// TODO: Surface that we are ignoring multiple jobs for a single pod.
+func (jm *JobController) getPodJob(pod *api.Pod) *api.Job {
+	jobs, err := jm.jobStore.GetPodJobs(pod)
+	if err != nil {
+		glog.V(4).Infof("No jobs found for pod %v, job controller will avoid syncing", pod.Name)
+		return nil
+	}
+	sort.Sort(byCreationTimestamp(jobs))
+	return &jobs[0]
+}
+
+// When a pod is created, enqueue the job that manages it and update its expectations.
+func (jm *JobController) addPod(obj interface{}) {
+	pod := obj.(*api.Pod)
+	if job := jm.getPodJob(pod); job != nil {
+		jobKey, err := keyFunc(job)
+		if err != nil {
+			glog.Errorf("Couldn't get key for object %+v: %v", job, err)
+			return
+		}
+		jm.expectations.CreationObserved(jobKey)
+		jm.enqueueJob(job)
+	}
+}
+
+// When a pod is updated, figure out what jobs manage it and wake them up.
+// Most importantly this notices pods that have terminated. If the labels of
+// the pod have changed we need to awaken both the old and new job. old and
+// cur must be *api.Pod types.
+func (jm *JobController) updatePod(old, cur interface{}) {
+	if api.Semantic.DeepEqual(old, cur) {
+		// A periodic relist will send update events for all known pods.
+		return
+	}
+	curPod := cur.(*api.Pod)
+	if job := jm.getPodJob(curPod); job != nil {
+		jm.enqueueJob(job)
+	}
+	oldPod := old.(*api.Pod)
+	if !reflect.DeepEqual(curPod.Labels, oldPod.Labels) {
+		if oldJob := jm.getPodJob(oldPod); oldJob != nil {
+			jm.enqueueJob(oldJob)
+		}
+	}
+}
+
+// When a pod is deleted, enqueue the job that manages the pod and update its expectations.
+// obj could be an *api.Pod, or a DeletionFinalStateUnknown marker item.
+func (jm *JobController) deletePod(obj interface{}) {
+	pod, ok := obj.(*api.Pod)
+	// When a delete is dropped, the relist will notice a pod in the store not
+	// in the list, leading to the insertion of a tombstone object which contains
+	// the deleted key/value. Note that this value might be stale.
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			glog.Errorf("Couldn't get object from tombstone %+v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*api.Pod)
+		if !ok {
+			glog.Errorf("Tombstone contained object that is not a pod %+v", obj)
+			return
+		}
+	}
+	if job := jm.getPodJob(pod); job != nil {
+		jobKey, err := keyFunc(job)
+		if err != nil {
+			glog.Errorf("Couldn't get key for object %+v: %v", job, err)
+			return
+		}
+		jm.expectations.DeletionObserved(jobKey)
+		jm.enqueueJob(job)
+	}
+}
+
+// syncJob will sync the job with the given key if it has had its expectations
+// fulfilled, meaning it did not expect to see any more of its pods created or
+// deleted. This function is not meant to be invoked concurrently with the same key.
+func (jm *JobController) syncJob(key string) error {
+	startTime := time.Now()
+	defer func() {
+		glog.V(4).Infof("Finished syncing job %q (%v)", key, time.Now().Sub(startTime))
+	}()
+
+	obj, exists, err := jm.jobStore.Store.GetByKey(key)
+	if err != nil {
+		glog.Infof("Unable to retrieve job %v from store: %v", key, err)
+		jm.queue.Add(key)
+		return err
+	}
+	if !exists {
+		glog.V(3).Infof("Job has been deleted %v", key)
+		jm.expectations.DeleteExpectations(key)
+		return nil
+	}
+	job := *obj.(*api.Job)
+	if !jm.podStoreSynced() {
+		// Sleep so we give the pod reflector goroutine a chance to run.
+		time.Sleep(PodStoreSyncedPollPeriod)
+		glog.Infof("Waiting for pods controller to sync, requeuing job %v", key)
+		jm.enqueueJob(&job)
+		return nil
+	}
+
+	// Check the expectations of the job before counting its pods, otherwise a new pod
+	// can sneak in and update the expectations after we've retrieved the pods from the store.
+	jobNeedsSync := jm.expectations.SatisfiedExpectations(key)
+	podList, err := jm.podStore.Pods(job.Namespace).List(labels.Set(job.Spec.Selector).AsSelector())
+	if err != nil {
+		glog.Errorf("Error getting pods for job %q: %v", key, err)
+		jm.queue.Add(key)
+		return err
+	}
+
+	activePods := filterActivePods(podList.Items)
+	active := len(activePods)
+	succeeded, failed := getStatus(podList.Items)
+	status := job.Status
+	status.Conditions = append([]api.JobCondition(nil), job.Status.Conditions...)
+	now := jm.now()
+	if status.StartTime == nil {
+		status.StartTime = &now
+	}
+
+	switch {
+	case isJobFinished(&job):
+		// A finished job runs no more pods, only its counts are kept up to date.
+		if jobNeedsSync {
+			jm.deleteJobPods(&job, key, activePods)
+		}
+		active = 0
+	case pastActiveDeadline(&job, now):
+		// Kill every remaining pod, the job is not allowed to run any longer.
+		if jobNeedsSync {
+			jm.deleteJobPods(&job, key, activePods)
+		}
+		active = 0
+		status.Conditions = append(status.Conditions, newCondition(api.JobFailed, "DeadlineExceeded", "Job was active longer than specified deadline", now))
+		jm.podControl.recordEvent(&job, "deadlineExceeded", "Job was active longer than specified deadline")
+	default:
+		if jobNeedsSync {
+			active = jm.manageJob(&job, key, activePods, succeeded)
+		}
+		if job.Spec.Completions != nil && succeeded >= *job.Spec.Completions {
+			status.Conditions = append(status.Conditions, newCondition(api.JobComplete, "", "", now))
+			status.CompletionTime = &now
+			jm.podControl.recordEvent(&job, "completed", fmt.Sprintf("Job completed after %d successful pods", succeeded))
+		}
+	}
+
+	status.Active = active
+	status.Succeeded = succeeded
+	status.Failed = failed
+	if api.Semantic.DeepEqual(job.Status, status) {
+		return nil
+	}
+	if err := jm.updateJobStatus(&job, status); err != nil {
+		glog.V(2).Infof("Failed to update status for job %v, requeuing: %v", key, err)
+		jm.enqueueJob(&job)
+	}
+	return nil
+}
+
+// manageJob creates or deletes pods so that the number of active pods matches
+// the job's parallelism, without running more pods than are still needed to
+// reach its completions. It returns the number of pods that will be active
+// once the creations and deletions it started are observed.
+func (jm *JobController) manageJob(job *api.Job, jobKey string, activePods []*api.Pod, succeeded int) int {
+	active := len(activePods)
+	wantActive := 0
+	if job.Spec.Parallelism != nil {
+		wantActive = *job.Spec.Parallelism
+	}
+	if job.Spec.Completions != nil {
+		if remaining := *job.Spec.Completions - succeeded; remaining < wantActive {
+			wantActive = remaining
+		}
+		if wantActive < 0 {
+			wantActive = 0
+		}
+	}
+
+	switch {
+	case active > wantActive:
+		diff := active - wantActive
+		if diff > jm.burstReplicas {
+			diff = jm.burstReplicas
+		}
+		// Delete the pods that have made the least progress first.
+		sort.Sort(activePodsByProgress(activePods))
+		jm.deleteJobPods(job, jobKey, activePods[:diff])
+		return active - diff
+	case active < wantActive:
+		diff := wantActive - active
+		if diff > jm.burstReplicas {
+			diff = jm.burstReplicas
+		}
+		if err := jm.expectations.ExpectCreations(jobKey, diff); err != nil {
+			util.HandleError(err)
+			return active
+		}
+		glog.V(2).Infof("Too few pods running job %q, need %d, creating %d", jobKey, wantActive, diff)
+		wait := sync.WaitGroup{}
+		wait.Add(diff)
+		for i := 0; i < diff; i++ {
+			go func() {
+				defer wait.Done()
+				if err := jm.podControl.createPod(job); err != nil {
+					// Decrement the expected number of creates because the informer won't observe this pod
+					glog.V(2).Infof("Failed creation, decrementing expectations for job %q", jobKey)
+					jm.expectations.CreationObserved(jobKey)
+					util.HandleError(err)
+				}
+			}()
+		}
+		wait.Wait()
+		return active + diff
+	}
+	return active
+}
+
+// deleteJobPods deletes the given pods of the job, setting expectations so
+// the job isn't synced again until the deletions are observed.
+func (jm *JobController) deleteJobPods(job *api.Job, jobKey string, pods []*api.Pod) {
+	if len(pods) == 0 {
+		return
+	}
+	if err := jm.expectations.ExpectDeletions(jobKey, len(pods)); err != nil {
+		util.HandleError(err)
+		return
+	}
+	glog.V(2).Infof("Deleting %d pods of job %q", len(pods), jobKey)
+	wait := sync.WaitGroup{}
+	wait.Add(len(pods))
+	for _, pod := range pods {
+		go func(pod *api.Pod) {
+			defer wait.Done()
+			if err := jm.podControl.deletePod(pod.Namespace, pod.Name, job); err != nil {
+				// Decrement the expected number of deletes because the informer won't observe this deletion
+				glog.V(2).Infof("Failed deletion, decrementing expectations for job %q", jobKey)
+				jm.expectations.DeletionObserved(jobKey)
+				util.HandleError(err)
+			}
+		}(pod)
+	}
+	wait.Wait()
+}
+
+// updateJobStatus writes the given status to the job, refetching it once if
+// the first attempt fails.
+func (jm *JobController) updateJobStatus(job *api.Job, status api.JobStatus) error {
+	jobClient := jm.kubeClient.Jobs(job.Namespace)
+	var updateErr error
+	for i, toUpdate := 0, *job; ; i++ {
+		toUpdate.Status = status
+		if _, updateErr = jobClient.Update(&toUpdate); updateErr == nil || i >= updateRetries {
+			return updateErr
+		}
+		// Update the job with the latest resource version for the next attempt.
+		latestJob, getErr := jobClient.Get(job.Name)
+		if getErr != nil {
+			return getErr
+		}
+		toUpdate = *latestJob
+	}
+}
+
+// isJobFinished returns true if the job has a Complete or Failed condition.
+func isJobFinished(job *api.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if (c.Type == api.JobComplete || c.Type == api.JobFailed) && c.Status == api.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// pastActiveDeadline returns true if the job has been running for longer
+// than its ActiveDeadlineSeconds.
+func pastActiveDeadline(job *api.Job, now util.Time) bool {
+	if job.Spec.ActiveDeadlineSeconds == nil || job.Status.StartTime == nil {
+		return false
+	}
+	duration := now.Time.Sub(job.Status.StartTime.Time)
+	allowedDuration := time.Duration(*job.Spec.ActiveDeadlineSeconds) * time.Second
+	return duration >= allowedDuration
+}
+
+func newCondition(conditionType api.JobConditionType, reason, message string, now util.Time) api.JobCondition {
+	return api.JobCondition{
+		Type:               conditionType,
+		Status:             api.ConditionTrue,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// getStatus returns the number of succeeded and failed pods.
+func getStatus(pods []api.Pod) (succeeded, failed int) {
+	for i := range pods {
+		switch pods[i].Status.Phase {
+		case api.PodSucceeded:
+			succeeded++
+		case api.PodFailed:
+			failed++
+		}
+	}
+	return
+}
+
+// filterActivePods returns the pods that have neither terminated nor been
+// marked for deletion.
+func filterActivePods(pods []api.Pod) []*api.Pod {
+	var result []*api.Pod
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != api.PodSucceeded &&
+			pod.Status.Phase != api.PodFailed &&
+			pod.DeletionTimestamp == nil {
+			result = append(result, pod)
+		}
+	}
+	return result
+}
+
+// podControlInterface knows how to add or delete job pods, created as an
+// interface to allow testing.
+type podControlInterface interface {
+	// createPod creates a pod from the job's template.
+	createPod(job *api.Job) error
+	// deletePod deletes the named pod of the job.
+	deletePod(namespace, name string, job *api.Job) error
+	// recordEvent records an event about the job.
+	recordEvent(job *api.Job, reason, message string)
+}
+
+// realPodControl is the default implementation of podControlInterface.
+type realPodControl struct {
+	kubeClient client.Interface
+	recorder   record.EventRecorder
+}
+
+func (r realPodControl) createPod(job *api.Job) error {
+	desiredLabels := make(labels.Set)
+	for k, v := range job.Spec.Template.Labels {
+		desiredLabels[k] = v
+	}
+	desiredAnnotations := make(labels.Set)
+	for k, v := range job.Spec.Template.Annotations {
+		desiredAnnotations[k] = v
+	}
+
+	createdByRef, err := api.GetReference(job)
+	if err != nil {
+		return fmt.Errorf("unable to get job reference: %v", err)
+	}
+	createdByRefJson, err := latest.Codec.Encode(&api.SerializedReference{
+		Reference: *createdByRef,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to serialize job reference: %v", err)
+	}
+	desiredAnnotations[controller.CreatedByAnnotation] = string(createdByRefJson)
+
+	// use the dash (if the name isn't too long) to make the pod name a bit prettier
+	prefix := fmt.Sprintf("%s-", job.Name)
+	if ok, _ := validation.ValidatePodName(prefix, true); !ok {
+		prefix = job.Name
+	}
+
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Labels:       desiredLabels,
+			Annotations:  desiredAnnotations,
+			GenerateName: prefix,
+		},
+	}
+	if err := api.Scheme.Convert(&job.Spec.Template.Spec, &pod.Spec); err != nil {
+		return fmt.Errorf("unable to convert pod template: %v", err)
+	}
+	if labels.Set(pod.Labels).AsSelector().Empty() {
+		return fmt.Errorf("unable to create job pod, no labels")
+	}
+	newPod, err := r.kubeClient.Pods(job.Namespace).Create(pod)
+	if err != nil {
+		r.recorder.Eventf(job, "failedCreate", "Error creating: %v", err)
+		return fmt.Errorf("unable to create job pod: %v", err)
+	}
+	glog.V(4).Infof("Job %v created pod %v", job.Name, newPod.Name)
+	r.recorder.Eventf(job, "successfulCreate", "Created pod: %v", newPod.Name)
+	return nil
+}
+
+func (r realPodControl) deletePod(namespace, name string, job *api.Job) error {
+	if err := r.kubeClient.Pods(namespace).Delete(name, nil); err != nil {
+		r.recorder.Eventf(job, "failedDelete", "Error deleting pod %v: %v", name, err)
+		return fmt.Errorf("unable to delete job pod: %v", err)
+	}
+	r.recorder.Eventf(job, "successfulDelete", "Deleted pod: %v", name)
+	return nil
+}
+
+func (r realPodControl) recordEvent(job *api.Job, reason, message string) {
+	r.recorder.Event(job, reason, message)
+}
+
+// byCreationTimestamp sorts a list of jobs by creation timestamp, using their names as a tie breaker.
+type byCreationTimestamp []api.Job
+
+func (o byCreationTimestamp) Len() int      { return len(o) }
+func (o byCreationTimestamp) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+
+func (o byCreationTimestamp) Less(i, j int) bool {
+	if o[i].CreationTimestamp.Equal(o[j].CreationTimestamp) {
+		return o[i].Name < o[j].Name
+	}
+	return o[i].CreationTimestamp.Before(o[j].CreationTimestamp)
+}
+
+// activePodsByProgress sorts active pods so that the ones that have made the
+// least progress come first: unscheduled < scheduled, pending < running, and
+// newer pods before older ones.
+type activePodsByProgress []*api.Pod
+
+func (s activePodsByProgress) Len() int      { return len(s) }
+func (s activePodsByProgress) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s activePodsByProgress) Less(i, j int) bool {
+	if (s[i].Spec.NodeName == "") != (s[j].Spec.NodeName == "") {
+		return s[i].Spec.NodeName == ""
+	}
+	if (s[i].Status.Phase == api.PodRunning) != (s[j].Status.Phase == api.PodRunning) {
+		return s[i].Status.Phase != api.PodRunning
+	}
+	return s[j].CreationTimestamp.Before(s[i].CreationTimestamp)
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+var simpleJobLabel = map[string]string{"name": "simple-job"}
+
+func init() {
+	api.ForTesting_ReferencesAllowBlankSelfLinks = true
+}
+
+type fakePodControl struct {
+	lock       sync.Mutex
+	creates    int
+	deletePods []string
+	events     []string
+	err        error
+}
+
+func (f *fakePodControl) createPod(job *api.Job) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.creates++
+	return nil
+}
+
+func (f *fakePodControl) deletePod(namespace, name string, job *api.Job) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.deletePods = append(f.deletePods, name)
+	return nil
+}
+
+func (f *fakePodControl) recordEvent(job *api.Job, reason, message string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.events = append(f.events, reason)
+}
+
+func (f *fakePodControl) clear() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.creates = 0
+	f.deletePods = nil
+	f.events = nil
+}
+
+// newFakeClient returns a fake client that echoes back the objects it is sent.
+func newFakeClient() *testclient.Fake {
+	return &testclient.Fake{
+		ReactFn: func(action testclient.FakeAction) (runtime.Object, error) {
+			if obj, ok := action.Value.(runtime.Object); ok {
+				return obj, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+func newTestController() (*JobController, *fakePodControl, *testclient.Fake) {
+	client := newFakeClient()
+	manager := NewJobController(client)
+	manager.podStoreSynced = func() bool { return true }
+	podControl := &fakePodControl{}
+	manager.podControl = podControl
+	return manager, podControl, client
+}
+
+func newJob(name string, parallelism, completions int) *api.Job {
+	return &api.Job{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec: api.JobSpec{
+			Parallelism: &parallelism,
+			Completions: &completions,
+			Selector:    simpleJobLabel,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: simpleJobLabel,
+				},
+				Spec: api.PodSpec{
+					Containers:    []api.Container{{Name: "foo", Image: "foo/bar"}},
+					RestartPolicy: api.RestartPolicyNever,
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+		},
+	}
+}
+
+// addPods adds count pods in the given phase to the pod store.
+func addPods(manager *JobController, prefix string, phase api.PodPhase, count int) {
+	for i := 0; i < count; i++ {
+		manager.podStore.Add(&api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%d", prefix, i),
+				Namespace: api.NamespaceDefault,
+				Labels:    simpleJobLabel,
+			},
+			Status: api.PodStatus{Phase: phase},
+		})
+	}
+}
+
+func syncAndValidate(t *testing.T, manager *JobController, job *api.Job, podControl *fakePodControl, expectedCreates, expectedDeletes int) {
+	key, err := keyFunc(job)
+	if err != nil {
+		t.Fatalf("unexpected error getting key for %v: %v", job.Name, err)
+	}
+	if err := manager.syncHandler(key); err != nil {
+		t.Fatalf("unexpected error syncing %v: %v", key, err)
+	}
+	if podControl.creates != expectedCreates {
+		t.Errorf("unexpected number of creates. Expected %d, saw %d", expectedCreates, podControl.creates)
+	}
+	if len(podControl.deletePods) != expectedDeletes {
+		t.Errorf("unexpected number of deletes. Expected %d, saw %d: %v", expectedDeletes, len(podControl.deletePods), podControl.deletePods)
+	}
+}
+
+// lastStatusUpdate returns the job sent in the last update, or nil.
+func lastStatusUpdate(client *testclient.Fake) *api.Job {
+	var updated *api.Job
+	for _, action := range client.Actions {
+		if action.Action == testclient.UpdateJobAction {
+			updated = action.Value.(*api.Job)
+		}
+	}
+	return updated
+}
+
+func TestControllerSyncJob(t *testing.T) {
+	testCases := map[string]struct {
+		parallelism int
+		completions int
+		// pods already in the store
+		pendingPods   int
+		activePods    int
+		succeededPods int
+		failedPods    int
+		// expectations
+		expectedCreations int
+		expectedDeletions int
+		expectedActive    int
+		expectedSucceeded int
+		expectedFailed    int
+		expectedComplete  bool
+	}{
+		"job start": {
+			2, 5,
+			0, 0, 0, 0,
+			2, 0, 2, 0, 0, false,
+		},
+		"correct number of pods": {
+			2, 5,
+			0, 2, 0, 0,
+			0, 0, 2, 0, 0, false,
+		},
+		"pending pods count as active": {
+			2, 5,
+			1, 1, 0, 0,
+			0, 0, 2, 0, 0, false,
+		},
+		"too few active pods": {
+			2, 5,
+			0, 1, 1, 0,
+			1, 0, 2, 1, 0, false,
+		},
+		"failed pods are replaced": {
+			2, 5,
+			0, 1, 0, 3,
+			1, 0, 2, 0, 3, false,
+		},
+		"too many active pods": {
+			2, 5,
+			0, 3, 0, 0,
+			0, 1, 2, 0, 0, false,
+		},
+		"no more pods than remaining completions": {
+			3, 5,
+			0, 1, 3, 0,
+			1, 0, 2, 3, 0, false,
+		},
+		"job finishes": {
+			2, 5,
+			0, 0, 5, 0,
+			0, 0, 0, 5, 0, true,
+		},
+		"extra pods are deleted once complete": {
+			2, 5,
+			0, 1, 5, 0,
+			0, 1, 0, 5, 0, true,
+		},
+		"zero parallelism": {
+			0, 5,
+			0, 0, 0, 0,
+			0, 0, 0, 0, 0, false,
+		},
+	}
+
+	for name, tc := range testCases {
+		manager, podControl, client := newTestController()
+		job := newJob("foo", tc.parallelism, tc.completions)
+		manager.jobStore.Add(job)
+		addPods(manager, "pending", api.PodPending, tc.pendingPods)
+		addPods(manager, "running", api.PodRunning, tc.activePods)
+		addPods(manager, "succeeded", api.PodSucceeded, tc.succeededPods)
+		addPods(manager, "failed", api.PodFailed, tc.failedPods)
+
+		key, _ := keyFunc(job)
+		if err := manager.syncJob(key); err != nil {
+			t.Errorf("%s: unexpected error syncing job: %v", name, err)
+			continue
+		}
+		if podControl.creates != tc.expectedCreations {
+			t.Errorf("%s: expected %d creations, got %d", name, tc.expectedCreations, podControl.creates)
+		}
+		if len(podControl.deletePods) != tc.expectedDeletions {
+			t.Errorf("%s: expected %d deletions, got %v", name, tc.expectedDeletions, podControl.deletePods)
+		}
+		updated := lastStatusUpdate(client)
+		if updated == nil {
+			t.Errorf("%s: expected a status update", name)
+			continue
+		}
+		status := updated.Status
+		if status.Active != tc.expectedActive || status.Succeeded != tc.expectedSucceeded || status.Failed != tc.expectedFailed {
+			t.Errorf("%s: expected active/succeeded/failed %d/%d/%d, got %d/%d/%d", name,
+				tc.expectedActive, tc.expectedSucceeded, tc.expectedFailed,
+				status.Active, status.Succeeded, status.Failed)
+		}
+		if status.StartTime == nil {
+			t.Errorf("%s: expected start time to be set", name)
+		}
+		complete := len(status.Conditions) == 1 && status.Conditions[0].Type == api.JobComplete
+		if complete != tc.expectedComplete {
+			t.Errorf("%s: expected complete %v, got conditions %+v", name, tc.expectedComplete, status.Conditions)
+		}
+		if complete && status.CompletionTime == nil {
+			t.Errorf("%s: expected completion time to be set", name)
+		}
+	}
+}
+
+// Pods of a job that ran past its deadline are killed and the job fails.
+func TestSyncJobPastDeadline(t *testing.T) {
+	manager, podControl, client := newTestController()
+	job := newJob("foo", 2, 5)
+	deadline := int64(10)
+	job.Spec.ActiveDeadlineSeconds = &deadline
+	start := util.NewTime(time.Now().Add(-time.Minute))
+	job.Status.StartTime = &start
+	manager.jobStore.Add(job)
+	addPods(manager, "running", api.PodRunning, 2)
+	addPods(manager, "succeeded", api.PodSucceeded, 1)
+
+	syncAndValidate(t, manager, job, podControl, 0, 2)
+	updated := lastStatusUpdate(client)
+	if updated == nil {
+		t.Fatalf("expected a status update")
+	}
+	if updated.Status.Active != 0 || updated.Status.Succeeded != 1 {
+		t.Errorf("unexpected status %+v", updated.Status)
+	}
+	conditions := updated.Status.Conditions
+	if len(conditions) != 1 || conditions[0].Type != api.JobFailed || conditions[0].Reason != "DeadlineExceeded" {
+		t.Errorf("expected a DeadlineExceeded failure, got %+v", conditions)
+	}
+	if len(podControl.events) != 1 || podControl.events[0] != "deadlineExceeded" {
+		t.Errorf("expected a deadlineExceeded event, got %v", podControl.events)
+	}
+}
+
+// A finished job neither creates pods nor records its completion again.
+func TestSyncFinishedJob(t *testing.T) {
+	manager, podControl, client := newTestController()
+	job := newJob("foo", 2, 5)
+	now := util.Now()
+	job.Status.StartTime = &now
+	job.Status.Succeeded = 5
+	job.Status.Conditions = append(job.Status.Conditions, newCondition(api.JobComplete, "", "", now))
+	manager.jobStore.Add(job)
+	addPods(manager, "succeeded", api.PodSucceeded, 5)
+
+	syncAndValidate(t, manager, job, podControl, 0, 0)
+	if updated := lastStatusUpdate(client); updated != nil {
+		t.Errorf("unexpected status update %+v", updated.Status)
+	}
+	if len(podControl.events) != 0 {
+		t.Errorf("unexpected events %v", podControl.events)
+	}
+}
+
+// A job doesn't act again until it has observed the pods it created.
+func TestExpectationsPreventDuplicateCreates(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	job := newJob("foo", 2, 5)
+	manager.jobStore.Add(job)
+	syncAndValidate(t, manager, job, podControl, 2, 0)
+
+	podControl.clear()
+	syncAndValidate(t, manager, job, podControl, 0, 0)
+
+	// Observing the creations lets the job sync again.
+	for i := 0; i < 2; i++ {
+		pod := &api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: fmt.Sprintf("pod-%d", i), Namespace: api.NamespaceDefault, Labels: simpleJobLabel},
+			Status:     api.PodStatus{Phase: api.PodSucceeded},
+		}
+		manager.podStore.Add(pod)
+		manager.addPod(pod)
+	}
+	syncAndValidate(t, manager, job, podControl, 2, 0)
+}
+
+// Failed creations lower the expectations so the next sync retries them.
+func TestFailedCreatesAreRetried(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	job := newJob("foo", 2, 5)
+	manager.jobStore.Add(job)
+	podControl.err = fmt.Errorf("fake error")
+	syncAndValidate(t, manager, job, podControl, 0, 0)
+
+	podControl.err = nil
+	syncAndValidate(t, manager, job, podControl, 2, 0)
+}
+
+func TestPodEventsEnqueueJob(t *testing.T) {
+	manager, _, _ := newTestController()
+	job := newJob("foo", 1, 1)
+	manager.jobStore.Add(job)
+
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: api.NamespaceDefault, Labels: simpleJobLabel},
+		Status:     api.PodStatus{Phase: api.PodRunning},
+	}
+	unrelated := *pod
+	unrelated.Labels = map[string]string{"name": "other"}
+	manager.addPod(&unrelated)
+	if manager.queue.Len() != 0 {
+		t.Errorf("expected a pod without a job not to enqueue anything")
+	}
+	succeeded := *pod
+	succeeded.Status.Phase = api.PodSucceeded
+	manager.updatePod(pod, &succeeded)
+	if manager.queue.Len() != 1 {
+		t.Errorf("expected a terminated pod to enqueue its job")
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podautoscaler
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/podautoscaler/metrics"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+const (
+	// Usage ratios within this distance of 1.0 do not trigger a rescale.
+	tolerance = 0.1
+
+	// After a rescale, further scaling up is forbidden for upscaleForbiddenWindow
+	// and scaling down for downscaleForbiddenWindow, so that the autoscaler does
+	// not flap while the new pods warm up and the metrics catch up.
+	upscaleForbiddenWindow   = 3 * time.Minute
+	downscaleForbiddenWindow = 5 * time.Minute
+)
+
+// HorizontalController periodically adjusts the replica count of the
+// replication controllers targeted by horizontal pod autoscalers so that
+// their pods' CPU utilization approaches the requested target.
+type HorizontalController struct {
+	kubeClient    client.Interface
+	metricsClient metrics.MetricsClient
+
+	// To allow injection of the clock for testing.
+	now func() time.Time
+}
+
+// NewHorizontalController creates a new HorizontalController.
+func NewHorizontalController(kubeClient client.Interface, metricsClient metrics.MetricsClient) *HorizontalController {
+	return &HorizontalController{
+		kubeClient:    kubeClient,
+		metricsClient: metricsClient,
+		now:           time.Now,
+	}
+}
+
+// Run begins reconciling autoscalers every period.
+func (a *HorizontalController) Run(period time.Duration) {
+	go util.Forever(func() {
+		if err := a.reconcileAutoscalers(); err != nil {
+			glog.Errorf("Couldn't reconcile horizontal pod autoscalers: %v", err)
+		}
+	}, period)
+}
+
+func (a *HorizontalController) reconcileAutoscalers() error {
+	list, err := a.kubeClient.HorizontalPodAutoscalers(api.NamespaceAll).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("error listing horizontal pod autoscalers: %v", err)
+	}
+	for i := range list.Items {
+		hpa := &list.Items[i]
+		if err := a.reconcileAutoscaler(hpa); err != nil {
+			glog.Errorf("Error reconciling horizontal pod autoscaler %s/%s: %v", hpa.Namespace, hpa.Name, err)
+		}
+	}
+	return nil
+}
+
+// computeUtilization returns the CPU usage of the given pods as a percentage
+// of what they requested. Pods that are not running are skipped; each
+// container's request falls back to its limit when unset.
+func (a *HorizontalController) computeUtilization(pods []api.Pod) (int, error) {
+	usage, request := int64(0), int64(0)
+	counted := 0
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != api.PodRunning {
+			continue
+		}
+		podRequest, err := podCPURequest(pod)
+		if err != nil {
+			return 0, err
+		}
+		podUsage, err := a.metricsClient.GetPodCPUUsage(pod)
+		if err != nil {
+			return 0, err
+		}
+		usage += podUsage
+		request += podRequest
+		counted++
+	}
+	if counted == 0 {
+		return 0, fmt.Errorf("no running pods to collect metrics from")
+	}
+	return int(usage * 100 / request), nil
+}
+
+// podCPURequest returns the total CPU requested by the containers of the
+// pod, in millicores.
+func podCPURequest(pod *api.Pod) (int64, error) {
+	total := int64(0)
+	for _, container := range pod.Spec.Containers {
+		quantity, found := container.Resources.Requests[api.ResourceCPU]
+		if !found {
+			quantity, found = container.Resources.Limits[api.ResourceCPU]
+		}
+		if !found || quantity.MilliValue() == 0 {
+			return 0, fmt.Errorf("container %s of pod %s/%s does not request any CPU", container.Name, pod.Namespace, pod.Name)
+		}
+		total += quantity.MilliValue()
+	}
+	return total, nil
+}
+
+// desiredReplicas returns the number of replicas that would bring the
+// utilization to the target, clamped to the autoscaler's bounds.
+func desiredReplicas(spec *api.HorizontalPodAutoscalerSpec, currentReplicas, utilization int) int {
+	desired := currentReplicas
+	usageRatio := float64(utilization) / float64(*spec.TargetCPUUtilizationPercentage)
+	if math.Abs(1.0-usageRatio) > tolerance {
+		desired = int(math.Ceil(usageRatio * float64(currentReplicas)))
+	}
+	if desired < *spec.MinReplicas {
+		desired = *spec.MinReplicas
+	}
+	if desired > spec.MaxReplicas {
+		desired = spec.MaxReplicas
+	}
+	return desired
+}
+
+// shouldScale returns true if a change from currentReplicas to desired is
+// allowed given the time of the last rescale.
+func shouldScale(lastScaleTime *util.Time, currentReplicas, desired int, now time.Time) bool {
+	if desired == currentReplicas {
+		return false
+	}
+	if lastScaleTime == nil {
+		return true
+	}
+	if desired < currentReplicas {
+		return !lastScaleTime.Add(downscaleForbiddenWindow).After(now)
+	}
+	return !lastScaleTime.Add(upscaleForbiddenWindow).After(now)
+}
+
+func (a *HorizontalController) reconcileAutoscaler(hpa *api.HorizontalPodAutoscaler) error {
+	ref := hpa.Spec.ScaleRef
+	if ref.Kind != "ReplicationController" {
+		return fmt.Errorf("unsupported scale target kind %q", ref.Kind)
+	}
+	if hpa.Spec.MinReplicas == nil || hpa.Spec.TargetCPUUtilizationPercentage == nil {
+		return fmt.Errorf("minReplicas and targetCPUUtilizationPercentage must be set")
+	}
+	rc, err := a.kubeClient.ReplicationControllers(hpa.Namespace).Get(ref.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get replication controller %s: %v", ref.Name, err)
+	}
+	currentReplicas := rc.Spec.Replicas
+
+	// A controller scaled down to zero has no pods to measure, so the
+	// autoscaler leaves it alone until it is scaled up by hand.
+	if currentReplicas == 0 {
+		return nil
+	}
+
+	pods, err := a.kubeClient.Pods(hpa.Namespace).List(labels.SelectorFromSet(rc.Spec.Selector), fields.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list pods of replication controller %s: %v", ref.Name, err)
+	}
+	utilization, err := a.computeUtilization(pods.Items)
+	if err != nil {
+		return fmt.Errorf("failed to compute CPU utilization of replication controller %s: %v", ref.Name, err)
+	}
+
+	now := a.now()
+	desired := desiredReplicas(&hpa.Spec, currentReplicas, utilization)
+	rescale := shouldScale(hpa.Status.LastScaleTime, currentReplicas, desired, now)
+	if rescale {
+		rc.Spec.Replicas = desired
+		if _, err := a.kubeClient.ReplicationControllers(hpa.Namespace).Update(rc); err != nil {
+			return fmt.Errorf("failed to rescale replication controller %s: %v", ref.Name, err)
+		}
+		glog.Infof("Rescaled replication controller %s/%s from %d to %d replicas (CPU utilization %d%%, target %d%%)",
+			hpa.Namespace, ref.Name, currentReplicas, desired, utilization, *hpa.Spec.TargetCPUUtilizationPercentage)
+	} else {
+		desired = currentReplicas
+	}
+
+	hpa.Status = api.HorizontalPodAutoscalerStatus{
+		LastScaleTime:                   hpa.Status.LastScaleTime,
+		CurrentReplicas:                 currentReplicas,
+		DesiredReplicas:                 desired,
+		CurrentCPUUtilizationPercentage: &utilization,
+	}
+	if rescale {
+		scaleTime := util.NewTime(now)
+		hpa.Status.LastScaleTime = &scaleTime
+	}
+	if _, err := a.kubeClient.HorizontalPodAutoscalers(hpa.Namespace).Update(hpa); err != nil {
+		return fmt.Errorf("failed to update status: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podautoscaler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// fakeMetricsClient reports a fixed usage, in millicores, for every pod.
+type fakeMetricsClient struct {
+	usage int64
+	err   error
+}
+
+func (f *fakeMetricsClient) GetPodCPUUsage(pod *api.Pod) (int64, error) {
+	return f.usage, f.err
+}
+
+var testNow = time.Date(2015, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newAutoscaler(min, max, target int, lastScale *time.Time) *api.HorizontalPodAutoscaler {
+	hpa := &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ScaleTargetReference{Kind: "ReplicationController", Name: "rc"},
+			MinReplicas:                    &min,
+			MaxReplicas:                    max,
+			TargetCPUUtilizationPercentage: &target,
+		},
+	}
+	if lastScale != nil {
+		t := util.NewTime(*lastScale)
+		hpa.Status.LastScaleTime = &t
+	}
+	return hpa
+}
+
+func newController(replicas int) *api.ReplicationController {
+	return &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{Name: "rc", Namespace: api.NamespaceDefault},
+		Spec: api.ReplicationControllerSpec{
+			Replicas: replicas,
+			Selector: map[string]string{"name": "rc"},
+		},
+	}
+}
+
+// newPods returns count running pods, each requesting 500 millicores.
+func newPods(count int) *api.PodList {
+	list := &api.PodList{}
+	for i := 0; i < count; i++ {
+		list.Items = append(list.Items, api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: fmt.Sprintf("pod%d", i), Namespace: api.NamespaceDefault},
+			Spec: api.PodSpec{
+				Containers: []api.Container{{
+					Name: "c",
+					Resources: api.ResourceRequirements{
+						Requests: api.ResourceList{api.ResourceCPU: resource.MustParse("500m")},
+					},
+				}},
+			},
+			Status: api.PodStatus{Phase: api.PodRunning, HostIP: "1.2.3.4"},
+		})
+	}
+	return list
+}
+
+func TestReconcileAutoscaler(t *testing.T) {
+	recent := testNow.Add(-time.Minute)
+	old := testNow.Add(-10 * time.Minute)
+	tests := []struct {
+		name            string
+		hpa             *api.HorizontalPodAutoscaler
+		replicas        int
+		usage           int64
+		expectScale     bool
+		expectReplicas  int
+		expectUtilRatio int
+	}{
+		{
+			name:            "scale up",
+			hpa:             newAutoscaler(1, 10, 50, nil),
+			replicas:        3,
+			usage:           500,
+			expectScale:     true,
+			expectReplicas:  6,
+			expectUtilRatio: 100,
+		},
+		{
+			name:            "scale down",
+			hpa:             newAutoscaler(1, 10, 50, &old),
+			replicas:        4,
+			usage:           100,
+			expectScale:     true,
+			expectReplicas:  2,
+			expectUtilRatio: 20,
+		},
+		{
+			name:            "within tolerance",
+			hpa:             newAutoscaler(1, 10, 50, nil),
+			replicas:        4,
+			usage:           265,
+			expectScale:     false,
+			expectReplicas:  4,
+			expectUtilRatio: 53,
+		},
+		{
+			name:            "capped at max",
+			hpa:             newAutoscaler(1, 5, 50, nil),
+			replicas:        3,
+			usage:           500,
+			expectScale:     true,
+			expectReplicas:  5,
+			expectUtilRatio: 100,
+		},
+		{
+			name:            "held at min",
+			hpa:             newAutoscaler(2, 10, 50, nil),
+			replicas:        3,
+			usage:           25,
+			expectScale:     true,
+			expectReplicas:  2,
+			expectUtilRatio: 5,
+		},
+		{
+			name:            "upscale cooldown",
+			hpa:             newAutoscaler(1, 10, 50, &recent),
+			replicas:        3,
+			usage:           500,
+			expectScale:     false,
+			expectReplicas:  3,
+			expectUtilRatio: 100,
+		},
+		{
+			name:            "downscale cooldown",
+			hpa:             newAutoscaler(1, 10, 50, &recent),
+			replicas:        4,
+			usage:           100,
+			expectScale:     false,
+			expectReplicas:  4,
+			expectUtilRatio: 20,
+		},
+	}
+
+	for _, test := range tests {
+		fakeClient := testclient.NewSimpleFake(test.hpa, newController(test.replicas), newPods(test.replicas))
+		controller := NewHorizontalController(fakeClient, &fakeMetricsClient{usage: test.usage})
+		controller.now = func() time.Time { return testNow }
+
+		if err := controller.reconcileAutoscaler(test.hpa); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		var rcUpdate *api.ReplicationController
+		var hpaUpdate *api.HorizontalPodAutoscaler
+		for _, action := range fakeClient.Actions {
+			switch action.Action {
+			case testclient.UpdateControllerAction:
+				rcUpdate = action.Value.(*api.ReplicationController)
+			case testclient.UpdateHorizontalPodAutoscalerAction:
+				hpaUpdate = action.Value.(*api.HorizontalPodAutoscaler)
+			}
+		}
+		if test.expectScale {
+			if rcUpdate == nil {
+				t.Errorf("%s: expected the replication controller to be rescaled", test.name)
+			} else if rcUpdate.Spec.Replicas != test.expectReplicas {
+				t.Errorf("%s: expected %d replicas, got %d", test.name, test.expectReplicas, rcUpdate.Spec.Replicas)
+			}
+		} else if rcUpdate != nil {
+			t.Errorf("%s: unexpected rescale to %d replicas", test.name, rcUpdate.Spec.Replicas)
+		}
+
+		if hpaUpdate == nil {
+			t.Errorf("%s: expected the autoscaler status to be updated", test.name)
+			continue
+		}
+		status := hpaUpdate.Status
+		if status.CurrentReplicas != test.replicas || status.DesiredReplicas != test.expectReplicas {
+			t.Errorf("%s: expected current/desired replicas %d/%d, got %d/%d",
+				test.name, test.replicas, test.expectReplicas, status.CurrentReplicas, status.DesiredReplicas)
+		}
+		if status.CurrentCPUUtilizationPercentage == nil || *status.CurrentCPUUtilizationPercentage != test.expectUtilRatio {
+			t.Errorf("%s: expected utilization %d%%, got %v", test.name, test.expectUtilRatio, status.CurrentCPUUtilizationPercentage)
+		}
+		scaled := status.LastScaleTime != nil && status.LastScaleTime.Time.Equal(testNow)
+		if scaled != test.expectScale {
+			t.Errorf("%s: expected last scale time updated to be %v, got %v", test.name, test.expectScale, status.LastScaleTime)
+		}
+	}
+}
+
+func TestReconcileAutoscalerErrors(t *testing.T) {
+	noRequests := newPods(2)
+	for i := range noRequests.Items {
+		noRequests.Items[i].Spec.Containers[0].Resources = api.ResourceRequirements{}
+	}
+	tests := []struct {
+		name    string
+		pods    *api.PodList
+		metrics *fakeMetricsClient
+	}{
+		{
+			name:    "metrics unavailable",
+			pods:    newPods(2),
+			metrics: &fakeMetricsClient{err: fmt.Errorf("kubelet unreachable")},
+		},
+		{
+			name:    "no cpu request",
+			pods:    noRequests,
+			metrics: &fakeMetricsClient{usage: 100},
+		},
+		{
+			name:    "no running pods",
+			pods:    &api.PodList{},
+			metrics: &fakeMetricsClient{usage: 100},
+		},
+	}
+	for _, test := range tests {
+		fakeClient := testclient.NewSimpleFake(newController(2), test.pods)
+		controller := NewHorizontalController(fakeClient, test.metrics)
+		if err := controller.reconcileAutoscaler(newAutoscaler(1, 10, 50, nil)); err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+		for _, action := range fakeClient.Actions {
+			if action.Action == testclient.UpdateControllerAction {
+				t.Errorf("%s: unexpected rescale", test.name)
+			}
+		}
+	}
+}
+
+func TestReconcileAutoscalerSkipsEmptyController(t *testing.T) {
+	fakeClient := testclient.NewSimpleFake(newController(0))
+	controller := NewHorizontalController(fakeClient, &fakeMetricsClient{usage: 100})
+	if err := controller.reconcileAutoscaler(newAutoscaler(1, 10, 50, nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range fakeClient.Actions {
+		if action.Action != testclient.GetControllerAction {
+			t.Errorf("unexpected action %s", action.Action)
+		}
+	}
+}
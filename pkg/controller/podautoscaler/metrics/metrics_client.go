@@ -0,0 +1,104 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/master/ports"
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+)
+
+// sampleCount is the number of recent cAdvisor samples requested for each
+// container; usage is averaged between the oldest and newest of them.
+const sampleCount = 10
+
+// MetricsClient is an interface for getting resource usage of pods.
+type MetricsClient interface {
+	// GetPodCPUUsage returns the CPU usage of all containers of the given
+	// pod, averaged over the recent sampling window, in millicores.
+	GetPodCPUUsage(pod *api.Pod) (int64, error)
+}
+
+// kubeletMetricsClient reads usage from the /stats/ endpoint of the kubelet
+// running the pod, which in turn serves it from cAdvisor.
+type kubeletMetricsClient struct {
+	infoGetter client.ContainerInfoGetter
+}
+
+// NewKubeletMetricsClient returns a MetricsClient that queries kubelets
+// through the given ContainerInfoGetter.
+func NewKubeletMetricsClient(infoGetter client.ContainerInfoGetter) MetricsClient {
+	return &kubeletMetricsClient{infoGetter: infoGetter}
+}
+
+// NewDefaultMetricsClient returns a MetricsClient that queries the read-only
+// port of each kubelet.
+func NewDefaultMetricsClient() MetricsClient {
+	return NewKubeletMetricsClient(&client.HTTPContainerInfoGetter{
+		Client: http.DefaultClient,
+		Port:   ports.KubeletReadOnlyPort,
+	})
+}
+
+func (c *kubeletMetricsClient) GetPodCPUUsage(pod *api.Pod) (int64, error) {
+	if pod.Status.HostIP == "" {
+		return 0, fmt.Errorf("pod %s/%s is not running on a host", pod.Namespace, pod.Name)
+	}
+	// The kubelet addresses containers as <namespace>/<pod name>/<uid>/<container name>.
+	podID := fmt.Sprintf("%s/%s/%s", pod.Namespace, pod.Name, pod.UID)
+	req := &cadvisorApi.ContainerInfoRequest{NumStats: sampleCount}
+	total := int64(0)
+	for _, container := range pod.Spec.Containers {
+		info, err := c.infoGetter.GetContainerInfo(pod.Status.HostIP, podID, container.Name, req)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get stats for container %s of pod %s/%s: %v", container.Name, pod.Namespace, pod.Name, err)
+		}
+		usage, err := cpuUsageFromStats(info.Stats)
+		if err != nil {
+			return 0, fmt.Errorf("container %s of pod %s/%s: %v", container.Name, pod.Namespace, pod.Name, err)
+		}
+		total += usage
+	}
+	return total, nil
+}
+
+// cpuUsageFromStats computes the average CPU usage, in millicores, between
+// the oldest and the newest of the given samples.
+func cpuUsageFromStats(stats []*cadvisorApi.ContainerStats) (int64, error) {
+	if len(stats) < 2 {
+		return 0, fmt.Errorf("need at least 2 samples to compute usage, got %d", len(stats))
+	}
+	first, last := stats[0], stats[len(stats)-1]
+	if last.Timestamp.Before(first.Timestamp) {
+		first, last = last, first
+	}
+	elapsed := last.Timestamp.Sub(first.Timestamp).Nanoseconds()
+	if elapsed <= 0 {
+		return 0, fmt.Errorf("samples do not span any time")
+	}
+	if last.Cpu.Usage.Total < first.Cpu.Usage.Total {
+		return 0, fmt.Errorf("cumulative CPU usage decreased between samples")
+	}
+	// Usage.Total is cumulative CPU time in nanoseconds, so the rate of
+	// change is in cores; scale it to millicores.
+	used := int64(last.Cpu.Usage.Total - first.Cpu.Usage.Total)
+	return used * 1000 / elapsed, nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+)
+
+type fakeContainerInfoGetter struct {
+	requests []string
+	stats    map[string][]*cadvisorApi.ContainerStats
+	err      error
+}
+
+func (f *fakeContainerInfoGetter) GetContainerInfo(host, podID, containerID string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
+	f.requests = append(f.requests, fmt.Sprintf("%s/%s/%s", host, podID, containerID))
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &cadvisorApi.ContainerInfo{Stats: f.stats[containerID]}, nil
+}
+
+func (f *fakeContainerInfoGetter) GetRootInfo(host string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
+	return nil, fmt.Errorf("unexpected call")
+}
+
+func (f *fakeContainerInfoGetter) GetMachineInfo(host string) (*cadvisorApi.MachineInfo, error) {
+	return nil, fmt.Errorf("unexpected call")
+}
+
+// samples returns two samples ten seconds apart during which the container
+// used the given number of millicores.
+func samples(millicores int64) []*cadvisorApi.ContainerStats {
+	start := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := &cadvisorApi.ContainerStats{Timestamp: start}
+	first.Cpu.Usage.Total = 5000000000
+	last := &cadvisorApi.ContainerStats{Timestamp: start.Add(10 * time.Second)}
+	last.Cpu.Usage.Total = first.Cpu.Usage.Total + uint64(millicores*10*1000000)
+	return []*cadvisorApi.ContainerStats{first, last}
+}
+
+func newPod() *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar", UID: "123"},
+		Spec: api.PodSpec{
+			Containers: []api.Container{{Name: "a"}, {Name: "b"}},
+		},
+		Status: api.PodStatus{HostIP: "1.2.3.4"},
+	}
+}
+
+func TestGetPodCPUUsage(t *testing.T) {
+	getter := &fakeContainerInfoGetter{
+		stats: map[string][]*cadvisorApi.ContainerStats{
+			"a": samples(250),
+			"b": samples(100),
+		},
+	}
+	usage, err := NewKubeletMetricsClient(getter).GetPodCPUUsage(newPod())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if usage != 350 {
+		t.Errorf("expected 350 millicores, got %d", usage)
+	}
+	expected := []string{"1.2.3.4/bar/foo/123/a", "1.2.3.4/bar/foo/123/b"}
+	if len(getter.requests) != len(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, getter.requests)
+	}
+	for i := range expected {
+		if getter.requests[i] != expected[i] {
+			t.Errorf("expected request %q, got %q", expected[i], getter.requests[i])
+		}
+	}
+}
+
+func TestGetPodCPUUsageErrors(t *testing.T) {
+	unscheduled := newPod()
+	unscheduled.Status.HostIP = ""
+	decreasing := samples(100)
+	decreasing[0], decreasing[1] = decreasing[1], decreasing[0]
+	decreasing[0].Timestamp, decreasing[1].Timestamp = decreasing[1].Timestamp, decreasing[0].Timestamp
+
+	tests := map[string]struct {
+		pod    *api.Pod
+		getter *fakeContainerInfoGetter
+	}{
+		"not scheduled": {
+			pod:    unscheduled,
+			getter: &fakeContainerInfoGetter{},
+		},
+		"kubelet error": {
+			pod:    newPod(),
+			getter: &fakeContainerInfoGetter{err: fmt.Errorf("connection refused")},
+		},
+		"single sample": {
+			pod: newPod(),
+			getter: &fakeContainerInfoGetter{stats: map[string][]*cadvisorApi.ContainerStats{
+				"a": samples(100)[:1],
+				"b": samples(100),
+			}},
+		},
+		"decreasing usage": {
+			pod: newPod(),
+			getter: &fakeContainerInfoGetter{stats: map[string][]*cadvisorApi.ContainerStats{
+				"a": decreasing,
+				"b": samples(100),
+			}},
+		},
+	}
+	for name, test := range tests {
+		if _, err := NewKubeletMetricsClient(test.getter).GetPodCPUUsage(test.pod); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -32,10 +32,11 @@ import (
 const (
 	get_long = `Display one or many resources.
 
-Possible resources include pods (po), replication controllers (rc), services
-(svc), nodes, events (ev), component statuses (cs), limit ranges (limits),
-nodes (no), persistent volumes (pv), persistent volume claims (pvc)
-or resource quotas (quota).
+Possible resources include pods (po), replication controllers (rc), deployments,
+daemon sets (ds), jobs, horizontal pod autoscalers (hpa), services (svc), nodes,
+events (ev), component statuses (cs), limit ranges (limits), nodes (no),
+persistent volumes (pv), persistent volume claims (pvc), roles, role bindings,
+cluster roles, cluster role bindings or resource quotas (quota).
 
 By specifying the output as 'template' and providing a Go template as the value
 of the --template flag, you can filter the attributes of the fetched resource(s).`
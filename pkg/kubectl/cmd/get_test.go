@@ -67,8 +67,9 @@ func testData() (*api.PodList, *api.ServiceList, *api.ReplicationControllerList)
 			{
 				ObjectMeta: api.ObjectMeta{Name: "baz", Namespace: "test", ResourceVersion: "12"},
 				Spec: api.ServiceSpec{
-					SessionAffinity: "None",
-					Type:            api.ServiceTypeClusterIP,
+					SessionAffinity:     "None",
+					Type:                api.ServiceTypeClusterIP,
+					LoadBalancingPolicy: api.ServiceLoadBalancingRoundRobin,
 				},
 			},
 		},
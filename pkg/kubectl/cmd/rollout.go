@@ -0,0 +1,127 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
+)
+
+const (
+	rollout_long    = `Manages a deployment using subcommands like "kubectl rollout undo deployment/abc"`
+	rollout_example = `// Rollback to the previous deployment
+$ kubectl rollout undo deployment/abc`
+
+	undo_long = `Rollback to a previous rollout.
+
+The deployment controller records the pod template of each rollout as a revision. Undo
+asks the controller to return the deployment to one of those revisions; by default the
+revision immediately preceding the current one is used.`
+	undo_example = `// Rollback to the previous deployment
+$ kubectl rollout undo deployment/abc
+
+// Rollback to revision 3 of deployment abc
+$ kubectl rollout undo deployment/abc --to-revision=3`
+)
+
+// NewCmdRollout returns a cobra command grouping the rollout subcommands
+func NewCmdRollout(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rollout SUBCOMMAND",
+		Short:   "Manage a deployment rollout.",
+		Long:    rollout_long,
+		Example: rollout_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+	cmd.AddCommand(NewCmdRolloutUndo(f, out))
+	return cmd
+}
+
+// NewCmdRolloutUndo returns a cobra command with the appropriate configuration and flags to run rollout undo
+func NewCmdRolloutUndo(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "undo (TYPE NAME | TYPE/NAME) [--to-revision=REVISION]",
+		Short:   "Undo a previous rollout.",
+		Long:    undo_long,
+		Example: undo_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunRolloutUndo(f, out, cmd, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	cmd.Flags().Int("to-revision", 0, "The revision to rollback to. Default to 0 (last revision).")
+	return cmd
+}
+
+// RunRolloutUndo executes the rollback
+func RunRolloutUndo(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmdutil.UsageError(cmd, "Required resource not specified.")
+	}
+	toRevision := cmdutil.GetFlagInt(cmd, "to-revision")
+	if toRevision < 0 {
+		return cmdutil.UsageError(cmd, "--to-revision must be non-negative")
+	}
+
+	cmdNamespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		ResourceTypeOrNameArgs(false, args...).
+		Flatten().
+		Do()
+	err = r.Err()
+	if err != nil {
+		return err
+	}
+	mapping, err := r.ResourceMapping()
+	if err != nil {
+		return err
+	}
+
+	infos, err := r.Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) > 1 {
+		return fmt.Errorf("multiple resources provided: %v", args)
+	}
+	info := infos[0]
+
+	rollbacker, err := f.Rollbacker(mapping)
+	if err != nil {
+		return err
+	}
+	result, err := rollbacker.Rollback(info.Namespace, info.Name, int64(toRevision))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s\n", result)
+	return nil
+}
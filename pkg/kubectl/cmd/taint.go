@@ -0,0 +1,243 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+const (
+	taint_long = `Update the taints on one or more nodes.
+
+A taint consists of a key, value, and effect. As an argument here, it is expressed as key=value:effect.
+The key must be a qualified name, and the value must be a valid label value of up to %[1]d characters.
+The effect must be NoSchedule or PreferNoSchedule.
+Pods are not scheduled onto a node with a NoSchedule taint unless they tolerate it, and the scheduler
+tries to avoid nodes with PreferNoSchedule taints that the pod does not tolerate.
+If --overwrite is true, then existing taints with the same key and effect are replaced, otherwise
+attempting to replace one results in an error.`
+	taint_example = `// Update node 'foo' with a taint with key 'dedicated' and value 'special-user' and effect 'NoSchedule'.
+// If a taint with that key and effect already exists, its value is replaced as specified.
+$ kubectl taint nodes foo dedicated=special-user:NoSchedule --overwrite
+
+// Remove from node 'foo' the taint with key 'dedicated' and effect 'NoSchedule' if one exists.
+$ kubectl taint nodes foo dedicated:NoSchedule-
+
+// Remove from node 'foo' all the taints with key 'dedicated'.
+$ kubectl taint nodes foo dedicated-
+
+// Add a taint without a value to every node.
+$ kubectl taint nodes --all maintenance:PreferNoSchedule`
+)
+
+func NewCmdTaint(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "taint [--overwrite] nodes NAME KEY_1=VAL_1:TAINT_EFFECT_1 ... KEY_N=VAL_N:TAINT_EFFECT_N",
+		Short:   "Update the taints on one or more nodes",
+		Long:    fmt.Sprintf(taint_long, util.LabelValueMaxLength),
+		Example: taint_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunTaint(f, out, cmd, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	cmdutil.AddPrinterFlags(cmd)
+	cmd.Flags().Bool("overwrite", false, "If true, allow taints to be overwritten, otherwise reject taint updates that overwrite existing taints.")
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on")
+	cmd.Flags().Bool("all", false, "select all nodes in the cluster")
+	return cmd
+}
+
+var supportedTaintEffects = util.NewStringSet(string(api.TaintEffectNoSchedule), string(api.TaintEffectPreferNoSchedule))
+
+// parseTaint parses a taint spec of the form key=value:effect or key:effect.
+func parseTaint(spec string) (api.Taint, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) != 2 || !supportedTaintEffects.Has(parts[1]) {
+		return api.Taint{}, fmt.Errorf("invalid taint spec: %v", spec)
+	}
+	taint := api.Taint{Key: parts[0], Effect: api.TaintEffect(parts[1])}
+	if strings.Contains(parts[0], "=") {
+		keyValue := strings.Split(parts[0], "=")
+		if len(keyValue) != 2 || !util.IsValidLabelValue(keyValue[1]) {
+			return api.Taint{}, fmt.Errorf("invalid taint spec: %v", spec)
+		}
+		taint.Key, taint.Value = keyValue[0], keyValue[1]
+	}
+	if !util.IsQualifiedName(taint.Key) {
+		return api.Taint{}, fmt.Errorf("invalid taint spec: %v", spec)
+	}
+	return taint, nil
+}
+
+// parseTaints splits the taint specs into taints to add and taints to remove.
+// A removal is written key:effect- or key-, the latter removing every effect.
+func parseTaints(spec []string) ([]api.Taint, []api.Taint, error) {
+	var add, remove []api.Taint
+	seen := util.StringSet{}
+	for _, taintSpec := range spec {
+		if strings.HasSuffix(taintSpec, "-") {
+			parts := strings.Split(taintSpec[:len(taintSpec)-1], ":")
+			if len(parts) > 2 || !util.IsQualifiedName(parts[0]) {
+				return nil, nil, fmt.Errorf("invalid taint spec: %v", taintSpec)
+			}
+			taint := api.Taint{Key: parts[0]}
+			if len(parts) == 2 {
+				if !supportedTaintEffects.Has(parts[1]) {
+					return nil, nil, fmt.Errorf("invalid taint spec: %v", taintSpec)
+				}
+				taint.Effect = api.TaintEffect(parts[1])
+			}
+			remove = append(remove, taint)
+			continue
+		}
+		taint, err := parseTaint(taintSpec)
+		if err != nil {
+			return nil, nil, err
+		}
+		id := taint.Key + ":" + string(taint.Effect)
+		if seen.Has(id) {
+			return nil, nil, fmt.Errorf("taint %q is specified more than once", id)
+		}
+		seen.Insert(id)
+		add = append(add, taint)
+	}
+	for _, removeTaint := range remove {
+		for _, addTaint := range add {
+			if removeTaint.Key == addTaint.Key && (len(removeTaint.Effect) == 0 || removeTaint.Effect == addTaint.Effect) {
+				return nil, nil, fmt.Errorf("can not both modify and remove a taint in the same command")
+			}
+		}
+	}
+	return add, remove, nil
+}
+
+func taintFunc(obj runtime.Object, overwrite bool, add, remove []api.Taint) (runtime.Object, error) {
+	node, ok := obj.(*api.Node)
+	if !ok {
+		return nil, fmt.Errorf("taints can only be applied to nodes, not %T", obj)
+	}
+
+	taints := []api.Taint{}
+	for _, taint := range node.Spec.Taints {
+		removed := false
+		for _, removeTaint := range remove {
+			if taint.Key == removeTaint.Key && (len(removeTaint.Effect) == 0 || taint.Effect == removeTaint.Effect) {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			taints = append(taints, taint)
+		}
+	}
+
+	for _, addTaint := range add {
+		replaced := false
+		for i := range taints {
+			if taints[i].Key == addTaint.Key && taints[i].Effect == addTaint.Effect {
+				if !overwrite {
+					return nil, fmt.Errorf("node %s already has a %s taint with key %s (value %q), and --overwrite is false", node.Name, addTaint.Effect, addTaint.Key, taints[i].Value)
+				}
+				taints[i] = addTaint
+				replaced = true
+			}
+		}
+		if !replaced {
+			taints = append(taints, addTaint)
+		}
+	}
+
+	if len(taints) == 0 {
+		taints = nil
+	}
+	node.Spec.Taints = taints
+	return node, nil
+}
+
+func RunTaint(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
+	resources, taintArgs := []string{}, []string{}
+	first := true
+	for _, s := range args {
+		isTaint := strings.Contains(s, ":") || strings.HasSuffix(s, "-")
+		switch {
+		case first && isTaint:
+			first = false
+			fallthrough
+		case !first && isTaint:
+			taintArgs = append(taintArgs, s)
+		case first && !isTaint:
+			resources = append(resources, s)
+		case !first && !isTaint:
+			return cmdutil.UsageError(cmd, "all resources must be specified before taint changes: %s", s)
+		}
+	}
+	if len(resources) < 1 {
+		return cmdutil.UsageError(cmd, "one or more nodes must be specified as nodes <name> or nodes/<name>")
+	}
+	if len(taintArgs) < 1 {
+		return cmdutil.UsageError(cmd, "at least one taint update is required")
+	}
+
+	selector := cmdutil.GetFlagString(cmd, "selector")
+	all := cmdutil.GetFlagBool(cmd, "all")
+	overwrite := cmdutil.GetFlagBool(cmd, "overwrite")
+
+	add, remove, err := parseTaints(taintArgs)
+	if err != nil {
+		return cmdutil.UsageError(cmd, "%v", err)
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		ContinueOnError().
+		SelectorParam(selector).
+		ResourceTypeOrNameArgs(all, resources...).
+		Flatten().
+		Latest().
+		Do()
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	return r.Visit(func(info *resource.Info) error {
+		if info.Mapping.Kind != "Node" {
+			return fmt.Errorf("taints can only be applied to nodes, not %s %q", info.Mapping.Resource, info.Name)
+		}
+		obj, err := updateObject(info, func(obj runtime.Object) (runtime.Object, error) {
+			return taintFunc(obj, overwrite, add, remove)
+		})
+		if err != nil {
+			return err
+		}
+
+		printer, err := f.PrinterForMapping(cmd, info.Mapping, false)
+		if err != nil {
+			return err
+		}
+		return printer.PrintObj(obj, out)
+	})
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+func TestParseTaints(t *testing.T) {
+	tests := []struct {
+		taints         []string
+		expected       []api.Taint
+		expectedRemove []api.Taint
+		expectErr      bool
+	}{
+		{
+			taints: []string{"dedicated=infra:NoSchedule", "maintenance:PreferNoSchedule"},
+			expected: []api.Taint{
+				{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule},
+				{Key: "maintenance", Effect: api.TaintEffectPreferNoSchedule},
+			},
+		},
+		{
+			taints:         []string{"dedicated=infra:NoSchedule", "maintenance:PreferNoSchedule-", "gpu-"},
+			expected:       []api.Taint{{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}},
+			expectedRemove: []api.Taint{{Key: "maintenance", Effect: api.TaintEffectPreferNoSchedule}, {Key: "gpu"}},
+		},
+		{
+			taints:    []string{"dedicated=infra"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"dedicated=infra:NoExecute"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"dedicated=%^$:NoSchedule"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"bad key:NoSchedule"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"dedicated=a:NoSchedule", "dedicated=b:NoSchedule"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"dedicated=infra:NoSchedule", "dedicated-"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"dedicated:Sometimes-"},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		add, remove, err := parseTaints(test.taints)
+		if test.expectErr && err == nil {
+			t.Errorf("unexpected non-error: %v", test)
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("unexpected error: %v %v", err, test)
+		}
+		if !reflect.DeepEqual(add, test.expected) {
+			t.Errorf("expected: %v, got %v", test.expected, add)
+		}
+		if !reflect.DeepEqual(remove, test.expectedRemove) {
+			t.Errorf("expected: %v, got %v", test.expectedRemove, remove)
+		}
+	}
+}
+
+func TestTaintFunc(t *testing.T) {
+	infra := api.Taint{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}
+	preferInfra := api.Taint{Key: "dedicated", Value: "infra", Effect: api.TaintEffectPreferNoSchedule}
+	gpu := api.Taint{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule}
+	maintenance := api.Taint{Key: "maintenance", Effect: api.TaintEffectPreferNoSchedule}
+
+	tests := []struct {
+		taints    []api.Taint
+		overwrite bool
+		add       []api.Taint
+		remove    []api.Taint
+		expected  []api.Taint
+		expectErr bool
+	}{
+		{
+			add:      []api.Taint{infra},
+			expected: []api.Taint{infra},
+		},
+		{
+			taints:   []api.Taint{maintenance},
+			add:      []api.Taint{infra},
+			expected: []api.Taint{maintenance, infra},
+		},
+		{
+			taints:    []api.Taint{infra},
+			add:       []api.Taint{gpu},
+			expectErr: true,
+		},
+		{
+			taints:    []api.Taint{infra, maintenance},
+			overwrite: true,
+			add:       []api.Taint{gpu},
+			expected:  []api.Taint{gpu, maintenance},
+		},
+		{
+			taints:   []api.Taint{infra},
+			add:      []api.Taint{preferInfra},
+			expected: []api.Taint{infra, preferInfra},
+		},
+		{
+			taints:   []api.Taint{infra, preferInfra, maintenance},
+			remove:   []api.Taint{{Key: "dedicated", Effect: api.TaintEffectNoSchedule}},
+			expected: []api.Taint{preferInfra, maintenance},
+		},
+		{
+			taints:   []api.Taint{infra, preferInfra, maintenance},
+			remove:   []api.Taint{{Key: "dedicated"}},
+			expected: []api.Taint{maintenance},
+		},
+		{
+			taints: []api.Taint{maintenance},
+			remove: []api.Taint{{Key: "maintenance"}},
+		},
+	}
+	for i, test := range tests {
+		node := &api.Node{ObjectMeta: api.ObjectMeta{Name: "foo"}, Spec: api.NodeSpec{Taints: test.taints}}
+		out, err := taintFunc(node, test.overwrite, test.add, test.remove)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%d: unexpected non-error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if taints := out.(*api.Node).Spec.Taints; !reflect.DeepEqual(taints, test.expected) {
+			t.Errorf("%d: expected: %v, got %v", i, test.expected, taints)
+		}
+	}
+
+	if _, err := taintFunc(&api.Pod{}, false, []api.Taint{infra}, nil); err == nil {
+		t.Errorf("expected an error tainting a pod")
+	}
+}
+
+func TestTaintNode(t *testing.T) {
+	node := &api.Node{ObjectMeta: api.ObjectMeta{Name: "node1", ResourceVersion: "10"}}
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/nodes/node1" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, node)}, nil
+			case p == "/nodes/node1" && m == "PUT":
+				return &http.Response{StatusCode: 200, Body: req.Body}, nil
+			default:
+				t.Fatalf("unexpected request: %s %#v\n%#v", m, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.ClientConfig = &client.Config{Version: testapi.Version()}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdTaint(f, buf)
+	if err := RunTaint(f, buf, cmd, []string{"nodes", "node1", "dedicated=infra:NoSchedule"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	objects := tf.Printer.(*testPrinter).Objects
+	if len(objects) != 1 {
+		t.Fatalf("expected one printed object, got %v", objects)
+	}
+	expected := []api.Taint{{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}}
+	if taints := objects[0].(*api.Node).Spec.Taints; !reflect.DeepEqual(taints, expected) {
+		t.Errorf("expected taints %v, got %v", expected, taints)
+	}
+}
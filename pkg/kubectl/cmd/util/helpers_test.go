@@ -147,8 +147,9 @@ func TestMerge(t *testing.T) {
 			fragment: fmt.Sprintf(`{ "apiVersion": "%s", "spec": { "ports": [ { "port": 0 } ] } }`, testapi.Version()),
 			expected: &api.Service{
 				Spec: api.ServiceSpec{
-					SessionAffinity: "None",
-					Type:            api.ServiceTypeClusterIP,
+					SessionAffinity:     "None",
+					Type:                api.ServiceTypeClusterIP,
+					LoadBalancingPolicy: api.ServiceLoadBalancingRoundRobin,
 					Ports: []api.ServicePort{
 						{
 							Protocol: api.ProtocolTCP,
@@ -170,8 +171,9 @@ func TestMerge(t *testing.T) {
 			fragment: fmt.Sprintf(`{ "apiVersion": "%s", "spec": { "selector": { "version": "v2" } } }`, testapi.Version()),
 			expected: &api.Service{
 				Spec: api.ServiceSpec{
-					SessionAffinity: "None",
-					Type:            api.ServiceTypeClusterIP,
+					SessionAffinity:     "None",
+					Type:                api.ServiceTypeClusterIP,
+					LoadBalancingPolicy: api.ServiceLoadBalancingRoundRobin,
 					Selector: map[string]string{
 						"version": "v2",
 					},
@@ -32,6 +32,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
 	"github.com/golang/glog"
 )
 
@@ -64,18 +65,22 @@ func (e ErrNoDescriber) Error() string {
 
 func describerMap(c *client.Client) map[string]Describer {
 	m := map[string]Describer{
-		"Pod": &PodDescriber{c},
-		"ReplicationController": &ReplicationControllerDescriber{c},
-		"Secret":                &SecretDescriber{c},
-		"Service":               &ServiceDescriber{c},
-		"ServiceAccount":        &ServiceAccountDescriber{c},
-		"Minion":                &NodeDescriber{c},
-		"Node":                  &NodeDescriber{c},
-		"LimitRange":            &LimitRangeDescriber{c},
-		"ResourceQuota":         &ResourceQuotaDescriber{c},
-		"PersistentVolume":      &PersistentVolumeDescriber{c},
-		"PersistentVolumeClaim": &PersistentVolumeClaimDescriber{c},
-		"Namespace":             &NamespaceDescriber{c},
+		"Pod":                     &PodDescriber{c},
+		"ReplicationController":   &ReplicationControllerDescriber{c},
+		"Deployment":              &DeploymentDescriber{c},
+		"DaemonSet":               &DaemonSetDescriber{c},
+		"Job":                     &JobDescriber{c},
+		"HorizontalPodAutoscaler": &HorizontalPodAutoscalerDescriber{c},
+		"Secret":                  &SecretDescriber{c},
+		"Service":                 &ServiceDescriber{c},
+		"ServiceAccount":          &ServiceAccountDescriber{c},
+		"Minion":                  &NodeDescriber{c},
+		"Node":                    &NodeDescriber{c},
+		"LimitRange":              &LimitRangeDescriber{c},
+		"ResourceQuota":           &ResourceQuotaDescriber{c},
+		"PersistentVolume":        &PersistentVolumeDescriber{c},
+		"PersistentVolumeClaim":   &PersistentVolumeClaimDescriber{c},
+		"Namespace":               &NamespaceDescriber{c},
 	}
 	return m
 }
@@ -271,13 +276,15 @@ func describeLimitRange(limitRange *api.LimitRange) (string, error) {
 	return tabbedString(func(out io.Writer) error {
 		fmt.Fprintf(out, "Name:\t%s\n", limitRange.Name)
 		fmt.Fprintf(out, "Namespace:\t%s\n", limitRange.Namespace)
-		fmt.Fprintf(out, "Type\tResource\tMin\tMax\tDefault\n")
-		fmt.Fprintf(out, "----\t--------\t---\t---\t---\n")
+		fmt.Fprintf(out, "Type\tResource\tMin\tMax\tDefault Request\tDefault Limit\tMax Limit/Request Ratio\n")
+		fmt.Fprintf(out, "----\t--------\t---\t---\t---------------\t-------------\t-----------------------\n")
 		for i := range limitRange.Spec.Limits {
 			item := limitRange.Spec.Limits[i]
 			maxResources := item.Max
 			minResources := item.Min
 			defaultResources := item.Default
+			defaultRequestResources := item.DefaultRequest
+			ratioResources := item.MaxLimitRequestRatio
 
 			set := map[api.ResourceName]bool{}
 			for k := range maxResources {
@@ -289,12 +296,20 @@ func describeLimitRange(limitRange *api.LimitRange) (string, error) {
 			for k := range defaultResources {
 				set[k] = true
 			}
+			for k := range defaultRequestResources {
+				set[k] = true
+			}
+			for k := range ratioResources {
+				set[k] = true
+			}
 
 			for k := range set {
 				// if no value is set, we output -
 				maxValue := "-"
 				minValue := "-"
 				defaultValue := "-"
+				defaultRequestValue := "-"
+				ratioValue := "-"
 
 				maxQuantity, maxQuantityFound := maxResources[k]
 				if maxQuantityFound {
@@ -311,8 +326,18 @@ func describeLimitRange(limitRange *api.LimitRange) (string, error) {
 					defaultValue = defaultQuantity.String()
 				}
 
-				msg := "%v\t%v\t%v\t%v\t%v\n"
-				fmt.Fprintf(out, msg, item.Type, k, minValue, maxValue, defaultValue)
+				defaultRequestQuantity, defaultRequestQuantityFound := defaultRequestResources[k]
+				if defaultRequestQuantityFound {
+					defaultRequestValue = defaultRequestQuantity.String()
+				}
+
+				ratioQuantity, ratioQuantityFound := ratioResources[k]
+				if ratioQuantityFound {
+					ratioValue = ratioQuantity.String()
+				}
+
+				msg := "%v\t%v\t%v\t%v\t%v\t%v\t%v\n"
+				fmt.Fprintf(out, msg, item.Type, k, minValue, maxValue, defaultRequestValue, defaultValue, ratioValue)
 			}
 		}
 		return nil
@@ -407,14 +432,19 @@ func describePod(pod *api.Pod, rcs []api.ReplicationController, events *api.Even
 		fmt.Fprintf(out, "Namespace:\t%s\n", pod.Namespace)
 		fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&pod.Spec))
 		fmt.Fprintf(out, "Node:\t%s\n", pod.Spec.NodeName+"/"+pod.Status.HostIP)
+		fmt.Fprintf(out, "Priority:\t%d\n", pod.Spec.Priority)
 		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(pod.Labels))
 		fmt.Fprintf(out, "Status:\t%s\n", string(pod.Status.Phase))
 		fmt.Fprintf(out, "Reason:\t%s\n", pod.Status.Reason)
 		fmt.Fprintf(out, "Message:\t%s\n", pod.Status.Message)
 		fmt.Fprintf(out, "IP:\t%s\n", pod.Status.PodIP)
 		fmt.Fprintf(out, "Replication Controllers:\t%s\n", printReplicationControllersByLabels(rcs))
+		if len(pod.Spec.InitContainers) > 0 {
+			fmt.Fprintf(out, "Init Containers:\n")
+			describeContainers(pod.Spec.InitContainers, pod.Status.InitContainerStatuses, out)
+		}
 		fmt.Fprintf(out, "Containers:\n")
-		describeContainers(pod, out)
+		describeContainers(pod.Spec.Containers, pod.Status.ContainerStatuses, out)
 		if len(pod.Status.Conditions) > 0 {
 			fmt.Fprint(out, "Conditions:\n  Type\tStatus\n")
 			for _, c := range pod.Status.Conditions {
@@ -423,6 +453,9 @@ func describePod(pod *api.Pod, rcs []api.ReplicationController, events *api.Even
 					c.Status)
 			}
 		}
+		if len(pod.Spec.NodeName) == 0 && events != nil {
+			describeSchedulingFailures(events, out)
+		}
 		if events != nil {
 			DescribeEvents(events, out)
 		}
@@ -430,6 +463,42 @@ func describePod(pod *api.Pod, rcs []api.ReplicationController, events *api.Even
 	})
 }
 
+// maxSchedulingFailureReasons bounds the reasons shown for a pending pod.
+const maxSchedulingFailureReasons = 3
+
+// describeSchedulingFailures summarizes why the scheduler last failed to place
+// a pod.  Its failedScheduling events for pods that fit nowhere carry an
+// algorithm.FitFailure counting the nodes each predicate failed on, most
+// common first; other failures are shown as is.
+func describeSchedulingFailures(events *api.EventList, out io.Writer) {
+	var latest *api.Event
+	for i := range events.Items {
+		event := &events.Items[i]
+		if event.Reason != "failedScheduling" {
+			continue
+		}
+		if latest == nil || latest.LastTimestamp.Before(event.LastTimestamp) {
+			latest = event
+		}
+	}
+	if latest == nil {
+		return
+	}
+	failure, ok := algorithm.ParseFitFailure(latest.Message)
+	if !ok {
+		fmt.Fprintf(out, "Scheduling Failures:\t%s\n", latest.Message)
+		return
+	}
+	fmt.Fprintf(out, "Scheduling Failures:\t%s\n", failure.Summary())
+	for i, predicate := range failure.Predicates {
+		if i == maxSchedulingFailureReasons {
+			fmt.Fprintf(out, "  ...and %d more\n", len(failure.Predicates)-i)
+			break
+		}
+		fmt.Fprintf(out, "  %s\n", predicate)
+	}
+}
+
 type PersistentVolumeDescriber struct {
 	client.Interface
 }
@@ -479,13 +548,13 @@ func (d *PersistentVolumeClaimDescriber) Describe(namespace, name string) (strin
 	})
 }
 
-func describeContainers(pod *api.Pod, out io.Writer) {
+func describeContainers(containers []api.Container, containerStatuses []api.ContainerStatus, out io.Writer) {
 	statuses := map[string]api.ContainerStatus{}
-	for _, status := range pod.Status.ContainerStatuses {
+	for _, status := range containerStatuses {
 		statuses[status.Name] = status
 	}
 
-	for _, container := range pod.Spec.Containers {
+	for _, container := range containers {
 		status := statuses[container.Name]
 		state := status.State
 
@@ -499,6 +568,13 @@ func describeContainers(pod *api.Pod, out io.Writer) {
 			fmt.Fprintf(out, "      %s:\t%s\n", name, quantity.String())
 		}
 
+		if len(container.Resources.Requests) > 0 {
+			fmt.Fprintf(out, "    Requests:\n")
+		}
+		for name, quantity := range container.Resources.Requests {
+			fmt.Fprintf(out, "      %s:\t%s\n", name, quantity.String())
+		}
+
 		switch {
 		case state.Running != nil:
 			fmt.Fprintf(out, "    State:\tRunning\n")
@@ -584,6 +660,198 @@ func describeReplicationController(controller *api.ReplicationController, events
 	})
 }
 
+// DeploymentDescriber generates information about a deployment.
+type DeploymentDescriber struct {
+	client.Interface
+}
+
+func (d *DeploymentDescriber) Describe(namespace, name string) (string, error) {
+	deployment, err := d.Deployments(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(deployment)
+
+	return describeDeployment(deployment, events)
+}
+
+func describeDeployment(deployment *api.Deployment, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", deployment.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", deployment.Namespace)
+		if deployment.Spec.Template != nil {
+			fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&deployment.Spec.Template.Spec))
+		} else {
+			fmt.Fprintf(out, "Image(s):\t%s\n", "<no template>")
+		}
+		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(deployment.Spec.Selector))
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(deployment.Labels))
+		fmt.Fprintf(out, "Replicas:\t%d updated / %d total / %d desired\n", deployment.Status.UpdatedReplicas, deployment.Status.Replicas, deployment.Spec.Replicas)
+		fmt.Fprintf(out, "Revision:\t%d\n", deployment.Status.Revision)
+		fmt.Fprintf(out, "Strategy:\t%s\n", deployment.Spec.Strategy.Type)
+		if ru := deployment.Spec.Strategy.RollingUpdate; ru != nil {
+			fmt.Fprintf(out, "RollingUpdate:\t%s max unavailable, %s max surge\n", ru.MaxUnavailable.String(), ru.MaxSurge.String())
+		}
+		if events != nil {
+			DescribeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+// DaemonSetDescriber generates information about a daemon set and the pods it has created.
+type DaemonSetDescriber struct {
+	client.Interface
+}
+
+func (d *DaemonSetDescriber) Describe(namespace, name string) (string, error) {
+	ds, err := d.DaemonSets(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	running, waiting, succeeded, failed, err := getPodStatusForSelector(d.Pods(namespace), ds.Spec.Selector)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(ds)
+
+	return describeDaemonSet(ds, events, running, waiting, succeeded, failed)
+}
+
+func describeDaemonSet(ds *api.DaemonSet, events *api.EventList, running, waiting, succeeded, failed int) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", ds.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", ds.Namespace)
+		if ds.Spec.Template != nil {
+			fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&ds.Spec.Template.Spec))
+			fmt.Fprintf(out, "Node-Selector:\t%s\n", formatLabels(ds.Spec.Template.Spec.NodeSelector))
+		} else {
+			fmt.Fprintf(out, "Image(s):\t%s\n", "<no template>")
+		}
+		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(ds.Spec.Selector))
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(ds.Labels))
+		fmt.Fprintf(out, "Desired Number of Nodes Scheduled:\t%d\n", ds.Status.DesiredNumberScheduled)
+		fmt.Fprintf(out, "Current Number of Nodes Scheduled:\t%d\n", ds.Status.CurrentNumberScheduled)
+		fmt.Fprintf(out, "Number of Nodes Misscheduled:\t%d\n", ds.Status.NumberMisscheduled)
+		fmt.Fprintf(out, "Pods Status:\t%d Running / %d Waiting / %d Succeeded / %d Failed\n", running, waiting, succeeded, failed)
+		if events != nil {
+			DescribeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+// JobDescriber generates information about a job and the pods it has created.
+type JobDescriber struct {
+	client.Interface
+}
+
+func (d *JobDescriber) Describe(namespace, name string) (string, error) {
+	job, err := d.Jobs(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(job)
+
+	return describeJob(job, events)
+}
+
+func describeJob(job *api.Job, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", job.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", job.Namespace)
+		if job.Spec.Template != nil {
+			fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&job.Spec.Template.Spec))
+		} else {
+			fmt.Fprintf(out, "Image(s):\t%s\n", "<no template>")
+		}
+		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(job.Spec.Selector))
+		if job.Spec.Parallelism != nil {
+			fmt.Fprintf(out, "Parallelism:\t%d\n", *job.Spec.Parallelism)
+		}
+		if job.Spec.Completions != nil {
+			fmt.Fprintf(out, "Completions:\t%d\n", *job.Spec.Completions)
+		}
+		if job.Spec.ActiveDeadlineSeconds != nil {
+			fmt.Fprintf(out, "Active Deadline Seconds:\t%ds\n", *job.Spec.ActiveDeadlineSeconds)
+		}
+		if job.Status.StartTime != nil {
+			fmt.Fprintf(out, "Start Time:\t%s\n", job.Status.StartTime.Time.Format(time.RFC1123Z))
+		}
+		if job.Status.CompletionTime != nil {
+			fmt.Fprintf(out, "Completion Time:\t%s\n", job.Status.CompletionTime.Time.Format(time.RFC1123Z))
+		}
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(job.Labels))
+		fmt.Fprintf(out, "Pods Statuses:\t%d Active / %d Succeeded / %d Failed\n", job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+		if len(job.Status.Conditions) > 0 {
+			fmt.Fprint(out, "Conditions:\n  Type\tStatus\tReason\tMessage\n")
+			for _, c := range job.Status.Conditions {
+				fmt.Fprintf(out, "  %v \t%v \t%v \t%v\n",
+					c.Type,
+					c.Status,
+					c.Reason,
+					c.Message)
+			}
+		}
+		if events != nil {
+			DescribeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+// HorizontalPodAutoscalerDescriber generates information about a horizontal
+// pod autoscaler and its most recent scaling decisions.
+type HorizontalPodAutoscalerDescriber struct {
+	client.Interface
+}
+
+func (d *HorizontalPodAutoscalerDescriber) Describe(namespace, name string) (string, error) {
+	hpa, err := d.HorizontalPodAutoscalers(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(hpa)
+
+	return describeHorizontalPodAutoscaler(hpa, events)
+}
+
+func describeHorizontalPodAutoscaler(hpa *api.HorizontalPodAutoscaler, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", hpa.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", hpa.Namespace)
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(hpa.Labels))
+		fmt.Fprintf(out, "CreationTimestamp:\t%s\n", hpa.CreationTimestamp.Time.Format(time.RFC1123Z))
+		fmt.Fprintf(out, "Reference:\t%s/%s\n", hpa.Spec.ScaleRef.Kind, hpa.Spec.ScaleRef.Name)
+		if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+			fmt.Fprintf(out, "Target CPU utilization:\t%d%%\n", *hpa.Spec.TargetCPUUtilizationPercentage)
+		}
+		fmt.Fprintf(out, "Current CPU utilization:\t")
+		if hpa.Status.CurrentCPUUtilizationPercentage != nil {
+			fmt.Fprintf(out, "%d%%\n", *hpa.Status.CurrentCPUUtilizationPercentage)
+		} else {
+			fmt.Fprintf(out, "<not available>\n")
+		}
+		if hpa.Spec.MinReplicas != nil {
+			fmt.Fprintf(out, "Min replicas:\t%d\n", *hpa.Spec.MinReplicas)
+		}
+		fmt.Fprintf(out, "Max replicas:\t%d\n", hpa.Spec.MaxReplicas)
+		fmt.Fprintf(out, "Replicas:\t%d current / %d desired\n", hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas)
+		if hpa.Status.LastScaleTime != nil {
+			fmt.Fprintf(out, "Last Scale Time:\t%s\n", hpa.Status.LastScaleTime.Time.Format(time.RFC1123Z))
+		}
+		if events != nil {
+			DescribeEvents(events, out)
+		}
+		return nil
+	})
+}
+
 // SecretDescriber generates information about a secret
 type SecretDescriber struct {
 	client.Interface
@@ -687,6 +955,9 @@ func describeService(service *api.Service, endpoints *api.Endpoints, events *api
 			fmt.Fprintf(out, "Endpoints:\t%s\n", formatEndpoints(endpoints, util.NewStringSet(sp.Name)))
 		}
 		fmt.Fprintf(out, "Session Affinity:\t%s\n", service.Spec.SessionAffinity)
+		if service.Spec.LoadBalancingPolicy != "" {
+			fmt.Fprintf(out, "Load Balancing Policy:\t%s\n", service.Spec.LoadBalancingPolicy)
+		}
 		if events != nil {
 			DescribeEvents(events, out)
 		}
@@ -793,10 +1064,27 @@ func (d *NodeDescriber) Describe(namespace, name string) (string, error) {
 	return describeNode(node, pods, events)
 }
 
+// formatTaints prints taints as key=value:effect, omitting empty values.
+func formatTaints(taints []api.Taint) string {
+	if len(taints) == 0 {
+		return "<none>"
+	}
+	list := []string{}
+	for _, taint := range taints {
+		if len(taint.Value) == 0 {
+			list = append(list, fmt.Sprintf("%s:%s", taint.Key, taint.Effect))
+		} else {
+			list = append(list, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+		}
+	}
+	return strings.Join(list, ",")
+}
+
 func describeNode(node *api.Node, pods []*api.Pod, events *api.EventList) (string, error) {
 	return tabbedString(func(out io.Writer) error {
 		fmt.Fprintf(out, "Name:\t%s\n", node.Name)
 		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(node.Labels))
+		fmt.Fprintf(out, "Taints:\t%s\n", formatTaints(node.Spec.Taints))
 		fmt.Fprintf(out, "CreationTimestamp:\t%s\n", node.CreationTimestamp.Time.Format(time.RFC1123Z))
 		if len(node.Status.Conditions) > 0 {
 			fmt.Fprint(out, "Conditions:\n  Type\tStatus\tLastHeartbeatTime\tLastTransitionTime\tReason\tMessage\n")
@@ -904,11 +1192,16 @@ func printReplicationControllersByLabels(matchingRCs []api.ReplicationController
 }
 
 func getPodStatusForReplicationController(c client.PodInterface, controller *api.ReplicationController) (running, waiting, succeeded, failed int, err error) {
-	rcPods, err := c.List(labels.SelectorFromSet(controller.Spec.Selector), fields.Everything())
+	return getPodStatusForSelector(c, controller.Spec.Selector)
+}
+
+// getPodStatusForSelector counts the pods matching the given selector by phase.
+func getPodStatusForSelector(c client.PodInterface, selector map[string]string) (running, waiting, succeeded, failed int, err error) {
+	pods, err := c.List(labels.SelectorFromSet(selector), fields.Everything())
 	if err != nil {
 		return
 	}
-	for _, pod := range rcPods.Items {
+	for _, pod := range pods.Items {
 		switch pod.Status.Phase {
 		case api.PodRunning:
 			running++
@@ -29,6 +29,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
 )
 
 type describeClient struct {
@@ -78,6 +79,117 @@ func TestDescribeService(t *testing.T) {
 	}
 }
 
+func TestDescribeDeployment(t *testing.T) {
+	fake := testclient.NewSimpleFake(&api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "bar",
+			Namespace: "foo",
+		},
+		Spec: api.DeploymentSpec{
+			Replicas: 3,
+			Strategy: api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType},
+		},
+		Status: api.DeploymentStatus{Revision: 2},
+	})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := DeploymentDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "bar") || !strings.Contains(out, "Revision:") || !strings.Contains(out, "Recreate") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
+func TestDescribeDaemonSet(t *testing.T) {
+	fake := testclient.NewSimpleFake(&api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "bar",
+			Namespace: "foo",
+		},
+		Spec: api.DaemonSetSpec{
+			Selector: map[string]string{"name": "bar"},
+			Template: &api.PodTemplateSpec{
+				Spec: api.PodSpec{NodeSelector: map[string]string{"role": "logging"}},
+			},
+		},
+		Status: api.DaemonSetStatus{DesiredNumberScheduled: 3, CurrentNumberScheduled: 2},
+	})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := DaemonSetDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "bar") || !strings.Contains(out, "role=logging") || !strings.Contains(out, "Misscheduled:") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
+func TestDescribeJob(t *testing.T) {
+	completions := 5
+	fake := testclient.NewSimpleFake(&api.Job{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "bar",
+			Namespace: "foo",
+		},
+		Spec: api.JobSpec{
+			Completions: &completions,
+			Selector:    map[string]string{"name": "bar"},
+		},
+		Status: api.JobStatus{
+			Active:     1,
+			Succeeded:  3,
+			Failed:     2,
+			Conditions: []api.JobCondition{{Type: api.JobFailed, Status: api.ConditionTrue, Reason: "DeadlineExceeded"}},
+		},
+	})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := JobDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "Completions:") ||
+		!strings.Contains(out, "1 Active / 3 Succeeded / 2 Failed") ||
+		!strings.Contains(out, "DeadlineExceeded") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
+func TestDescribeHorizontalPodAutoscaler(t *testing.T) {
+	minReplicas, target, current := 2, 50, 70
+	fake := testclient.NewSimpleFake(&api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "bar",
+			Namespace: "foo",
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ScaleTargetReference{Kind: "ReplicationController", Name: "frontend"},
+			MinReplicas:                    &minReplicas,
+			MaxReplicas:                    10,
+			TargetCPUUtilizationPercentage: &target,
+		},
+		Status: api.HorizontalPodAutoscalerStatus{
+			CurrentReplicas:                 3,
+			DesiredReplicas:                 5,
+			CurrentCPUUtilizationPercentage: &current,
+		},
+	})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := HorizontalPodAutoscalerDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "ReplicationController/frontend") ||
+		!strings.Contains(out, "70%") ||
+		!strings.Contains(out, "3 current / 5 desired") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
 func TestPodDescribeResultsSorted(t *testing.T) {
 	// Arrange
 	fake := testclient.NewSimpleFake(&api.EventList{
@@ -118,6 +230,74 @@ func TestPodDescribeResultsSorted(t *testing.T) {
 	VerifyDatesInOrder(out, "\n" /* rowDelimiter */, "\t" /* columnDelimiter */, t)
 }
 
+func TestDescribeSchedulingFailures(t *testing.T) {
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "foo"}}
+	events := &api.EventList{
+		Items: []api.Event{
+			{
+				Reason:        "failedScheduling",
+				Message:       "pod (bar) failed to fit on 1 node(s): PodFitsPorts (1)",
+				LastTimestamp: util.NewTime(time.Date(2015, time.January, 15, 0, 0, 0, 0, time.UTC)),
+			},
+			{
+				Reason: "failedScheduling",
+				Message: (&algorithm.FitFailure{
+					Pod:   "bar",
+					Nodes: 9,
+					Predicates: []algorithm.PredicateFailure{
+						{Predicate: "PodFitsResources", Nodes: 4},
+						{Predicate: "MatchNodeSelector", Nodes: 2},
+						{Predicate: "NoDiskConflict", Nodes: 1},
+						{Predicate: "PodFitsPorts", Nodes: 1},
+						{Predicate: "PodToleratesNodeTaints", Nodes: 1},
+					},
+				}).String(),
+				LastTimestamp: util.NewTime(time.Date(2015, time.January, 16, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+	}
+	out, err := describePod(pod, nil, events)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, expected := range []string{"pod (bar) failed to fit on 9 node(s)", "PodFitsResources (4)", "NoDiskConflict (1)", "...and 2 more"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in output: %s", expected, out)
+		}
+	}
+	if strings.Contains(out, "  PodToleratesNodeTaints (1)\n") {
+		t.Errorf("expected reasons beyond the first %d to be elided: %s", maxSchedulingFailureReasons, out)
+	}
+
+	events.Items = append(events.Items, api.Event{
+		Reason:        "failedScheduling",
+		Message:       "Binding rejected: conflict",
+		LastTimestamp: util.NewTime(time.Date(2015, time.January, 17, 0, 0, 0, 0, time.UTC)),
+	})
+	out, err = describePod(pod, nil, events)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	found := false
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "Scheduling Failures:") {
+			found = strings.TrimSpace(strings.TrimPrefix(line, "Scheduling Failures:")) == "Binding rejected: conflict"
+		}
+	}
+	if !found {
+		t.Errorf("expected other scheduling failures to be shown as is: %s", out)
+	}
+
+	pod.Spec.NodeName = "machine"
+	out, err = describePod(pod, nil, events)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(out, "Scheduling Failures:") {
+		t.Errorf("unexpected scheduling failures for a scheduled pod: %s", out)
+	}
+}
+
 func TestDescribeContainers(t *testing.T) {
 	testCases := []struct {
 		container        api.Container
@@ -214,7 +394,7 @@ func TestDescribeContainers(t *testing.T) {
 				ContainerStatuses: []api.ContainerStatus{testCase.status},
 			},
 		}
-		describeContainers(&pod, out)
+		describeContainers(pod.Spec.Containers, pod.Status.ContainerStatuses, out)
 		output := out.String()
 		for _, expected := range testCase.expectedElements {
 			if !strings.Contains(output, expected) {
@@ -104,8 +104,10 @@ func expandResourceShortcut(resource string) string {
 	shortForms := map[string]string{
 		// Please keep this alphabetized
 		"cs":     "componentstatuses",
+		"ds":     "daemonsets",
 		"ev":     "events",
 		"ep":     "endpoints",
+		"hpa":    "horizontalpodautoscalers",
 		"limits": "limitRanges",
 		"no":     "nodes",
 		"po":     "pods",
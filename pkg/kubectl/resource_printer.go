@@ -259,6 +259,10 @@ func (h *HumanReadablePrinter) HandledResources() []string {
 var podColumns = []string{"NAME", "READY", "STATUS", "RESTARTS", "AGE"}
 var podTemplateColumns = []string{"TEMPLATE", "CONTAINER(S)", "IMAGE(S)", "PODLABELS"}
 var replicationControllerColumns = []string{"CONTROLLER", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "REPLICAS"}
+var deploymentColumns = []string{"NAME", "UPDATEDREPLICAS", "REPLICAS", "REVISION", "STRATEGY"}
+var daemonSetColumns = []string{"NAME", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "NODE-SELECTOR"}
+var jobColumns = []string{"JOB", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "SUCCESSFUL"}
+var horizontalPodAutoscalerColumns = []string{"NAME", "REFERENCE", "TARGET", "CURRENT", "MINPODS", "MAXPODS", "AGE"}
 var serviceColumns = []string{"NAME", "LABELS", "SELECTOR", "IP(S)", "PORT(S)"}
 var endpointColumns = []string{"NAME", "ENDPOINTS"}
 var nodeColumns = []string{"NAME", "LABELS", "STATUS"}
@@ -271,6 +275,8 @@ var serviceAccountColumns = []string{"NAME", "SECRETS"}
 var persistentVolumeColumns = []string{"NAME", "LABELS", "CAPACITY", "ACCESSMODES", "STATUS", "CLAIM", "REASON"}
 var persistentVolumeClaimColumns = []string{"NAME", "LABELS", "STATUS", "VOLUME"}
 var componentStatusColumns = []string{"NAME", "STATUS", "MESSAGE", "ERROR"}
+var roleColumns = []string{"NAME", "RULES"}
+var roleBindingColumns = []string{"NAME", "ROLE", "SUBJECTS"}
 var withNamespacePrefixColumns = []string{"NAMESPACE"} // TODO(erictune): print cluster name too.
 
 // addDefaultHandlers adds print handlers for default Kubernetes types.
@@ -281,6 +287,14 @@ func (h *HumanReadablePrinter) addDefaultHandlers() {
 	h.Handler(podTemplateColumns, printPodTemplateList)
 	h.Handler(replicationControllerColumns, printReplicationController)
 	h.Handler(replicationControllerColumns, printReplicationControllerList)
+	h.Handler(deploymentColumns, printDeployment)
+	h.Handler(deploymentColumns, printDeploymentList)
+	h.Handler(daemonSetColumns, printDaemonSet)
+	h.Handler(daemonSetColumns, printDaemonSetList)
+	h.Handler(jobColumns, printJob)
+	h.Handler(jobColumns, printJobList)
+	h.Handler(horizontalPodAutoscalerColumns, printHorizontalPodAutoscaler)
+	h.Handler(horizontalPodAutoscalerColumns, printHorizontalPodAutoscalerList)
 	h.Handler(serviceColumns, printService)
 	h.Handler(serviceColumns, printServiceList)
 	h.Handler(endpointColumns, printEndpoints)
@@ -305,6 +319,14 @@ func (h *HumanReadablePrinter) addDefaultHandlers() {
 	h.Handler(persistentVolumeColumns, printPersistentVolumeList)
 	h.Handler(componentStatusColumns, printComponentStatus)
 	h.Handler(componentStatusColumns, printComponentStatusList)
+	h.Handler(roleColumns, printRole)
+	h.Handler(roleColumns, printRoleList)
+	h.Handler(roleColumns, printClusterRole)
+	h.Handler(roleColumns, printClusterRoleList)
+	h.Handler(roleBindingColumns, printRoleBinding)
+	h.Handler(roleBindingColumns, printRoleBindingList)
+	h.Handler(roleBindingColumns, printClusterRoleBinding)
+	h.Handler(roleBindingColumns, printClusterRoleBindingList)
 }
 
 func (h *HumanReadablePrinter) unknown(data []byte, w io.Writer) error {
@@ -556,6 +578,196 @@ func printReplicationControllerList(list *api.ReplicationControllerList, w io.Wr
 	return nil
 }
 
+func printDeployment(deployment *api.Deployment, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	if withNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", deployment.Namespace); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s",
+		deployment.Name,
+		deployment.Status.UpdatedReplicas,
+		deployment.Spec.Replicas,
+		deployment.Status.Revision,
+		deployment.Spec.Strategy.Type,
+	); err != nil {
+		return err
+	}
+	_, err := fmt.Fprint(w, appendLabels(deployment.Labels, columnLabels))
+	return err
+}
+
+func printDeploymentList(list *api.DeploymentList, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	for _, deployment := range list.Items {
+		if err := printDeployment(&deployment, w, withNamespace, wide, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDaemonSet(ds *api.DaemonSet, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	name := ds.Name
+	namespace := ds.Namespace
+
+	var containers []api.Container
+	var nodeSelector map[string]string
+	if ds.Spec.Template != nil {
+		containers = ds.Spec.Template.Spec.Containers
+		nodeSelector = ds.Spec.Template.Spec.NodeSelector
+	}
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+
+	if withNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", namespace); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s",
+		name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(ds.Spec.Selector),
+		formatLabels(nodeSelector),
+	); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprint(w, appendLabels(ds.Labels, columnLabels)); err != nil {
+		return err
+	}
+
+	// Lay out all the other containers on separate lines.
+	extraLinePrefix := "\t"
+	if withNamespace {
+		extraLinePrefix = "\t\t"
+	}
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s%s\t%s\t%s\t%s", extraLinePrefix, container.Name, container.Image, "", "")
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprint(w, appendLabelTabs(columnLabels)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDaemonSetList(list *api.DaemonSetList, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	for _, ds := range list.Items {
+		if err := printDaemonSet(&ds, w, withNamespace, wide, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printJob(job *api.Job, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	name := job.Name
+	namespace := job.Namespace
+
+	var containers []api.Container
+	if job.Spec.Template != nil {
+		containers = job.Spec.Template.Spec.Containers
+	}
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+
+	if withNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", namespace); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d",
+		name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(job.Spec.Selector),
+		job.Status.Succeeded,
+	); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprint(w, appendLabels(job.Labels, columnLabels)); err != nil {
+		return err
+	}
+
+	// Lay out all the other containers on separate lines.
+	extraLinePrefix := "\t"
+	if withNamespace {
+		extraLinePrefix = "\t\t"
+	}
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s%s\t%s\t%s\t%s", extraLinePrefix, container.Name, container.Image, "", "")
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprint(w, appendLabelTabs(columnLabels)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printJobList(list *api.JobList, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	for _, job := range list.Items {
+		if err := printJob(&job, w, withNamespace, wide, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printHorizontalPodAutoscaler(hpa *api.HorizontalPodAutoscaler, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	namespace := hpa.Namespace
+	name := hpa.Name
+	reference := fmt.Sprintf("%s/%s", hpa.Spec.ScaleRef.Kind, hpa.Spec.ScaleRef.Name)
+	target := "<unset>"
+	if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+		target = fmt.Sprintf("%d%%", *hpa.Spec.TargetCPUUtilizationPercentage)
+	}
+	current := "<waiting>"
+	if hpa.Status.CurrentCPUUtilizationPercentage != nil {
+		current = fmt.Sprintf("%d%%", *hpa.Status.CurrentCPUUtilizationPercentage)
+	}
+	minPods := "<unset>"
+	if hpa.Spec.MinReplicas != nil {
+		minPods = fmt.Sprintf("%d", *hpa.Spec.MinReplicas)
+	}
+
+	if withNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", namespace); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s",
+		name,
+		reference,
+		target,
+		current,
+		minPods,
+		hpa.Spec.MaxReplicas,
+		translateTimestamp(hpa.CreationTimestamp),
+	); err != nil {
+		return err
+	}
+	_, err := fmt.Fprint(w, appendLabels(hpa.Labels, columnLabels))
+	return err
+}
+
+func printHorizontalPodAutoscalerList(list *api.HorizontalPodAutoscalerList, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	for i := range list.Items {
+		if err := printHorizontalPodAutoscaler(&list.Items[i], w, withNamespace, wide, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printService(svc *api.Service, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
 	name := svc.Name
 	namespace := svc.Namespace
@@ -717,6 +929,103 @@ func printServiceAccountList(list *api.ServiceAccountList, w io.Writer, withName
 	return nil
 }
 
+func printRole(item *api.Role, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	if withNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", item.Namespace); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "%s\t%d", item.Name, len(item.Rules)); err != nil {
+		return err
+	}
+	_, err := fmt.Fprint(w, appendLabels(item.Labels, columnLabels))
+	return err
+}
+
+func printRoleList(list *api.RoleList, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	for _, item := range list.Items {
+		if err := printRole(&item, w, withNamespace, wide, columnLabels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printClusterRole(item *api.ClusterRole, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	if withNamespace {
+		return fmt.Errorf("clusterRole is not namespaced")
+	}
+	if _, err := fmt.Fprintf(w, "%s\t%d", item.Name, len(item.Rules)); err != nil {
+		return err
+	}
+	_, err := fmt.Fprint(w, appendLabels(item.Labels, columnLabels))
+	return err
+}
+
+func printClusterRoleList(list *api.ClusterRoleList, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	for _, item := range list.Items {
+		if err := printClusterRole(&item, w, withNamespace, wide, columnLabels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatSubjects returns a comma separated list of kind/name pairs for the subjects of a role binding.
+func formatSubjects(subjects []api.ObjectReference) string {
+	list := []string{}
+	for _, subject := range subjects {
+		list = append(list, fmt.Sprintf("%s/%s", subject.Kind, subject.Name))
+	}
+	return strings.Join(list, ",")
+}
+
+func printRoleBinding(item *api.RoleBinding, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	if withNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", item.Namespace); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "%s\t%s/%s\t%s", item.Name, item.RoleRef.Kind, item.RoleRef.Name, formatSubjects(item.Subjects)); err != nil {
+		return err
+	}
+	_, err := fmt.Fprint(w, appendLabels(item.Labels, columnLabels))
+	return err
+}
+
+func printRoleBindingList(list *api.RoleBindingList, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	for _, item := range list.Items {
+		if err := printRoleBinding(&item, w, withNamespace, wide, columnLabels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printClusterRoleBinding(item *api.ClusterRoleBinding, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	if withNamespace {
+		return fmt.Errorf("clusterRoleBinding is not namespaced")
+	}
+	if _, err := fmt.Fprintf(w, "%s\t%s/%s\t%s", item.Name, item.RoleRef.Kind, item.RoleRef.Name, formatSubjects(item.Subjects)); err != nil {
+		return err
+	}
+	_, err := fmt.Fprint(w, appendLabels(item.Labels, columnLabels))
+	return err
+}
+
+func printClusterRoleBindingList(list *api.ClusterRoleBindingList, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
+	for _, item := range list.Items {
+		if err := printClusterRoleBinding(&item, w, withNamespace, wide, columnLabels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func printNode(node *api.Node, w io.Writer, withNamespace bool, wide bool, columnLabels []string) error {
 	if withNamespace {
 		return fmt.Errorf("node is not namespaced")
@@ -867,6 +867,58 @@ func TestPrintHumanReadableWithNamespace(t *testing.T) {
 			},
 			isNamespaced: true,
 		},
+		{
+			obj: &api.Deployment{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+			},
+			isNamespaced: true,
+		},
+		{
+			obj: &api.DaemonSet{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+			},
+			isNamespaced: true,
+		},
+		{
+			obj: &api.Job{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+			},
+			isNamespaced: true,
+		},
+		{
+			obj: &api.HorizontalPodAutoscaler{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+			},
+			isNamespaced: true,
+		},
+		{
+			obj: &api.Role{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+				Rules:      []api.PolicyRule{},
+			},
+			isNamespaced: true,
+		},
+		{
+			obj: &api.RoleBinding{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+				RoleRef:    api.ObjectReference{Kind: api.RoleKind, Name: "reader"},
+			},
+			isNamespaced: true,
+		},
+		{
+			obj: &api.ClusterRole{
+				ObjectMeta: api.ObjectMeta{Name: name},
+				Rules:      []api.PolicyRule{},
+			},
+			isNamespaced: false,
+		},
+		{
+			obj: &api.ClusterRoleBinding{
+				ObjectMeta: api.ObjectMeta{Name: name},
+				RoleRef:    api.ObjectReference{Kind: api.ClusterRoleKind, Name: "admin"},
+			},
+			isNamespaced: false,
+		},
 		{
 			obj: &api.Node{
 				ObjectMeta: api.ObjectMeta{Name: name},
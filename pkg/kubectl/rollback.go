@@ -0,0 +1,60 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+// Rollbacker provides an interface for resources that can be rolled back.
+type Rollbacker interface {
+	// Rollback asks the server to return the named resource to the given revision.
+	// A revision of 0 means the revision immediately preceding the current one.
+	Rollback(namespace, name string, toRevision int64) (string, error)
+}
+
+func RollbackerFor(kind string, c client.Interface) (Rollbacker, error) {
+	switch kind {
+	case "Deployment":
+		return &DeploymentRollbacker{c}, nil
+	}
+	return nil, fmt.Errorf("no rollbacker has been implemented for %q", kind)
+}
+
+type DeploymentRollbacker struct {
+	c client.Interface
+}
+
+// Rollback records the requested revision in the deployment's spec.rollbackTo
+// field; the deployment controller performs the actual rollback.
+func (r *DeploymentRollbacker) Rollback(namespace, name string, toRevision int64) (string, error) {
+	if toRevision < 0 {
+		return "", fmt.Errorf("revision must be non-negative, got %d", toRevision)
+	}
+	deployment, err := r.c.Deployments(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+	deployment.Spec.RollbackTo = &api.RollbackConfig{Revision: toRevision}
+	if _, err := r.c.Deployments(namespace).Update(deployment); err != nil {
+		return "", err
+	}
+	return "rolled back", nil
+}
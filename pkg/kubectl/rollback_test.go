@@ -0,0 +1,80 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+)
+
+func TestDeploymentRollback(t *testing.T) {
+	tests := []struct {
+		toRevision int64
+		expectErr  bool
+	}{
+		{toRevision: 0},
+		{toRevision: 3},
+		{toRevision: -1, expectErr: true},
+	}
+	for i, test := range tests {
+		fake := testclient.NewSimpleFake(&api.Deployment{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "default"},
+		})
+		rollbacker, err := RollbackerFor("Deployment", fake)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		s, err := rollbacker.Rollback("default", "foo", test.toRevision)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%d: expected an error", i)
+			}
+			if len(fake.Actions) != 0 {
+				t.Errorf("%d: unexpected actions: %v", i, fake.Actions)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if s != "rolled back" {
+			t.Errorf("%d: unexpected output: %s", i, s)
+		}
+		if len(fake.Actions) != 2 {
+			t.Fatalf("%d: unexpected actions: %v, expected 2 actions (get, update)", i, fake.Actions)
+		}
+		if fake.Actions[0].Action != testclient.GetDeploymentAction || fake.Actions[1].Action != testclient.UpdateDeploymentAction {
+			t.Errorf("%d: unexpected actions: %v", i, fake.Actions)
+		}
+		updated, ok := fake.Actions[1].Value.(*api.Deployment)
+		if !ok {
+			t.Fatalf("%d: unexpected update value: %#v", i, fake.Actions[1].Value)
+		}
+		if updated.Spec.RollbackTo == nil || updated.Spec.RollbackTo.Revision != test.toRevision {
+			t.Errorf("%d: expected rollbackTo revision %d, got %#v", i, test.toRevision, updated.Spec.RollbackTo)
+		}
+	}
+}
+
+func TestRollbackerForUnsupportedKind(t *testing.T) {
+	if _, err := RollbackerFor("Pod", testclient.NewSimpleFake()); err == nil {
+		t.Errorf("expected an error for an unsupported kind")
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import "sync"
+
+// LivenessManager maintains the liveness information (probe results) of
+// containers, as last determined by their liveness probe workers.
+// This manager is thread-safe, no locks are necessary for the caller.
+type LivenessManager struct {
+	// guards states
+	sync.RWMutex
+	states map[string]bool
+}
+
+// NewLivenessManager creates and returns a liveness manager with empty
+// contents.
+func NewLivenessManager() *LivenessManager {
+	return &LivenessManager{states: make(map[string]bool)}
+}
+
+// GetLiveness returns the liveness value for the container with the given ID.
+// If the liveness value is found, returns it.
+// If the liveness is not found, the container is assumed to be live and true
+// is returned.
+func (l *LivenessManager) GetLiveness(id string) bool {
+	l.RLock()
+	defer l.RUnlock()
+	state, found := l.states[id]
+	return state || !found
+}
+
+// SetLiveness sets the liveness value for the container with the given ID.
+func (l *LivenessManager) SetLiveness(id string, value bool) {
+	l.Lock()
+	defer l.Unlock()
+	l.states[id] = value
+}
+
+// RemoveLiveness clears the liveness value for the container with the given ID.
+func (l *LivenessManager) RemoveLiveness(id string) {
+	l.Lock()
+	defer l.Unlock()
+	delete(l.states, id)
+}
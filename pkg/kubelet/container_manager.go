@@ -18,6 +18,7 @@ package kubelet
 
 import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 )
 
 // Manages the containers running on a machine.
@@ -30,4 +31,40 @@ type containerManager interface {
 	// Returns resources allocated to system containers in the machine.
 	// These containers include the system and Kubernetes services.
 	SystemContainersLimit() api.ResourceList
+
+	// Creates the cgroups of the given pods under the cgroup of their QoS
+	// class, updates the CPU shares of the QoS cgroups and removes the
+	// cgroups of any other pod. Does nothing unless pod cgroups are enabled.
+	UpdatePodCgroups(pods []*api.Pod) error
+
+	// Returns the cgroup the containers of the pod should run in, or "" if
+	// pod cgroups are disabled.
+	PodCgroupParent(pod *api.Pod) string
+}
+
+// allocatableResources returns the resources of a node with the given
+// capacity that are left for pods once the given resources are reserved
+// for the system.
+func allocatableResources(capacity, reserved api.ResourceList) api.ResourceList {
+	allocatable := api.ResourceList{}
+	for name, quantity := range capacity {
+		reservation, found := reserved[name]
+		if !found {
+			allocatable[name] = quantity
+			continue
+		}
+		if name == api.ResourceCPU {
+			allocatable[name] = *resource.NewMilliQuantity(nonNegative(quantity.MilliValue()-reservation.MilliValue()), quantity.Format)
+		} else {
+			allocatable[name] = *resource.NewQuantity(nonNegative(quantity.Value()-reservation.Value()), quantity.Format)
+		}
+	}
+	return allocatable
+}
+
+func nonNegative(value int64) int64 {
+	if value < 0 {
+		return 0
+	}
+	return value
 }
@@ -72,6 +72,9 @@ func newSystemContainer(containerName string) *systemContainer {
 type containerManagerImpl struct {
 	// External containers being managed.
 	systemContainers []*systemContainer
+
+	// Manager of the cgroups of pods, nil if pods are not given their own cgroups.
+	podCgroups *podCgroupManager
 }
 
 var _ containerManager = &containerManagerImpl{}
@@ -79,7 +82,9 @@ var _ containerManager = &containerManagerImpl{}
 // TODO(vmarmol): Add limits to the system containers.
 // Takes the absolute name of the specified containers.
 // Empty container name disables use of the specified container.
-func newContainerManager(cadvisorInterface cadvisor.Interface, dockerDaemonContainerName, systemContainerName, kubeletContainerName string) (containerManager, error) {
+// If cgroupsPerQOS is set, pods are placed in cgroups under cgroupRoot grouped
+// by their QoS class, limited to the machine capacity minus systemReserved.
+func newContainerManager(cadvisorInterface cadvisor.Interface, dockerDaemonContainerName, systemContainerName, kubeletContainerName string, cgroupsPerQOS bool, cgroupRoot string, systemReserved api.ResourceList) (containerManager, error) {
 	systemContainers := []*systemContainer{}
 
 	if dockerDaemonContainerName != "" {
@@ -138,8 +143,22 @@ func newContainerManager(cadvisorInterface cadvisor.Interface, dockerDaemonConta
 
 	// TODO(vmarmol): Add Kube-proxy container.
 
+	var podCgroups *podCgroupManager
+	if cgroupsPerQOS {
+		info, err := cadvisorInterface.MachineInfo()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the machine capacity: %v", err)
+		}
+		allocatable := allocatableResources(CapacityFromMachineInfo(info), systemReserved)
+		podCgroups, err = newPodCgroupManager(cgroupRoot, allocatable)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &containerManagerImpl{
 		systemContainers: systemContainers,
+		podCgroups:       podCgroups,
 	}, nil
 }
 
@@ -194,6 +213,20 @@ func (cm *containerManagerImpl) SystemContainersLimit() api.ResourceList {
 	}
 }
 
+func (cm *containerManagerImpl) UpdatePodCgroups(pods []*api.Pod) error {
+	if cm.podCgroups == nil {
+		return nil
+	}
+	return cm.podCgroups.Update(pods)
+}
+
+func (cm *containerManagerImpl) PodCgroupParent(pod *api.Pod) string {
+	if cm.podCgroups == nil {
+		return ""
+	}
+	return cm.podCgroups.podCgroupName(pod)
+}
+
 // Ensures that the Docker daemon is in the desired container.
 func ensureDockerInContainer(cadvisor cadvisor.Interface, oomScoreAdj int, manager *fs.Manager) error {
 	// What container is Docker in?
@@ -0,0 +1,41 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocatableResources(t *testing.T) {
+	capacity := api.ResourceList{
+		api.ResourceCPU:    resource.MustParse("2"),
+		api.ResourceMemory: resource.MustParse("4Gi"),
+		api.ResourcePods:   resource.MustParse("40"),
+	}
+	reserved := api.ResourceList{
+		api.ResourceCPU:    resource.MustParse("500m"),
+		api.ResourceMemory: resource.MustParse("8Gi"),
+	}
+	allocatable := allocatableResources(capacity, reserved)
+	assert.Equal(t, int64(1500), allocatable.Cpu().MilliValue())
+	assert.Equal(t, int64(0), allocatable.Memory().Value(), "allocatable memory should not be negative")
+	assert.Equal(t, int64(40), allocatable.Pods().Value())
+}
@@ -38,6 +38,14 @@ func (unsupportedContainerManager) SystemContainersLimit() api.ResourceList {
 	return api.ResourceList{}
 }
 
-func newContainerManager(cadvisorInterface cadvisor.Interface, dockerDaemonContainer, systemContainer, kubeletContainer string) (containerManager, error) {
+func (unsupportedContainerManager) UpdatePodCgroups(pods []*api.Pod) error {
+	return nil
+}
+
+func (unsupportedContainerManager) PodCgroupParent(pod *api.Pod) string {
+	return ""
+}
+
+func newContainerManager(cadvisorInterface cadvisor.Interface, dockerDaemonContainer, systemContainer, kubeletContainer string, cgroupsPerQOS bool, cgroupRoot string, systemReserved api.ResourceList) (containerManager, error) {
 	return &unsupportedContainerManager{}, nil
 }
@@ -310,7 +310,8 @@ func ConnectToDockerOrDie(dockerEndpoint string) DockerInterface {
 	return client
 }
 
-func milliCPUToShares(milliCPU int64) int64 {
+// MilliCPUToShares converts a CPU amount in millicores to cgroup CPU shares.
+func MilliCPUToShares(milliCPU int64) int64 {
 	if milliCPU == 0 {
 		// Docker converts zero milliCPU to unset, which maps to kernel default
 		// for unset: 1024. Return 2 here to really match kernel default for
@@ -338,7 +338,7 @@ func (dm *DockerManager) GetPodStatus(pod *api.Pod) (*api.PodStatus, error) {
 
 	oldStatuses := make(map[string]api.ContainerStatus, len(pod.Spec.Containers))
 	lastObservedTime := make(map[string]util.Time, len(pod.Spec.Containers))
-	for _, status := range pod.Status.ContainerStatuses {
+	for _, status := range append(append([]api.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...) {
 		oldStatuses[status.Name] = status
 		if status.LastTerminationState.Terminated != nil {
 			lastObservedTime[status.Name] = status.LastTerminationState.Terminated.FinishedAt
@@ -348,8 +348,13 @@ func (dm *DockerManager) GetPodStatus(pod *api.Pod) (*api.PodStatus, error) {
 	var podStatus api.PodStatus
 	statuses := make(map[string]*api.ContainerStatus, len(pod.Spec.Containers))
 
+	allContainers := append(append([]api.Container{}, manifest.InitContainers...), manifest.Containers...)
+	initContainerNames := util.NewStringSet()
+	for _, container := range manifest.InitContainers {
+		initContainerNames.Insert(container.Name)
+	}
 	expectedContainers := make(map[string]api.Container)
-	for _, container := range manifest.Containers {
+	for _, container := range allContainers {
 		expectedContainers[container.Name] = container
 	}
 	expectedContainers[PodInfraContainerName] = api.Container{}
@@ -436,7 +441,7 @@ func (dm *DockerManager) GetPodStatus(pod *api.Pod) (*api.PodStatus, error) {
 
 	// Handle the containers for which we cannot find any associated active or
 	// dead docker containers.
-	for _, container := range manifest.Containers {
+	for _, container := range allContainers {
 		if _, found := statuses[container.Name]; found {
 			continue
 		}
@@ -448,6 +453,13 @@ func (dm *DockerManager) GetPodStatus(pod *api.Pod) (*api.PodStatus, error) {
 			// values if possible.
 			containerStatus.RestartCount = oldStatus.RestartCount
 			containerStatus.LastTerminationState = oldStatus.LastTerminationState
+			// An init container that ran to completion must not be run again
+			// just because its dead docker container was garbage collected.
+			if initContainerNames.Has(container.Name) && isSuccessfullyTerminated(&oldStatus) {
+				containerStatus.State = oldStatus.State
+				statuses[container.Name] = &containerStatus
+				continue
+			}
 		}
 		//Check image is ready on the node or not.
 		image := container.Image
@@ -473,12 +485,19 @@ func (dm *DockerManager) GetPodStatus(pod *api.Pod) (*api.PodStatus, error) {
 				status.State.Waiting.Reason = reason
 			}
 		}
+		if initContainerNames.Has(containerName) {
+			continue
+		}
 		podStatus.ContainerStatuses = append(podStatus.ContainerStatuses, *status)
 	}
 	// Sort the container statuses since clients of this interface expect the list
 	// of containers in a pod to behave like the output of `docker list`, which has a
 	// deterministic order.
 	sort.Sort(kubeletTypes.SortedContainerStatuses(podStatus.ContainerStatuses))
+	// Init container statuses are reported in the order the containers run.
+	for _, container := range manifest.InitContainers {
+		podStatus.InitContainerStatuses = append(podStatus.InitContainerStatuses, *statuses[container.Name])
+	}
 	return &podStatus, nil
 }
 
@@ -578,8 +597,15 @@ func (dm *DockerManager) runContainer(
 			labels[kubernetesContainerLabel] = container.Name
 		}
 	}
+	// The memory limit is enforced as a hard cap.  CPU shares are weighted by
+	// the request, and the CPU limit is not enforced: a container may use any
+	// idle CPU on the node, beyond its limit.
 	memoryLimit := container.Resources.Limits.Memory().Value()
-	cpuShares := milliCPUToShares(container.Resources.Limits.Cpu().MilliValue())
+	cpuRequest := container.Resources.Requests.Cpu()
+	if _, found := container.Resources.Requests[api.ResourceCPU]; !found {
+		cpuRequest = container.Resources.Limits.Cpu()
+	}
+	cpuShares := MilliCPUToShares(cpuRequest.MilliValue())
 	dockerOpts := docker.CreateContainerOptions{
 		Name: BuildDockerName(dockerName, container),
 		Config: &docker.Config{
@@ -1155,6 +1181,7 @@ func (dm *DockerManager) killContainer(containerID types.UID) error {
 		}
 	}
 	dm.readinessManager.RemoveReadiness(ID)
+	dm.prober.RemoveContainer(ID)
 	err = dm.client.StopContainer(ID, 10)
 	ref, ok := dm.containerRefManager.GetRef(ID)
 	if !ok {
@@ -1343,14 +1370,59 @@ func (dm *DockerManager) createPodInfraContainer(pod *api.Pod) (kubeletTypes.Doc
 // - containersToKeep stores mapping from dockerIDs of running containers to indices of their Specs for containers that
 //   should be kept running. If startInfraContainer is false then it contains an entry for infraContainerId (mapped to -1).
 //   It shouldn't be the case where containersToStart is empty and containersToKeep contains only infraContainerId. In such case
-//   Infra Container should be killed, hence it's removed from this map.
+//   Infra Container should be killed, hence it's removed from this map. A running init container is kept mapped to -1 as well.
+// - initContainerToStart is the next init container to run, if any. Containers are only added to containersToStart
+//   once every init container has run to completion.
 // - all running containers which are NOT contained in containersToKeep should be killed.
 type empty struct{}
 type PodContainerChangesSpec struct {
-	StartInfraContainer bool
-	InfraContainerId    kubeletTypes.DockerID
-	ContainersToStart   map[int]empty
-	ContainersToKeep    map[kubeletTypes.DockerID]int
+	StartInfraContainer  bool
+	InfraContainerId     kubeletTypes.DockerID
+	InitContainerToStart *api.Container
+	ContainersToStart    map[int]empty
+	ContainersToKeep     map[kubeletTypes.DockerID]int
+}
+
+// isSuccessfullyTerminated returns true if the container status shows the
+// container exited with code 0.
+func isSuccessfullyTerminated(status *api.ContainerStatus) bool {
+	return status.State.Terminated != nil && status.State.Terminated.ExitCode == 0
+}
+
+// computeInitContainerChanges walks the init containers of the pod in order and
+// returns the one that should be started next, or nil if none should. A running
+// init container is added to containersToKeep. The returned bool is true once
+// every init container has run to completion.
+func (dm *DockerManager) computeInitContainerChanges(pod *api.Pod, runningPod kubecontainer.Pod, podStatus api.PodStatus, createPodInfraContainer bool, containersToKeep map[kubeletTypes.DockerID]int) (*api.Container, bool) {
+	podFullName := kubecontainer.GetPodFullName(pod)
+	for i := range pod.Spec.InitContainers {
+		container := &pod.Spec.InitContainers[i]
+		if c := runningPod.FindContainerByName(container.Name); c != nil {
+			if createPodInfraContainer {
+				// The init container is killed along with the infra container and
+				// has to be run again from the start.
+				glog.V(1).Infof("Infra Container is being recreated. Init container %q will be restarted.", container.Name)
+				return container, false
+			}
+			if c.Hash != 0 && c.Hash != kubecontainer.HashContainer(container) {
+				glog.Infof("pod %q init container %q hash changed, it will be killed and re-created.", podFullName, container.Name)
+				return container, false
+			}
+			// Wait for the init container to finish.
+			containersToKeep[kubeletTypes.DockerID(c.ID)] = -1
+			return nil, false
+		}
+		status, found := api.GetContainerStatus(podStatus.InitContainerStatuses, container.Name)
+		if found && isSuccessfullyTerminated(&status) {
+			continue
+		}
+		if found && status.State.Terminated != nil && pod.Spec.RestartPolicy == api.RestartPolicyNever {
+			glog.V(3).Infof("pod %q init container %q failed and RestartPolicy is Never, not starting any containers.", podFullName, container.Name)
+			return nil, false
+		}
+		return container, false
+	}
+	return nil, true
 }
 
 func (dm *DockerManager) computePodContainerChanges(pod *api.Pod, runningPod kubecontainer.Pod, podStatus api.PodStatus) (PodContainerChangesSpec, error) {
@@ -1391,11 +1463,20 @@ func (dm *DockerManager) computePodContainerChanges(pod *api.Pod, runningPod kub
 		containersToKeep[podInfraContainerID] = -1
 	}
 
+	initContainerToStart, initialized := dm.computeInitContainerChanges(pod, runningPod, podStatus, createPodInfraContainer, containersToKeep)
 	for index, container := range pod.Spec.Containers {
+		if !initialized {
+			// Regular containers only start once all init containers succeeded.
+			break
+		}
 		expectedHash := kubecontainer.HashContainer(&container)
 
 		c := runningPod.FindContainerByName(container.Name)
 		if c == nil {
+			// Stop probing the container if it died on its own.
+			if status, found := api.GetContainerStatus(podStatus.ContainerStatuses, container.Name); found && status.State.Terminated != nil {
+				dm.prober.RemoveContainer(kubecontainer.TrimRuntimePrefix(status.State.Terminated.ContainerID))
+			}
 			if kubecontainer.ShouldContainerBeRestarted(&container, pod, &podStatus, dm.readinessManager) {
 				// If we are here it means that the container is dead and should be restarted, or never existed and should
 				// be created. We may be inserting this ID again if the container has changed and it has
@@ -1453,15 +1534,16 @@ func (dm *DockerManager) computePodContainerChanges(pod *api.Pod, runningPod kub
 	// - createPodInfraContainer is false and containersToKeep contains at least ID of Infra Container
 
 	// If Infra container is the last running one, we don't want to keep it.
-	if !createPodInfraContainer && len(containersToStart) == 0 && len(containersToKeep) == 1 {
+	if !createPodInfraContainer && initContainerToStart == nil && len(containersToStart) == 0 && len(containersToKeep) == 1 {
 		containersToKeep = make(map[kubeletTypes.DockerID]int)
 	}
 
 	return PodContainerChangesSpec{
-		StartInfraContainer: createPodInfraContainer,
-		InfraContainerId:    podInfraContainerID,
-		ContainersToStart:   containersToStart,
-		ContainersToKeep:    containersToKeep,
+		StartInfraContainer:  createPodInfraContainer,
+		InfraContainerId:     podInfraContainerID,
+		InitContainerToStart: initContainerToStart,
+		ContainersToStart:    containersToStart,
+		ContainersToKeep:     containersToKeep,
 	}, nil
 }
 
@@ -1517,8 +1599,9 @@ func (dm *DockerManager) SyncPod(pod *api.Pod, runningPod kubecontainer.Pod, pod
 		return err
 	}
 
-	if containerChanges.StartInfraContainer || (len(containerChanges.ContainersToKeep) == 0 && len(containerChanges.ContainersToStart) == 0) {
-		if len(containerChanges.ContainersToKeep) == 0 && len(containerChanges.ContainersToStart) == 0 {
+	nothingToStart := containerChanges.InitContainerToStart == nil && len(containerChanges.ContainersToStart) == 0
+	if containerChanges.StartInfraContainer || (len(containerChanges.ContainersToKeep) == 0 && nothingToStart) {
+		if len(containerChanges.ContainersToKeep) == 0 && nothingToStart {
 			glog.V(4).Infof("Killing Infra Container for %q because all other containers are dead.", podFullName)
 		} else {
 			glog.V(4).Infof("Killing Infra Container for %q, will start new one", podFullName)
@@ -1545,7 +1628,7 @@ func (dm *DockerManager) SyncPod(pod *api.Pod, runningPod kubecontainer.Pod, pod
 
 	// If we should create infra container then we do it first.
 	podInfraContainerID := containerChanges.InfraContainerId
-	if containerChanges.StartInfraContainer && (len(containerChanges.ContainersToStart) > 0) {
+	if containerChanges.StartInfraContainer && !nothingToStart {
 		glog.V(4).Infof("Creating pod infra container for %q", podFullName)
 		podInfraContainerID, err = dm.createPodInfraContainer(pod)
 
@@ -1559,9 +1642,17 @@ func (dm *DockerManager) SyncPod(pod *api.Pod, runningPod kubecontainer.Pod, pod
 		}
 	}
 
-	// Start everything
+	// Start everything, beginning with the next init container. Init containers
+	// run one at a time; the next one is started by a later sync once this one
+	// has exited successfully.
+	var containersToStart []*api.Container
+	if containerChanges.InitContainerToStart != nil {
+		containersToStart = append(containersToStart, containerChanges.InitContainerToStart)
+	}
 	for idx := range containerChanges.ContainersToStart {
-		container := &pod.Spec.Containers[idx]
+		containersToStart = append(containersToStart, &pod.Spec.Containers[idx])
+	}
+	for _, container := range containersToStart {
 		glog.V(4).Infof("Creating container %+v in pod %v", container, podFullName)
 		err := dm.pullImage(pod, container, pullSecrets)
 		dm.updateReasonCache(pod, container, err)
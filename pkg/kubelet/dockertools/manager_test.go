@@ -17,7 +17,6 @@ limitations under the License.
 package dockertools
 
 import (
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -31,6 +30,7 @@ import (
 	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
 	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
@@ -40,6 +40,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	uexec "github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/wait"
 	docker "github.com/fsouza/go-dockerclient"
 )
 
@@ -564,287 +565,6 @@ func TestKillContainerInPodWithError(t *testing.T) {
 	}
 }
 
-type fakeExecProber struct {
-	result probe.Result
-	output string
-	err    error
-}
-
-func (p fakeExecProber) Probe(_ uexec.Cmd) (probe.Result, string, error) {
-	return p.result, p.output, p.err
-}
-
-func replaceProber(dm *DockerManager, result probe.Result, err error) {
-	fakeExec := fakeExecProber{
-		result: result,
-		err:    err,
-	}
-
-	dm.prober = kubeprober.NewTestProber(fakeExec, dm.readinessManager, dm.containerRefManager, &record.FakeRecorder{})
-	return
-}
-
-// TestProbeContainer tests the functionality of probeContainer.
-// Test cases are:
-//
-// No probe.
-// Only LivenessProbe.
-// Only ReadinessProbe.
-// Both probes.
-//
-// Also, for each probe, there will be several cases covering whether the initial
-// delay has passed, whether the probe handler will return Success, Failure,
-// Unknown or error.
-//
-// PLEASE READ THE PROBE DOCS BEFORE CHANGING THIS TEST IF YOU ARE UNSURE HOW PROBES ARE SUPPOSED TO WORK:
-// (See https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/pod-states.md#pod-conditions)
-func TestProbeContainer(t *testing.T) {
-	manager, _ := newTestDockerManager()
-	dc := &docker.APIContainers{
-		ID:      "foobar",
-		Created: time.Now().Unix(),
-	}
-	tests := []struct {
-		testContainer     api.Container
-		expectError       bool
-		expectedResult    probe.Result
-		expectedReadiness bool
-	}{
-		// No probes.
-		{
-			testContainer:     api.Container{},
-			expectedResult:    probe.Success,
-			expectedReadiness: true,
-		},
-		// Only LivenessProbe. expectedReadiness should always be true here.
-		{
-			testContainer: api.Container{
-				LivenessProbe: &api.Probe{InitialDelaySeconds: 100},
-			},
-			expectedResult:    probe.Success,
-			expectedReadiness: true,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe: &api.Probe{InitialDelaySeconds: -100},
-			},
-			expectedResult:    probe.Unknown,
-			expectedReadiness: true,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-			},
-			expectedResult:    probe.Failure,
-			expectedReadiness: true,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-			},
-			expectedResult:    probe.Success,
-			expectedReadiness: true,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-			},
-			expectedResult:    probe.Unknown,
-			expectedReadiness: true,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-			},
-			expectError:       true,
-			expectedResult:    probe.Unknown,
-			expectedReadiness: true,
-		},
-		// // Only ReadinessProbe. expectedResult should always be probe.Success here.
-		{
-			testContainer: api.Container{
-				ReadinessProbe: &api.Probe{InitialDelaySeconds: 100},
-			},
-			expectedResult:    probe.Success,
-			expectedReadiness: false,
-		},
-		{
-			testContainer: api.Container{
-				ReadinessProbe: &api.Probe{InitialDelaySeconds: -100},
-			},
-			expectedResult:    probe.Success,
-			expectedReadiness: false,
-		},
-		{
-			testContainer: api.Container{
-				ReadinessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-			},
-			expectedResult:    probe.Success,
-			expectedReadiness: true,
-		},
-		{
-			testContainer: api.Container{
-				ReadinessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-			},
-			expectedResult:    probe.Success,
-			expectedReadiness: true,
-		},
-		{
-			testContainer: api.Container{
-				ReadinessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-			},
-			expectedResult:    probe.Success,
-			expectedReadiness: true,
-		},
-		{
-			testContainer: api.Container{
-				ReadinessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-			},
-			expectError:       false,
-			expectedResult:    probe.Success,
-			expectedReadiness: true,
-		},
-		// Both LivenessProbe and ReadinessProbe.
-		{
-			testContainer: api.Container{
-				LivenessProbe:  &api.Probe{InitialDelaySeconds: 100},
-				ReadinessProbe: &api.Probe{InitialDelaySeconds: 100},
-			},
-			expectedResult:    probe.Success,
-			expectedReadiness: false,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe:  &api.Probe{InitialDelaySeconds: 100},
-				ReadinessProbe: &api.Probe{InitialDelaySeconds: -100},
-			},
-			expectedResult:    probe.Success,
-			expectedReadiness: false,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe:  &api.Probe{InitialDelaySeconds: -100},
-				ReadinessProbe: &api.Probe{InitialDelaySeconds: 100},
-			},
-			expectedResult:    probe.Unknown,
-			expectedReadiness: false,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe:  &api.Probe{InitialDelaySeconds: -100},
-				ReadinessProbe: &api.Probe{InitialDelaySeconds: -100},
-			},
-			expectedResult:    probe.Unknown,
-			expectedReadiness: false,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-				ReadinessProbe: &api.Probe{InitialDelaySeconds: -100},
-			},
-			expectedResult:    probe.Unknown,
-			expectedReadiness: false,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-				ReadinessProbe: &api.Probe{InitialDelaySeconds: -100},
-			},
-			expectedResult:    probe.Failure,
-			expectedReadiness: false,
-		},
-		{
-			testContainer: api.Container{
-				LivenessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-				ReadinessProbe: &api.Probe{
-					InitialDelaySeconds: -100,
-					Handler: api.Handler{
-						Exec: &api.ExecAction{},
-					},
-				},
-			},
-			expectedResult:    probe.Success,
-			expectedReadiness: true,
-		},
-	}
-
-	for i, test := range tests {
-		if test.expectError {
-			replaceProber(manager, test.expectedResult, errors.New("error"))
-		} else {
-			replaceProber(manager, test.expectedResult, nil)
-		}
-		result, err := manager.prober.Probe(&api.Pod{}, api.PodStatus{}, test.testContainer, dc.ID, dc.Created)
-		if test.expectError && err == nil {
-			t.Error("[%d] Expected error but no error was returned.", i)
-		}
-		if !test.expectError && err != nil {
-			t.Errorf("[%d] Didn't expect error but got: %v", i, err)
-		}
-		if test.expectedResult != result {
-			t.Errorf("[%d] Expected result to be %v but was %v", i, test.expectedResult, result)
-		}
-		if test.expectedReadiness != manager.readinessManager.GetReadiness(dc.ID) {
-			t.Errorf("[%d] Expected readiness to be %v but was %v", i, test.expectedReadiness, manager.readinessManager.GetReadiness(dc.ID))
-		}
-	}
-}
-
 func TestIsAExitError(t *testing.T) {
 	var err error
 	err = &dockerExitError{nil}
@@ -1230,6 +950,8 @@ func TestSyncPodsUnhealthy(t *testing.T) {
 		},
 	}
 
+	dm.prober = &kubeprober.FakeProber{Liveness: probe.Failure}
+
 	runSyncPod(t, dm, fakeDocker, pod)
 
 	verifyCalls(t, fakeDocker, []string{
@@ -1246,6 +968,133 @@ func TestSyncPodsUnhealthy(t *testing.T) {
 	}
 }
 
+type fakeExecProber struct {
+	result probe.Result
+	err    error
+}
+
+func (p fakeExecProber) Probe(_ uexec.Cmd) (probe.Result, string, error) {
+	return p.result, "", p.err
+}
+
+// newProbedPod returns a pod with a single container "bar" that has the given
+// probes, and sets up fakeDocker so that the container is running as "1234".
+func newProbedPod(fakeDocker *FakeDockerClient, liveness, readiness *api.Probe) *api.Pod {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       "12345678",
+			Name:      "foo",
+			Namespace: "new",
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{Name: "bar", LivenessProbe: liveness, ReadinessProbe: readiness},
+			},
+		},
+	}
+	fakeDocker.ContainerList = []docker.APIContainers{
+		{
+			// the k8s prefix is required for the kubelet to manage the container
+			Names: []string{"/k8s_bar." + strconv.FormatUint(kubecontainer.HashContainer(&pod.Spec.Containers[0]), 16) + "_foo_new_12345678_42"},
+			ID:    "1234",
+		},
+		{
+			// pod infra container
+			Names: []string{"/k8s_POD." + strconv.FormatUint(generatePodInfraContainerHash(pod), 16) + "_foo_new_12345678_42"},
+			ID:    "9876",
+		},
+	}
+	fakeDocker.ContainerMap = map[string]*docker.Container{
+		"1234": {
+			ID:         "1234",
+			Config:     &docker.Config{},
+			HostConfig: &docker.HostConfig{},
+		},
+		"9876": {
+			ID:         "9876",
+			Config:     &docker.Config{},
+			HostConfig: &docker.HostConfig{},
+		},
+	}
+	return pod
+}
+
+// TestSyncPodProbes checks that the results of the probe workers started by
+// SyncPod reach the readiness of the container and cause failed containers to
+// be restarted.
+//
+// PLEASE READ THE PROBE DOCS BEFORE CHANGING THIS TEST IF YOU ARE UNSURE HOW PROBES ARE SUPPOSED TO WORK:
+// (See https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/user-guide/pod-states.md#container-probes)
+func TestSyncPodProbes(t *testing.T) {
+	execProbe := func() *api.Probe {
+		return &api.Probe{
+			Handler:          api.Handler{Exec: &api.ExecAction{}},
+			PeriodSeconds:    1,
+			SuccessThreshold: 1,
+			FailureThreshold: 1,
+		}
+	}
+	probeResult := func(dm *DockerManager, pod *api.Pod) (probe.Result, error) {
+		return dm.prober.Probe(pod, api.PodStatus{}, pod.Spec.Containers[0], "1234", 0)
+	}
+
+	// A failing liveness probe gets the container restarted.
+	dm, fakeDocker := newTestDockerManager()
+	pod := newProbedPod(fakeDocker, execProbe(), nil)
+	dm.prober = kubeprober.NewTestProber(fakeExecProber{result: probe.Failure}, dm.readinessManager, dm.containerRefManager, &record.FakeRecorder{})
+	runSyncPod(t, dm, fakeDocker, pod)
+	err := wait.Poll(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		result, err := probeResult(dm, pod)
+		return result == probe.Failure, err
+	})
+	if err != nil {
+		t.Fatalf("liveness failure never reached the prober: %v", err)
+	}
+	runSyncPod(t, dm, fakeDocker, pod)
+	if err := fakeDocker.AssertStopped([]string{"1234"}); err != nil {
+		t.Errorf("expected the unhealthy container to be stopped: %v", err)
+	}
+	fakeDocker.Lock()
+	created := len(fakeDocker.Created)
+	fakeDocker.Unlock()
+	if created != 1 {
+		t.Errorf("expected the unhealthy container to be restarted, got %d containers created", created)
+	}
+	dm.prober.RemoveContainer("1234")
+
+	// A passing readiness probe makes the container ready.
+	dm, fakeDocker = newTestDockerManager()
+	pod = newProbedPod(fakeDocker, nil, execProbe())
+	dm.prober = kubeprober.NewTestProber(fakeExecProber{result: probe.Success}, dm.readinessManager, dm.containerRefManager, &record.FakeRecorder{})
+	runSyncPod(t, dm, fakeDocker, pod)
+	err = wait.Poll(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return dm.readinessManager.GetReadiness("1234"), nil
+	})
+	if err != nil {
+		t.Errorf("container never became ready: %v", err)
+	}
+	dm.prober.RemoveContainer("1234")
+
+	// A failing readiness probe makes the container unready, but does not
+	// restart it.
+	dm, fakeDocker = newTestDockerManager()
+	pod = newProbedPod(fakeDocker, nil, execProbe())
+	dm.readinessManager.SetReadiness("1234", true)
+	dm.prober = kubeprober.NewTestProber(fakeExecProber{result: probe.Failure}, dm.readinessManager, dm.containerRefManager, &record.FakeRecorder{})
+	runSyncPod(t, dm, fakeDocker, pod)
+	err = wait.Poll(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return !dm.readinessManager.GetReadiness("1234"), nil
+	})
+	if err != nil {
+		t.Errorf("container never became unready: %v", err)
+	}
+	runSyncPod(t, dm, fakeDocker, pod)
+	if err := fakeDocker.AssertStopped([]string{}); err != nil {
+		t.Errorf("expected the unready container to keep running: %v", err)
+	}
+	dm.prober.RemoveContainer("1234")
+}
+
 func TestSyncPodsDoesNothing(t *testing.T) {
 	dm, fakeDocker := newTestDockerManager()
 	container := api.Container{Name: "bar"}
@@ -1472,6 +1321,175 @@ func TestSyncPodWithRestartPolicy(t *testing.T) {
 	}
 }
 
+func TestSyncPodWithInitContainers(t *testing.T) {
+	dm, fakeDocker := newTestDockerManager()
+	initContainers := []api.Container{
+		{Name: "init"},
+	}
+	containers := []api.Container{
+		{Name: "bar"},
+	}
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       "12345678",
+			Name:      "foo",
+			Namespace: "new",
+		},
+		Spec: api.PodSpec{
+			InitContainers: initContainers,
+			Containers:     containers,
+		},
+	}
+
+	infraContainer := docker.APIContainers{
+		// pod infra container
+		Names: []string{"/k8s_POD." + strconv.FormatUint(generatePodInfraContainerHash(pod), 16) + "_foo_new_12345678_0"},
+		ID:    "9876",
+	}
+	initContainer := docker.APIContainers{
+		// format is // k8s_<container-id>_<pod-fullname>_<pod-uid>
+		Names: []string{"/k8s_init." + strconv.FormatUint(kubecontainer.HashContainer(&initContainers[0]), 16) + "_foo_new_12345678_0"},
+		ID:    "1234",
+	}
+	infraState := docker.State{
+		StartedAt: time.Now(),
+		Running:   true,
+	}
+	exitedState := func(exitCode int) docker.State {
+		return docker.State{
+			ExitCode:   exitCode,
+			StartedAt:  time.Now(),
+			FinishedAt: time.Now(),
+		}
+	}
+
+	tests := []struct {
+		description string
+		policy      api.RestartPolicy
+		running     []docker.APIContainers
+		exited      []docker.APIContainers
+		initState   docker.State
+		calls       []string
+		created     []string
+		stopped     []string
+	}{
+		{
+			"start the infra and init containers of a new pod",
+			api.RestartPolicyAlways,
+			[]docker.APIContainers{},
+			[]docker.APIContainers{},
+			docker.State{},
+			[]string{
+				// Create pod infra container.
+				"create", "start", "inspect_container",
+				// Create the init container only.
+				"create", "start", "inspect_container",
+			},
+			[]string{"POD", "init"},
+			[]string{},
+		},
+		{
+			"wait for a running init container",
+			api.RestartPolicyAlways,
+			[]docker.APIContainers{infraContainer, initContainer},
+			[]docker.APIContainers{},
+			infraState,
+			[]string{
+				// Check the pod infra container.
+				"inspect_container",
+			},
+			[]string{},
+			[]string{},
+		},
+		{
+			"start the containers once the init container succeeded",
+			api.RestartPolicyAlways,
+			[]docker.APIContainers{infraContainer},
+			[]docker.APIContainers{initContainer},
+			exitedState(0),
+			[]string{
+				// Check the pod infra container.
+				"inspect_container",
+				// Create container.
+				"create", "start", "inspect_container",
+			},
+			[]string{"bar"},
+			[]string{},
+		},
+		{
+			"restart a failed init container",
+			api.RestartPolicyOnFailure,
+			[]docker.APIContainers{infraContainer},
+			[]docker.APIContainers{initContainer},
+			exitedState(42),
+			[]string{
+				// Check the pod infra container.
+				"inspect_container",
+				// Restart the init container.
+				"create", "start", "inspect_container",
+			},
+			[]string{"init"},
+			[]string{},
+		},
+		{
+			"give up on a failed init container",
+			api.RestartPolicyNever,
+			[]docker.APIContainers{infraContainer},
+			[]docker.APIContainers{initContainer},
+			exitedState(42),
+			[]string{
+				// Check the pod infra container.
+				"inspect_container",
+				// Stop the last pod infra container.
+				"inspect_container", "stop",
+			},
+			[]string{},
+			[]string{"9876"},
+		},
+	}
+
+	for _, tt := range tests {
+		fakeDocker.ContainerList = tt.running
+		fakeDocker.ExitedContainerList = tt.exited
+		fakeDocker.ContainerMap = map[string]*docker.Container{
+			"9876": {
+				ID:     "9876",
+				Name:   "POD",
+				Config: &docker.Config{},
+				State:  infraState,
+			},
+			"1234": {
+				ID:     "1234",
+				Name:   "init",
+				Config: &docker.Config{},
+				State:  tt.initState,
+			},
+		}
+		pod.Spec.RestartPolicy = tt.policy
+
+		runSyncPod(t, dm, fakeDocker, pod)
+
+		verifyCalls(t, fakeDocker, tt.calls)
+		if err := fakeDocker.AssertCreated(tt.created); err != nil {
+			t.Errorf("%s: %v", tt.description, err)
+		}
+		if err := fakeDocker.AssertStopped(tt.stopped); err != nil {
+			t.Errorf("%s: %v", tt.description, err)
+		}
+
+		status, err := dm.GetPodStatus(pod)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", tt.description, err)
+		}
+		if len(status.InitContainerStatuses) != 1 || status.InitContainerStatuses[0].Name != "init" {
+			t.Errorf("%s: unexpected init container statuses %+v", tt.description, status.InitContainerStatuses)
+		}
+		if len(status.ContainerStatuses) != 1 || status.ContainerStatuses[0].Name != "bar" {
+			t.Errorf("%s: unexpected container statuses %+v", tt.description, status.ContainerStatuses)
+		}
+	}
+}
+
 func TestGetPodStatusWithLastTermination(t *testing.T) {
 	dm, fakeDocker := newTestDockerManager()
 	containers := []api.Container{
@@ -1993,6 +2011,55 @@ func TestSyncPodWithTerminationLog(t *testing.T) {
 	}
 }
 
+func TestSyncPodWithResourceRequestsAndLimits(t *testing.T) {
+	dm, fakeDocker := newTestDockerManager()
+	container := api.Container{
+		Name: "bar",
+		Resources: api.ResourceRequirements{
+			Requests: api.ResourceList{
+				api.ResourceCPU:    resource.MustParse("250m"),
+				api.ResourceMemory: resource.MustParse("64Mi"),
+			},
+			Limits: api.ResourceList{
+				api.ResourceCPU:    resource.MustParse("1"),
+				api.ResourceMemory: resource.MustParse("128Mi"),
+			},
+		},
+	}
+	fakeDocker.ContainerList = []docker.APIContainers{}
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       "12345678",
+			Name:      "foo",
+			Namespace: "new",
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				container,
+			},
+		},
+	}
+
+	runSyncPod(t, dm, fakeDocker, pod)
+	verifyCalls(t, fakeDocker, []string{
+		// Create pod infra container.
+		"create", "start", "inspect_container",
+		// Create container.
+		"create", "start", "inspect_container",
+	})
+
+	fakeDocker.Lock()
+	defer fakeDocker.Unlock()
+
+	hc := fakeDocker.Container.HostConfig
+	if hc.CPUShares != 256 {
+		t.Errorf("expected CPU shares to follow the request, got %d", hc.CPUShares)
+	}
+	if hc.Memory != 128*1024*1024 {
+		t.Errorf("expected memory to follow the limit, got %d", hc.Memory)
+	}
+}
+
 func TestGetPodStatusSortedContainers(t *testing.T) {
 	dm, fakeDocker := newTestDockerManager()
 	dockerInspect := map[string]*docker.Container{}
@@ -0,0 +1,406 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/cadvisor"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/qos"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+)
+
+// Manages eviction of pods when the node runs low on memory or disk.
+
+// EvictionSignal is a resource the eviction manager observes on the node.
+type EvictionSignal string
+
+const (
+	// SignalMemoryAvailable is the memory capacity of the machine minus the
+	// working set of all its processes.
+	SignalMemoryAvailable EvictionSignal = "memory.available"
+	// SignalNodeFsAvailable is the free space on the root filesystem, which
+	// holds volumes and logs.
+	SignalNodeFsAvailable EvictionSignal = "nodefs.available"
+	// SignalImageFsAvailable is the free space on the filesystem holding
+	// docker images and container filesystems.
+	SignalImageFsAvailable EvictionSignal = "imagefs.available"
+)
+
+// EvictionThreshold is met when the observed value of its signal drops
+// below its value.
+type EvictionThreshold struct {
+	Signal EvictionSignal
+	Value  resource.Quantity
+	// How long the threshold must be met before pods are evicted. Zero for
+	// hard thresholds, which evict pods as soon as they are met.
+	GracePeriod time.Duration
+}
+
+type EvictionPolicy struct {
+	// Hard and soft thresholds, in the order given by the user.
+	Thresholds []EvictionThreshold
+	// How often signals are observed and pods are evicted, at most one per interval.
+	MonitoringInterval time.Duration
+}
+
+// Implementation is thread-safe.
+type evictionManager interface {
+	// Starts observing the node and evicting pods.
+	Start()
+	// Whether a threshold on available memory is currently met.
+	IsUnderMemoryPressure() bool
+	// Whether a threshold on available disk is currently met.
+	IsUnderDiskPressure() bool
+}
+
+// podUsage is the usage of a pod's running containers, in bytes.
+type podUsage struct {
+	memory int64
+	disk   int64
+}
+
+// Returns the pods that may be evicted.
+type activePodsFunc func() []*api.Pod
+
+// Returns the memory and disk usage of a pod.
+type podUsageFunc func(pod *api.Pod) (podUsage, error)
+
+// Kills the pod, failing it with the given reason and message.
+type evictPodFunc func(pod *api.Pod, reason, message string) error
+
+type realEvictionManager struct {
+	cadvisor   cadvisor.Interface
+	recorder   record.EventRecorder
+	policy     EvictionPolicy
+	activePods activePodsFunc
+	podUsage   podUsageFunc
+	evictPod   evictPodFunc
+
+	lock sync.Mutex
+	// when each currently met threshold, by index in the policy, was first met.
+	thresholdsMetSince map[int]time.Time
+	memoryPressure     bool
+	diskPressure       bool
+}
+
+const evictionReason = "Evicted"
+
+// defaultEvictionMonitoringInterval is used when the policy does not set an interval.
+const defaultEvictionMonitoringInterval = 10 * time.Second
+
+func (em *realEvictionManager) Start() {
+	if len(em.policy.Thresholds) == 0 {
+		return
+	}
+	interval := em.policy.MonitoringInterval
+	if interval <= 0 {
+		interval = defaultEvictionMonitoringInterval
+	}
+	go util.Until(em.synchronize, interval, util.NeverStop)
+}
+
+func (em *realEvictionManager) IsUnderMemoryPressure() bool {
+	em.lock.Lock()
+	defer em.lock.Unlock()
+	return em.memoryPressure
+}
+
+func (em *realEvictionManager) IsUnderDiskPressure() bool {
+	em.lock.Lock()
+	defer em.lock.Unlock()
+	return em.diskPressure
+}
+
+// synchronize observes the signals, updates the pressure of the node and
+// evicts one pod if a threshold has been met for longer than its grace period.
+func (em *realEvictionManager) synchronize() {
+	observations := em.makeObservations()
+	now := time.Now()
+
+	em.lock.Lock()
+	metSince := map[int]time.Time{}
+	memoryPressure, diskPressure := false, false
+	var starved *EvictionThreshold
+	for i := range em.policy.Thresholds {
+		threshold := &em.policy.Thresholds[i]
+		available, found := observations[threshold.Signal]
+		if !found || available >= threshold.Value.Value() {
+			continue
+		}
+		since, found := em.thresholdsMetSince[i]
+		if !found {
+			since = now
+		}
+		metSince[i] = since
+		if threshold.Signal == SignalMemoryAvailable {
+			memoryPressure = true
+		} else {
+			diskPressure = true
+		}
+		if now.Sub(since) >= threshold.GracePeriod && (starved == nil || threshold.Signal == SignalMemoryAvailable) {
+			starved = threshold
+		}
+	}
+	em.thresholdsMetSince = metSince
+	em.memoryPressure = memoryPressure
+	em.diskPressure = diskPressure
+	em.lock.Unlock()
+
+	if starved == nil {
+		return
+	}
+	glog.Infof("Eviction threshold %s<%s met, available: %d", starved.Signal, starved.Value.String(), observations[starved.Signal])
+	em.evictOne(starved.Signal)
+}
+
+// makeObservations returns the available bytes of each signal that could be observed.
+func (em *realEvictionManager) makeObservations() map[EvictionSignal]int64 {
+	observations := map[EvictionSignal]int64{}
+	if available, err := em.memoryAvailable(); err != nil {
+		glog.Errorf("Failed to observe %s: %v", SignalMemoryAvailable, err)
+	} else {
+		observations[SignalMemoryAvailable] = available
+	}
+	if info, err := em.cadvisor.RootFsInfo(); err != nil {
+		glog.Errorf("Failed to observe %s: %v", SignalNodeFsAvailable, err)
+	} else {
+		observations[SignalNodeFsAvailable] = int64(info.Available)
+	}
+	if info, err := em.cadvisor.DockerImagesFsInfo(); err != nil {
+		glog.Errorf("Failed to observe %s: %v", SignalImageFsAvailable, err)
+	} else {
+		observations[SignalImageFsAvailable] = int64(info.Available)
+	}
+	return observations
+}
+
+func (em *realEvictionManager) memoryAvailable() (int64, error) {
+	machineInfo, err := em.cadvisor.MachineInfo()
+	if err != nil {
+		return 0, err
+	}
+	rootInfo, err := em.cadvisor.ContainerInfo("/", &cadvisorApi.ContainerInfoRequest{NumStats: 1})
+	if err != nil {
+		return 0, err
+	}
+	if len(rootInfo.Stats) == 0 {
+		return 0, fmt.Errorf("no memory stats for the root container")
+	}
+	workingSet := rootInfo.Stats[len(rootInfo.Stats)-1].Memory.WorkingSet
+	return machineInfo.MemoryCapacity - int64(workingSet), nil
+}
+
+// evictOne evicts the first pod in eviction order for the starved resource.
+func (em *realEvictionManager) evictOne(signal EvictionSignal) {
+	resourceName := "memory"
+	if signal != SignalMemoryAvailable {
+		resourceName = "disk"
+	}
+	candidates := []evictionCandidate{}
+	for _, pod := range em.activePods() {
+		usage, err := em.podUsage(pod)
+		if err != nil {
+			glog.Errorf("Failed to get usage of pod %q: %v", kubecontainer.GetPodFullName(pod), err)
+		}
+		candidate := evictionCandidate{pod: pod, bestEffort: qos.GetPodQOS(pod) == qos.BestEffort, excess: usage.disk}
+		if signal == SignalMemoryAvailable {
+			candidate.excess = usage.memory - podMemoryRequest(pod)
+		}
+		candidates = append(candidates, candidate)
+	}
+	if len(candidates) == 0 {
+		glog.Errorf("No pods to evict to reclaim %s", resourceName)
+		return
+	}
+	sort.Sort(byEvictionOrder(candidates))
+	pod := candidates[0].pod
+	message := fmt.Sprintf("The node was low on %s.", resourceName)
+	glog.Infof("Evicting pod %q to reclaim %s", kubecontainer.GetPodFullName(pod), resourceName)
+	em.recorder.Eventf(pod, evictionReason, "The node was low on %s.", resourceName)
+	if err := em.evictPod(pod, evictionReason, message); err != nil {
+		glog.Errorf("Failed to evict pod %q: %v", kubecontainer.GetPodFullName(pod), err)
+	}
+}
+
+type evictionCandidate struct {
+	pod        *api.Pod
+	bestEffort bool
+	// usage of the starved resource in excess of the pod's request.
+	excess int64
+}
+
+// byEvictionOrder sorts best-effort pods first, then the pods using the most
+// of the starved resource in excess of their requests.
+type byEvictionOrder []evictionCandidate
+
+func (s byEvictionOrder) Len() int {
+	return len(s)
+}
+
+func (s byEvictionOrder) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s byEvictionOrder) Less(i, j int) bool {
+	if s[i].bestEffort != s[j].bestEffort {
+		return s[i].bestEffort
+	}
+	return s[i].excess > s[j].excess
+}
+
+// podMemoryRequest returns the sum of the memory requests of the pod's containers,
+// or the largest memory request of its init containers if that is more.
+func podMemoryRequest(pod *api.Pod) int64 {
+	total := int64(0)
+	for _, container := range pod.Spec.Containers {
+		if request, found := container.Resources.Requests[api.ResourceMemory]; found {
+			total += request.Value()
+		}
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if request, found := container.Resources.Requests[api.ResourceMemory]; found && request.Value() > total {
+			total = request.Value()
+		}
+	}
+	return total
+}
+
+// ParseEvictionThresholds parses hard and soft thresholds given as
+// "signal<quantity" pairs, e.g. "memory.available<100Mi,nodefs.available<1Gi",
+// and soft grace periods given as "signal=duration" pairs, e.g.
+// "memory.available=1m30s". Every soft threshold needs a grace period.
+func ParseEvictionThresholds(hard, soft, softGracePeriod string) ([]EvictionThreshold, error) {
+	hardThresholds, err := parseThresholdStatements(hard)
+	if err != nil {
+		return nil, err
+	}
+	softThresholds, err := parseThresholdStatements(soft)
+	if err != nil {
+		return nil, err
+	}
+	gracePeriods, err := parseGracePeriods(softGracePeriod)
+	if err != nil {
+		return nil, err
+	}
+	for i := range softThresholds {
+		gracePeriod, found := gracePeriods[softThresholds[i].Signal]
+		if !found || gracePeriod <= 0 {
+			return nil, fmt.Errorf("soft eviction threshold %q has no grace period", softThresholds[i].Signal)
+		}
+		softThresholds[i].GracePeriod = gracePeriod
+	}
+	return append(hardThresholds, softThresholds...), nil
+}
+
+func parseThresholdStatements(statements string) ([]EvictionThreshold, error) {
+	thresholds := []EvictionThreshold{}
+	for _, statement := range splitStatements(statements) {
+		parts := strings.SplitN(statement, "<", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid eviction threshold %q, expected signal<quantity", statement)
+		}
+		signal := EvictionSignal(parts[0])
+		if !isValidEvictionSignal(signal) {
+			return nil, fmt.Errorf("unknown eviction signal %q", signal)
+		}
+		value, err := resource.ParseQuantity(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity in eviction threshold %q: %v", statement, err)
+		}
+		thresholds = append(thresholds, EvictionThreshold{Signal: signal, Value: *value})
+	}
+	return thresholds, nil
+}
+
+func parseGracePeriods(statements string) (map[EvictionSignal]time.Duration, error) {
+	gracePeriods := map[EvictionSignal]time.Duration{}
+	for _, statement := range splitStatements(statements) {
+		parts := strings.SplitN(statement, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid eviction grace period %q, expected signal=duration", statement)
+		}
+		signal := EvictionSignal(parts[0])
+		if !isValidEvictionSignal(signal) {
+			return nil, fmt.Errorf("unknown eviction signal %q", signal)
+		}
+		gracePeriod, err := time.ParseDuration(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration in eviction grace period %q: %v", statement, err)
+		}
+		gracePeriods[signal] = gracePeriod
+	}
+	return gracePeriods, nil
+}
+
+func splitStatements(statements string) []string {
+	result := []string{}
+	for _, statement := range strings.Split(statements, ",") {
+		if statement = strings.TrimSpace(statement); statement != "" {
+			result = append(result, statement)
+		}
+	}
+	return result
+}
+
+func isValidEvictionSignal(signal EvictionSignal) bool {
+	switch signal {
+	case SignalMemoryAvailable, SignalNodeFsAvailable, SignalImageFsAvailable:
+		return true
+	}
+	return false
+}
+
+func validateEvictionPolicy(policy EvictionPolicy) error {
+	for _, threshold := range policy.Thresholds {
+		if !isValidEvictionSignal(threshold.Signal) {
+			return fmt.Errorf("unknown eviction signal %q", threshold.Signal)
+		}
+		if threshold.Value.Value() < 0 {
+			return fmt.Errorf("eviction threshold for %q should be non-negative", threshold.Signal)
+		}
+		if threshold.GracePeriod < 0 {
+			return fmt.Errorf("eviction grace period for %q should be non-negative", threshold.Signal)
+		}
+	}
+	return nil
+}
+
+func newEvictionManager(cadvisorInterface cadvisor.Interface, recorder record.EventRecorder, policy EvictionPolicy, activePods activePodsFunc, podUsage podUsageFunc, evictPod evictPodFunc) (evictionManager, error) {
+	if err := validateEvictionPolicy(policy); err != nil {
+		return nil, err
+	}
+	return &realEvictionManager{
+		cadvisor:           cadvisorInterface,
+		recorder:           recorder,
+		policy:             policy,
+		activePods:         activePods,
+		podUsage:           podUsage,
+		evictPod:           evictPod,
+		thresholdsMetSince: map[int]time.Time{},
+	}, nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/cadvisor"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+	cadvisorApiV2 "github.com/google/cadvisor/info/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mi = 1024 * 1024
+
+func newEvictionTestPod(name string, memoryRequest string, usage podUsage, usages map[types.UID]podUsage) *api.Pod {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{UID: types.UID(name), Name: name, Namespace: "test"},
+		Spec:       api.PodSpec{Containers: []api.Container{{Name: "foo"}}},
+	}
+	if memoryRequest != "" {
+		pod.Spec.Containers[0].Resources.Requests = api.ResourceList{api.ResourceMemory: resource.MustParse(memoryRequest)}
+	}
+	usages[pod.UID] = usage
+	return pod
+}
+
+// newTestEvictionManager returns an eviction manager observing the given
+// available memory and root filesystem space, in MB, and the names of the
+// pods it evicts.
+func newTestEvictionManager(t *testing.T, memoryAvailable, nodeFsAvailable uint64, thresholds []EvictionThreshold, pods []*api.Pod, usages map[types.UID]podUsage) (*realEvictionManager, *[]string) {
+	mockCadvisor := new(cadvisor.Mock)
+	mockCadvisor.On("MachineInfo").Return(&cadvisorApi.MachineInfo{MemoryCapacity: 1000 * mi}, nil)
+	rootInfo := &cadvisorApi.ContainerInfo{
+		Stats: []*cadvisorApi.ContainerStats{{Memory: cadvisorApi.MemoryStats{WorkingSet: (1000 - memoryAvailable) * mi}}},
+	}
+	mockCadvisor.On("ContainerInfo", "/", &cadvisorApi.ContainerInfoRequest{NumStats: 1}).Return(rootInfo, nil)
+	mockCadvisor.On("RootFsInfo").Return(cadvisorApiV2.FsInfo{Capacity: 10000 * mi, Available: nodeFsAvailable * mi}, nil)
+	mockCadvisor.On("DockerImagesFsInfo").Return(cadvisorApiV2.FsInfo{Capacity: 10000 * mi, Available: 10000 * mi}, nil)
+
+	evicted := []string{}
+	em, err := newEvictionManager(mockCadvisor, &record.FakeRecorder{}, EvictionPolicy{Thresholds: thresholds},
+		func() []*api.Pod { return pods },
+		func(pod *api.Pod) (podUsage, error) { return usages[pod.UID], nil },
+		func(pod *api.Pod, reason, message string) error {
+			assert.Equal(t, evictionReason, reason)
+			evicted = append(evicted, pod.Name)
+			return nil
+		})
+	require.NoError(t, err)
+	return em.(*realEvictionManager), &evicted
+}
+
+func TestParseEvictionThresholds(t *testing.T) {
+	thresholds, err := ParseEvictionThresholds("memory.available<100Mi, nodefs.available<1Gi", "imagefs.available<2Gi", "imagefs.available=1m30s")
+	require.NoError(t, err)
+	require.Len(t, thresholds, 3)
+	assert.Equal(t, SignalMemoryAvailable, thresholds[0].Signal)
+	assert.Equal(t, int64(100*mi), thresholds[0].Value.Value())
+	assert.Equal(t, time.Duration(0), thresholds[0].GracePeriod)
+	assert.Equal(t, SignalNodeFsAvailable, thresholds[1].Signal)
+	assert.Equal(t, SignalImageFsAvailable, thresholds[2].Signal)
+	assert.Equal(t, 90*time.Second, thresholds[2].GracePeriod)
+
+	thresholds, err = ParseEvictionThresholds("", "", "")
+	require.NoError(t, err)
+	assert.Empty(t, thresholds)
+
+	invalid := [][3]string{
+		{"memory.available>100Mi", "", ""},
+		{"cpu.available<1", "", ""},
+		{"memory.available<lots", "", ""},
+		{"", "memory.available<100Mi", ""},
+		{"", "memory.available<100Mi", "nodefs.available=1m"},
+		{"", "memory.available<100Mi", "memory.available=soon"},
+	}
+	for _, args := range invalid {
+		_, err := ParseEvictionThresholds(args[0], args[1], args[2])
+		assert.Error(t, err, "expected error for %v", args)
+	}
+}
+
+func TestEvictionOrder(t *testing.T) {
+	usages := map[types.UID]podUsage{}
+	guaranteed := newEvictionTestPod("guaranteed", "500Mi", podUsage{memory: 450 * mi}, usages)
+	burstable := newEvictionTestPod("burstable", "100Mi", podUsage{memory: 300 * mi}, usages)
+	greedy := newEvictionTestPod("greedy", "100Mi", podUsage{memory: 400 * mi}, usages)
+	bestEffort := newEvictionTestPod("best-effort", "", podUsage{memory: 10 * mi}, usages)
+	thresholds := []EvictionThreshold{{Signal: SignalMemoryAvailable, Value: resource.MustParse("100Mi")}}
+
+	// Best-effort pods go first, then the biggest consumers over their requests.
+	pods := []*api.Pod{guaranteed, burstable, greedy, bestEffort}
+	expected := []string{"best-effort", "greedy", "burstable", "guaranteed"}
+	for _, name := range expected {
+		em, evicted := newTestEvictionManager(t, 50, 10000, thresholds, pods, usages)
+		em.synchronize()
+		require.Equal(t, []string{name}, *evicted)
+		assert.True(t, em.IsUnderMemoryPressure())
+		assert.False(t, em.IsUnderDiskPressure())
+		remaining := []*api.Pod{}
+		for _, pod := range pods {
+			if pod.Name != name {
+				remaining = append(remaining, pod)
+			}
+		}
+		pods = remaining
+	}
+}
+
+func TestEvictionOrderInitContainers(t *testing.T) {
+	usages := map[types.UID]podUsage{}
+	initRequest := newEvictionTestPod("init-request", "", podUsage{memory: 450 * mi}, usages)
+	initRequest.Spec.InitContainers = []api.Container{{
+		Name:      "init",
+		Resources: api.ResourceRequirements{Requests: api.ResourceList{api.ResourceMemory: resource.MustParse("500Mi")}},
+	}}
+	burstable := newEvictionTestPod("burstable", "100Mi", podUsage{memory: 300 * mi}, usages)
+	bestEffort := newEvictionTestPod("best-effort", "", podUsage{memory: 10 * mi}, usages)
+	thresholds := []EvictionThreshold{{Signal: SignalMemoryAvailable, Value: resource.MustParse("100Mi")}}
+
+	// A pod whose init container requests memory is not best-effort, and is
+	// only over its request once it uses more than the init container asked.
+	pods := []*api.Pod{initRequest, burstable, bestEffort}
+	expected := []string{"best-effort", "burstable", "init-request"}
+	for _, name := range expected {
+		em, evicted := newTestEvictionManager(t, 50, 10000, thresholds, pods, usages)
+		em.synchronize()
+		require.Equal(t, []string{name}, *evicted)
+		remaining := []*api.Pod{}
+		for _, pod := range pods {
+			if pod.Name != name {
+				remaining = append(remaining, pod)
+			}
+		}
+		pods = remaining
+	}
+}
+
+func TestEvictionThresholdNotMet(t *testing.T) {
+	usages := map[types.UID]podUsage{}
+	pods := []*api.Pod{newEvictionTestPod("best-effort", "", podUsage{memory: 10 * mi}, usages)}
+	thresholds := []EvictionThreshold{
+		{Signal: SignalMemoryAvailable, Value: resource.MustParse("100Mi")},
+		{Signal: SignalNodeFsAvailable, Value: resource.MustParse("1Gi")},
+	}
+	em, evicted := newTestEvictionManager(t, 500, 10000, thresholds, pods, usages)
+	em.synchronize()
+	assert.Empty(t, *evicted)
+	assert.False(t, em.IsUnderMemoryPressure())
+	assert.False(t, em.IsUnderDiskPressure())
+}
+
+func TestEvictionDiskPressure(t *testing.T) {
+	usages := map[types.UID]podUsage{}
+	pods := []*api.Pod{
+		newEvictionTestPod("small", "100Mi", podUsage{memory: 200 * mi, disk: 10 * mi}, usages),
+		newEvictionTestPod("big", "100Mi", podUsage{memory: 100 * mi, disk: 500 * mi}, usages),
+	}
+	thresholds := []EvictionThreshold{{Signal: SignalNodeFsAvailable, Value: resource.MustParse("1Gi")}}
+	em, evicted := newTestEvictionManager(t, 500, 100, thresholds, pods, usages)
+	em.synchronize()
+	assert.Equal(t, []string{"big"}, *evicted)
+	assert.False(t, em.IsUnderMemoryPressure())
+	assert.True(t, em.IsUnderDiskPressure())
+}
+
+func TestEvictionSoftThreshold(t *testing.T) {
+	usages := map[types.UID]podUsage{}
+	pods := []*api.Pod{newEvictionTestPod("best-effort", "", podUsage{memory: 10 * mi}, usages)}
+	thresholds := []EvictionThreshold{{Signal: SignalMemoryAvailable, Value: resource.MustParse("100Mi"), GracePeriod: time.Minute}}
+	em, evicted := newTestEvictionManager(t, 50, 10000, thresholds, pods, usages)
+
+	// The node is under pressure right away, but pods are only evicted once
+	// the threshold has been met for the grace period.
+	em.synchronize()
+	assert.Empty(t, *evicted)
+	assert.True(t, em.IsUnderMemoryPressure())
+
+	em.thresholdsMetSince[0] = time.Now().Add(-2 * time.Minute)
+	em.synchronize()
+	assert.Equal(t, []string{"best-effort"}, *evicted)
+}
@@ -49,6 +49,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/envvars"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/metrics"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/network"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/remote"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/rkt"
 	kubeletTypes "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/types"
 	kubeletUtil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/util"
@@ -138,19 +139,23 @@ func NewMainKubelet(
 	cadvisorInterface cadvisor.Interface,
 	imageGCPolicy ImageGCPolicy,
 	diskSpacePolicy DiskSpacePolicy,
+	evictionPolicy EvictionPolicy,
 	cloud cloudprovider.Interface,
 	nodeStatusUpdateFrequency time.Duration,
 	resourceContainer string,
 	osInterface kubecontainer.OSInterface,
 	cgroupRoot string,
 	containerRuntime string,
+	containerRuntimeEndpoint string,
 	mounter mount.Interface,
 	dockerDaemonContainer string,
 	systemContainer string,
 	configureCBR0 bool,
 	podCIDR string,
 	pods int,
-	dockerExecHandler dockertools.ExecHandler) (*Kubelet, error) {
+	dockerExecHandler dockertools.ExecHandler,
+	cgroupsPerQOS bool,
+	systemReserved api.ResourceList) (*Kubelet, error) {
 	if rootDirectory == "" {
 		return nil, fmt.Errorf("invalid root directory %q", rootDirectory)
 	}
@@ -266,8 +271,15 @@ func NewMainKubelet(
 		podCIDR:                        podCIDR,
 		pods:                           pods,
 		syncLoopMonitor:                util.AtomicValue{},
+		systemReserved:                 systemReserved,
 	}
 
+	evictionManager, err := newEvictionManager(cadvisorInterface, recorder, evictionPolicy, klet.getActivePods, klet.getPodUsage, klet.evictPod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize eviction manager: %v", err)
+	}
+	klet.evictionManager = evictionManager
+
 	if plug, err := network.InitNetworkPlugin(networkPlugins, networkPluginName, &networkHost{klet}); err != nil {
 		return nil, err
 	} else {
@@ -307,6 +319,15 @@ func NewMainKubelet(
 		}
 		klet.containerRuntime = rktRuntime
 
+		// No Docker daemon to put in a container.
+		dockerDaemonContainer = ""
+	case "remote":
+		remoteRuntime, err := remote.New(containerRuntimeEndpoint, klet)
+		if err != nil {
+			return nil, err
+		}
+		klet.containerRuntime = remoteRuntime
+
 		// No Docker daemon to put in a container.
 		dockerDaemonContainer = ""
 	default:
@@ -315,7 +336,7 @@ func NewMainKubelet(
 
 	// Setup container manager, can fail if the devices hierarchy is not mounted
 	// (it is required by Docker however).
-	containerManager, err := newContainerManager(cadvisorInterface, dockerDaemonContainer, systemContainer, resourceContainer)
+	containerManager, err := newContainerManager(cadvisorInterface, dockerDaemonContainer, systemContainer, resourceContainer, cgroupsPerQOS, cgroupRoot, systemReserved)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create the Container Manager: %v", err)
 	}
@@ -452,6 +473,9 @@ type Kubelet struct {
 	// Diskspace manager.
 	diskSpaceManager diskSpaceManager
 
+	// Evicts pods when the node runs low on memory or disk.
+	evictionManager evictionManager
+
 	// Cached MachineInfo returned by cadvisor.
 	machineInfo *cadvisorApi.MachineInfo
 
@@ -501,6 +525,9 @@ type Kubelet struct {
 	// Manager of non-Runtime containers.
 	containerManager containerManager
 
+	// Resources reserved for the system, which are not available to pods.
+	systemReserved api.ResourceList
+
 	// Whether or not kubelet should take responsibility for keeping cbr0 in
 	// the correct state.
 	configureCBR0 bool
@@ -724,6 +751,8 @@ func (kl *Kubelet) Run(updates <-chan PodUpdate) {
 
 	go util.Until(kl.updateRuntimeUp, 5*time.Second, util.NeverStop)
 
+	kl.evictionManager.Start()
+
 	// Run the system oom watcher forever.
 	kl.statusManager.Start()
 	kl.syncLoop(updates, kl)
@@ -892,6 +921,9 @@ func makePortMappings(container *api.Container) (ports []kubecontainer.PortMappi
 func (kl *Kubelet) GenerateRunContainerOptions(pod *api.Pod, container *api.Container) (*kubecontainer.RunContainerOptions, error) {
 	var err error
 	opts := &kubecontainer.RunContainerOptions{CgroupParent: kl.cgroupRoot}
+	if cgroupParent := kl.containerManager.PodCgroupParent(pod); cgroupParent != "" {
+		opts.CgroupParent = cgroupParent
+	}
 
 	vol, ok := kl.volumeManager.GetVolumes(pod.UID)
 	if !ok {
@@ -1420,6 +1452,11 @@ func (kl *Kubelet) SyncPods(allPods []*api.Pod, podSyncTypes map[types.UID]SyncP
 	// Handles pod admission.
 	pods := kl.admitPods(allPods, podSyncTypes)
 
+	// Create the cgroups of the admitted pods before their containers start.
+	if err := kl.containerManager.UpdatePodCgroups(pods); err != nil {
+		glog.Errorf("Failed to update pod cgroups: %v", err)
+	}
+
 	glog.V(4).Infof("Desired pods: %s", kubeletUtil.FormatPodNames(pods))
 	var err error
 	desiredPods := make(map[types.UID]empty)
@@ -1579,8 +1616,8 @@ func (kl *Kubelet) checkCapacityExceeded(pods []*api.Pod) (fitting []*api.Pod, n
 	// Respect the pod creation order when resolving conflicts.
 	sort.Sort(podsByCreationTime(pods))
 
-	capacity := CapacityFromMachineInfo(info)
-	return predicates.CheckPodsExceedingCapacity(pods, capacity)
+	allocatable := allocatableResources(CapacityFromMachineInfo(info), kl.systemReserved)
+	return predicates.CheckPodsExceedingCapacity(pods, allocatable)
 }
 
 // handleOutOfDisk detects if pods can't fit due to lack of disk space.
@@ -1631,6 +1668,48 @@ func (kl *Kubelet) handleOutOfDisk(pods []*api.Pod, podSyncTypes map[types.UID]S
 	return fitting
 }
 
+// getActivePods returns the pods that are not yet terminated.
+func (kl *Kubelet) getActivePods() []*api.Pod {
+	return kl.filterOutTerminatedPods(kl.podManager.GetPods())
+}
+
+// getPodUsage returns the memory working set and the filesystem usage of the
+// running containers of a pod.
+func (kl *Kubelet) getPodUsage(pod *api.Pod) (podUsage, error) {
+	usage := podUsage{}
+	runningPods, err := kl.runtimeCache.GetPods()
+	if err != nil {
+		return usage, err
+	}
+	runningPod := kubecontainer.Pods(runningPods).FindPodByID(pod.UID)
+	for _, container := range runningPod.Containers {
+		info, err := kl.cadvisor.DockerContainer(string(container.ID), &cadvisorApi.ContainerInfoRequest{NumStats: 1})
+		if err != nil {
+			return usage, err
+		}
+		if len(info.Stats) == 0 {
+			continue
+		}
+		stats := info.Stats[len(info.Stats)-1]
+		usage.memory += int64(stats.Memory.WorkingSet)
+		for _, fs := range stats.Filesystem {
+			usage.disk += int64(fs.Usage)
+		}
+	}
+	return usage, nil
+}
+
+// evictPod fails the pod with the given reason.  Its containers are not killed
+// here, which would race with its pod worker; instead the next SyncPods
+// filters out the terminated pod and kills its containers as unwanted.
+func (kl *Kubelet) evictPod(pod *api.Pod, reason, message string) error {
+	kl.statusManager.SetPodStatus(pod, api.PodStatus{
+		Phase:   api.PodFailed,
+		Reason:  reason,
+		Message: message})
+	return nil
+}
+
 // checkNodeSelectorMatching detects pods that do not match node's labels.
 func (kl *Kubelet) checkNodeSelectorMatching(pods []*api.Pod) (fitting []*api.Pod, notFitting []*api.Pod) {
 	if kl.standaloneMode {
@@ -1803,6 +1882,9 @@ func (kl *Kubelet) validateContainerStatus(podStatus *api.PodStatus, containerNa
 	var cID string
 
 	cStatus, found := api.GetContainerStatus(podStatus.ContainerStatuses, containerName)
+	if !found {
+		cStatus, found = api.GetContainerStatus(podStatus.InitContainerStatuses, containerName)
+	}
 	if !found {
 		return "", fmt.Errorf("container %q not found in pod", containerName)
 	}
@@ -2013,6 +2095,7 @@ func (kl *Kubelet) setNodeStatus(node *api.Node) error {
 			api.ResourceMemory: resource.MustParse("0Gi"),
 			api.ResourcePods:   *resource.NewQuantity(int64(kl.pods), resource.DecimalSI),
 		}
+		node.Status.Allocatable = node.Status.Capacity
 		glog.Errorf("Error getting machine info: %v", err)
 	} else {
 		node.Status.NodeInfo.MachineID = info.MachineID
@@ -2020,6 +2103,7 @@ func (kl *Kubelet) setNodeStatus(node *api.Node) error {
 		node.Status.Capacity = CapacityFromMachineInfo(info)
 		node.Status.Capacity[api.ResourcePods] = *resource.NewQuantity(
 			int64(kl.pods), resource.DecimalSI)
+		node.Status.Allocatable = allocatableResources(node.Status.Capacity, kl.systemReserved)
 		if node.Status.NodeInfo.BootID != "" &&
 			node.Status.NodeInfo.BootID != info.BootID {
 			// TODO: This requires a transaction, either both node status is updated
@@ -2098,6 +2182,10 @@ func (kl *Kubelet) setNodeStatus(node *api.Node) error {
 			kl.recordNodeStatusEvent("NodeNotReady")
 		}
 	}
+
+	kl.setNodePressureCondition(node, api.NodeMemoryPressure, kl.evictionManager.IsUnderMemoryPressure(), "memory", currentTime)
+	kl.setNodePressureCondition(node, api.NodeDiskPressure, kl.evictionManager.IsUnderDiskPressure(), "disk", currentTime)
+
 	if oldNodeUnschedulable != node.Spec.Unschedulable {
 		if node.Spec.Unschedulable {
 			kl.recordNodeStatusEvent("NodeNotSchedulable")
@@ -2109,6 +2197,48 @@ func (kl *Kubelet) setNodeStatus(node *api.Node) error {
 	return nil
 }
 
+// setNodePressureCondition sets the given pressure condition of the node,
+// recording an event when the node comes under or leaves pressure.
+func (kl *Kubelet) setNodePressureCondition(node *api.Node, conditionType api.NodeConditionType, underPressure bool, resourceName string, currentTime util.Time) {
+	newCondition := api.NodeCondition{
+		Type:              conditionType,
+		Status:            api.ConditionFalse,
+		Reason:            fmt.Sprintf("kubelet has sufficient %s available", resourceName),
+		LastHeartbeatTime: currentTime,
+	}
+	if underPressure {
+		newCondition.Status = api.ConditionTrue
+		newCondition.Reason = fmt.Sprintf("kubelet has insufficient %s available", resourceName)
+	}
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type != conditionType {
+			continue
+		}
+		if node.Status.Conditions[i].Status == newCondition.Status {
+			newCondition.LastTransitionTime = node.Status.Conditions[i].LastTransitionTime
+		} else {
+			newCondition.LastTransitionTime = currentTime
+			kl.recordNodeStatusEvent(pressureEvent(conditionType, underPressure))
+		}
+		node.Status.Conditions[i] = newCondition
+		return
+	}
+	newCondition.LastTransitionTime = currentTime
+	node.Status.Conditions = append(node.Status.Conditions, newCondition)
+	if underPressure {
+		kl.recordNodeStatusEvent(pressureEvent(conditionType, underPressure))
+	}
+}
+
+// pressureEvent returns the node event for entering or leaving a pressure condition,
+// e.g. NodeHasMemoryPressure or NodeHasNoMemoryPressure.
+func pressureEvent(conditionType api.NodeConditionType, underPressure bool) string {
+	if underPressure {
+		return "NodeHas" + string(conditionType)
+	}
+	return "NodeHasNo" + string(conditionType)
+}
+
 func (kl *Kubelet) containerRuntimeUp() bool {
 	kl.runtimeMutex.Lock()
 	defer kl.runtimeMutex.Unlock()
@@ -2207,6 +2337,27 @@ func GetPhase(spec *api.PodSpec, info []api.ContainerStatus) api.PodPhase {
 	}
 }
 
+// getInitializationPhase returns the phase of a pod whose init containers have
+// not all run to completion yet, given their statuses. It returns an empty phase
+// once every init container has succeeded.
+func getInitializationPhase(spec *api.PodSpec, info []api.ContainerStatus) api.PodPhase {
+	for _, container := range spec.InitContainers {
+		containerStatus, ok := api.GetContainerStatus(info, container.Name)
+		if !ok || containerStatus.State.Terminated == nil {
+			// The init container has not been started or is still running.
+			return api.PodPending
+		}
+		if containerStatus.State.Terminated.ExitCode != 0 {
+			if spec.RestartPolicy == api.RestartPolicyNever {
+				// Failed init containers are not retried.
+				return api.PodFailed
+			}
+			return api.PodPending
+		}
+	}
+	return ""
+}
+
 // getPodReadyCondition returns ready condition if all containers in a pod are ready, else it returns an unready condition.
 func getPodReadyCondition(spec *api.PodSpec, statuses []api.ContainerStatus) []api.PodCondition {
 	ready := []api.PodCondition{{
@@ -2275,6 +2426,9 @@ func (kl *Kubelet) generatePodStatus(pod *api.Pod) (api.PodStatus, error) {
 
 	// Assume info is ready to process
 	podStatus.Phase = GetPhase(spec, podStatus.ContainerStatuses)
+	if phase := getInitializationPhase(spec, podStatus.InitContainerStatuses); phase != "" {
+		podStatus.Phase = phase
+	}
 	for _, c := range spec.Containers {
 		for i, st := range podStatus.ContainerStatuses {
 			if st.Name == c.Name {
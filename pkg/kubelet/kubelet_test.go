@@ -118,6 +118,11 @@ func newTestKubelet(t *testing.T) *TestKubelet {
 		t.Fatalf("can't initialize disk space manager: %v", err)
 	}
 	kubelet.diskSpaceManager = diskSpaceManager
+	evictionManager, err := newEvictionManager(mockCadvisor, fakeRecorder, EvictionPolicy{}, kubelet.getActivePods, kubelet.getPodUsage, kubelet.evictPod)
+	if err != nil {
+		t.Fatalf("can't initialize eviction manager: %v", err)
+	}
+	kubelet.evictionManager = evictionManager
 
 	kubelet.containerRuntime = fakeRuntime
 	kubelet.runtimeCache = kubecontainer.NewFakeRuntimeCache(kubelet.containerRuntime)
@@ -127,7 +132,7 @@ func newTestKubelet(t *testing.T) *TestKubelet {
 		t:            t,
 	}
 	kubelet.volumeManager = newVolumeManager()
-	kubelet.containerManager, _ = newContainerManager(mockCadvisor, "", "", "")
+	kubelet.containerManager, _ = newContainerManager(mockCadvisor, "", "", "", false, "", nil)
 	kubelet.networkConfigured = true
 	return &TestKubelet{kubelet, fakeRuntime, mockCadvisor, fakeKubeClient, fakeMirrorClient}
 }
@@ -387,6 +392,47 @@ func TestSyncPodsDeletesWhenSourcesAreReady(t *testing.T) {
 	fakeRuntime.AssertKilledPods([]string{"12345678"})
 }
 
+func TestSyncPodsKillsEvictedPods(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	fakeRuntime := testKubelet.fakeRuntime
+	testKubelet.fakeCadvisor.On("MachineInfo").Return(&cadvisorApi.MachineInfo{}, nil)
+	testKubelet.fakeCadvisor.On("DockerImagesFsInfo").Return(cadvisorApiv2.FsInfo{}, nil)
+	testKubelet.fakeCadvisor.On("RootFsInfo").Return(cadvisorApiv2.FsInfo{}, nil)
+	kubelet := testKubelet.kubelet
+
+	pods := []*api.Pod{
+		{
+			ObjectMeta: api.ObjectMeta{UID: "12345678", Name: "foo", Namespace: "new"},
+			Spec:       api.PodSpec{Containers: []api.Container{{Name: "bar"}}},
+		},
+	}
+	kubelet.podManager.SetPods(pods)
+	fakeRuntime.PodList = []*kubecontainer.Pod{
+		{
+			ID:   "12345678",
+			Name: "foo", Namespace: "new",
+			Containers: []*kubecontainer.Container{
+				{Name: "bar"},
+			},
+		},
+	}
+
+	if err := kubelet.evictPod(pods[0], "Evicted", "The node was low on memory."); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	// Eviction only fails the pod; its containers are left to SyncPods.
+	fakeRuntime.AssertKilledPods([]string{})
+	status, found := kubelet.statusManager.GetPodStatus(kubecontainer.GetPodFullName(pods[0]))
+	if !found || status.Phase != api.PodFailed || status.Reason != "Evicted" {
+		t.Errorf("expected the evicted pod to have failed, got %+v", status)
+	}
+
+	if err := kubelet.SyncPods(pods, emptyPodUIDs, map[string]*api.Pod{}, time.Now()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	fakeRuntime.AssertKilledPods([]string{"12345678"})
+}
+
 func TestMountExternalVolumes(t *testing.T) {
 	testKubelet := newTestKubelet(t)
 	kubelet := testKubelet.kubelet
@@ -1648,6 +1694,60 @@ func TestPodPhaseWithRestartOnFailure(t *testing.T) {
 	}
 }
 
+func TestPodInitializationPhase(t *testing.T) {
+	spec := func(policy api.RestartPolicy) *api.PodSpec {
+		return &api.PodSpec{
+			InitContainers: []api.Container{
+				{Name: "initA"},
+				{Name: "initB"},
+			},
+			Containers: []api.Container{
+				{Name: "containerA"},
+			},
+			RestartPolicy: policy,
+		}
+	}
+
+	tests := []struct {
+		spec     *api.PodSpec
+		statuses []api.ContainerStatus
+		phase    api.PodPhase
+		test     string
+	}{
+		{spec(api.RestartPolicyAlways), nil, api.PodPending, "waiting"},
+		{
+			spec(api.RestartPolicyAlways),
+			[]api.ContainerStatus{succeededState("initA"), runningState("initB")},
+			api.PodPending,
+			"second init container running",
+		},
+		{
+			spec(api.RestartPolicyAlways),
+			[]api.ContainerStatus{succeededState("initA"), succeededState("initB")},
+			"",
+			"all init containers succeeded",
+		},
+		{
+			spec(api.RestartPolicyOnFailure),
+			[]api.ContainerStatus{failedState("initA")},
+			api.PodPending,
+			"init container failed with restart onfailure",
+		},
+		{
+			spec(api.RestartPolicyNever),
+			[]api.ContainerStatus{failedState("initA")},
+			api.PodFailed,
+			"init container failed with restart never",
+		},
+		{&api.PodSpec{RestartPolicy: api.RestartPolicyNever}, nil, "", "no init containers"},
+	}
+	for _, test := range tests {
+		if phase := getInitializationPhase(test.spec, test.statuses); phase != test.phase {
+			t.Errorf("In test %s, expected %q, got %q", test.test, test.phase, phase)
+		}
+	}
+}
+
 func getReadyStatus(cName string) api.ContainerStatus {
 	return api.ContainerStatus{
 		Name:  cName,
@@ -2100,7 +2200,7 @@ func TestHandleMemExceeded(t *testing.T) {
 	testKubelet.fakeCadvisor.On("RootFsInfo").Return(cadvisorApiv2.FsInfo{}, nil)
 
 	spec := api.PodSpec{Containers: []api.Container{{Resources: api.ResourceRequirements{
-		Limits: api.ResourceList{
+		Requests: api.ResourceList{
 			"memory": resource.MustParse("90"),
 		},
 	}}}}
@@ -2267,6 +2367,10 @@ func TestValidateContainerStatus(t *testing.T) {
 func TestUpdateNewNodeStatus(t *testing.T) {
 	testKubelet := newTestKubelet(t)
 	kubelet := testKubelet.kubelet
+	kubelet.systemReserved = api.ResourceList{
+		api.ResourceCPU:    *resource.NewMilliQuantity(500, resource.DecimalSI),
+		api.ResourceMemory: *resource.NewQuantity(256, resource.BinarySI),
+	}
 	kubeClient := testKubelet.fakeKubeClient
 	kubeClient.ReactFn = testclient.NewSimpleFake(&api.NodeList{Items: []api.Node{
 		{ObjectMeta: api.ObjectMeta{Name: testKubeletHostname}},
@@ -2298,6 +2402,20 @@ func TestUpdateNewNodeStatus(t *testing.T) {
 					LastHeartbeatTime:  util.Time{},
 					LastTransitionTime: util.Time{},
 				},
+				{
+					Type:               api.NodeMemoryPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient memory available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
+				{
+					Type:               api.NodeDiskPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient disk available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
 			},
 			NodeInfo: api.NodeSystemInfo{
 				MachineID:               "123",
@@ -2314,6 +2432,11 @@ func TestUpdateNewNodeStatus(t *testing.T) {
 				api.ResourceMemory: *resource.NewQuantity(1024, resource.BinarySI),
 				api.ResourcePods:   *resource.NewQuantity(0, resource.DecimalSI),
 			},
+			Allocatable: api.ResourceList{
+				api.ResourceCPU:    *resource.NewMilliQuantity(1500, resource.DecimalSI),
+				api.ResourceMemory: *resource.NewQuantity(768, resource.BinarySI),
+				api.ResourcePods:   *resource.NewQuantity(0, resource.DecimalSI),
+			},
 			Addresses: []api.NodeAddress{{Type: api.NodeLegacyHostIP, Address: "127.0.0.1"}},
 		},
 	}
@@ -2335,8 +2458,10 @@ func TestUpdateNewNodeStatus(t *testing.T) {
 	if updatedNode.Status.Conditions[0].LastTransitionTime.IsZero() {
 		t.Errorf("unexpected zero last transition timestamp")
 	}
-	updatedNode.Status.Conditions[0].LastHeartbeatTime = util.Time{}
-	updatedNode.Status.Conditions[0].LastTransitionTime = util.Time{}
+	for i := range updatedNode.Status.Conditions {
+		updatedNode.Status.Conditions[i].LastHeartbeatTime = util.Time{}
+		updatedNode.Status.Conditions[i].LastTransitionTime = util.Time{}
+	}
 	if !reflect.DeepEqual(expectedNode, updatedNode) {
 		t.Errorf("unexpected objects: %s", util.ObjectDiff(expectedNode, updatedNode))
 	}
@@ -2395,6 +2520,20 @@ func TestUpdateExistingNodeStatus(t *testing.T) {
 					LastHeartbeatTime:  util.Time{}, // placeholder
 					LastTransitionTime: util.Time{}, // placeholder
 				},
+				{
+					Type:               api.NodeMemoryPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient memory available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
+				{
+					Type:               api.NodeDiskPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient disk available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
 			},
 			NodeInfo: api.NodeSystemInfo{
 				MachineID:               "123",
@@ -2411,6 +2550,11 @@ func TestUpdateExistingNodeStatus(t *testing.T) {
 				api.ResourceMemory: *resource.NewQuantity(1024, resource.BinarySI),
 				api.ResourcePods:   *resource.NewQuantity(0, resource.DecimalSI),
 			},
+			Allocatable: api.ResourceList{
+				api.ResourceCPU:    *resource.NewMilliQuantity(2000, resource.DecimalSI),
+				api.ResourceMemory: *resource.NewQuantity(1024, resource.BinarySI),
+				api.ResourcePods:   *resource.NewQuantity(0, resource.DecimalSI),
+			},
 			Addresses: []api.NodeAddress{{Type: api.NodeLegacyHostIP, Address: "127.0.0.1"}},
 		},
 	}
@@ -2434,8 +2578,10 @@ func TestUpdateExistingNodeStatus(t *testing.T) {
 		t.Errorf("expected \n%#v\n, got \n%#v", updatedNode.Status.Conditions[0].LastTransitionTime.Rfc3339Copy(),
 			util.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC))
 	}
-	updatedNode.Status.Conditions[0].LastHeartbeatTime = util.Time{}
-	updatedNode.Status.Conditions[0].LastTransitionTime = util.Time{}
+	for i := range updatedNode.Status.Conditions {
+		updatedNode.Status.Conditions[i].LastHeartbeatTime = util.Time{}
+		updatedNode.Status.Conditions[i].LastTransitionTime = util.Time{}
+	}
 	if !reflect.DeepEqual(expectedNode, updatedNode) {
 		t.Errorf("expected \n%v\n, got \n%v", expectedNode, updatedNode)
 	}
@@ -2481,6 +2627,20 @@ func TestUpdateNodeStatusWithoutContainerRuntime(t *testing.T) {
 					LastHeartbeatTime:  util.Time{},
 					LastTransitionTime: util.Time{},
 				},
+				{
+					Type:               api.NodeMemoryPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient memory available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
+				{
+					Type:               api.NodeDiskPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient disk available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
 			},
 			NodeInfo: api.NodeSystemInfo{
 				MachineID:               "123",
@@ -2497,6 +2657,11 @@ func TestUpdateNodeStatusWithoutContainerRuntime(t *testing.T) {
 				api.ResourceMemory: *resource.NewQuantity(1024, resource.BinarySI),
 				api.ResourcePods:   *resource.NewQuantity(0, resource.DecimalSI),
 			},
+			Allocatable: api.ResourceList{
+				api.ResourceCPU:    *resource.NewMilliQuantity(2000, resource.DecimalSI),
+				api.ResourceMemory: *resource.NewQuantity(1024, resource.BinarySI),
+				api.ResourcePods:   *resource.NewQuantity(0, resource.DecimalSI),
+			},
 			Addresses: []api.NodeAddress{{Type: api.NodeLegacyHostIP, Address: "127.0.0.1"}},
 		},
 	}
@@ -2520,8 +2685,10 @@ func TestUpdateNodeStatusWithoutContainerRuntime(t *testing.T) {
 	if updatedNode.Status.Conditions[0].LastTransitionTime.IsZero() {
 		t.Errorf("unexpected zero last transition timestamp")
 	}
-	updatedNode.Status.Conditions[0].LastHeartbeatTime = util.Time{}
-	updatedNode.Status.Conditions[0].LastTransitionTime = util.Time{}
+	for i := range updatedNode.Status.Conditions {
+		updatedNode.Status.Conditions[i].LastHeartbeatTime = util.Time{}
+		updatedNode.Status.Conditions[i].LastTransitionTime = util.Time{}
+	}
 	if !reflect.DeepEqual(expectedNode, updatedNode) {
 		t.Errorf("unexpected objects: %s", util.ObjectDiff(expectedNode, updatedNode))
 	}
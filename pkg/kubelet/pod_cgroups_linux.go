@@ -0,0 +1,229 @@
+// +build linux
+
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/dockertools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/qos"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/errors"
+	"github.com/docker/libcontainer/cgroups"
+	"github.com/golang/glog"
+)
+
+// Name of the cgroup holding all pods, relative to the cgroup root.
+const podsCgroupName = "kubepods"
+
+// Prefix of the names of pod cgroups, followed by the pod UID.
+const podCgroupPrefix = "pod"
+
+// The cgroup hierarchies pod cgroups are configured in.
+var podCgroupSubsystems = []string{"cpu", "memory"}
+
+// podCgroupManager maintains the cgroup hierarchy of pods:
+//
+//   <root>/kubepods                       limited to the allocatable resources of the node
+//   <root>/kubepods/<qos class>           shares the CPU by the requests of its pods
+//   <root>/kubepods/<qos class>/pod<uid>  limited by the requests and limits of the pod
+//
+// Cgroups are written directly to the cpu and memory hierarchies, as they are
+// only created here and populated by the container runtime.
+type podCgroupManager struct {
+	// Absolute name of the cgroup the pods cgroup is created in.
+	root string
+	// Mount points of the cgroup hierarchies, by subsystem.
+	mountpoints map[string]string
+	// Resources of the node available to pods.
+	allocatable api.ResourceList
+}
+
+func newPodCgroupManager(root string, allocatable api.ResourceList) (*podCgroupManager, error) {
+	mountpoints := map[string]string{}
+	for _, subsystem := range podCgroupSubsystems {
+		mountpoint, err := cgroups.FindCgroupMountpoint(subsystem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the %s cgroup hierarchy: %v", subsystem, err)
+		}
+		mountpoints[subsystem] = mountpoint
+	}
+	if root == "" {
+		root = "/"
+	}
+	return &podCgroupManager{
+		root:        root,
+		mountpoints: mountpoints,
+		allocatable: allocatable,
+	}, nil
+}
+
+// qosCgroupName returns the absolute name of the cgroup of a QoS class.
+func (m *podCgroupManager) qosCgroupName(class qos.QOSClass) string {
+	return path.Join(m.root, podsCgroupName, strings.ToLower(string(class)))
+}
+
+// podCgroupName returns the absolute name of the cgroup of a pod.
+func (m *podCgroupManager) podCgroupName(pod *api.Pod) string {
+	return path.Join(m.qosCgroupName(qos.GetPodQOS(pod)), podCgroupPrefix+string(pod.UID))
+}
+
+func (m *podCgroupManager) Update(pods []*api.Pod) error {
+	var errs []error
+	allocatableCPU := m.allocatable[api.ResourceCPU]
+	allocatableMemory := m.allocatable[api.ResourceMemory]
+	if err := m.ensureCgroup(path.Join(m.root, podsCgroupName), dockertools.MilliCPUToShares(allocatableCPU.MilliValue()), allocatableMemory.Value()); err != nil {
+		errs = append(errs, err)
+	}
+
+	classes := []qos.QOSClass{qos.Guaranteed, qos.Burstable, qos.BestEffort}
+	classCPURequests := map[qos.QOSClass]int64{}
+	wanted := map[qos.QOSClass]util.StringSet{}
+	for _, class := range classes {
+		wanted[class] = util.NewStringSet()
+	}
+	for _, pod := range pods {
+		class := qos.GetPodQOS(pod)
+		cpuRequest, memoryLimit := podCgroupResources(pod)
+		classCPURequests[class] += cpuRequest
+		wanted[class].Insert(podCgroupPrefix + string(pod.UID))
+		if err := m.ensureCgroup(m.podCgroupName(pod), dockertools.MilliCPUToShares(cpuRequest), memoryLimit); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, class := range classes {
+		// Best-effort pods request no CPU, so they get the minimum shares.
+		if err := m.ensureCgroup(m.qosCgroupName(class), dockertools.MilliCPUToShares(classCPURequests[class]), 0); err != nil {
+			errs = append(errs, err)
+		}
+		if err := m.removeOtherPodCgroups(class, wanted[class]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
+// podCgroupResources returns the CPU request, in millicores, and the memory
+// limit, in bytes, of a pod. The memory limit is zero, i.e. unlimited, unless
+// every container and init container of the pod has one. Containers without a
+// CPU request request their CPU limit, as in the container runtime. Init
+// containers run one at a time before the other containers, so the pod gets
+// the resources of its largest init container when they exceed the sum over
+// the other containers.
+func podCgroupResources(pod *api.Pod) (cpuRequest int64, memoryLimit int64) {
+	memoryLimited := true
+	for i := range pod.Spec.Containers {
+		cpu, memory := containerCgroupResources(&pod.Spec.Containers[i])
+		cpuRequest += cpu
+		memoryLimit += memory
+		if memory == 0 {
+			memoryLimited = false
+		}
+	}
+	for i := range pod.Spec.InitContainers {
+		cpu, memory := containerCgroupResources(&pod.Spec.InitContainers[i])
+		if cpu > cpuRequest {
+			cpuRequest = cpu
+		}
+		if memory > memoryLimit {
+			memoryLimit = memory
+		}
+		if memory == 0 {
+			memoryLimited = false
+		}
+	}
+	if !memoryLimited {
+		memoryLimit = 0
+	}
+	return cpuRequest, memoryLimit
+}
+
+// containerCgroupResources returns the CPU request, in millicores, and the
+// memory limit, in bytes, of a container, see podCgroupResources.
+func containerCgroupResources(container *api.Container) (cpuRequest int64, memoryLimit int64) {
+	if request, found := container.Resources.Requests[api.ResourceCPU]; found {
+		cpuRequest = request.MilliValue()
+	} else if limit, found := container.Resources.Limits[api.ResourceCPU]; found {
+		cpuRequest = limit.MilliValue()
+	}
+	if limit, found := container.Resources.Limits[api.ResourceMemory]; found && limit.Value() > 0 {
+		memoryLimit = limit.Value()
+	}
+	return cpuRequest, memoryLimit
+}
+
+// ensureCgroup creates the cgroup if needed and sets its CPU shares and
+// memory limit. A zero memory limit means unlimited.
+func (m *podCgroupManager) ensureCgroup(name string, cpuShares, memoryLimit int64) error {
+	cpuPath := filepath.Join(m.mountpoints["cpu"], name)
+	if err := os.MkdirAll(cpuPath, 0755); err != nil {
+		return fmt.Errorf("failed to create cgroup %q: %v", name, err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(cpuPath, "cpu.shares"), []byte(strconv.FormatInt(cpuShares, 10)), 0644); err != nil {
+		return fmt.Errorf("failed to set the CPU shares of cgroup %q: %v", name, err)
+	}
+
+	memoryPath := filepath.Join(m.mountpoints["memory"], name)
+	if err := os.MkdirAll(memoryPath, 0755); err != nil {
+		return fmt.Errorf("failed to create cgroup %q: %v", name, err)
+	}
+	limit := "-1"
+	if memoryLimit > 0 {
+		limit = strconv.FormatInt(memoryLimit, 10)
+	}
+	if err := ioutil.WriteFile(filepath.Join(memoryPath, "memory.limit_in_bytes"), []byte(limit), 0644); err != nil {
+		return fmt.Errorf("failed to set the memory limit of cgroup %q: %v", name, err)
+	}
+	return nil
+}
+
+// removeOtherPodCgroups removes the pod cgroups of a QoS class that are not
+// wanted. Cgroups which still hold processes cannot be removed, and are
+// retried on the next update.
+func (m *podCgroupManager) removeOtherPodCgroups(class qos.QOSClass, wanted util.StringSet) error {
+	var errs []error
+	for _, subsystem := range podCgroupSubsystems {
+		qosPath := filepath.Join(m.mountpoints[subsystem], m.qosCgroupName(class))
+		entries, err := ioutil.ReadDir(qosPath)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() || !strings.HasPrefix(entry.Name(), podCgroupPrefix) || wanted.Has(entry.Name()) {
+				continue
+			}
+			glog.V(3).Infof("Removing cgroup %q of pod that is no longer running", path.Join(m.qosCgroupName(class), entry.Name()))
+			if err := os.Remove(filepath.Join(qosPath, entry.Name())); err != nil && !os.IsNotExist(err) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.NewAggregate(errs)
+}
@@ -0,0 +1,164 @@
+// +build linux
+
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPodCgroupManager returns a pod cgroup manager writing to cgroup
+// hierarchies in a temporary directory, which the caller must remove.
+func newTestPodCgroupManager(t *testing.T) (*podCgroupManager, string) {
+	dir, err := ioutil.TempDir("", "pod_cgroups")
+	require.NoError(t, err)
+	return &podCgroupManager{
+		root: "/",
+		mountpoints: map[string]string{
+			"cpu":    filepath.Join(dir, "cpu"),
+			"memory": filepath.Join(dir, "memory"),
+		},
+		allocatable: api.ResourceList{
+			api.ResourceCPU:    resource.MustParse("2"),
+			api.ResourceMemory: resource.MustParse("1Gi"),
+		},
+	}, dir
+}
+
+func newCgroupTestPod(uid string, requests, limits api.ResourceList) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{UID: types.UID(uid), Name: uid, Namespace: "test"},
+		Spec: api.PodSpec{Containers: []api.Container{{
+			Name:      "foo",
+			Resources: api.ResourceRequirements{Requests: requests, Limits: limits},
+		}}},
+	}
+}
+
+func readCgroupFile(t *testing.T, dir, subsystem, name, file string) string {
+	data, err := ioutil.ReadFile(filepath.Join(dir, subsystem, name, file))
+	require.NoError(t, err)
+	return strings.TrimSpace(string(data))
+}
+
+func TestPodCgroupsUpdate(t *testing.T) {
+	m, dir := newTestPodCgroupManager(t)
+	defer os.RemoveAll(dir)
+
+	guaranteed := newCgroupTestPod("guaranteed", nil, api.ResourceList{
+		api.ResourceCPU:    resource.MustParse("500m"),
+		api.ResourceMemory: resource.MustParse("100Mi"),
+	})
+	burstable := newCgroupTestPod("burstable", api.ResourceList{
+		api.ResourceCPU: resource.MustParse("250m"),
+	}, nil)
+	bestEffort := newCgroupTestPod("besteffort", nil, nil)
+	require.NoError(t, m.Update([]*api.Pod{guaranteed, burstable, bestEffort}))
+
+	assert.Equal(t, "2048", readCgroupFile(t, dir, "cpu", "kubepods", "cpu.shares"))
+	assert.Equal(t, "1073741824", readCgroupFile(t, dir, "memory", "kubepods", "memory.limit_in_bytes"))
+
+	assert.Equal(t, "512", readCgroupFile(t, dir, "cpu", "kubepods/guaranteed", "cpu.shares"))
+	assert.Equal(t, "256", readCgroupFile(t, dir, "cpu", "kubepods/burstable", "cpu.shares"))
+	assert.Equal(t, "2", readCgroupFile(t, dir, "cpu", "kubepods/besteffort", "cpu.shares"))
+
+	assert.Equal(t, "/kubepods/guaranteed/podguaranteed", m.podCgroupName(guaranteed))
+	assert.Equal(t, "512", readCgroupFile(t, dir, "cpu", "kubepods/guaranteed/podguaranteed", "cpu.shares"))
+	assert.Equal(t, "104857600", readCgroupFile(t, dir, "memory", "kubepods/guaranteed/podguaranteed", "memory.limit_in_bytes"))
+	assert.Equal(t, "256", readCgroupFile(t, dir, "cpu", "kubepods/burstable/podburstable", "cpu.shares"))
+	assert.Equal(t, "-1", readCgroupFile(t, dir, "memory", "kubepods/burstable/podburstable", "memory.limit_in_bytes"))
+	assert.Equal(t, "2", readCgroupFile(t, dir, "cpu", "kubepods/besteffort/podbesteffort", "cpu.shares"))
+	assert.Equal(t, "-1", readCgroupFile(t, dir, "memory", "kubepods/besteffort/podbesteffort", "memory.limit_in_bytes"))
+}
+
+func TestPodCgroupsInitContainers(t *testing.T) {
+	m, dir := newTestPodCgroupManager(t)
+	defer os.RemoveAll(dir)
+
+	limits := api.ResourceList{
+		api.ResourceCPU:    resource.MustParse("500m"),
+		api.ResourceMemory: resource.MustParse("100Mi"),
+	}
+	// The init container needs more than the containers of the pod.
+	bigInit := newCgroupTestPod("biginit", nil, limits)
+	bigInit.Spec.InitContainers = []api.Container{{
+		Name: "init",
+		Resources: api.ResourceRequirements{Limits: api.ResourceList{
+			api.ResourceCPU:    resource.MustParse("1"),
+			api.ResourceMemory: resource.MustParse("200Mi"),
+		}},
+	}}
+	// The init container needs less than the containers of the pod.
+	smallInit := newCgroupTestPod("smallinit", nil, limits)
+	smallInit.Spec.InitContainers = []api.Container{{
+		Name: "init",
+		Resources: api.ResourceRequirements{Limits: api.ResourceList{
+			api.ResourceCPU:    resource.MustParse("100m"),
+			api.ResourceMemory: resource.MustParse("50Mi"),
+		}},
+	}}
+	// The init container has no memory limit.
+	unlimitedInit := newCgroupTestPod("unlimitedinit", nil, limits)
+	unlimitedInit.Spec.InitContainers = []api.Container{{Name: "init"}}
+	require.NoError(t, m.Update([]*api.Pod{bigInit, smallInit, unlimitedInit}))
+
+	assert.Equal(t, "1024", readCgroupFile(t, dir, "cpu", m.podCgroupName(bigInit), "cpu.shares"))
+	assert.Equal(t, "209715200", readCgroupFile(t, dir, "memory", m.podCgroupName(bigInit), "memory.limit_in_bytes"))
+	assert.Equal(t, "512", readCgroupFile(t, dir, "cpu", m.podCgroupName(smallInit), "cpu.shares"))
+	assert.Equal(t, "104857600", readCgroupFile(t, dir, "memory", m.podCgroupName(smallInit), "memory.limit_in_bytes"))
+	assert.Equal(t, "512", readCgroupFile(t, dir, "cpu", m.podCgroupName(unlimitedInit), "cpu.shares"))
+	assert.Equal(t, "-1", readCgroupFile(t, dir, "memory", m.podCgroupName(unlimitedInit), "memory.limit_in_bytes"))
+}
+
+func TestPodCgroupsRemoveDeletedPods(t *testing.T) {
+	m, dir := newTestPodCgroupManager(t)
+	defer os.RemoveAll(dir)
+
+	kept := newCgroupTestPod("kept", nil, nil)
+	deleted := newCgroupTestPod("deleted", nil, nil)
+	require.NoError(t, m.Update([]*api.Pod{kept, deleted}))
+	// Only the empty cgroup directories can be removed, as in a cgroup file system.
+	for _, subsystem := range podCgroupSubsystems {
+		for _, pod := range []*api.Pod{kept, deleted} {
+			cgroupDir := filepath.Join(dir, subsystem, m.podCgroupName(pod))
+			files, err := ioutil.ReadDir(cgroupDir)
+			require.NoError(t, err)
+			for _, file := range files {
+				require.NoError(t, os.Remove(filepath.Join(cgroupDir, file.Name())))
+			}
+		}
+	}
+
+	require.NoError(t, m.Update([]*api.Pod{kept}))
+	for _, subsystem := range podCgroupSubsystems {
+		_, err := os.Stat(filepath.Join(dir, subsystem, m.podCgroupName(kept)))
+		assert.NoError(t, err)
+		_, err = os.Stat(filepath.Join(dir, subsystem, m.podCgroupName(deleted)))
+		assert.True(t, os.IsNotExist(err), "cgroup of deleted pod was not removed from %s hierarchy", subsystem)
+	}
+}
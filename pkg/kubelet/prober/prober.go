@@ -18,10 +18,12 @@ package prober
 
 import (
 	"fmt"
+	"io"
 	"net"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
@@ -37,11 +39,13 @@ import (
 	"github.com/golang/glog"
 )
 
-const maxProbeRetries = 3
-
 // Prober checks the healthiness of a container.
 type Prober interface {
+	// Probe returns the last known liveness of the given container, making
+	// sure that its probes are being run periodically in the background.
 	Probe(pod *api.Pod, status api.PodStatus, container api.Container, containerID string, createdAt int64) (probe.Result, error)
+	// RemoveContainer stops probing the container with the given ID.
+	RemoveContainer(containerID string)
 }
 
 // Prober helps to check the liveness/readiness of a container.
@@ -52,8 +56,14 @@ type prober struct {
 	runner kubecontainer.ContainerCommandRunner
 
 	readinessManager *kubecontainer.ReadinessManager
+	livenessManager  *kubecontainer.LivenessManager
 	refManager       *kubecontainer.RefManager
 	recorder         record.EventRecorder
+
+	// guards workers
+	workerLock sync.Mutex
+	// the probe workers of running containers
+	workers map[workerKey]*worker
 }
 
 // NewProber creates a Prober, it takes a command runner and
@@ -71,8 +81,10 @@ func New(
 		runner: runner,
 
 		readinessManager: readinessManager,
+		livenessManager:  kubecontainer.NewLivenessManager(),
 		refManager:       refManager,
 		recorder:         recorder,
+		workers:          make(map[workerKey]*worker),
 	}
 }
 
@@ -86,114 +98,78 @@ func NewTestProber(
 	return &prober{
 		exec:             exec,
 		readinessManager: readinessManager,
+		livenessManager:  kubecontainer.NewLivenessManager(),
 		refManager:       refManager,
 		recorder:         recorder,
+		workers:          make(map[workerKey]*worker),
 	}
 }
 
-// Probe checks the liveness/readiness of the given container.
+// Probe starts the probe workers of the given container if they are not yet
+// running, and returns its liveness as last determined by its liveness worker.
+// Containers without a readiness probe are ready as soon as they are running.
 func (pb *prober) Probe(pod *api.Pod, status api.PodStatus, container api.Container, containerID string, createdAt int64) (probe.Result, error) {
-	pb.probeReadiness(pod, status, container, containerID, createdAt)
-	return pb.probeLiveness(pod, status, container, containerID, createdAt)
-}
-
-// probeLiveness probes the liveness of a container.
-// If the initalDelay since container creation on liveness probe has not passed the probe will return probe.Success.
-func (pb *prober) probeLiveness(pod *api.Pod, status api.PodStatus, container api.Container, containerID string, createdAt int64) (probe.Result, error) {
-	var live probe.Result
-	var output string
-	var err error
-	p := container.LivenessProbe
-	if p == nil {
-		return probe.Success, nil
+	if container.ReadinessProbe == nil {
+		pb.readinessManager.SetReadiness(containerID, true)
+	} else {
+		pb.startWorker(readiness, container.ReadinessProbe, pod, status, container, containerID, createdAt)
 	}
-	if time.Now().Unix()-createdAt < p.InitialDelaySeconds {
+	if container.LivenessProbe == nil {
 		return probe.Success, nil
-	} else {
-		live, output, err = pb.runProbeWithRetries(p, pod, status, container, containerID, maxProbeRetries)
 	}
-	ctrName := fmt.Sprintf("%s:%s", kubecontainer.GetPodFullName(pod), container.Name)
-	if err != nil || live != probe.Success {
-		// Liveness failed in one way or another.
-		ref, ok := pb.refManager.GetRef(containerID)
-		if !ok {
-			glog.Warningf("No ref for pod %q - '%v'", containerID, container.Name)
-		}
-		if err != nil {
-			glog.V(1).Infof("Liveness probe for %q errored: %v", ctrName, err)
-			if ok {
-				pb.recorder.Eventf(ref, "unhealthy", "Liveness probe errored: %v", err)
-			}
-			return probe.Unknown, err
-		} else { // live != probe.Success
-			glog.V(1).Infof("Liveness probe for %q failed (%v): %s", ctrName, live, output)
-			if ok {
-				pb.recorder.Eventf(ref, "unhealthy", "Liveness probe failed: %s", output)
-			}
-			return live, nil
-		}
+	pb.startWorker(liveness, container.LivenessProbe, pod, status, container, containerID, createdAt)
+	if !pb.livenessManager.GetLiveness(containerID) {
+		return probe.Failure, nil
 	}
-	glog.V(3).Infof("Liveness probe for %q succeeded", ctrName)
 	return probe.Success, nil
 }
 
-// probeReadiness probes and sets the readiness of a container.
-// If the initial delay on the readiness probe has not passed, we set readiness to false.
-func (pb *prober) probeReadiness(pod *api.Pod, status api.PodStatus, container api.Container, containerID string, createdAt int64) {
-	var ready probe.Result
-	var output string
-	var err error
-	p := container.ReadinessProbe
-	if p == nil {
-		ready = probe.Success
-	} else if time.Now().Unix()-createdAt < p.InitialDelaySeconds {
-		ready = probe.Failure
-	} else {
-		ready, output, err = pb.runProbeWithRetries(p, pod, status, container, containerID, maxProbeRetries)
+// startWorker starts a worker for the given probe of a container, or refreshes
+// the pod status seen by the worker if it is already running.
+func (pb *prober) startWorker(probeType probeType, spec *api.Probe, pod *api.Pod, status api.PodStatus, container api.Container, containerID string, createdAt int64) {
+	pb.workerLock.Lock()
+	defer pb.workerLock.Unlock()
+	key := workerKey{containerID, probeType}
+	if w, found := pb.workers[key]; found {
+		w.update(pod, status)
+		return
 	}
-	ctrName := fmt.Sprintf("%s:%s", kubecontainer.GetPodFullName(pod), container.Name)
-	if err != nil || ready == probe.Failure {
-		// Readiness failed in one way or another.
-		pb.readinessManager.SetReadiness(containerID, false)
-		ref, ok := pb.refManager.GetRef(containerID)
-		if !ok {
-			glog.Warningf("No ref for pod '%v' - '%v'", containerID, container.Name)
-		}
-		if err != nil {
-			glog.V(1).Infof("readiness probe for %q errored: %v", ctrName, err)
-			if ok {
-				pb.recorder.Eventf(ref, "unhealthy", "Readiness probe errored: %v", err)
-			}
-			return
-		} else { // ready != probe.Success
-			glog.V(1).Infof("Readiness probe for %q failed (%v): %s", ctrName, ready, output)
-			if ok {
-				pb.recorder.Eventf(ref, "unhealthy", "Readiness probe failed: %s", output)
-			}
-			return
+	w := newWorker(pb, probeType, spec, pod, status, container, containerID, createdAt)
+	pb.workers[key] = w
+	go func() {
+		defer util.HandleCrash()
+		w.run()
+	}()
+}
+
+// RemoveContainer stops the probe workers of a container and forgets its liveness.
+func (pb *prober) RemoveContainer(containerID string) {
+	pb.workerLock.Lock()
+	defer pb.workerLock.Unlock()
+	for _, probeType := range []probeType{liveness, readiness} {
+		key := workerKey{containerID, probeType}
+		if w, found := pb.workers[key]; found {
+			close(w.stop)
+			delete(pb.workers, key)
 		}
 	}
-	if ready == probe.Success {
-		pb.readinessManager.SetReadiness(containerID, true)
-	}
-
-	glog.V(3).Infof("Readiness probe for %q succeeded", ctrName)
-
+	pb.livenessManager.RemoveLiveness(containerID)
 }
 
-// runProbeWithRetries tries to probe the container in a finite loop, it returns the last result
-// if it never succeeds.
-func (pb *prober) runProbeWithRetries(p *api.Probe, pod *api.Pod, status api.PodStatus, container api.Container, containerID string, retries int) (probe.Result, string, error) {
-	var err error
-	var result probe.Result
-	var output string
-	for i := 0; i < retries; i++ {
-		result, output, err = pb.runProbe(p, pod, status, container, containerID)
-		if result == probe.Success {
-			return probe.Success, output, nil
-		}
+// setResult records the outcome of a probe worker, unless the worker has
+// been stopped in the meantime.
+func (pb *prober) setResult(w *worker, healthy bool) {
+	pb.workerLock.Lock()
+	defer pb.workerLock.Unlock()
+	if pb.workers[workerKey{w.containerID, w.probeType}] != w {
+		return
+	}
+	switch w.probeType {
+	case liveness:
+		pb.livenessManager.SetLiveness(w.containerID, healthy)
+	case readiness:
+		pb.readinessManager.SetReadiness(w.containerID, healthy)
 	}
-	return result, output, err
 }
 
 func (pb *prober) runProbe(p *api.Probe, pod *api.Pod, status api.PodStatus, container api.Container, containerID string) (probe.Result, string, error) {
@@ -287,3 +263,7 @@ func (eic execInContainer) CombinedOutput() ([]byte, error) {
 func (eic execInContainer) SetDir(dir string) {
 	//unimplemented
 }
+
+func (eic execInContainer) SetStdin(in io.Reader) {
+	//unimplemented
+}
@@ -24,8 +24,12 @@ import (
 var _ Prober = &FakeProber{}
 
 type FakeProber struct {
+	Liveness probe.Result
+	Error    error
 }
 
 func (fp *FakeProber) Probe(pod *api.Pod, status api.PodStatus, container api.Container, containerID string, createdAt int64) (probe.Result, error) {
-	return probe.Success, nil
+	return fp.Liveness, fp.Error
 }
+
+func (fp *FakeProber) RemoveContainer(containerID string) {}
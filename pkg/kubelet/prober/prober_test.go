@@ -165,6 +165,6 @@ type FakeExecProber struct {
 	err    error
 }
 
-func (p FakeExecProber) Probe(_ exec.Cmd) (probe.Result, error) {
-	return p.result, p.err
+func (p FakeExecProber) Probe(_ exec.Cmd) (probe.Result, string, error) {
+	return p.result, "", p.err
 }
@@ -0,0 +1,181 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prober
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/probe"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// defaultProbePeriod is used when a probe does not specify its period.
+	defaultProbePeriod = 10 * time.Second
+	// defaultSuccessThreshold and defaultFailureThreshold are used when a
+	// probe does not specify its thresholds.
+	defaultSuccessThreshold = 1
+	defaultFailureThreshold = 3
+)
+
+type probeType int
+
+const (
+	liveness probeType = iota
+	readiness
+)
+
+func (t probeType) String() string {
+	switch t {
+	case liveness:
+		return "Liveness"
+	case readiness:
+		return "Readiness"
+	default:
+		return "Unknown"
+	}
+}
+
+// workerKey identifies the probe worker of a container.
+type workerKey struct {
+	containerID string
+	probeType   probeType
+}
+
+// worker periodically runs one probe of a container and reports its result
+// back to the prober once the probe's success or failure threshold is met.
+type worker struct {
+	prober *prober
+
+	probeType   probeType
+	spec        *api.Probe
+	container   api.Container
+	containerID string
+	createdAt   int64
+
+	// guards pod and status, which are refreshed on every pod sync.
+	lock   sync.Mutex
+	pod    *api.Pod
+	status api.PodStatus
+
+	// closed to stop the worker
+	stop chan struct{}
+
+	// the result of the last probe and how many times in a row it occurred
+	lastResult probe.Result
+	resultRun  int
+}
+
+func newWorker(pb *prober, probeType probeType, spec *api.Probe, pod *api.Pod, status api.PodStatus, container api.Container, containerID string, createdAt int64) *worker {
+	return &worker{
+		prober:      pb,
+		probeType:   probeType,
+		spec:        spec,
+		pod:         pod,
+		status:      status,
+		container:   container,
+		containerID: containerID,
+		createdAt:   createdAt,
+		stop:        make(chan struct{}),
+	}
+}
+
+// update refreshes the pod and pod status the worker probes against.
+func (w *worker) update(pod *api.Pod, status api.PodStatus) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.pod = pod
+	w.status = status
+}
+
+// run probes the container every period until the worker is stopped.
+func (w *worker) run() {
+	period := time.Duration(w.spec.PeriodSeconds) * time.Second
+	if period <= 0 {
+		period = defaultProbePeriod
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		w.doProbe()
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// doProbe runs the probe once and reports the result if the same result has
+// now been seen often enough in a row.
+func (w *worker) doProbe() {
+	if time.Now().Unix()-w.createdAt < w.spec.InitialDelaySeconds {
+		return
+	}
+	w.lock.Lock()
+	pod, status := w.pod, w.status
+	w.lock.Unlock()
+
+	result, output, err := w.prober.runProbe(w.spec, pod, status, w.container, w.containerID)
+	ctrName := fmt.Sprintf("%s:%s", kubecontainer.GetPodFullName(pod), w.container.Name)
+	if err != nil || result != probe.Success {
+		result = probe.Failure
+		ref, ok := w.prober.refManager.GetRef(w.containerID)
+		if !ok {
+			glog.Warningf("No ref for pod %q - '%v'", w.containerID, w.container.Name)
+		}
+		if err != nil {
+			glog.V(1).Infof("%s probe for %q errored: %v", w.probeType, ctrName, err)
+			if ok {
+				w.prober.recorder.Eventf(ref, "unhealthy", "%s probe errored: %v", w.probeType, err)
+			}
+		} else {
+			glog.V(1).Infof("%s probe for %q failed: %s", w.probeType, ctrName, output)
+			if ok {
+				w.prober.recorder.Eventf(ref, "unhealthy", "%s probe failed: %s", w.probeType, output)
+			}
+		}
+	} else {
+		glog.V(3).Infof("%s probe for %q succeeded", w.probeType, ctrName)
+	}
+
+	if result == w.lastResult {
+		w.resultRun++
+	} else {
+		w.lastResult = result
+		w.resultRun = 1
+	}
+	threshold := w.spec.SuccessThreshold
+	if threshold <= 0 {
+		threshold = defaultSuccessThreshold
+	}
+	if result == probe.Failure {
+		threshold = w.spec.FailureThreshold
+		if threshold <= 0 {
+			threshold = defaultFailureThreshold
+		}
+	}
+	if w.resultRun < threshold {
+		return
+	}
+	w.prober.setResult(w, result == probe.Success)
+}
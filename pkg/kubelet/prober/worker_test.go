@@ -0,0 +1,146 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prober
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/probe"
+)
+
+const testContainerID = "foobar"
+
+func newTestProber() *prober {
+	return NewTestProber(FakeExecProber{}, kubecontainer.NewReadinessManager(), kubecontainer.NewRefManager(), &record.FakeRecorder{}).(*prober)
+}
+
+// newTestWorker registers a worker for the given probe without starting it,
+// so that tests can drive it through doProbe.
+func newTestWorker(pb *prober, probeType probeType, spec api.Probe) *worker {
+	spec.Handler = api.Handler{Exec: &api.ExecAction{}}
+	w := newWorker(pb, probeType, &spec, &api.Pod{}, api.PodStatus{}, api.Container{}, testContainerID, time.Now().Unix())
+	pb.workers[workerKey{testContainerID, probeType}] = w
+	return w
+}
+
+func TestDoProbeFailureThreshold(t *testing.T) {
+	pb := newTestProber()
+	w := newTestWorker(pb, liveness, api.Probe{FailureThreshold: 3})
+
+	steps := []struct {
+		prober FakeExecProber
+		live   bool
+	}{
+		{FakeExecProber{result: probe.Failure}, true},
+		{FakeExecProber{result: probe.Unknown}, true},
+		{FakeExecProber{err: errors.New("error")}, false},
+		{FakeExecProber{result: probe.Failure}, false},
+		{FakeExecProber{result: probe.Success}, true},
+		{FakeExecProber{result: probe.Failure}, true},
+	}
+	for i, step := range steps {
+		pb.exec = step.prober
+		w.doProbe()
+		if live := pb.livenessManager.GetLiveness(testContainerID); live != step.live {
+			t.Errorf("[%d] expected liveness %v, got %v", i, step.live, live)
+		}
+	}
+}
+
+func TestDoProbeSuccessThreshold(t *testing.T) {
+	pb := newTestProber()
+	w := newTestWorker(pb, readiness, api.Probe{SuccessThreshold: 2, FailureThreshold: 1})
+
+	steps := []struct {
+		result probe.Result
+		ready  bool
+	}{
+		{probe.Success, false},
+		{probe.Success, true},
+		{probe.Failure, false},
+		{probe.Success, false},
+		{probe.Success, true},
+	}
+	for i, step := range steps {
+		pb.exec = FakeExecProber{result: step.result}
+		w.doProbe()
+		if ready := pb.readinessManager.GetReadiness(testContainerID); ready != step.ready {
+			t.Errorf("[%d] expected readiness %v, got %v", i, step.ready, ready)
+		}
+	}
+}
+
+func TestDoProbeInitialDelay(t *testing.T) {
+	pb := newTestProber()
+	w := newTestWorker(pb, liveness, api.Probe{InitialDelaySeconds: 100, FailureThreshold: 1})
+	pb.exec = FakeExecProber{result: probe.Failure}
+	w.doProbe()
+	if !pb.livenessManager.GetLiveness(testContainerID) {
+		t.Errorf("expected container to be live before its initial delay passed")
+	}
+}
+
+func TestProbe(t *testing.T) {
+	pb := newTestProber()
+
+	// Containers without probes are ready and live.
+	result, err := pb.Probe(&api.Pod{}, api.PodStatus{}, api.Container{}, testContainerID, time.Now().Unix())
+	if err != nil || result != probe.Success {
+		t.Errorf("expected success, got %v (%v)", result, err)
+	}
+	if !pb.readinessManager.GetReadiness(testContainerID) {
+		t.Errorf("expected container without readiness probe to be ready")
+	}
+
+	// Containers with probes get a worker per probe, and report the cached liveness.
+	container := api.Container{
+		LivenessProbe:  &api.Probe{InitialDelaySeconds: 100},
+		ReadinessProbe: &api.Probe{InitialDelaySeconds: 100},
+	}
+	pb.readinessManager.RemoveReadiness(testContainerID)
+	result, err = pb.Probe(&api.Pod{}, api.PodStatus{}, container, testContainerID, time.Now().Unix())
+	if err != nil || result != probe.Success {
+		t.Errorf("expected success, got %v (%v)", result, err)
+	}
+	if len(pb.workers) != 2 {
+		t.Fatalf("expected 2 workers, got %d", len(pb.workers))
+	}
+	w := pb.workers[workerKey{testContainerID, liveness}]
+	pb.setResult(w, false)
+	result, err = pb.Probe(&api.Pod{}, api.PodStatus{}, container, testContainerID, time.Now().Unix())
+	if err != nil || result != probe.Failure {
+		t.Errorf("expected failure, got %v (%v)", result, err)
+	}
+	if len(pb.workers) != 2 {
+		t.Errorf("expected workers to be reused, got %d workers", len(pb.workers))
+	}
+
+	// Removed containers are no longer probed, and late results are dropped.
+	pb.RemoveContainer(testContainerID)
+	if len(pb.workers) != 0 {
+		t.Errorf("expected no workers, got %d", len(pb.workers))
+	}
+	pb.setResult(w, false)
+	if !pb.livenessManager.GetLiveness(testContainerID) {
+		t.Errorf("expected result of stopped worker to be dropped")
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qos
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+// QOSClass is the quality of service class of a pod.
+type QOSClass string
+
+const (
+	// Guaranteed pods have cpu and memory limits on all their containers, and
+	// request exactly their limits. They are the last to be throttled or killed.
+	Guaranteed QOSClass = "Guaranteed"
+	// Burstable pods request some resources but may use more, up to their
+	// limits if any.
+	Burstable QOSClass = "Burstable"
+	// BestEffort pods neither request nor limit any resource. They only get
+	// what other pods leave over, and are the first to be killed.
+	BestEffort QOSClass = "BestEffort"
+)
+
+// computeResources are the resources considered to determine the QoS class.
+var computeResources = []api.ResourceName{api.ResourceCPU, api.ResourceMemory}
+
+// GetPodQOS returns the QoS class of a pod, taking its init containers into
+// account like its other containers.
+func GetPodQOS(pod *api.Pod) QOSClass {
+	bestEffort := true
+	guaranteed := true
+	containers := append(append([]api.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, name := range computeResources {
+			request, hasRequest := container.Resources.Requests[name]
+			limit, hasLimit := container.Resources.Limits[name]
+			if hasRequest || hasLimit {
+				bestEffort = false
+			}
+			if !hasLimit || (hasRequest && request.MilliValue() != limit.MilliValue()) {
+				guaranteed = false
+			}
+		}
+	}
+	switch {
+	case bestEffort:
+		return BestEffort
+	case guaranteed:
+		return Guaranteed
+	default:
+		return Burstable
+	}
+}
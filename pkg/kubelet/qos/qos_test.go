@@ -0,0 +1,108 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qos
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+)
+
+func resourceList(cpu, memory string) api.ResourceList {
+	list := api.ResourceList{}
+	if cpu != "" {
+		list[api.ResourceCPU] = resource.MustParse(cpu)
+	}
+	if memory != "" {
+		list[api.ResourceMemory] = resource.MustParse(memory)
+	}
+	return list
+}
+
+func container(requests, limits api.ResourceList) api.Container {
+	return api.Container{Resources: api.ResourceRequirements{Requests: requests, Limits: limits}}
+}
+
+func TestGetPodQOS(t *testing.T) {
+	tests := []struct {
+		name           string
+		initContainers []api.Container
+		containers     []api.Container
+		expected       QOSClass
+	}{
+		{
+			name:       "no resources",
+			containers: []api.Container{container(nil, nil), container(nil, nil)},
+			expected:   BestEffort,
+		},
+		{
+			name:       "limits only",
+			containers: []api.Container{container(nil, resourceList("100m", "100Mi"))},
+			expected:   Guaranteed,
+		},
+		{
+			name:       "requests equal to limits",
+			containers: []api.Container{container(resourceList("100m", "100Mi"), resourceList("0.1", "100Mi"))},
+			expected:   Guaranteed,
+		},
+		{
+			name:       "requests below limits",
+			containers: []api.Container{container(resourceList("50m", "100Mi"), resourceList("100m", "100Mi"))},
+			expected:   Burstable,
+		},
+		{
+			name:       "no memory limit",
+			containers: []api.Container{container(nil, resourceList("100m", ""))},
+			expected:   Burstable,
+		},
+		{
+			name:       "requests only",
+			containers: []api.Container{container(resourceList("100m", "100Mi"), nil)},
+			expected:   Burstable,
+		},
+		{
+			name:       "one container without resources",
+			containers: []api.Container{container(nil, resourceList("100m", "100Mi")), container(nil, nil)},
+			expected:   Burstable,
+		},
+		{
+			name:           "init container with resources",
+			initContainers: []api.Container{container(resourceList("100m", "100Mi"), nil)},
+			containers:     []api.Container{container(nil, nil)},
+			expected:       Burstable,
+		},
+		{
+			name:           "init container without resources",
+			initContainers: []api.Container{container(nil, nil)},
+			containers:     []api.Container{container(nil, resourceList("100m", "100Mi"))},
+			expected:       Burstable,
+		},
+		{
+			name:           "guaranteed init container",
+			initContainers: []api.Container{container(nil, resourceList("200m", "200Mi"))},
+			containers:     []api.Container{container(nil, resourceList("100m", "100Mi"))},
+			expected:       Guaranteed,
+		},
+	}
+	for _, test := range tests {
+		pod := &api.Pod{Spec: api.PodSpec{InitContainers: test.initContainers, Containers: test.containers}}
+		if actual := GetPodQOS(pod); actual != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expected, actual)
+		}
+	}
+}
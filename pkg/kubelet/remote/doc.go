@@ -0,0 +1,27 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package remote implements a container runtime which forwards the calls of
+// the kubelet to a runtime shim running out of process, listening on a unix
+// socket. New container runtimes can be developed as shims without changing
+// the kubelet.
+//
+// Every connection to the shim starts with a handshake, in which the kubelet
+// sends the version of the protocol it speaks and the shim rejects versions it
+// does not support. Plain calls are then made with JSON-RPC on the
+// connection, while streaming calls (exec, logs and port forwarding) use a
+// connection of their own carrying framed streams.
+package remote
@@ -0,0 +1,68 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+)
+
+// FakeShim serves a runtime, usually a kubecontainer.FakeRuntime, on a unix
+// socket in a temporary directory, for testing.
+type FakeShim struct {
+	// Cache of the run options sent by the kubelet.
+	Options *RunContainerOptionsCache
+
+	dir      string
+	listener net.Listener
+}
+
+// NewFakeShim starts serving the runtime. The shim must be stopped by the
+// caller.
+func NewFakeShim(runtime kubecontainer.Runtime) (*FakeShim, error) {
+	dir, err := ioutil.TempDir("", "fake_shim")
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("unix", filepath.Join(dir, "runtime.sock"))
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	options := NewRunContainerOptionsCache()
+	go NewServer(runtime, options).Serve(listener)
+	return &FakeShim{
+		Options:  options,
+		dir:      dir,
+		listener: listener,
+	}, nil
+}
+
+// Endpoint returns the path of the socket of the shim.
+func (f *FakeShim) Endpoint() string {
+	return f.listener.Addr().String()
+}
+
+// Stop stops accepting connections and removes the socket.
+func (f *FakeShim) Stop() {
+	f.listener.Close()
+	os.RemoveAll(f.dir)
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+)
+
+// ProtocolVersion is the version of the protocol spoken between the kubelet
+// and runtime shims. It must be changed whenever the protocol changes in a
+// backwards incompatible way.
+const ProtocolVersion = "v1alpha1"
+
+// Name of the JSON-RPC service exposed by runtime shims.
+const serviceName = "RuntimeService"
+
+// handshakeRequest is the first message sent on every connection to a shim,
+// as a line of JSON.
+type handshakeRequest struct {
+	// The version of the protocol spoken by the kubelet.
+	Version string `json:"version"`
+	// The streaming call made on the connection, or nil if the connection
+	// carries JSON-RPC calls.
+	Stream *StreamRequest `json:"stream,omitempty"`
+}
+
+// handshakeResponse is the answer of the shim to a handshakeRequest, as a
+// line of JSON.
+type handshakeResponse struct {
+	// The reason the shim refused the connection, empty if it accepted it.
+	Error string `json:"error,omitempty"`
+}
+
+// Empty is the argument or result of calls that take or return nothing.
+type Empty struct{}
+
+type VersionResponse struct {
+	Version string
+}
+
+type CompareVersionRequest struct {
+	Other string
+}
+
+type CompareVersionResponse struct {
+	Result int
+}
+
+type GetPodsRequest struct {
+	All bool
+}
+
+type GetPodsResponse struct {
+	Pods []*kubecontainer.Pod
+}
+
+type SyncPodRequest struct {
+	Pod         *api.Pod
+	RunningPod  kubecontainer.Pod
+	PodStatus   api.PodStatus
+	PullSecrets []api.Secret
+	// The options to run the init containers and containers of the pod with,
+	// by container name, as generated by the kubelet.
+	RunContainerOptions map[string]*kubecontainer.RunContainerOptions
+}
+
+type KillPodRequest struct {
+	Pod kubecontainer.Pod
+}
+
+type GetPodStatusRequest struct {
+	Pod *api.Pod
+}
+
+type GetPodStatusResponse struct {
+	Status *api.PodStatus
+}
+
+type ImageRequest struct {
+	Image       kubecontainer.ImageSpec
+	PullSecrets []api.Secret
+}
+
+type IsImagePresentResponse struct {
+	Present bool
+}
+
+type ListImagesResponse struct {
+	Images []kubecontainer.Image
+}
+
+type RunInContainerRequest struct {
+	ContainerID string
+	Cmd         []string
+}
+
+type RunInContainerResponse struct {
+	Output []byte
+	// The error running the command, returned with its output.
+	Error string
+}
+
+// Methods of streaming calls.
+const (
+	streamExecInContainer  = "ExecInContainer"
+	streamGetContainerLogs = "GetContainerLogs"
+	streamPortForward      = "PortForward"
+)
+
+// StreamRequest describes a streaming call.
+type StreamRequest struct {
+	Method string `json:"method"`
+
+	// Arguments of ExecInContainer and GetContainerLogs.
+	ContainerID string `json:"containerID,omitempty"`
+	// Arguments of ExecInContainer.
+	Cmd   []string `json:"cmd,omitempty"`
+	Stdin bool     `json:"stdin,omitempty"`
+	TTY   bool     `json:"tty,omitempty"`
+	// Arguments of GetContainerLogs.
+	Pod    *api.Pod `json:"pod,omitempty"`
+	Tail   string   `json:"tail,omitempty"`
+	Follow bool     `json:"follow,omitempty"`
+	// Arguments of PortForward.
+	RunningPod *kubecontainer.Pod `json:"runningPod,omitempty"`
+	Port       uint16             `json:"port,omitempty"`
+}
+
+// Channels multiplexed on the connection of a streaming call. Each frame is
+// made of the channel, the length of the payload as a big endian uint32 and
+// the payload. The kubelet sends data on the input channel, where an empty
+// frame closes the input, and the shim sends data on the output channels.
+// The call ends with a frame on the error channel from the shim, carrying
+// the error of the call, or nothing if it succeeded.
+const (
+	inputChannel byte = iota
+	stdoutChannel
+	stderrChannel
+	errorChannel
+)
+
+// Maximum size of the payload of a frame.
+const maxFramePayload = 32 * 1024
+
+func writeLine(w io.Writer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func readLine(r *bufio.Reader, v interface{}) error {
+	line, err := r.ReadBytes('\n')
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(line, v)
+}
+
+// frameWriter writes frames on a connection shared by several channels.
+type frameWriter struct {
+	lock *sync.Mutex
+	w    io.Writer
+}
+
+func (f frameWriter) writeFrame(channel byte, payload []byte) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	header := make([]byte, 5)
+	header[0] = channel
+	binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+	if _, err := f.w.Write(header); err != nil {
+		return err
+	}
+	_, err := f.w.Write(payload)
+	return err
+}
+
+// channel returns a writer of frames on the channel. Closing the writer
+// sends an empty frame.
+func (f frameWriter) channel(channel byte) io.WriteCloser {
+	return &channelWriter{frameWriter: f, channel: channel}
+}
+
+type channelWriter struct {
+	frameWriter
+	channel byte
+}
+
+func (c *channelWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := len(p)
+		if n > maxFramePayload {
+			n = maxFramePayload
+		}
+		if err := c.writeFrame(c.channel, p[:n]); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+func (c *channelWriter) Close() error {
+	return c.writeFrame(c.channel, nil)
+}
+
+// readFrame reads the next frame from the connection.
+func readFrame(r io.Reader) (byte, []byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+	length := binary.BigEndian.Uint32(header[1:])
+	if length > maxFramePayload {
+		return 0, nil, fmt.Errorf("frame of %d bytes exceeds the maximum of %d bytes", length, maxFramePayload)
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	return header[0], payload, nil
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+	"github.com/golang/glog"
+)
+
+const (
+	// Timeout of connecting to the shim, including the handshake.
+	dialTimeout = 10 * time.Second
+	// Timeout of calls to the shim.
+	defaultCallTimeout = 2 * time.Minute
+	// Timeout of calls which may pull images.
+	defaultPullTimeout = 30 * time.Minute
+)
+
+// runtime implements the container runtime interface by calling a runtime
+// shim listening on a unix socket.
+type runtime struct {
+	// Path of the socket of the shim.
+	endpoint  string
+	generator kubecontainer.RunContainerOptionsGenerator
+
+	// Timeouts of the handshake, of calls and of calls which may pull images.
+	dialTimeout time.Duration
+	callTimeout time.Duration
+	pullTimeout time.Duration
+
+	// Client of the JSON-RPC connection to the shim, nil until the first call
+	// and after the connection is lost.
+	lock   sync.Mutex
+	client *rpc.Client
+}
+
+var _ kubecontainer.Runtime = &runtime{}
+
+// New creates a container runtime forwarding its calls to the runtime shim
+// listening on the unix socket at endpoint. The options to run containers
+// with are generated by generator and sent to the shim when syncing pods.
+// It fails if the shim cannot be reached or does not speak the protocol.
+func New(endpoint string, generator kubecontainer.RunContainerOptionsGenerator) (kubecontainer.Runtime, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("no endpoint given for the remote container runtime")
+	}
+	r := &runtime{
+		endpoint:    endpoint,
+		generator:   generator,
+		dialTimeout: dialTimeout,
+		callTimeout: defaultCallTimeout,
+		pullTimeout: defaultPullTimeout,
+	}
+	version, err := r.Version()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the version of the runtime shim at %q: %v", endpoint, err)
+	}
+	glog.Infof("Connected to runtime shim at %q, version %s", endpoint, version)
+	return r, nil
+}
+
+// dial opens a connection to the shim and makes the handshake for it.
+func (r *runtime) dial(stream *StreamRequest) (*bufferedConn, error) {
+	conn, err := net.DialTimeout("unix", r.endpoint, r.dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	// Do not wait forever for a shim which accepts connections but does not
+	// answer the handshake.
+	if err := conn.SetDeadline(time.Now().Add(r.dialTimeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := writeLine(conn, handshakeRequest{Version: ProtocolVersion, Stream: stream}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	reader := bufio.NewReader(conn)
+	var response handshakeResponse
+	if err := readLine(reader, &response); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if response.Error != "" {
+		conn.Close()
+		return nil, fmt.Errorf("runtime shim refused the connection: %s", response.Error)
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &bufferedConn{Reader: reader, Conn: conn}, nil
+}
+
+// call makes a JSON-RPC call to the shim, connecting to it if needed. Calls
+// which do not complete in time fail and drop the connection, so that a hung
+// shim does not block the calls after them.
+func (r *runtime) call(method string, args, reply interface{}) error {
+	r.lock.Lock()
+	client := r.client
+	if client == nil {
+		conn, err := r.dial(nil)
+		if err != nil {
+			r.lock.Unlock()
+			return err
+		}
+		client = rpc.NewClientWithCodec(jsonrpc.NewClientCodec(conn))
+		r.client = client
+	}
+	r.lock.Unlock()
+
+	timeout := r.callTimeout
+	if method == "SyncPod" || method == "PullImage" {
+		timeout = r.pullTimeout
+	}
+	call := client.Go(serviceName+"."+method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		if call.Error == rpc.ErrShutdown || call.Error == io.ErrUnexpectedEOF {
+			// The connection to the shim was lost, reconnect on the next call.
+			r.resetClient(client)
+		}
+		return call.Error
+	case <-time.After(timeout):
+		// The shim may be hung, reconnect on the next call.
+		r.resetClient(client)
+		return fmt.Errorf("%s call to the runtime shim timed out after %v", method, timeout)
+	}
+}
+
+// resetClient closes client and forgets it if it is still the client in use.
+func (r *runtime) resetClient(client *rpc.Client) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.client == client {
+		r.client = nil
+		client.Close()
+	}
+}
+
+// stream makes a streaming call to the shim. The input, if any, is sent to the
+// shim until it ends, while the output of the shim is written to stdout and
+// stderr until the call ends.
+func (r *runtime) stream(request *StreamRequest, input io.Reader, stdout, stderr io.Writer) error {
+	conn, err := r.dial(request)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if input != nil {
+		go func() {
+			writer := frameWriter{lock: &sync.Mutex{}, w: conn}.channel(inputChannel)
+			if _, err := io.Copy(writer, input); err != nil {
+				glog.V(4).Infof("Failed to send input of %s call: %v", request.Method, err)
+			}
+			writer.Close()
+		}()
+	}
+
+	for {
+		channel, payload, err := readFrame(conn)
+		if err != nil {
+			return fmt.Errorf("lost the connection to the runtime shim: %v", err)
+		}
+		var output io.Writer
+		switch channel {
+		case stdoutChannel:
+			output = stdout
+		case stderrChannel:
+			output = stderr
+		case errorChannel:
+			if len(payload) > 0 {
+				return errors.New(string(payload))
+			}
+			return nil
+		default:
+			return fmt.Errorf("unexpected frame on channel %d", channel)
+		}
+		if output == nil || len(payload) == 0 {
+			continue
+		}
+		if _, err := output.Write(payload); err != nil {
+			return err
+		}
+	}
+}
+
+// remoteVersion is the version of the runtime of a shim, compared by the shim.
+type remoteVersion struct {
+	runtime *runtime
+	version string
+}
+
+func (v *remoteVersion) Compare(other string) (int, error) {
+	var response CompareVersionResponse
+	if err := v.runtime.call("CompareVersion", &CompareVersionRequest{Other: other}, &response); err != nil {
+		return 0, err
+	}
+	return response.Result, nil
+}
+
+func (v *remoteVersion) String() string {
+	return v.version
+}
+
+func (r *runtime) Version() (kubecontainer.Version, error) {
+	var response VersionResponse
+	if err := r.call("Version", &Empty{}, &response); err != nil {
+		return nil, err
+	}
+	return &remoteVersion{runtime: r, version: response.Version}, nil
+}
+
+func (r *runtime) GetPods(all bool) ([]*kubecontainer.Pod, error) {
+	var response GetPodsResponse
+	if err := r.call("GetPods", &GetPodsRequest{All: all}, &response); err != nil {
+		return nil, err
+	}
+	return response.Pods, nil
+}
+
+func (r *runtime) SyncPod(pod *api.Pod, runningPod kubecontainer.Pod, podStatus api.PodStatus, pullSecrets []api.Secret) error {
+	// The shim cannot generate the run options of containers, which depend on
+	// the state of the kubelet, so they are sent with the pod for its init
+	// containers and containers alike. Containers whose options cannot be
+	// generated fail to start on the shim.
+	options := map[string]*kubecontainer.RunContainerOptions{}
+	containers := append(append([]api.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for i := range containers {
+		container := &containers[i]
+		opts, err := r.generator.GenerateRunContainerOptions(pod, container)
+		if err != nil {
+			glog.Errorf("Failed to generate run options for container %q of pod %q: %v", container.Name, kubecontainer.GetPodFullName(pod), err)
+			continue
+		}
+		options[container.Name] = opts
+	}
+	return r.call("SyncPod", &SyncPodRequest{
+		Pod:                 pod,
+		RunningPod:          runningPod,
+		PodStatus:           podStatus,
+		PullSecrets:         pullSecrets,
+		RunContainerOptions: options,
+	}, &Empty{})
+}
+
+func (r *runtime) KillPod(pod kubecontainer.Pod) error {
+	return r.call("KillPod", &KillPodRequest{Pod: pod}, &Empty{})
+}
+
+func (r *runtime) GetPodStatus(pod *api.Pod) (*api.PodStatus, error) {
+	var response GetPodStatusResponse
+	if err := r.call("GetPodStatus", &GetPodStatusRequest{Pod: pod}, &response); err != nil {
+		return nil, err
+	}
+	if response.Status == nil {
+		return nil, fmt.Errorf("runtime shim returned no status for pod %q", kubecontainer.GetPodFullName(pod))
+	}
+	return response.Status, nil
+}
+
+func (r *runtime) PullImage(image kubecontainer.ImageSpec, pullSecrets []api.Secret) error {
+	return r.call("PullImage", &ImageRequest{Image: image, PullSecrets: pullSecrets}, &Empty{})
+}
+
+func (r *runtime) IsImagePresent(image kubecontainer.ImageSpec) (bool, error) {
+	var response IsImagePresentResponse
+	if err := r.call("IsImagePresent", &ImageRequest{Image: image}, &response); err != nil {
+		return false, err
+	}
+	return response.Present, nil
+}
+
+func (r *runtime) ListImages() ([]kubecontainer.Image, error) {
+	var response ListImagesResponse
+	if err := r.call("ListImages", &Empty{}, &response); err != nil {
+		return nil, err
+	}
+	return response.Images, nil
+}
+
+func (r *runtime) RemoveImage(image kubecontainer.ImageSpec) error {
+	return r.call("RemoveImage", &ImageRequest{Image: image}, &Empty{})
+}
+
+func (r *runtime) GetContainerLogs(pod *api.Pod, containerID, tail string, follow bool, stdout, stderr io.Writer) error {
+	return r.stream(&StreamRequest{
+		Method:      streamGetContainerLogs,
+		Pod:         pod,
+		ContainerID: containerID,
+		Tail:        tail,
+		Follow:      follow,
+	}, nil, stdout, stderr)
+}
+
+func (r *runtime) RunInContainer(containerID string, cmd []string) ([]byte, error) {
+	var response RunInContainerResponse
+	if err := r.call("RunInContainer", &RunInContainerRequest{ContainerID: containerID, Cmd: cmd}, &response); err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return response.Output, errors.New(response.Error)
+	}
+	return response.Output, nil
+}
+
+func (r *runtime) ExecInContainer(containerID string, cmd []string, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) error {
+	return r.stream(&StreamRequest{
+		Method:      streamExecInContainer,
+		ContainerID: containerID,
+		Cmd:         cmd,
+		Stdin:       stdin != nil,
+		TTY:         tty,
+	}, stdin, stdout, stderr)
+}
+
+func (r *runtime) PortForward(pod *kubecontainer.Pod, port uint16, stream io.ReadWriteCloser) error {
+	return r.stream(&StreamRequest{
+		Method:     streamPortForward,
+		RunningPod: pod,
+		Port:       port,
+	}, stream, stream, nil)
+}
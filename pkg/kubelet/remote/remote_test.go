@@ -0,0 +1,432 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+)
+
+type fakeOptionsGenerator struct{}
+
+func (fakeOptionsGenerator) GenerateRunContainerOptions(pod *api.Pod, container *api.Container) (*kubecontainer.RunContainerOptions, error) {
+	if container.Name == "broken" {
+		return nil, fmt.Errorf("broken container")
+	}
+	return &kubecontainer.RunContainerOptions{
+		Envs:         []kubecontainer.EnvVar{{Name: "CONTAINER", Value: container.Name}},
+		CgroupParent: "/" + string(pod.UID),
+	}, nil
+}
+
+func newTestRuntime(t *testing.T, runtime kubecontainer.Runtime) (kubecontainer.Runtime, *FakeShim) {
+	shim, err := NewFakeShim(runtime)
+	if err != nil {
+		t.Fatalf("unexpected error starting shim: %v", err)
+	}
+	r, err := New(shim.Endpoint(), fakeOptionsGenerator{})
+	if err != nil {
+		shim.Stop()
+		t.Fatalf("unexpected error connecting to shim: %v", err)
+	}
+	return r, shim
+}
+
+func TestVersion(t *testing.T) {
+	fake := &kubecontainer.FakeRuntime{VersionInfo: "1.2"}
+	r, shim := newTestRuntime(t, fake)
+	defer shim.Stop()
+
+	version, err := r.Version()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version.String() != "1.2" {
+		t.Errorf("expected version 1.2, got %q", version.String())
+	}
+	result, err := version.Compare("1.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != 1 {
+		t.Errorf("expected version 1.2 to be greater than 1.1, got %d", result)
+	}
+}
+
+func TestProtocolVersionMismatch(t *testing.T) {
+	shim, err := NewFakeShim(&kubecontainer.FakeRuntime{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer shim.Stop()
+
+	conn, err := net.Dial("unix", shim.Endpoint())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer conn.Close()
+	if err := writeLine(conn, handshakeRequest{Version: "v0"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var response handshakeResponse
+	if err := readLine(bufio.NewReader(conn), &response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(response.Error, "unsupported protocol version") {
+		t.Errorf("expected the shim to refuse version v0, got %q", response.Error)
+	}
+}
+
+func TestNoShim(t *testing.T) {
+	shim, err := NewFakeShim(&kubecontainer.FakeRuntime{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	shim.Stop()
+	if _, err := New(shim.Endpoint(), fakeOptionsGenerator{}); err == nil {
+		t.Errorf("expected an error connecting to a stopped shim")
+	}
+}
+
+func TestPods(t *testing.T) {
+	fake := &kubecontainer.FakeRuntime{
+		PodList: []*kubecontainer.Pod{{
+			ID:         "12345678",
+			Name:       "foo",
+			Namespace:  "new",
+			Containers: []*kubecontainer.Container{{ID: "1234", Name: "bar", Image: "busybox", Hash: 1 << 63}},
+		}},
+		PodStatus: api.PodStatus{Phase: api.PodRunning, PodIP: "10.0.0.1"},
+	}
+	r, shim := newTestRuntime(t, fake)
+	defer shim.Stop()
+
+	pods, err := r.GetPods(true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fake.PodList, pods) {
+		t.Errorf("expected pods %#v, got %#v", fake.PodList, pods)
+	}
+
+	status, err := r.GetPodStatus(&api.Pod{ObjectMeta: api.ObjectMeta{UID: "12345678", Name: "foo", Namespace: "new"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fake.PodStatus, *status) {
+		t.Errorf("expected status %#v, got %#v", fake.PodStatus, *status)
+	}
+
+	if err := r.KillPod(*pods[0]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := fake.AssertKilledPods([]string{"12345678"}); err != nil {
+		t.Error(err)
+	}
+	if err := fake.AssertCalls([]string{"Version", "GetPods", "GetPodStatus", "KillPod"}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSyncPodSendsRunContainerOptions(t *testing.T) {
+	fake := &kubecontainer.FakeRuntime{}
+	r, shim := newTestRuntime(t, fake)
+	defer shim.Stop()
+
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{UID: "12345678", Name: "foo", Namespace: "new"},
+		Spec: api.PodSpec{
+			Containers: []api.Container{{Name: "bar"}, {Name: "broken"}},
+		},
+	}
+	if err := r.SyncPod(pod, kubecontainer.Pod{}, api.PodStatus{}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := fake.AssertStartedContainers([]string{"bar", "broken"}); err != nil {
+		t.Error(err)
+	}
+
+	options, err := shim.Options.GenerateRunContainerOptions(pod, &pod.Spec.Containers[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected, _ := fakeOptionsGenerator{}.GenerateRunContainerOptions(pod, &pod.Spec.Containers[0])
+	if !reflect.DeepEqual(expected, options) {
+		t.Errorf("expected options %#v, got %#v", expected, options)
+	}
+	if _, err := shim.Options.GenerateRunContainerOptions(pod, &pod.Spec.Containers[1]); err == nil {
+		t.Errorf("expected an error generating the options of a container the kubelet failed to generate them for")
+	}
+
+	if err := r.KillPod(kubecontainer.Pod{ID: pod.UID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := shim.Options.GenerateRunContainerOptions(pod, &pod.Spec.Containers[0]); err == nil {
+		t.Errorf("expected the options of a killed pod to be forgotten")
+	}
+}
+
+func TestSyncPodSendsInitContainerRunOptions(t *testing.T) {
+	fake := &kubecontainer.FakeRuntime{}
+	r, shim := newTestRuntime(t, fake)
+	defer shim.Stop()
+
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{UID: "12345678", Name: "foo", Namespace: "new"},
+		Spec: api.PodSpec{
+			InitContainers: []api.Container{{Name: "init"}},
+			Containers:     []api.Container{{Name: "bar"}},
+		},
+	}
+	if err := r.SyncPod(pod, kubecontainer.Pod{}, api.PodStatus{}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	options, err := shim.Options.GenerateRunContainerOptions(pod, &pod.Spec.InitContainers[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected, _ := fakeOptionsGenerator{}.GenerateRunContainerOptions(pod, &pod.Spec.InitContainers[0])
+	if !reflect.DeepEqual(expected, options) {
+		t.Errorf("expected options %#v, got %#v", expected, options)
+	}
+}
+
+func TestImages(t *testing.T) {
+	fake := &kubecontainer.FakeRuntime{
+		ImageList: []kubecontainer.Image{{ID: "busybox", Tags: []string{"busybox:latest"}, Size: 1024}},
+	}
+	r, shim := newTestRuntime(t, fake)
+	defer shim.Stop()
+
+	images, err := r.ListImages()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fake.ImageList, images) {
+		t.Errorf("expected images %#v, got %#v", fake.ImageList, images)
+	}
+	present, err := r.IsImagePresent(kubecontainer.ImageSpec{Image: "busybox"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !present {
+		t.Errorf("expected image busybox to be present")
+	}
+	if err := r.PullImage(kubecontainer.ImageSpec{Image: "nginx"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.RemoveImage(kubecontainer.ImageSpec{Image: "busybox"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fake.ImageList) != 0 {
+		t.Errorf("expected image busybox to be removed, got %#v", fake.ImageList)
+	}
+}
+
+func TestErrorsAreReturned(t *testing.T) {
+	fake := &kubecontainer.FakeRuntime{}
+	r, shim := newTestRuntime(t, fake)
+	defer shim.Stop()
+
+	fake.Err = fmt.Errorf("runtime is down")
+	if _, err := r.GetPods(false); err == nil || err.Error() != "runtime is down" {
+		t.Errorf("expected error %q, got %v", fake.Err, err)
+	}
+	if _, err := r.RunInContainer("1234", []string{"ls"}); err == nil || err.Error() != "runtime is down" {
+		t.Errorf("expected error %q, got %v", fake.Err, err)
+	}
+	if err := r.ExecInContainer("1234", []string{"ls"}, nil, nil, nil, false); err == nil || err.Error() != "runtime is down" {
+		t.Errorf("expected error %q, got %v", fake.Err, err)
+	}
+}
+
+// echoRuntime echoes the input of streaming calls.
+type echoRuntime struct {
+	kubecontainer.FakeRuntime
+}
+
+func (e *echoRuntime) ExecInContainer(containerID string, cmd []string, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) error {
+	fmt.Fprintf(stderr, "%s %s", containerID, strings.Join(cmd, " "))
+	if stdin != nil {
+		if _, err := io.Copy(stdout, stdin); err != nil {
+			return err
+		}
+	}
+	if cmd[0] == "false" {
+		return fmt.Errorf("exit status 1")
+	}
+	return nil
+}
+
+func (e *echoRuntime) GetContainerLogs(pod *api.Pod, containerID, tail string, follow bool, stdout, stderr io.Writer) error {
+	fmt.Fprintf(stdout, "logs of %s in %s, tail %s", containerID, pod.Name, tail)
+	return nil
+}
+
+func (e *echoRuntime) PortForward(pod *kubecontainer.Pod, port uint16, stream io.ReadWriteCloser) error {
+	fmt.Fprintf(stream, "%s:%d ", pod.Name, port)
+	_, err := io.Copy(stream, stream)
+	return err
+}
+
+type closingBuffer struct {
+	bytes.Buffer
+}
+
+func (*closingBuffer) Close() error {
+	return nil
+}
+
+func TestExecInContainer(t *testing.T) {
+	r, shim := newTestRuntime(t, &echoRuntime{})
+	defer shim.Stop()
+
+	input := strings.Repeat("x", 3*maxFramePayload)
+	stdout, stderr := &closingBuffer{}, &closingBuffer{}
+	if err := r.ExecInContainer("1234", []string{"cat", "-"}, strings.NewReader(input), stdout, stderr, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stdout.String() != input {
+		t.Errorf("expected %d bytes of output, got %d", len(input), stdout.Len())
+	}
+	if stderr.String() != "1234 cat -" {
+		t.Errorf("expected stderr %q, got %q", "1234 cat -", stderr.String())
+	}
+
+	if err := r.ExecInContainer("1234", []string{"false"}, nil, &closingBuffer{}, &closingBuffer{}, false); err == nil || err.Error() != "exit status 1" {
+		t.Errorf("expected error %q, got %v", "exit status 1", err)
+	}
+}
+
+func TestGetContainerLogs(t *testing.T) {
+	r, shim := newTestRuntime(t, &echoRuntime{})
+	defer shim.Stop()
+
+	stdout := &bytes.Buffer{}
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
+	if err := r.GetContainerLogs(pod, "1234", "10", false, stdout, &bytes.Buffer{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := "logs of 1234 in foo, tail 10"; stdout.String() != expected {
+		t.Errorf("expected logs %q, got %q", expected, stdout.String())
+	}
+}
+
+type pipeStream struct {
+	io.Reader
+	io.Writer
+}
+
+func (*pipeStream) Close() error {
+	return nil
+}
+
+func TestPortForward(t *testing.T) {
+	r, shim := newTestRuntime(t, &echoRuntime{})
+	defer shim.Stop()
+
+	output := &bytes.Buffer{}
+	stream := &pipeStream{Reader: strings.NewReader("ping"), Writer: output}
+	if err := r.PortForward(&kubecontainer.Pod{Name: "foo"}, 8080, stream); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := "foo:8080 ping"; output.String() != expected {
+		t.Errorf("expected %q, got %q", expected, output.String())
+	}
+}
+
+// startHungShim listens on a unix socket and accepts connections without ever
+// answering calls. The handshake is answered only if handshake is true.
+func startHungShim(t *testing.T, handshake bool) (string, func()) {
+	dir, err := ioutil.TempDir("", "hung_shim")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	listener, err := net.Listen("unix", filepath.Join(dir, "runtime.sock"))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("unexpected error: %v", err)
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				var request handshakeRequest
+				if err := readLine(reader, &request); err != nil {
+					return
+				}
+				if handshake {
+					writeLine(conn, handshakeResponse{})
+				}
+				io.Copy(ioutil.Discard, reader)
+			}()
+		}
+	}()
+	return listener.Addr().String(), func() {
+		listener.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestHandshakeTimeout(t *testing.T) {
+	endpoint, stop := startHungShim(t, false)
+	defer stop()
+
+	r := &runtime{endpoint: endpoint, dialTimeout: 100 * time.Millisecond}
+	if _, err := r.dial(nil); err == nil {
+		t.Errorf("expected the handshake with a hung shim to time out")
+	}
+}
+
+func TestCallTimeout(t *testing.T) {
+	endpoint, stop := startHungShim(t, true)
+	defer stop()
+
+	r := &runtime{
+		endpoint:    endpoint,
+		dialTimeout: time.Second,
+		callTimeout: 100 * time.Millisecond,
+		pullTimeout: 200 * time.Millisecond,
+	}
+	if _, err := r.Version(); err == nil || !strings.Contains(err.Error(), "timed out after 100ms") {
+		t.Errorf("expected the call to time out, got %v", err)
+	}
+	if r.client != nil {
+		t.Errorf("expected the connection to the hung shim to be dropped")
+	}
+	if err := r.PullImage(kubecontainer.ImageSpec{Image: "busybox"}, nil); err == nil || !strings.Contains(err.Error(), "timed out after 200ms") {
+		t.Errorf("expected the pull to time out, got %v", err)
+	}
+}
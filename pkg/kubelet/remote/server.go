@@ -0,0 +1,317 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"sync"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// Server serves a container runtime to the kubelet, as a runtime shim.
+type Server struct {
+	runtime kubecontainer.Runtime
+	options *RunContainerOptionsCache
+	rpc     *rpc.Server
+}
+
+// NewServer creates a server for the runtime. The run options the kubelet
+// sends when syncing a pod are stored in options, which should be the
+// generator of run options of the runtime. options may be nil if the runtime
+// does not need them.
+func NewServer(runtime kubecontainer.Runtime, options *RunContainerOptionsCache) *Server {
+	s := &Server{
+		runtime: runtime,
+		options: options,
+		rpc:     rpc.NewServer(),
+	}
+	if err := s.rpc.RegisterName(serviceName, &runtimeService{s}); err != nil {
+		// The service is statically defined, so this is a programming error.
+		panic(err)
+	}
+	return s
+}
+
+// ListenAndServe removes any stale socket, listens on the unix socket and
+// serves connections until the listener fails.
+func (s *Server) ListenAndServe(socketPath string) error {
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return err
+	}
+	return s.Serve(listener)
+}
+
+// Serve serves the connections accepted by the listener until it fails.
+func (s *Server) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer util.HandleCrash()
+	reader := bufio.NewReader(conn)
+	var request handshakeRequest
+	if err := readLine(reader, &request); err != nil {
+		glog.Errorf("Failed to read handshake: %v", err)
+		conn.Close()
+		return
+	}
+	if request.Version != ProtocolVersion {
+		writeLine(conn, handshakeResponse{Error: fmt.Sprintf("unsupported protocol version %q, expected %q", request.Version, ProtocolVersion)})
+		conn.Close()
+		return
+	}
+	if err := writeLine(conn, handshakeResponse{}); err != nil {
+		glog.Errorf("Failed to write handshake: %v", err)
+		conn.Close()
+		return
+	}
+
+	buffered := &bufferedConn{Reader: reader, Conn: conn}
+	if request.Stream == nil {
+		s.rpc.ServeCodec(jsonrpc.NewServerCodec(buffered))
+		return
+	}
+	defer conn.Close()
+	if err := s.serveStream(request.Stream, buffered); err != nil {
+		glog.Errorf("Failed to serve %s call: %v", request.Stream.Method, err)
+	}
+}
+
+// bufferedConn reads the connection through the reader which buffered the
+// handshake.
+type bufferedConn struct {
+	*bufio.Reader
+	net.Conn
+}
+
+func (b *bufferedConn) Read(p []byte) (int, error) {
+	return b.Reader.Read(p)
+}
+
+// serveStream serves a streaming call, then reports its error.
+func (s *Server) serveStream(request *StreamRequest, conn *bufferedConn) error {
+	frames := frameWriter{lock: &sync.Mutex{}, w: conn}
+	input, inputWriter := io.Pipe()
+	go func() {
+		// Frames from the kubelet are copied to the input until it is closed.
+		for {
+			channel, payload, err := readFrame(conn)
+			if err != nil {
+				inputWriter.CloseWithError(err)
+				return
+			}
+			if channel != inputChannel {
+				inputWriter.CloseWithError(fmt.Errorf("unexpected frame on channel %d", channel))
+				return
+			}
+			if len(payload) == 0 {
+				inputWriter.Close()
+				return
+			}
+			if _, err := inputWriter.Write(payload); err != nil {
+				return
+			}
+		}
+	}()
+	defer input.Close()
+
+	var err error
+	stdout := frames.channel(stdoutChannel)
+	switch request.Method {
+	case streamExecInContainer:
+		var stdin io.Reader
+		if request.Stdin {
+			stdin = input
+		}
+		err = s.runtime.ExecInContainer(request.ContainerID, request.Cmd, stdin, stdout, frames.channel(stderrChannel), request.TTY)
+	case streamGetContainerLogs:
+		if request.Pod == nil {
+			err = fmt.Errorf("no pod given")
+			break
+		}
+		err = s.runtime.GetContainerLogs(request.Pod, request.ContainerID, request.Tail, request.Follow, stdout, frames.channel(stderrChannel))
+	case streamPortForward:
+		if request.RunningPod == nil {
+			err = fmt.Errorf("no pod given")
+			break
+		}
+		err = s.runtime.PortForward(request.RunningPod, request.Port, &portForwardStream{Reader: input, Writer: stdout})
+	default:
+		err = fmt.Errorf("unknown streaming call %q", request.Method)
+	}
+
+	message := ""
+	if err != nil {
+		message = err.Error()
+	}
+	return frames.writeFrame(errorChannel, []byte(message))
+}
+
+// portForwardStream is the stream given to the runtime for a port forward.
+// The call ends when the runtime returns, so closing it does nothing.
+type portForwardStream struct {
+	io.Reader
+	io.Writer
+}
+
+func (p *portForwardStream) Close() error {
+	return nil
+}
+
+// runtimeService exposes the plain calls of the runtime over JSON-RPC.
+type runtimeService struct {
+	server *Server
+}
+
+func (r *runtimeService) Version(_ *Empty, response *VersionResponse) error {
+	version, err := r.server.runtime.Version()
+	if err != nil {
+		return err
+	}
+	response.Version = version.String()
+	return nil
+}
+
+func (r *runtimeService) CompareVersion(request *CompareVersionRequest, response *CompareVersionResponse) error {
+	version, err := r.server.runtime.Version()
+	if err != nil {
+		return err
+	}
+	response.Result, err = version.Compare(request.Other)
+	return err
+}
+
+func (r *runtimeService) GetPods(request *GetPodsRequest, response *GetPodsResponse) error {
+	pods, err := r.server.runtime.GetPods(request.All)
+	response.Pods = pods
+	return err
+}
+
+func (r *runtimeService) SyncPod(request *SyncPodRequest, _ *Empty) error {
+	if request.Pod == nil {
+		return fmt.Errorf("no pod given")
+	}
+	if r.server.options != nil {
+		r.server.options.set(request.Pod.UID, request.RunContainerOptions)
+	}
+	return r.server.runtime.SyncPod(request.Pod, request.RunningPod, request.PodStatus, request.PullSecrets)
+}
+
+func (r *runtimeService) KillPod(request *KillPodRequest, _ *Empty) error {
+	if err := r.server.runtime.KillPod(request.Pod); err != nil {
+		return err
+	}
+	if r.server.options != nil {
+		r.server.options.remove(request.Pod.ID)
+	}
+	return nil
+}
+
+func (r *runtimeService) GetPodStatus(request *GetPodStatusRequest, response *GetPodStatusResponse) error {
+	if request.Pod == nil {
+		return fmt.Errorf("no pod given")
+	}
+	status, err := r.server.runtime.GetPodStatus(request.Pod)
+	response.Status = status
+	return err
+}
+
+func (r *runtimeService) PullImage(request *ImageRequest, _ *Empty) error {
+	return r.server.runtime.PullImage(request.Image, request.PullSecrets)
+}
+
+func (r *runtimeService) IsImagePresent(request *ImageRequest, response *IsImagePresentResponse) error {
+	present, err := r.server.runtime.IsImagePresent(request.Image)
+	response.Present = present
+	return err
+}
+
+func (r *runtimeService) ListImages(_ *Empty, response *ListImagesResponse) error {
+	images, err := r.server.runtime.ListImages()
+	response.Images = images
+	return err
+}
+
+func (r *runtimeService) RemoveImage(request *ImageRequest, _ *Empty) error {
+	return r.server.runtime.RemoveImage(request.Image)
+}
+
+func (r *runtimeService) RunInContainer(request *RunInContainerRequest, response *RunInContainerResponse) error {
+	output, err := r.server.runtime.RunInContainer(request.ContainerID, request.Cmd)
+	response.Output = output
+	if err != nil {
+		response.Error = err.Error()
+	}
+	return nil
+}
+
+// RunContainerOptionsCache generates the run options of containers from the
+// options the kubelet sent with the last sync of their pod. Shims give it to
+// runtimes in place of the generator of the kubelet.
+type RunContainerOptionsCache struct {
+	lock    sync.Mutex
+	options map[types.UID]map[string]*kubecontainer.RunContainerOptions
+}
+
+var _ kubecontainer.RunContainerOptionsGenerator = &RunContainerOptionsCache{}
+
+func NewRunContainerOptionsCache() *RunContainerOptionsCache {
+	return &RunContainerOptionsCache{options: map[types.UID]map[string]*kubecontainer.RunContainerOptions{}}
+}
+
+func (c *RunContainerOptionsCache) set(uid types.UID, options map[string]*kubecontainer.RunContainerOptions) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.options[uid] = options
+}
+
+func (c *RunContainerOptionsCache) remove(uid types.UID) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.options, uid)
+}
+
+func (c *RunContainerOptionsCache) GenerateRunContainerOptions(pod *api.Pod, container *api.Container) (*kubecontainer.RunContainerOptions, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	options, found := c.options[pod.UID][container.Name]
+	if !found {
+		return nil, fmt.Errorf("no run options were sent for container %q of pod %q", container.Name, kubecontainer.GetPodFullName(pod))
+	}
+	return options, nil
+}
@@ -565,9 +565,15 @@ func (r *runtime) preparePod(pod *api.Pod) (string, bool, error) {
 
 // RunPod first creates the unit file for a pod, and then calls
 // StartUnit over d-bus.
+// TODO(yifan): Support init containers once rkt can run apps to completion in
+// the namespaces of a pod before starting its other apps.
 func (r *runtime) RunPod(pod *api.Pod) error {
 	glog.V(4).Infof("Rkt starts to run pod: name %q.", pod.Name)
 
+	if len(pod.Spec.InitContainers) > 0 {
+		return fmt.Errorf("init containers are not supported by the rkt runtime")
+	}
+
 	name, needReload, err := r.preparePod(pod)
 	if err != nil {
 		return err
@@ -676,6 +682,9 @@ func (r *runtime) KillPod(pod kubecontainer.Pod) error {
 
 	// TODO(yifan): More graceful stop. Replace with StopUnit and wait for a timeout.
 	r.systemd.KillUnit(makePodServiceFileName(pod.ID), int32(syscall.SIGKILL))
+	for _, c := range pod.Containers {
+		r.prober.RemoveContainer(string(c.ID))
+	}
 	return r.systemd.Reload()
 }
 
@@ -841,6 +850,10 @@ func (r *runtime) SyncPod(pod *api.Pod, runningPod kubecontainer.Pod, podStatus
 
 		c := runningPod.FindContainerByName(container.Name)
 		if c == nil {
+			// Stop probing the container if it died on its own.
+			if status, found := api.GetContainerStatus(podStatus.ContainerStatuses, container.Name); found && status.State.Terminated != nil {
+				r.prober.RemoveContainer(kubecontainer.TrimRuntimePrefix(status.State.Terminated.ContainerID))
+			}
 			if kubecontainer.ShouldContainerBeRestarted(&container, pod, &podStatus, r.readinessManager) {
 				glog.V(3).Infof("Container %+v is dead, but RestartPolicy says that we should restart it.", container)
 				// TODO(yifan): Containers in one pod are fate-sharing at this moment, see:
@@ -46,12 +46,18 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/master/ports"
+	clusterroleetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrole/etcd"
+	clusterrolebindingetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrolebinding/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/componentstatus"
 	controlleretcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/controller/etcd"
+	daemonsetetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/daemonset/etcd"
+	deploymentetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/deployment/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/endpoint"
 	endpointsetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/endpoint/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/event"
+	horizontalpodautoscaleretcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/horizontalpodautoscaler/etcd"
+	jobetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/job/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/limitrange"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/minion"
 	nodeetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/minion/etcd"
@@ -63,6 +69,8 @@ import (
 	podetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/pod/etcd"
 	podtemplateetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/podtemplate/etcd"
 	resourcequotaetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/resourcequota/etcd"
+	roleetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/role/etcd"
+	rolebindingetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/rolebinding/etcd"
 	secretetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/secret/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/service"
 	etcdallocator "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/service/allocator/etcd"
@@ -431,6 +439,11 @@ func (m *Master) init(c *Config) {
 	persistentVolumeStorage, persistentVolumeStatusStorage := pvetcd.NewStorage(c.EtcdHelper)
 	persistentVolumeClaimStorage, persistentVolumeClaimStatusStorage := pvcetcd.NewStorage(c.EtcdHelper)
 
+	roleStorage := roleetcd.NewStorage(c.EtcdHelper)
+	roleBindingStorage := rolebindingetcd.NewStorage(c.EtcdHelper)
+	clusterRoleStorage := clusterroleetcd.NewStorage(c.EtcdHelper)
+	clusterRoleBindingStorage := clusterrolebindingetcd.NewStorage(c.EtcdHelper)
+
 	namespaceStorage, namespaceStatusStorage, namespaceFinalizeStorage := namespaceetcd.NewStorage(c.EtcdHelper)
 	m.namespaceRegistry = namespace.NewRegistry(namespaceStorage)
 
@@ -463,6 +476,10 @@ func (m *Master) init(c *Config) {
 	m.serviceNodePortAllocator = serviceNodePortRegistry
 
 	controllerStorage := controlleretcd.NewREST(c.EtcdHelper)
+	deploymentStorage := deploymentetcd.NewREST(c.EtcdHelper)
+	daemonSetStorage := daemonsetetcd.NewREST(c.EtcdHelper)
+	jobStorage := jobetcd.NewREST(c.EtcdHelper)
+	autoscalerStorage := horizontalpodautoscaleretcd.NewREST(c.EtcdHelper)
 
 	// TODO: Factor out the core API registration
 	m.storage = map[string]rest.Storage{
@@ -477,14 +494,18 @@ func (m *Master) init(c *Config) {
 
 		"podTemplates": podTemplateStorage,
 
-		"replicationControllers": controllerStorage,
-		"services":               service.NewStorage(m.serviceRegistry, m.nodeRegistry, m.endpointRegistry, serviceClusterIPAllocator, serviceNodePortAllocator, c.ClusterName),
-		"endpoints":              endpointsStorage,
-		"minions":                nodeStorage,
-		"minions/status":         nodeStatusStorage,
-		"nodes":                  nodeStorage,
-		"nodes/status":           nodeStatusStorage,
-		"events":                 event.NewStorage(eventRegistry),
+		"replicationControllers":   controllerStorage,
+		"deployments":              deploymentStorage,
+		"daemonsets":               daemonSetStorage,
+		"jobs":                     jobStorage,
+		"horizontalpodautoscalers": autoscalerStorage,
+		"services":                 service.NewStorage(m.serviceRegistry, m.nodeRegistry, m.endpointRegistry, serviceClusterIPAllocator, serviceNodePortAllocator, c.ClusterName),
+		"endpoints":                endpointsStorage,
+		"minions":                  nodeStorage,
+		"minions/status":           nodeStatusStorage,
+		"nodes":                    nodeStorage,
+		"nodes/status":             nodeStatusStorage,
+		"events":                   event.NewStorage(eventRegistry),
 
 		"limitRanges":                   limitrange.NewStorage(limitRangeRegistry),
 		"resourceQuotas":                resourceQuotaStorage,
@@ -499,6 +520,11 @@ func (m *Master) init(c *Config) {
 		"persistentVolumeClaims":        persistentVolumeClaimStorage,
 		"persistentVolumeClaims/status": persistentVolumeClaimStatusStorage,
 
+		"roles":               roleStorage,
+		"roleBindings":        roleBindingStorage,
+		"clusterRoles":        clusterRoleStorage,
+		"clusterRoleBindings": clusterRoleBindingStorage,
+
 		"componentStatuses": componentstatus.NewStorage(func() map[string]apiserver.Server { return m.getServersToValidate(c) }),
 	}
 
@@ -70,6 +70,24 @@ type EndpointsConfigHandler interface {
 	OnUpdate(endpoints []api.Endpoints)
 }
 
+// ServiceConfigHandlerFunc is an adapter to allow the use of ordinary
+// functions as ServiceConfigHandlers.
+type ServiceConfigHandlerFunc func(services []api.Service)
+
+// OnUpdate calls f(services).
+func (f ServiceConfigHandlerFunc) OnUpdate(services []api.Service) {
+	f(services)
+}
+
+// EndpointsConfigHandlerFunc is an adapter to allow the use of ordinary
+// functions as EndpointsConfigHandlers.
+type EndpointsConfigHandlerFunc func(endpoints []api.Endpoints)
+
+// OnUpdate calls f(endpoints).
+func (f EndpointsConfigHandlerFunc) OnUpdate(endpoints []api.Endpoints) {
+	f(endpoints)
+}
+
 // EndpointsConfig tracks a set of endpoints configurations.
 // It accepts "set", "add" and "remove" operations of endpoints via channels, and invokes registered handlers on change.
 type EndpointsConfig struct {
@@ -0,0 +1,91 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var activeConnectionsGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: "kubeproxy",
+		Name:      "endpoint_active_connections",
+		Help:      "Number of connections currently proxied to a service endpoint.",
+	},
+	[]string{"service", "endpoint"},
+)
+
+var registerMetrics sync.Once
+
+// RegisterMetrics registers the proxy's metrics with the default prometheus registry.
+func RegisterMetrics() {
+	registerMetrics.Do(func() {
+		prometheus.MustRegister(activeConnectionsGauge)
+	})
+}
+
+type connectionKey struct {
+	service  ServicePortName
+	endpoint string
+}
+
+// connectionTracker counts the connections that are currently being proxied
+// to each service endpoint.
+type connectionTracker struct {
+	lock   sync.Mutex
+	counts map[connectionKey]int
+}
+
+// activeConnections is shared by all proxy sockets and read by the
+// connection-aware load balancers.
+var activeConnections = newConnectionTracker()
+
+func newConnectionTracker() *connectionTracker {
+	return &connectionTracker{counts: map[connectionKey]int{}}
+}
+
+// Opened records a new connection from the service to the endpoint.
+func (ct *connectionTracker) Opened(service ServicePortName, endpoint string) {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+	key := connectionKey{service, endpoint}
+	ct.counts[key]++
+	activeConnectionsGauge.WithLabelValues(service.String(), endpoint).Set(float64(ct.counts[key]))
+}
+
+// Closed records that a connection opened with Opened has finished.
+func (ct *connectionTracker) Closed(service ServicePortName, endpoint string) {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+	key := connectionKey{service, endpoint}
+	if ct.counts[key] <= 1 {
+		delete(ct.counts, key)
+		activeConnectionsGauge.DeleteLabelValues(service.String(), endpoint)
+		return
+	}
+	ct.counts[key]--
+	activeConnectionsGauge.WithLabelValues(service.String(), endpoint).Set(float64(ct.counts[key]))
+}
+
+// Count returns the number of open connections from the service to the endpoint.
+func (ct *connectionTracker) Count(service ServicePortName, endpoint string) int {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+	return ct.counts[connectionKey{service, endpoint}]
+}
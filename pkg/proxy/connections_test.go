@@ -0,0 +1,46 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+func TestConnectionTracker(t *testing.T) {
+	tracker := newConnectionTracker()
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+
+	tracker.Opened(service, "10.0.0.1:80")
+	tracker.Opened(service, "10.0.0.1:80")
+	tracker.Opened(service, "10.0.0.2:80")
+	if count := tracker.Count(service, "10.0.0.1:80"); count != 2 {
+		t.Errorf("expected 2 connections, got %d", count)
+	}
+	tracker.Closed(service, "10.0.0.1:80")
+	tracker.Closed(service, "10.0.0.2:80")
+	if count := tracker.Count(service, "10.0.0.1:80"); count != 1 {
+		t.Errorf("expected 1 connection, got %d", count)
+	}
+	if count := tracker.Count(service, "10.0.0.2:80"); count != 0 {
+		t.Errorf("expected no connections, got %d", count)
+	}
+	if len(tracker.counts) != 1 {
+		t.Errorf("expected idle endpoints to be forgotten, got %v", tracker.counts)
+	}
+}
@@ -0,0 +1,521 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iptables
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base32"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/proxy"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	utilexec "github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
+	utiliptables "github.com/GoogleCloudPlatform/kubernetes/pkg/util/iptables"
+	"github.com/golang/glog"
+)
+
+// The top-level chains. Service and endpoint chains hang off of these.
+const (
+	iptablesServicesChain    utiliptables.Chain = "KUBE-SERVICES"
+	iptablesNodePortsChain   utiliptables.Chain = "KUBE-NODEPORTS"
+	iptablesPostroutingChain utiliptables.Chain = "KUBE-POSTROUTING"
+)
+
+// Prefixes of the per-service and per-endpoint chains.
+const (
+	servicePrefix  = "KUBE-SVC-"
+	endpointPrefix = "KUBE-SEP-"
+)
+
+// masqueradeMark is set on packets that must be SNATed on their way out,
+// e.g. NodePort traffic or a pod reaching itself through its own service.
+const masqueradeMark = "0x4d415351"
+
+// stickyMaxAgeSeconds is how long ClientIP affinity is remembered, matching
+// the userspace proxier.
+const stickyMaxAgeSeconds = 180 * 60
+
+// The iptables-restore input needs the statistic and recent modules and a
+// reliable `-C`, all of which are present from this version on.
+const (
+	iptablesMinMajor = 1
+	iptablesMinMinor = 4
+	iptablesMinPatch = 11
+)
+
+// CanUseIptablesProxier returns nil if the node's iptables is recent enough
+// for this proxier, or an error describing why it is not.
+func CanUseIptablesProxier(exec utilexec.Interface) error {
+	major, minor, patch, err := utiliptables.GetIptablesVersion(exec)
+	if err != nil {
+		return err
+	}
+	if major > iptablesMinMajor ||
+		(major == iptablesMinMajor && minor > iptablesMinMinor) ||
+		(major == iptablesMinMajor && minor == iptablesMinMinor && patch >= iptablesMinPatch) {
+		return nil
+	}
+	return fmt.Errorf("iptables %d.%d.%d is older than the required %d.%d.%d",
+		major, minor, patch, iptablesMinMajor, iptablesMinMinor, iptablesMinPatch)
+}
+
+// serviceInfo captures what the rules for one service port depend on.
+type serviceInfo struct {
+	clusterIP           net.IP
+	port                int
+	protocol            api.Protocol
+	nodePort            int
+	loadBalancerStatus  api.LoadBalancerStatus
+	sessionAffinityType api.ServiceAffinity
+	// Deprecated, but required for back-compat (including e2e)
+	deprecatedPublicIPs []string
+}
+
+// Proxier is an iptables based proxy for connections between a service's
+// virtual IP (or NodePort, or load balancer IP) and its endpoints.
+type Proxier struct {
+	mu           sync.Mutex // protects the fields below
+	serviceMap   map[proxy.ServicePortName]*serviceInfo
+	endpointsMap map[proxy.ServicePortName][]string // "ip:port" endpoints
+	// Rules are not written until both services and endpoints have been
+	// seen once, so that a restart does not briefly drop every endpoint.
+	haveReceivedServiceUpdate   bool
+	haveReceivedEndpointsUpdate bool
+
+	syncPeriod time.Duration
+	iptables   utiliptables.Interface
+}
+
+// NewProxier returns a new Proxier that writes its rules through ipt. The
+// rules are rewritten on every change and at least once per syncPeriod.
+func NewProxier(ipt utiliptables.Interface, syncPeriod time.Duration) (*Proxier, error) {
+	glog.V(2).Info("Initializing iptables proxier")
+	if err := ensureTopLevelChains(ipt); err != nil {
+		return nil, fmt.Errorf("failed to initialize iptables: %v", err)
+	}
+	return &Proxier{
+		serviceMap:   make(map[proxy.ServicePortName]*serviceInfo),
+		endpointsMap: make(map[proxy.ServicePortName][]string),
+		syncPeriod:   syncPeriod,
+		iptables:     ipt,
+	}, nil
+}
+
+// ensureTopLevelChains creates our top-level chains and the jumps to them
+// from the built-in chains. This can safely be called periodically.
+func ensureTopLevelChains(ipt utiliptables.Interface) error {
+	for _, chain := range []utiliptables.Chain{iptablesServicesChain, iptablesNodePortsChain, iptablesPostroutingChain} {
+		if _, err := ipt.EnsureChain(utiliptables.TableNAT, chain); err != nil {
+			return err
+		}
+	}
+	args := []string{"-m", "comment", "--comment", "kubernetes service portals", "-j", string(iptablesServicesChain)}
+	for _, chain := range []utiliptables.Chain{utiliptables.ChainOutput, utiliptables.ChainPrerouting} {
+		if _, err := ipt.EnsureRule(utiliptables.Prepend, utiliptables.TableNAT, chain, args...); err != nil {
+			return err
+		}
+	}
+	args = []string{"-m", "comment", "--comment", "kubernetes postrouting rules", "-j", string(iptablesPostroutingChain)}
+	if _, err := ipt.EnsureRule(utiliptables.Prepend, utiliptables.TableNAT, utiliptables.ChainPostrouting, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CleanupLeftovers removes the jumps and chains installed by this proxier,
+// so that the userspace proxier can take over the node. It returns true if
+// any error was encountered.
+func CleanupLeftovers(ipt utiliptables.Interface) (encounteredError bool) {
+	args := []string{"-m", "comment", "--comment", "kubernetes service portals", "-j", string(iptablesServicesChain)}
+	for _, chain := range []utiliptables.Chain{utiliptables.ChainOutput, utiliptables.ChainPrerouting} {
+		if err := ipt.DeleteRule(utiliptables.TableNAT, chain, args...); err != nil {
+			glog.Errorf("Error removing iptables proxier rule: %v", err)
+			encounteredError = true
+		}
+	}
+	args = []string{"-m", "comment", "--comment", "kubernetes postrouting rules", "-j", string(iptablesPostroutingChain)}
+	if err := ipt.DeleteRule(utiliptables.TableNAT, utiliptables.ChainPostrouting, args...); err != nil {
+		glog.Errorf("Error removing iptables proxier rule: %v", err)
+		encounteredError = true
+	}
+
+	existing, err := ipt.Save(utiliptables.TableNAT)
+	if err != nil {
+		glog.Errorf("Failed to read the nat table: %v", err)
+		return true
+	}
+	var chains []utiliptables.Chain
+	for _, chain := range getChainNames(existing) {
+		if chain == iptablesServicesChain || chain == iptablesNodePortsChain || chain == iptablesPostroutingChain ||
+			strings.HasPrefix(string(chain), servicePrefix) || strings.HasPrefix(string(chain), endpointPrefix) {
+			chains = append(chains, chain)
+		}
+	}
+	if len(chains) == 0 {
+		return encounteredError
+	}
+	// Declaring the chains flushes them, so they can all be deleted at once
+	// regardless of the jumps between them.
+	buf := bytes.NewBuffer(nil)
+	writeLine(buf, "*nat")
+	for _, chain := range chains {
+		writeLine(buf, fmt.Sprintf(":%s - [0:0]", chain))
+	}
+	for _, chain := range chains {
+		writeLine(buf, "-X", string(chain))
+	}
+	writeLine(buf, "COMMIT")
+	if err := ipt.Restore(utiliptables.TableNAT, buf.Bytes(), utiliptables.NoFlushTables); err != nil {
+		glog.Errorf("Failed to delete iptables proxier chains: %v", err)
+		encounteredError = true
+	}
+	return encounteredError
+}
+
+// SyncLoop rewrites the rules every syncPeriod.  This is expected to run as
+// a goroutine or as the main loop of the app.  It does not return.
+func (proxier *Proxier) SyncLoop() {
+	t := time.NewTicker(proxier.syncPeriod)
+	defer t.Stop()
+	for {
+		<-t.C
+		glog.V(6).Infof("Periodic sync")
+		proxier.Sync()
+	}
+}
+
+// Sync rewrites all of the iptables rules now.
+func (proxier *Proxier) Sync() {
+	proxier.mu.Lock()
+	defer proxier.mu.Unlock()
+	proxier.syncProxyRules()
+}
+
+// OnServiceUpdate tracks the active set of service proxies. It can be
+// registered with a ServiceConfig through config.ServiceConfigHandlerFunc.
+func (proxier *Proxier) OnServiceUpdate(allServices []api.Service) {
+	proxier.mu.Lock()
+	defer proxier.mu.Unlock()
+	proxier.haveReceivedServiceUpdate = true
+
+	activeServices := make(map[proxy.ServicePortName]bool) // use a map as a set
+	for i := range allServices {
+		service := &allServices[i]
+
+		// if ClusterIP is "None" or empty, skip proxying
+		if !api.IsServiceIPSet(service) {
+			glog.V(3).Infof("Skipping service %s due to clusterIP = %q", types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service.Spec.ClusterIP)
+			continue
+		}
+
+		for i := range service.Spec.Ports {
+			servicePort := &service.Spec.Ports[i]
+			serviceName := proxy.ServicePortName{NamespacedName: types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, Port: servicePort.Name}
+			activeServices[serviceName] = true
+
+			info := &serviceInfo{
+				clusterIP:           net.ParseIP(service.Spec.ClusterIP),
+				port:                servicePort.Port,
+				protocol:            servicePort.Protocol,
+				nodePort:            servicePort.NodePort,
+				loadBalancerStatus:  *api.LoadBalancerStatusDeepCopy(&service.Status.LoadBalancer),
+				sessionAffinityType: service.Spec.SessionAffinity,
+				deprecatedPublicIPs: service.Spec.DeprecatedPublicIPs,
+			}
+			if old, exists := proxier.serviceMap[serviceName]; exists && reflect.DeepEqual(old, info) {
+				continue
+			}
+			glog.V(1).Infof("Setting service %q to %s:%d/%s", serviceName, info.clusterIP, info.port, info.protocol)
+			if policy := service.Spec.LoadBalancingPolicy; policy != "" && policy != api.ServiceLoadBalancingRoundRobin {
+				glog.Warningf("Service %q asks for load-balancing policy %q, which the iptables proxier does not support; picking endpoints at random", serviceName, policy)
+			}
+			proxier.serviceMap[serviceName] = info
+		}
+	}
+
+	for name := range proxier.serviceMap {
+		if !activeServices[name] {
+			glog.V(1).Infof("Removing service %q", name)
+			delete(proxier.serviceMap, name)
+		}
+	}
+
+	proxier.syncProxyRules()
+}
+
+// OnEndpointsUpdate tracks the endpoints of every service port. It can be
+// registered with an EndpointsConfig through config.EndpointsConfigHandlerFunc.
+func (proxier *Proxier) OnEndpointsUpdate(allEndpoints []api.Endpoints) {
+	proxier.mu.Lock()
+	defer proxier.mu.Unlock()
+	proxier.haveReceivedEndpointsUpdate = true
+
+	newEndpointsMap := make(map[proxy.ServicePortName][]string)
+	for i := range allEndpoints {
+		svcEndpoints := &allEndpoints[i]
+
+		// Explode Endpoints.Subsets[*] into a map of port name to all of the
+		// ip:ports for that port name.
+		for i := range svcEndpoints.Subsets {
+			ss := &svcEndpoints.Subsets[i]
+			for i := range ss.Ports {
+				port := &ss.Ports[i]
+				svcPort := proxy.ServicePortName{NamespacedName: types.NamespacedName{Namespace: svcEndpoints.Namespace, Name: svcEndpoints.Name}, Port: port.Name}
+				for i := range ss.Addresses {
+					addr := &ss.Addresses[i]
+					// Ignore the protocol field - we'll get that from the Service objects.
+					newEndpointsMap[svcPort] = append(newEndpointsMap[svcPort], net.JoinHostPort(addr.IP, strconv.Itoa(port.Port)))
+				}
+			}
+		}
+	}
+	for svcPort := range newEndpointsMap {
+		// Sort so that the generated rules do not depend on the order of
+		// the update.
+		sort.Strings(newEndpointsMap[svcPort])
+		if !reflect.DeepEqual(newEndpointsMap[svcPort], proxier.endpointsMap[svcPort]) {
+			glog.V(1).Infof("Setting endpoints for %q to %+v", svcPort, newEndpointsMap[svcPort])
+		}
+	}
+	proxier.endpointsMap = newEndpointsMap
+
+	proxier.syncProxyRules()
+}
+
+// servicePortChainName returns the chain that load balances across the
+// endpoints of a service port. Chain names are limited to 28 characters, so
+// the name is hashed.
+func servicePortChainName(s proxy.ServicePortName, protocol api.Protocol) utiliptables.Chain {
+	return utiliptables.Chain(servicePrefix + hashName(s.String()+"/"+string(protocol)))
+}
+
+// endpointChainName returns the chain that DNATs to a single endpoint of a
+// service port.
+func endpointChainName(s proxy.ServicePortName, protocol api.Protocol, endpoint string) utiliptables.Chain {
+	return utiliptables.Chain(endpointPrefix + hashName(s.String()+"/"+string(protocol)+"/"+endpoint))
+}
+
+func hashName(name string) string {
+	hash := sha256.Sum256([]byte(name))
+	return base32.StdEncoding.EncodeToString(hash[:])[:16]
+}
+
+// getChainNames returns the chains declared in iptables-save output.
+func getChainNames(save []byte) []utiliptables.Chain {
+	var chains []utiliptables.Chain
+	for _, line := range strings.Split(string(save), "\n") {
+		if !strings.HasPrefix(line, ":") {
+			continue
+		}
+		fields := strings.Fields(line[1:])
+		if len(fields) > 0 {
+			chains = append(chains, utiliptables.Chain(fields[0]))
+		}
+	}
+	return chains
+}
+
+func writeLine(buf *bytes.Buffer, words ...string) {
+	buf.WriteString(strings.Join(words, " ") + "\n")
+}
+
+// This is where all of the iptables-save/restore calls happen.  The only
+// other iptables rules are those that are setup in ensureTopLevelChains().
+// This assumes proxier.mu is held.
+func (proxier *Proxier) syncProxyRules() {
+	// Don't sync rules until we've received both services and endpoints.
+	if !proxier.haveReceivedEndpointsUpdate || !proxier.haveReceivedServiceUpdate {
+		glog.V(2).Info("Not syncing iptables until services and endpoints have been received")
+		return
+	}
+	glog.V(4).Infof("Syncing iptables rules")
+
+	if err := ensureTopLevelChains(proxier.iptables); err != nil {
+		glog.Errorf("Failed to ensure iptables chains: %v", err)
+		return
+	}
+	existing, err := proxier.iptables.Save(utiliptables.TableNAT)
+	if err != nil {
+		glog.Errorf("Failed to read the nat table: %v", err)
+		return
+	}
+	data := proxier.buildRules(getChainNames(existing))
+	glog.V(5).Infof("Restoring iptables rules: %s", data)
+	if err := proxier.iptables.Restore(utiliptables.TableNAT, data, utiliptables.NoFlushTables); err != nil {
+		glog.Errorf("Failed to execute iptables-restore: %v", err)
+	}
+}
+
+// buildRules returns the iptables-restore input for the nat table given the
+// chains that currently exist in it. Our chains are declared, and therefore
+// flushed, and then rewritten; our chains that are no longer needed are
+// deleted. Everything else in the table is left alone.
+// This assumes proxier.mu is held.
+func (proxier *Proxier) buildRules(existingChains []utiliptables.Chain) []byte {
+	chains := bytes.NewBuffer(nil)
+	rules := bytes.NewBuffer(nil)
+	writeLine(chains, "*nat")
+
+	for _, chain := range []utiliptables.Chain{iptablesServicesChain, iptablesNodePortsChain, iptablesPostroutingChain} {
+		writeLine(chains, fmt.Sprintf(":%s - [0:0]", chain))
+	}
+	// Masquerade marked traffic on its way out, so replies come back
+	// through this node.
+	writeLine(rules, "-A", string(iptablesPostroutingChain),
+		"-m", "comment", "--comment", `"kubernetes service traffic requiring SNAT"`,
+		"-m", "mark", "--mark", masqueradeMark, "-j", "MASQUERADE")
+	markArgs := []string{"-j", "MARK", "--set-xmark", masqueradeMark + "/0xffffffff"}
+
+	activeChains := make(map[utiliptables.Chain]bool)
+
+	// Sort the services so that the output is stable.
+	names := make([]string, 0, len(proxier.serviceMap))
+	byName := make(map[string]proxy.ServicePortName, len(proxier.serviceMap))
+	for name := range proxier.serviceMap {
+		names = append(names, name.String())
+		byName[name.String()] = name
+	}
+	sort.Strings(names)
+
+	for _, key := range names {
+		svcName := byName[key]
+		info := proxier.serviceMap[svcName]
+		protocol := strings.ToLower(string(info.protocol))
+		svcChain := servicePortChainName(svcName, info.protocol)
+		activeChains[svcChain] = true
+		writeLine(chains, fmt.Sprintf(":%s - [0:0]", svcChain))
+
+		// Capture the cluster IP.
+		writeLine(rules, "-A", string(iptablesServicesChain),
+			"-m", "comment", "--comment", fmt.Sprintf(`"%s cluster IP"`, svcName),
+			"-m", protocol, "-p", protocol,
+			"-d", fmt.Sprintf("%s/32", info.clusterIP),
+			"--dport", strconv.Itoa(info.port),
+			"-j", string(svcChain))
+
+		// Capture external IPs, which arrive from off-node and so must be
+		// masqueraded.
+		externalIPs := append([]string{}, info.deprecatedPublicIPs...)
+		for _, ingress := range info.loadBalancerStatus.Ingress {
+			if ingress.IP != "" {
+				externalIPs = append(externalIPs, ingress.IP)
+			}
+		}
+		for _, ip := range externalIPs {
+			args := []string{"-A", string(iptablesServicesChain),
+				"-m", "comment", "--comment", fmt.Sprintf(`"%s external IP"`, svcName),
+				"-m", protocol, "-p", protocol,
+				"-d", fmt.Sprintf("%s/32", ip),
+				"--dport", strconv.Itoa(info.port),
+			}
+			writeLine(rules, append(args, markArgs...)...)
+			writeLine(rules, append(args, "-j", string(svcChain))...)
+		}
+
+		// Capture node ports.
+		if info.nodePort != 0 {
+			args := []string{"-A", string(iptablesNodePortsChain),
+				"-m", "comment", "--comment", fmt.Sprintf(`"%s"`, svcName),
+				"-m", protocol, "-p", protocol,
+				"--dport", strconv.Itoa(info.nodePort),
+			}
+			writeLine(rules, append(args, markArgs...)...)
+			writeLine(rules, append(args, "-j", string(svcChain))...)
+		}
+
+		endpoints := proxier.endpointsMap[svcName]
+		endpointChains := make([]utiliptables.Chain, 0, len(endpoints))
+		for _, endpoint := range endpoints {
+			endpointChain := endpointChainName(svcName, info.protocol, endpoint)
+			endpointChains = append(endpointChains, endpointChain)
+			activeChains[endpointChain] = true
+			writeLine(chains, fmt.Sprintf(":%s - [0:0]", endpointChain))
+		}
+
+		// First send clients back to the endpoint they used last, if
+		// ClientIP affinity is requested.
+		if info.sessionAffinityType == api.ServiceAffinityClientIP {
+			for _, endpointChain := range endpointChains {
+				writeLine(rules, "-A", string(svcChain),
+					"-m", "comment", "--comment", fmt.Sprintf(`"%s"`, svcName),
+					"-m", "recent", "--name", string(endpointChain),
+					"--rcheck", "--seconds", strconv.Itoa(stickyMaxAgeSeconds), "--reap",
+					"-j", string(endpointChain))
+			}
+		}
+
+		// Then balance randomly: each rule takes its share of what the
+		// previous rules let through, and the last one takes the rest.
+		n := len(endpointChains)
+		for i, endpointChain := range endpointChains {
+			args := []string{"-A", string(svcChain), "-m", "comment", "--comment", fmt.Sprintf(`"%s"`, svcName)}
+			if i < n-1 {
+				args = append(args,
+					"-m", "statistic",
+					"--mode", "random",
+					"--probability", fmt.Sprintf("%0.5f", 1.0/float64(n-i)))
+			}
+			writeLine(rules, append(args, "-j", string(endpointChain))...)
+		}
+
+		for i, endpointChain := range endpointChains {
+			endpoint := endpoints[i]
+			host, _, err := net.SplitHostPort(endpoint)
+			if err != nil {
+				glog.Errorf("Failed to parse endpoint %q of %q: %v", endpoint, svcName, err)
+				continue
+			}
+			args := []string{"-A", string(endpointChain), "-m", "comment", "--comment", fmt.Sprintf(`"%s"`, svcName)}
+			// Masquerade a pod that is reaching itself through the service,
+			// otherwise its replies would bypass the DNAT.
+			writeLine(rules, append(append(args, "-s", fmt.Sprintf("%s/32", host)), markArgs...)...)
+			if info.sessionAffinityType == api.ServiceAffinityClientIP {
+				args = append(args, "-m", "recent", "--name", string(endpointChain), "--set")
+			}
+			writeLine(rules, append(args, "-m", protocol, "-p", protocol, "-j", "DNAT", "--to-destination", endpoint)...)
+		}
+	}
+
+	// Node ports match broadly, so they come last.
+	writeLine(rules, "-A", string(iptablesServicesChain),
+		"-m", "comment", "--comment", `"kubernetes service nodeports; NOTE: this must be the last rule in this chain"`,
+		"-m", "addrtype", "--dst-type", "LOCAL",
+		"-j", string(iptablesNodePortsChain))
+
+	// Delete our chains that are no longer used. They are declared first so
+	// that they are flushed before being deleted.
+	for _, chain := range existingChains {
+		name := string(chain)
+		if activeChains[chain] || !(strings.HasPrefix(name, servicePrefix) || strings.HasPrefix(name, endpointPrefix)) {
+			continue
+		}
+		writeLine(chains, fmt.Sprintf(":%s - [0:0]", chain))
+		writeLine(rules, "-X", name)
+	}
+
+	writeLine(rules, "COMMIT")
+	return append(chains.Bytes(), rules.Bytes()...)
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iptables
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/proxy"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	utiliptables "github.com/GoogleCloudPlatform/kubernetes/pkg/util/iptables"
+)
+
+type fakeIptables struct {
+	saveOutput []byte
+	restored   [][]byte
+	deleted    [][]string
+}
+
+func (fake *fakeIptables) EnsureChain(table utiliptables.Table, chain utiliptables.Chain) (bool, error) {
+	return false, nil
+}
+
+func (fake *fakeIptables) FlushChain(table utiliptables.Table, chain utiliptables.Chain) error {
+	return nil
+}
+
+func (fake *fakeIptables) DeleteChain(table utiliptables.Table, chain utiliptables.Chain) error {
+	return nil
+}
+
+func (fake *fakeIptables) EnsureRule(position utiliptables.RulePosition, table utiliptables.Table, chain utiliptables.Chain, args ...string) (bool, error) {
+	return false, nil
+}
+
+func (fake *fakeIptables) DeleteRule(table utiliptables.Table, chain utiliptables.Chain, args ...string) error {
+	fake.deleted = append(fake.deleted, append([]string{string(chain)}, args...))
+	return nil
+}
+
+func (fake *fakeIptables) IsIpv6() bool {
+	return false
+}
+
+func (fake *fakeIptables) Save(table utiliptables.Table) ([]byte, error) {
+	return fake.saveOutput, nil
+}
+
+func (fake *fakeIptables) Restore(table utiliptables.Table, data []byte, flush utiliptables.FlushFlag) error {
+	fake.restored = append(fake.restored, data)
+	return nil
+}
+
+func (fake *fakeIptables) lastRestore(t *testing.T) string {
+	if len(fake.restored) == 0 {
+		t.Fatalf("expected iptables-restore to be called")
+	}
+	return string(fake.restored[len(fake.restored)-1])
+}
+
+func newService(name string, affinity api.ServiceAffinity, nodePort int) api.Service {
+	return api.Service{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec: api.ServiceSpec{
+			ClusterIP:       "10.0.0.1",
+			SessionAffinity: affinity,
+			Ports:           []api.ServicePort{{Name: "p", Port: 80, Protocol: api.ProtocolTCP, NodePort: nodePort}},
+		},
+	}
+}
+
+func newEndpoints(name string, ips ...string) api.Endpoints {
+	addresses := []api.EndpointAddress{}
+	for _, ip := range ips {
+		addresses = append(addresses, api.EndpointAddress{IP: ip})
+	}
+	return api.Endpoints{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: "ns"},
+		Subsets: []api.EndpointSubset{{
+			Addresses: addresses,
+			Ports:     []api.EndpointPort{{Name: "p", Port: 8080}},
+		}},
+	}
+}
+
+func newTestProxier(t *testing.T, ipt *fakeIptables) *Proxier {
+	proxier, err := NewProxier(ipt, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return proxier
+}
+
+func svcPortName(name string) proxy.ServicePortName {
+	return proxy.ServicePortName{NamespacedName: types.NamespacedName{Namespace: "ns", Name: name}, Port: "p"}
+}
+
+func TestNoSyncBeforeBothUpdates(t *testing.T) {
+	ipt := &fakeIptables{}
+	proxier := newTestProxier(t, ipt)
+	proxier.OnServiceUpdate([]api.Service{newService("foo", api.ServiceAffinityNone, 0)})
+	if len(ipt.restored) != 0 {
+		t.Errorf("expected no rules before endpoints are received, got %q", ipt.restored)
+	}
+	proxier.OnEndpointsUpdate([]api.Endpoints{newEndpoints("foo", "1.2.3.4")})
+	if len(ipt.restored) != 1 {
+		t.Errorf("expected rules to be written once both updates are received, got %d writes", len(ipt.restored))
+	}
+}
+
+func TestRandomBalancing(t *testing.T) {
+	ipt := &fakeIptables{}
+	proxier := newTestProxier(t, ipt)
+	proxier.OnServiceUpdate([]api.Service{newService("foo", api.ServiceAffinityNone, 0)})
+	proxier.OnEndpointsUpdate([]api.Endpoints{newEndpoints("foo", "1.2.3.6", "1.2.3.4", "1.2.3.5")})
+	rules := ipt.lastRestore(t)
+
+	name := svcPortName("foo")
+	svcChain := string(servicePortChainName(name, api.ProtocolTCP))
+	for _, expected := range []string{
+		"-A KUBE-SERVICES -m comment --comment \"ns/foo:p cluster IP\" -m tcp -p tcp -d 10.0.0.1/32 --dport 80 -j " + svcChain,
+		"-A " + svcChain + " -m comment --comment \"ns/foo:p\" -m statistic --mode random --probability 0.33333 -j " +
+			string(endpointChainName(name, api.ProtocolTCP, "1.2.3.4:8080")),
+		"-A " + svcChain + " -m comment --comment \"ns/foo:p\" -m statistic --mode random --probability 0.50000 -j " +
+			string(endpointChainName(name, api.ProtocolTCP, "1.2.3.5:8080")),
+		"-A " + svcChain + " -m comment --comment \"ns/foo:p\" -j " +
+			string(endpointChainName(name, api.ProtocolTCP, "1.2.3.6:8080")),
+		"-A " + string(endpointChainName(name, api.ProtocolTCP, "1.2.3.4:8080")) +
+			" -m comment --comment \"ns/foo:p\" -m tcp -p tcp -j DNAT --to-destination 1.2.3.4:8080",
+		"COMMIT",
+	} {
+		if !strings.Contains(rules, expected+"\n") {
+			t.Errorf("expected rule %q in:\n%s", expected, rules)
+		}
+	}
+	if strings.Contains(rules, "recent") {
+		t.Errorf("unexpected affinity rules in:\n%s", rules)
+	}
+	if strings.Contains(rules, "KUBE-NODEPORTS -m comment --comment \"ns/foo:p\"") {
+		t.Errorf("unexpected node port rules in:\n%s", rules)
+	}
+}
+
+func TestClientIPAffinity(t *testing.T) {
+	ipt := &fakeIptables{}
+	proxier := newTestProxier(t, ipt)
+	proxier.OnServiceUpdate([]api.Service{newService("foo", api.ServiceAffinityClientIP, 0)})
+	proxier.OnEndpointsUpdate([]api.Endpoints{newEndpoints("foo", "1.2.3.4")})
+	rules := ipt.lastRestore(t)
+
+	name := svcPortName("foo")
+	svcChain := string(servicePortChainName(name, api.ProtocolTCP))
+	sepChain := string(endpointChainName(name, api.ProtocolTCP, "1.2.3.4:8080"))
+	for _, expected := range []string{
+		"-A " + svcChain + " -m comment --comment \"ns/foo:p\" -m recent --name " + sepChain + " --rcheck --seconds 10800 --reap -j " + sepChain,
+		"-A " + sepChain + " -m comment --comment \"ns/foo:p\" -m recent --name " + sepChain + " --set -m tcp -p tcp -j DNAT --to-destination 1.2.3.4:8080",
+	} {
+		if !strings.Contains(rules, expected+"\n") {
+			t.Errorf("expected rule %q in:\n%s", expected, rules)
+		}
+	}
+}
+
+func TestNodePortAndExternalIPs(t *testing.T) {
+	ipt := &fakeIptables{}
+	proxier := newTestProxier(t, ipt)
+	service := newService("foo", api.ServiceAffinityNone, 30001)
+	service.Spec.DeprecatedPublicIPs = []string{"5.6.7.8"}
+	service.Status.LoadBalancer.Ingress = []api.LoadBalancerIngress{{IP: "9.9.9.9"}}
+	proxier.OnServiceUpdate([]api.Service{service})
+	proxier.OnEndpointsUpdate([]api.Endpoints{newEndpoints("foo", "1.2.3.4")})
+	rules := ipt.lastRestore(t)
+
+	svcChain := string(servicePortChainName(svcPortName("foo"), api.ProtocolTCP))
+	for _, expected := range []string{
+		"-A KUBE-NODEPORTS -m comment --comment \"ns/foo:p\" -m tcp -p tcp --dport 30001 -j MARK --set-xmark 0x4d415351/0xffffffff",
+		"-A KUBE-NODEPORTS -m comment --comment \"ns/foo:p\" -m tcp -p tcp --dport 30001 -j " + svcChain,
+		"-A KUBE-SERVICES -m comment --comment \"ns/foo:p external IP\" -m tcp -p tcp -d 5.6.7.8/32 --dport 80 -j " + svcChain,
+		"-A KUBE-SERVICES -m comment --comment \"ns/foo:p external IP\" -m tcp -p tcp -d 9.9.9.9/32 --dport 80 -j " + svcChain,
+	} {
+		if !strings.Contains(rules, expected+"\n") {
+			t.Errorf("expected rule %q in:\n%s", expected, rules)
+		}
+	}
+	// The node port jump must be the last rule of the services chain.
+	nodePortJump := "-A KUBE-SERVICES -m comment --comment \"kubernetes service nodeports; NOTE: this must be the last rule in this chain\" -m addrtype --dst-type LOCAL -j KUBE-NODEPORTS"
+	if strings.LastIndex(rules, "-A KUBE-SERVICES ") != strings.Index(rules, nodePortJump) {
+		t.Errorf("expected the node port jump to be the last rule of KUBE-SERVICES in:\n%s", rules)
+	}
+}
+
+func TestStaleChainsDeleted(t *testing.T) {
+	name := svcPortName("foo")
+	liveChain := servicePortChainName(name, api.ProtocolTCP)
+	ipt := &fakeIptables{
+		saveOutput: []byte("*nat\n" +
+			":PREROUTING ACCEPT [0:0]\n" +
+			":DOCKER - [0:0]\n" +
+			":" + string(liveChain) + " - [0:0]\n" +
+			":KUBE-SVC-STALESTALESTALE - [0:0]\n" +
+			":KUBE-SEP-STALESTALESTALE - [0:0]\n" +
+			"COMMIT\n"),
+	}
+	proxier := newTestProxier(t, ipt)
+	proxier.OnServiceUpdate([]api.Service{newService("foo", api.ServiceAffinityNone, 0)})
+	proxier.OnEndpointsUpdate([]api.Endpoints{})
+	rules := ipt.lastRestore(t)
+
+	for _, expected := range []string{"-X KUBE-SVC-STALESTALESTALE", "-X KUBE-SEP-STALESTALESTALE"} {
+		if !strings.Contains(rules, expected+"\n") {
+			t.Errorf("expected %q in:\n%s", expected, rules)
+		}
+	}
+	for _, unexpected := range []string{"-X " + string(liveChain), "-X DOCKER", "DOCKER - [0:0]"} {
+		if strings.Contains(rules, unexpected) {
+			t.Errorf("unexpected %q in:\n%s", unexpected, rules)
+		}
+	}
+}
+
+func TestServiceRemoved(t *testing.T) {
+	ipt := &fakeIptables{}
+	proxier := newTestProxier(t, ipt)
+	proxier.OnEndpointsUpdate([]api.Endpoints{newEndpoints("foo", "1.2.3.4")})
+	proxier.OnServiceUpdate([]api.Service{newService("foo", api.ServiceAffinityNone, 0)})
+	if !strings.Contains(ipt.lastRestore(t), "10.0.0.1/32") {
+		t.Fatalf("expected cluster IP rule in:\n%s", ipt.lastRestore(t))
+	}
+	proxier.OnServiceUpdate([]api.Service{})
+	if strings.Contains(ipt.lastRestore(t), "10.0.0.1/32") {
+		t.Errorf("unexpected cluster IP rule after the service was removed:\n%s", ipt.lastRestore(t))
+	}
+}
+
+func TestCleanupLeftovers(t *testing.T) {
+	ipt := &fakeIptables{
+		saveOutput: []byte("*nat\n" +
+			":KUBE-SERVICES - [0:0]\n" +
+			":KUBE-SVC-AAAAAAAAAAAAAAAA - [0:0]\n" +
+			":DOCKER - [0:0]\n" +
+			"COMMIT\n"),
+	}
+	if CleanupLeftovers(ipt) {
+		t.Errorf("unexpected error during cleanup")
+	}
+	if len(ipt.deleted) != 3 {
+		t.Errorf("expected the 3 jump rules to be deleted, got %v", ipt.deleted)
+	}
+	rules := ipt.lastRestore(t)
+	for _, expected := range []string{"-X KUBE-SERVICES", "-X KUBE-SVC-AAAAAAAAAAAAAAAA"} {
+		if !strings.Contains(rules, expected+"\n") {
+			t.Errorf("expected %q in:\n%s", expected, rules)
+		}
+	}
+	if strings.Contains(rules, "DOCKER") {
+		t.Errorf("unexpected DOCKER chain in:\n%s", rules)
+	}
+}
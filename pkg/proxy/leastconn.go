@@ -0,0 +1,49 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+// LoadBalancerLeastConnections sends each new connection to the endpoint
+// with the fewest connections currently proxied to it.
+type LoadBalancerLeastConnections struct {
+	selectingLoadBalancer
+}
+
+// Ensure this implements LoadBalancer.
+var _ LoadBalancer = &LoadBalancerLeastConnections{}
+
+// NewLoadBalancerLeastConnections returns a new LoadBalancerLeastConnections
+// that reads connection counts from the proxy's sockets.
+func NewLoadBalancerLeastConnections() *LoadBalancerLeastConnections {
+	return newLoadBalancerLeastConnections(activeConnections.Count)
+}
+
+func newLoadBalancerLeastConnections(connections func(ServicePortName, string) int) *LoadBalancerLeastConnections {
+	return &LoadBalancerLeastConnections{
+		selectingLoadBalancer: newSelectingLoadBalancer("LoadBalancerLeastConnections", func(svcPort ServicePortName, state *selectorState) string {
+			// Scan from the rotating index so that ties are broken round-robin.
+			best, bestCount := -1, 0
+			for n := 0; n < len(state.endpoints); n++ {
+				i := (state.index + n) % len(state.endpoints)
+				if count := connections(svcPort, state.endpoints[i]); best < 0 || count < bestCount {
+					best, bestCount = i, count
+				}
+			}
+			state.index = (best + 1) % len(state.endpoints)
+			return state.endpoints[best]
+		}),
+	}
+}
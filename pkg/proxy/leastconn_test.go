@@ -0,0 +1,55 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+func TestLeastConnectionsPicksIdlestEndpoint(t *testing.T) {
+	counts := map[string]int{"10.0.0.1:80": 3, "10.0.0.2:80": 1, "10.0.0.3:80": 2}
+	loadBalancer := newLoadBalancerLeastConnections(func(_ ServicePortName, endpoint string) int { return counts[endpoint] })
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(service, nil, "10.0.0.1", "10.0.0.2", "10.0.0.3")})
+
+	expectNextEndpoint(t, loadBalancer, service, "10.0.0.2:80", nil)
+	counts["10.0.0.2:80"] = 5
+	expectNextEndpoint(t, loadBalancer, service, "10.0.0.3:80", nil)
+}
+
+func TestLeastConnectionsSpreadsTies(t *testing.T) {
+	loadBalancer := newLoadBalancerLeastConnections(func(ServicePortName, string) int { return 0 })
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(service, nil, "10.0.0.1", "10.0.0.2", "10.0.0.3")})
+
+	seen := map[string]int{}
+	for i := 0; i < 6; i++ {
+		endpoint, err := loadBalancer.NextEndpoint(service, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		seen[endpoint]++
+	}
+	for _, endpoint := range []string{"10.0.0.1:80", "10.0.0.2:80", "10.0.0.3:80"} {
+		if seen[endpoint] != 2 {
+			t.Errorf("expected %s to be picked twice, got %v", endpoint, seen)
+		}
+	}
+}
@@ -33,6 +33,13 @@ type LoadBalancer interface {
 	CleanupStaleStickySessions(service ServicePortName)
 }
 
+// PolicyLoadBalancer is implemented by LoadBalancers that can balance each
+// service according to its api.ServiceLoadBalancingPolicy.  The proxier sets
+// the policy before calling NewService.
+type PolicyLoadBalancer interface {
+	SetLoadBalancingPolicy(service ServicePortName, policy api.ServiceLoadBalancingPolicy) error
+}
+
 // ServicePortName carries a namespace + name + portname.  This is the unique
 // identfier for a load-balanced service.
 type ServicePortName struct {
@@ -0,0 +1,110 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/golang/glog"
+)
+
+// endpointsLoadBalancer is a LoadBalancer that tracks endpoints itself.
+type endpointsLoadBalancer interface {
+	LoadBalancer
+	OnUpdate(endpoints []api.Endpoints)
+}
+
+// LoadBalancerPolicy dispatches each service to the load balancer that
+// implements its api.ServiceLoadBalancingPolicy.  Services without a policy
+// are balanced round-robin.
+type LoadBalancerPolicy struct {
+	balancers map[api.ServiceLoadBalancingPolicy]endpointsLoadBalancer
+
+	lock     sync.RWMutex
+	policies map[ServicePortName]api.ServiceLoadBalancingPolicy
+}
+
+// Ensure this implements LoadBalancer and PolicyLoadBalancer.
+var _ LoadBalancer = &LoadBalancerPolicy{}
+var _ PolicyLoadBalancer = &LoadBalancerPolicy{}
+
+// NewLoadBalancerPolicy returns a new LoadBalancerPolicy backed by every
+// supported policy.
+func NewLoadBalancerPolicy() *LoadBalancerPolicy {
+	return newLoadBalancerPolicy(map[api.ServiceLoadBalancingPolicy]endpointsLoadBalancer{
+		api.ServiceLoadBalancingRoundRobin:       NewLoadBalancerRR(),
+		api.ServiceLoadBalancingLeastConnections: NewLoadBalancerLeastConnections(),
+		api.ServiceLoadBalancingWeighted:         NewLoadBalancerWeighted(),
+		api.ServiceLoadBalancingRandomTwoChoices: NewLoadBalancerRandomTwoChoices(),
+	})
+}
+
+func newLoadBalancerPolicy(balancers map[api.ServiceLoadBalancingPolicy]endpointsLoadBalancer) *LoadBalancerPolicy {
+	return &LoadBalancerPolicy{
+		balancers: balancers,
+		policies:  map[ServicePortName]api.ServiceLoadBalancingPolicy{},
+	}
+}
+
+// SetLoadBalancingPolicy selects the load balancer used for service.
+func (lb *LoadBalancerPolicy) SetLoadBalancingPolicy(service ServicePortName, policy api.ServiceLoadBalancingPolicy) error {
+	if policy == "" {
+		policy = api.ServiceLoadBalancingRoundRobin
+	}
+	if _, found := lb.balancers[policy]; !found {
+		return fmt.Errorf("unsupported load balancing policy %q", policy)
+	}
+	lb.lock.Lock()
+	defer lb.lock.Unlock()
+	if old, found := lb.policies[service]; !found || old != policy {
+		glog.V(2).Infof("Using load balancing policy %s for service %q", policy, service)
+	}
+	lb.policies[service] = policy
+	return nil
+}
+
+func (lb *LoadBalancerPolicy) balancerFor(service ServicePortName) LoadBalancer {
+	lb.lock.RLock()
+	defer lb.lock.RUnlock()
+	if policy, found := lb.policies[service]; found {
+		return lb.balancers[policy]
+	}
+	return lb.balancers[api.ServiceLoadBalancingRoundRobin]
+}
+
+func (lb *LoadBalancerPolicy) NextEndpoint(service ServicePortName, srcAddr net.Addr) (string, error) {
+	return lb.balancerFor(service).NextEndpoint(service, srcAddr)
+}
+
+func (lb *LoadBalancerPolicy) NewService(service ServicePortName, sessionAffinityType api.ServiceAffinity, stickyMaxAgeMinutes int) error {
+	return lb.balancerFor(service).NewService(service, sessionAffinityType, stickyMaxAgeMinutes)
+}
+
+func (lb *LoadBalancerPolicy) CleanupStaleStickySessions(service ServicePortName) {
+	lb.balancerFor(service).CleanupStaleStickySessions(service)
+}
+
+// OnUpdate hands the endpoints to every policy's load balancer, so that a
+// service whose policy changes can be balanced immediately.
+func (lb *LoadBalancerPolicy) OnUpdate(allEndpoints []api.Endpoints) {
+	for _, balancer := range lb.balancers {
+		balancer.OnUpdate(allEndpoints)
+	}
+}
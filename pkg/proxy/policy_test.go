@@ -0,0 +1,66 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+func TestLoadBalancerPolicyDispatches(t *testing.T) {
+	counts := map[string]int{"10.0.0.1:80": 5}
+	loadBalancer := newLoadBalancerPolicy(map[api.ServiceLoadBalancingPolicy]endpointsLoadBalancer{
+		api.ServiceLoadBalancingRoundRobin:       NewLoadBalancerRR(),
+		api.ServiceLoadBalancingLeastConnections: newLoadBalancerLeastConnections(func(_ ServicePortName, endpoint string) int { return counts[endpoint] }),
+	})
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(service, nil, "10.0.0.1", "10.0.0.2")})
+
+	// Round robin by default visits both endpoints.
+	seen := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		endpoint, err := loadBalancer.NextEndpoint(service, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		seen[endpoint] = true
+	}
+	if len(seen) != 2 {
+		t.Errorf("expected round robin over both endpoints, got %v", seen)
+	}
+
+	if err := loadBalancer.SetLoadBalancingPolicy(service, api.ServiceLoadBalancingLeastConnections); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	loadBalancer.NewService(service, api.ServiceAffinityNone, 0)
+	for i := 0; i < 3; i++ {
+		expectNextEndpoint(t, loadBalancer, service, "10.0.0.2:80", nil)
+	}
+}
+
+func TestLoadBalancerPolicyRejectsUnknownPolicy(t *testing.T) {
+	loadBalancer := NewLoadBalancerPolicy()
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	if err := loadBalancer.SetLoadBalancingPolicy(service, "Fastest"); err == nil {
+		t.Errorf("expected an error for an unknown policy")
+	}
+	if err := loadBalancer.SetLoadBalancingPolicy(service, ""); err != nil {
+		t.Errorf("unexpected error for the default policy: %v", err)
+	}
+}
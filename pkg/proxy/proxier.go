@@ -48,6 +48,7 @@ type serviceInfo struct {
 	loadBalancerStatus  api.LoadBalancerStatus
 	sessionAffinityType api.ServiceAffinity
 	stickyMaxAgeMinutes int
+	loadBalancingPolicy api.ServiceLoadBalancingPolicy
 	// Deprecated, but required for back-compat (including e2e)
 	deprecatedPublicIPs []string
 }
@@ -316,12 +317,18 @@ func (proxier *Proxier) OnUpdate(services []api.Service) {
 			info.loadBalancerStatus = *api.LoadBalancerStatusDeepCopy(&service.Status.LoadBalancer)
 			info.nodePort = servicePort.NodePort
 			info.sessionAffinityType = service.Spec.SessionAffinity
+			info.loadBalancingPolicy = service.Spec.LoadBalancingPolicy
 			glog.V(4).Infof("info: %+v", info)
 
 			err = proxier.openPortal(serviceName, info)
 			if err != nil {
 				glog.Errorf("Failed to open portal for %q: %v", serviceName, err)
 			}
+			if policyLB, ok := proxier.loadBalancer.(PolicyLoadBalancer); ok {
+				if err := policyLB.SetLoadBalancingPolicy(serviceName, info.loadBalancingPolicy); err != nil {
+					glog.Errorf("Failed to set load balancing policy for %q: %v", serviceName, err)
+				}
+			}
 			proxier.loadBalancer.NewService(serviceName, info.sessionAffinityType, info.stickyMaxAgeMinutes)
 		}
 	}
@@ -358,6 +365,9 @@ func sameConfig(info *serviceInfo, service *api.Service, port *api.ServicePort)
 	if info.sessionAffinityType != service.Spec.SessionAffinity {
 		return false
 	}
+	if info.loadBalancingPolicy != service.Spec.LoadBalancingPolicy {
+		return false
+	}
 	return true
 }
 
@@ -654,6 +664,51 @@ func iptablesFlush(ipt iptables.Interface) error {
 	return errors.NewAggregate(el)
 }
 
+// CleanupLeftovers removes the jump rules and chains installed by
+// iptablesInit, so that a different proxy mode can take over the node.
+// It returns true if any error was encountered.
+func CleanupLeftovers(ipt iptables.Interface) (encounteredError bool) {
+	args := []string{"-m", "comment", "--comment", "handle ClusterIPs; NOTE: this must be before the NodePort rules"}
+	if err := ipt.DeleteRule(iptables.TableNAT, iptables.ChainPrerouting, append(args, "-j", string(iptablesContainerPortalChain))...); err != nil {
+		glog.Errorf("Error removing userspace rule: %v", err)
+		encounteredError = true
+	}
+	if err := ipt.DeleteRule(iptables.TableNAT, iptables.ChainOutput, append(args, "-j", string(iptablesHostPortalChain))...); err != nil {
+		glog.Errorf("Error removing userspace rule: %v", err)
+		encounteredError = true
+	}
+	args = []string{"-m", "addrtype", "--dst-type", "LOCAL"}
+	args = append(args, "-m", "comment", "--comment", "handle service NodePorts; NOTE: this must be the last rule in the chain")
+	if err := ipt.DeleteRule(iptables.TableNAT, iptables.ChainPrerouting, append(args, "-j", string(iptablesContainerNodePortChain))...); err != nil {
+		glog.Errorf("Error removing userspace rule: %v", err)
+		encounteredError = true
+	}
+	if err := ipt.DeleteRule(iptables.TableNAT, iptables.ChainOutput, append(args, "-j", string(iptablesHostNodePortChain))...); err != nil {
+		glog.Errorf("Error removing userspace rule: %v", err)
+		encounteredError = true
+	}
+
+	for _, chain := range []iptables.Chain{iptablesContainerPortalChain, iptablesHostPortalChain, iptablesContainerNodePortChain, iptablesHostNodePortChain} {
+		// Ensure the chain first so that flushing and deleting it cannot
+		// fail just because it was never created.
+		if _, err := ipt.EnsureChain(iptables.TableNAT, chain); err != nil {
+			glog.Errorf("Error ensuring userspace chain %q: %v", chain, err)
+			encounteredError = true
+			continue
+		}
+		if err := ipt.FlushChain(iptables.TableNAT, chain); err != nil {
+			glog.Errorf("Error flushing userspace chain %q: %v", chain, err)
+			encounteredError = true
+			continue
+		}
+		if err := ipt.DeleteChain(iptables.TableNAT, chain); err != nil {
+			glog.Errorf("Error deleting userspace chain %q: %v", chain, err)
+			encounteredError = true
+		}
+	}
+	return encounteredError
+}
+
 // Used below.
 var zeroIPv4 = net.ParseIP("0.0.0.0")
 var localhostIPv4 = net.ParseIP("127.0.0.1")
@@ -81,12 +81,15 @@ func (tcp *tcpProxySocket) ListenPort() int {
 	return tcp.port
 }
 
-func tryConnect(service ServicePortName, srcAddr net.Addr, protocol string, proxier *Proxier) (out net.Conn, err error) {
+// tryConnect dials an endpoint chosen by the load balancer.  On success the
+// connection is counted in activeConnections against the returned endpoint;
+// the caller must call activeConnections.Closed once it is done with it.
+func tryConnect(service ServicePortName, srcAddr net.Addr, protocol string, proxier *Proxier) (out net.Conn, endpoint string, err error) {
 	for _, retryTimeout := range endpointDialTimeout {
 		endpoint, err := proxier.loadBalancer.NextEndpoint(service, srcAddr)
 		if err != nil {
 			glog.Errorf("Couldn't find an endpoint for %s: %v", service, err)
-			return nil, err
+			return nil, "", err
 		}
 		glog.V(3).Infof("Mapped service %q to endpoint %s", service, endpoint)
 		// TODO: This could spin up a new goroutine to make the outbound connection,
@@ -99,9 +102,10 @@ func tryConnect(service ServicePortName, srcAddr net.Addr, protocol string, prox
 			glog.Errorf("Dial failed: %v", err)
 			continue
 		}
-		return outConn, nil
+		activeConnections.Opened(service, endpoint)
+		return outConn, endpoint, nil
 	}
-	return nil, fmt.Errorf("failed to connect to an endpoint.")
+	return nil, "", fmt.Errorf("failed to connect to an endpoint.")
 }
 
 func (tcp *tcpProxySocket) ProxyLoop(service ServicePortName, myInfo *serviceInfo, proxier *Proxier) {
@@ -128,14 +132,17 @@ func (tcp *tcpProxySocket) ProxyLoop(service ServicePortName, myInfo *serviceInf
 			continue
 		}
 		glog.V(2).Infof("Accepted TCP connection from %v to %v", inConn.RemoteAddr(), inConn.LocalAddr())
-		outConn, err := tryConnect(service, inConn.(*net.TCPConn).RemoteAddr(), "tcp", proxier)
+		outConn, endpoint, err := tryConnect(service, inConn.(*net.TCPConn).RemoteAddr(), "tcp", proxier)
 		if err != nil {
 			glog.Errorf("Failed to connect to balancer: %v", err)
 			inConn.Close()
 			continue
 		}
 		// Spin up an async copy loop.
-		go proxyTCP(inConn.(*net.TCPConn), outConn.(*net.TCPConn))
+		go func(in, out *net.TCPConn, endpoint string) {
+			proxyTCP(in, out)
+			activeConnections.Closed(service, endpoint)
+		}(inConn.(*net.TCPConn), outConn.(*net.TCPConn), endpoint)
 	}
 }
 
@@ -244,18 +251,21 @@ func (udp *udpProxySocket) getBackendConn(activeClients *clientCache, cliAddr ne
 		// TODO: This could spin up a new goroutine to make the outbound connection,
 		// and keep accepting inbound traffic.
 		glog.V(2).Infof("New UDP connection from %s", cliAddr)
+		var endpoint string
 		var err error
-		svrConn, err = tryConnect(service, cliAddr, "udp", proxier)
+		svrConn, endpoint, err = tryConnect(service, cliAddr, "udp", proxier)
 		if err != nil {
 			return nil, err
 		}
 		if err = svrConn.SetDeadline(time.Now().Add(timeout)); err != nil {
 			glog.Errorf("SetDeadline failed: %v", err)
+			activeConnections.Closed(service, endpoint)
 			return nil, err
 		}
 		activeClients.clients[cliAddr.String()] = svrConn
 		go func(cliAddr net.Addr, svrConn net.Conn, activeClients *clientCache, timeout time.Duration) {
 			defer util.HandleCrash()
+			defer activeConnections.Closed(service, endpoint)
 			udp.proxyClient(cliAddr, svrConn, activeClients, timeout)
 		}(cliAddr, svrConn, activeClients, timeout)
 	}
@@ -0,0 +1,234 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/slice"
+	"github.com/golang/glog"
+)
+
+// EndpointWeightsAnnotation is the annotation on an Endpoints object that
+// assigns relative weights to its addresses for the Weighted load-balancing
+// policy.  Its value is a JSON object mapping endpoint IP to a positive
+// integer weight, e.g. {"10.244.1.5": 3, "10.244.2.7": 1}.  Addresses that
+// are not listed have a weight of 1.
+const EndpointWeightsAnnotation = "proxy.kubernetes.io/endpoint-weights"
+
+// endpointChooser picks the endpoint for a new connection to svcPort.  It is
+// called with the load balancer's lock held, and only when state has at
+// least one endpoint.
+type endpointChooser func(svcPort ServicePortName, state *selectorState) string
+
+type selectorState struct {
+	balancerState
+	weights map[string]int // endpoint IP -> weight, from EndpointWeightsAnnotation
+	current map[string]int // endpoint -> running weight, used by the weighted chooser
+}
+
+// selectingLoadBalancer holds what the non-round-robin load balancers have in
+// common: endpoint bookkeeping and session affinity.  Each of them only
+// supplies the endpointChooser used when affinity does not apply.
+type selectingLoadBalancer struct {
+	name     string
+	choose   endpointChooser
+	lock     sync.Mutex
+	services map[ServicePortName]*selectorState
+}
+
+func newSelectingLoadBalancer(name string, choose endpointChooser) selectingLoadBalancer {
+	return selectingLoadBalancer{
+		name:     name,
+		choose:   choose,
+		services: map[ServicePortName]*selectorState{},
+	}
+}
+
+func (lb *selectingLoadBalancer) NewService(svcPort ServicePortName, affinityType api.ServiceAffinity, ttlMinutes int) error {
+	lb.lock.Lock()
+	defer lb.lock.Unlock()
+	lb.newServiceInternal(svcPort, affinityType, ttlMinutes)
+	return nil
+}
+
+// This assumes that lb.lock is already held.
+func (lb *selectingLoadBalancer) newServiceInternal(svcPort ServicePortName, affinityType api.ServiceAffinity, ttlMinutes int) *selectorState {
+	if ttlMinutes == 0 {
+		ttlMinutes = 180 // default to 3 hours, like LoadBalancerRR.
+	}
+
+	if _, exists := lb.services[svcPort]; !exists {
+		lb.services[svcPort] = &selectorState{
+			balancerState: balancerState{affinity: *newAffinityPolicy(affinityType, ttlMinutes)},
+			current:       map[string]int{},
+		}
+		glog.V(4).Infof("%s service %q did not exist, created", lb.name, svcPort)
+	} else if affinityType != "" {
+		lb.services[svcPort].affinity.affinityType = affinityType
+	}
+	return lb.services[svcPort]
+}
+
+// NextEndpoint returns a service endpoint, honoring session affinity before
+// falling back to the load balancer's chooser.
+func (lb *selectingLoadBalancer) NextEndpoint(svcPort ServicePortName, srcAddr net.Addr) (string, error) {
+	lb.lock.Lock()
+	defer lb.lock.Unlock()
+
+	state, exists := lb.services[svcPort]
+	if !exists || state == nil {
+		return "", ErrMissingServiceEntry
+	}
+	if len(state.endpoints) == 0 {
+		return "", ErrMissingEndpoints
+	}
+	glog.V(4).Infof("NextEndpoint for service %q, srcAddr=%v: endpoints: %+v", svcPort, srcAddr, state.endpoints)
+
+	sessionAffinityEnabled := isSessionAffinity(&state.affinity)
+
+	var ipaddr string
+	if sessionAffinityEnabled {
+		var err error
+		ipaddr, _, err = net.SplitHostPort(srcAddr.String())
+		if err != nil {
+			return "", fmt.Errorf("malformed source address %q: %v", srcAddr.String(), err)
+		}
+		sessionAffinity, exists := state.affinity.affinityMap[ipaddr]
+		if exists && int(time.Now().Sub(sessionAffinity.lastUsed).Minutes()) < state.affinity.ttlMinutes {
+			sessionAffinity.lastUsed = time.Now()
+			glog.V(4).Infof("NextEndpoint for service %q from IP %s with sessionAffinity %+v: %s", svcPort, ipaddr, sessionAffinity, sessionAffinity.endpoint)
+			return sessionAffinity.endpoint, nil
+		}
+	}
+
+	endpoint := lb.choose(svcPort, state)
+
+	if sessionAffinityEnabled {
+		state.affinity.affinityMap[ipaddr] = &affinityState{
+			clientIP: ipaddr,
+			endpoint: endpoint,
+			lastUsed: time.Now(),
+		}
+		glog.V(4).Infof("Updated affinity key %s: %+v", ipaddr, state.affinity.affinityMap[ipaddr])
+	}
+	return endpoint, nil
+}
+
+// OnUpdate manages the registered service endpoints.
+// Registered endpoints are updated if found in the update set or
+// unregistered if missing from the update set.
+func (lb *selectingLoadBalancer) OnUpdate(allEndpoints []api.Endpoints) {
+	registeredEndpoints := make(map[ServicePortName]bool)
+	lb.lock.Lock()
+	defer lb.lock.Unlock()
+
+	for i := range allEndpoints {
+		svcEndpoints := &allEndpoints[i]
+		weights := parseEndpointWeights(svcEndpoints)
+		portsToEndpoints := buildPortsToEndpointsMap(svcEndpoints)
+
+		for portname := range portsToEndpoints {
+			svcPort := ServicePortName{NamespacedName: types.NamespacedName{Namespace: svcEndpoints.Namespace, Name: svcEndpoints.Name}, Port: portname}
+			newEndpoints := flattenValidEndpoints(portsToEndpoints[portname])
+			// OnUpdate can be called without NewService being called externally,
+			// so create the service if it does not exist yet.
+			state := lb.newServiceInternal(svcPort, api.ServiceAffinity(""), 0)
+			state.weights = weights
+
+			if !slicesEquiv(slice.CopyStrings(state.endpoints), slice.CopyStrings(newEndpoints)) {
+				glog.V(1).Infof("%s: Setting endpoints for %s to %+v", lb.name, svcPort, newEndpoints)
+				removeStaleSessionAffinity(&state.balancerState, svcPort, newEndpoints)
+				state.endpoints = slice.ShuffleStrings(newEndpoints)
+				state.index = 0
+				state.current = map[string]int{}
+			}
+			registeredEndpoints[svcPort] = true
+		}
+	}
+	// Remove endpoints missing from the update.
+	for k := range lb.services {
+		if !registeredEndpoints[k] {
+			glog.V(2).Infof("%s: Removing endpoints for %s", lb.name, k)
+			delete(lb.services, k)
+		}
+	}
+}
+
+func (lb *selectingLoadBalancer) CleanupStaleStickySessions(svcPort ServicePortName) {
+	lb.lock.Lock()
+	defer lb.lock.Unlock()
+
+	state, exists := lb.services[svcPort]
+	if !exists {
+		return
+	}
+	for ip, affinity := range state.affinity.affinityMap {
+		if int(time.Now().Sub(affinity.lastUsed).Minutes()) >= state.affinity.ttlMinutes {
+			glog.V(4).Infof("Removing client %s from affinityMap for service %q", affinity.clientIP, svcPort)
+			delete(state.affinity.affinityMap, ip)
+		}
+	}
+}
+
+// removeStaleSessionAffinity drops the affinity records of endpoints that
+// are not in newEndpoints.
+func removeStaleSessionAffinity(state *balancerState, svcPort ServicePortName, newEndpoints []string) {
+	keep := map[string]bool{}
+	for _, endpoint := range newEndpoints {
+		keep[endpoint] = true
+	}
+	for _, endpoint := range state.endpoints {
+		if !keep[endpoint] {
+			glog.V(2).Infof("Delete endpoint %s for service %q", endpoint, svcPort)
+			removeSessionAffinityByEndpoint(state, svcPort, endpoint)
+		}
+	}
+}
+
+// parseEndpointWeights reads EndpointWeightsAnnotation.  A malformed
+// annotation is logged and ignored, leaving every endpoint with weight 1.
+func parseEndpointWeights(endpoints *api.Endpoints) map[string]int {
+	value, found := endpoints.Annotations[EndpointWeightsAnnotation]
+	if !found {
+		return nil
+	}
+	weights := map[string]int{}
+	if err := json.Unmarshal([]byte(value), &weights); err != nil {
+		glog.Errorf("Ignoring malformed %s annotation on endpoints %s/%s: %v", EndpointWeightsAnnotation, endpoints.Namespace, endpoints.Name, err)
+		return nil
+	}
+	return weights
+}
+
+// endpointWeight returns the weight of an "ip:port" endpoint.
+func (state *selectorState) endpointWeight(endpoint string) int {
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return 1
+	}
+	if weight, found := state.weights[host]; found && weight > 0 {
+		return weight
+	}
+	return 1
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+func makeTestEndpoints(service ServicePortName, annotations map[string]string, ips ...string) api.Endpoints {
+	addresses := []api.EndpointAddress{}
+	for _, ip := range ips {
+		addresses = append(addresses, api.EndpointAddress{IP: ip})
+	}
+	return api.Endpoints{
+		ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace, Annotations: annotations},
+		Subsets: []api.EndpointSubset{{
+			Addresses: addresses,
+			Ports:     []api.EndpointPort{{Name: service.Port, Port: 80}},
+		}},
+	}
+}
+
+func expectNextEndpoint(t *testing.T, loadBalancer LoadBalancer, service ServicePortName, expected string, netaddr net.Addr) {
+	endpoint, err := loadBalancer.NextEndpoint(service, netaddr)
+	if err != nil {
+		t.Errorf("Didn't find a service for %s, expected %s, failed with: %v", service, expected, err)
+	}
+	if endpoint != expected {
+		t.Errorf("Didn't get expected endpoint for service %s client %v, expected %s, got: %s", service, netaddr, expected, endpoint)
+	}
+}
+
+func TestSelectingLoadBalancerFailsWithNoEndpoints(t *testing.T) {
+	loadBalancer := NewLoadBalancerLeastConnections()
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	if _, err := loadBalancer.NextEndpoint(service, nil); err != ErrMissingServiceEntry {
+		t.Errorf("expected %v, got %v", ErrMissingServiceEntry, err)
+	}
+	loadBalancer.NewService(service, api.ServiceAffinityNone, 0)
+	if _, err := loadBalancer.NextEndpoint(service, nil); err != ErrMissingEndpoints {
+		t.Errorf("expected %v, got %v", ErrMissingEndpoints, err)
+	}
+}
+
+func TestSelectingLoadBalancerSessionAffinity(t *testing.T) {
+	counts := map[string]int{}
+	loadBalancer := newLoadBalancerLeastConnections(func(_ ServicePortName, endpoint string) int { return counts[endpoint] })
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	loadBalancer.NewService(service, api.ServiceAffinityClientIP, 0)
+	loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(service, nil, "10.0.0.1", "10.0.0.2")})
+
+	client := &net.TCPAddr{IP: net.IPv4(192, 168, 0, 1), Port: 0}
+	first, err := loadBalancer.NextEndpoint(service, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Make the pinned endpoint the busiest one; affinity still wins.
+	counts[first] = 10
+	expectNextEndpoint(t, loadBalancer, service, first, client)
+	expectNextEndpoint(t, loadBalancer, service, first, client)
+
+	// Removing the pinned endpoint drops the affinity.
+	remaining := "10.0.0.1"
+	if first == "10.0.0.1:80" {
+		remaining = "10.0.0.2"
+	}
+	loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(service, nil, remaining)})
+	expectNextEndpoint(t, loadBalancer, service, remaining+":80", client)
+}
+
+func TestSelectingLoadBalancerRemovesServices(t *testing.T) {
+	loadBalancer := NewLoadBalancerWeighted()
+	foo := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	bar := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "bar"}, Port: "p"}
+	loadBalancer.OnUpdate([]api.Endpoints{
+		makeTestEndpoints(foo, nil, "10.0.0.1"),
+		makeTestEndpoints(bar, nil, "10.0.0.2"),
+	})
+	expectNextEndpoint(t, loadBalancer, foo, "10.0.0.1:80", nil)
+	expectNextEndpoint(t, loadBalancer, bar, "10.0.0.2:80", nil)
+
+	loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(bar, nil, "10.0.0.2")})
+	if _, err := loadBalancer.NextEndpoint(foo, nil); err != ErrMissingServiceEntry {
+		t.Errorf("expected %v, got %v", ErrMissingServiceEntry, err)
+	}
+	expectNextEndpoint(t, loadBalancer, bar, "10.0.0.2:80", nil)
+}
+
+func TestParseEndpointWeights(t *testing.T) {
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	tests := []struct {
+		annotation string
+		expected   map[string]int
+	}{
+		{"", nil},
+		{"not json", nil},
+		{`{"10.0.0.1": 3}`, map[string]int{"10.0.0.1": 3}},
+	}
+	for _, test := range tests {
+		annotations := map[string]string{}
+		if test.annotation != "" {
+			annotations[EndpointWeightsAnnotation] = test.annotation
+		}
+		endpoints := makeTestEndpoints(service, annotations, "10.0.0.1")
+		weights := parseEndpointWeights(&endpoints)
+		if len(weights) != len(test.expected) || weights["10.0.0.1"] != test.expected["10.0.0.1"] {
+			t.Errorf("annotation %q: expected %v, got %v", test.annotation, test.expected, weights)
+		}
+	}
+}
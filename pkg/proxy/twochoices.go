@@ -0,0 +1,59 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"math/rand"
+)
+
+// LoadBalancerRandomTwoChoices samples two distinct endpoints at random and
+// sends the connection to the one with fewer active connections.  This gets
+// most of the benefit of least-connections without every proxy herding onto
+// the same momentarily idle endpoint.
+type LoadBalancerRandomTwoChoices struct {
+	selectingLoadBalancer
+}
+
+// Ensure this implements LoadBalancer.
+var _ LoadBalancer = &LoadBalancerRandomTwoChoices{}
+
+// NewLoadBalancerRandomTwoChoices returns a new LoadBalancerRandomTwoChoices
+// that reads connection counts from the proxy's sockets.
+func NewLoadBalancerRandomTwoChoices() *LoadBalancerRandomTwoChoices {
+	return newLoadBalancerRandomTwoChoices(activeConnections.Count, rand.Intn)
+}
+
+func newLoadBalancerRandomTwoChoices(connections func(ServicePortName, string) int, intn func(int) int) *LoadBalancerRandomTwoChoices {
+	return &LoadBalancerRandomTwoChoices{
+		selectingLoadBalancer: newSelectingLoadBalancer("LoadBalancerRandomTwoChoices", func(svcPort ServicePortName, state *selectorState) string {
+			n := len(state.endpoints)
+			if n == 1 {
+				return state.endpoints[0]
+			}
+			first := intn(n)
+			second := intn(n - 1)
+			if second >= first {
+				second++
+			}
+			a, b := state.endpoints[first], state.endpoints[second]
+			if connections(svcPort, b) < connections(svcPort, a) {
+				return b
+			}
+			return a
+		}),
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+func TestRandomTwoChoicesPicksLessLoadedSample(t *testing.T) {
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	for _, sample := range [][2]int{{0, 1}, {1, 0}, {2, 0}} {
+		counts := map[string]int{}
+		draws := []int{sample[0], sample[1]}
+		loadBalancer := newLoadBalancerRandomTwoChoices(
+			func(_ ServicePortName, endpoint string) int { return counts[endpoint] },
+			func(int) int {
+				next := draws[0]
+				draws = draws[1:]
+				return next
+			})
+		loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(service, nil, "10.0.0.1", "10.0.0.2", "10.0.0.3")})
+
+		state := loadBalancer.services[service]
+		first := state.endpoints[sample[0]]
+		// The second draw skips over the first endpoint.
+		second := state.endpoints[sample[1]]
+		if sample[1] >= sample[0] {
+			second = state.endpoints[sample[1]+1]
+		}
+		counts[first] = 2
+		counts[second] = 1
+		expectNextEndpoint(t, loadBalancer, service, second, nil)
+	}
+}
+
+func TestRandomTwoChoicesSingleEndpoint(t *testing.T) {
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	loadBalancer := newLoadBalancerRandomTwoChoices(
+		func(ServicePortName, string) int { return 0 },
+		func(int) int {
+			t.Errorf("unexpected random draw with a single endpoint")
+			return 0
+		})
+	loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(service, nil, "10.0.0.1")})
+	expectNextEndpoint(t, loadBalancer, service, "10.0.0.1:80", nil)
+}
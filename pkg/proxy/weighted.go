@@ -0,0 +1,51 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+// LoadBalancerWeighted hands out endpoints in proportion to the weights set
+// by EndpointWeightsAnnotation, interleaving them smoothly rather than in
+// bursts.
+type LoadBalancerWeighted struct {
+	selectingLoadBalancer
+}
+
+// Ensure this implements LoadBalancer.
+var _ LoadBalancer = &LoadBalancerWeighted{}
+
+// NewLoadBalancerWeighted returns a new LoadBalancerWeighted.
+func NewLoadBalancerWeighted() *LoadBalancerWeighted {
+	return &LoadBalancerWeighted{
+		selectingLoadBalancer: newSelectingLoadBalancer("LoadBalancerWeighted", chooseWeighted),
+	}
+}
+
+// chooseWeighted implements smooth weighted round-robin: every endpoint's
+// running weight grows by its weight, and the endpoint with the highest
+// running weight is picked and set back by the total of all weights.
+func chooseWeighted(svcPort ServicePortName, state *selectorState) string {
+	best, total := "", 0
+	for _, endpoint := range state.endpoints {
+		weight := state.endpointWeight(endpoint)
+		state.current[endpoint] += weight
+		total += weight
+		if best == "" || state.current[endpoint] > state.current[best] {
+			best = endpoint
+		}
+	}
+	state.current[best] -= total
+	return best
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+func TestWeightedFollowsWeights(t *testing.T) {
+	loadBalancer := NewLoadBalancerWeighted()
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	annotations := map[string]string{EndpointWeightsAnnotation: `{"10.0.0.1": 3}`}
+	loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(service, annotations, "10.0.0.1", "10.0.0.2")})
+
+	seen := map[string]int{}
+	previous, run := "", 0
+	for i := 0; i < 8; i++ {
+		endpoint, err := loadBalancer.NextEndpoint(service, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		seen[endpoint]++
+		if endpoint == previous {
+			run++
+		} else {
+			previous, run = endpoint, 1
+		}
+		if run > 3 {
+			t.Errorf("expected endpoints to be interleaved, got %d picks of %s in a row", run, endpoint)
+		}
+	}
+	if seen["10.0.0.1:80"] != 6 || seen["10.0.0.2:80"] != 2 {
+		t.Errorf("expected a 3:1 split, got %v", seen)
+	}
+}
+
+func TestWeightedPicksUpWeightChanges(t *testing.T) {
+	loadBalancer := NewLoadBalancerWeighted()
+	service := ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testnamespace", Name: "foo"}, Port: "p"}
+	loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(service, nil, "10.0.0.1", "10.0.0.2")})
+	annotations := map[string]string{EndpointWeightsAnnotation: `{"10.0.0.2": 4}`}
+	loadBalancer.OnUpdate([]api.Endpoints{makeTestEndpoints(service, annotations, "10.0.0.1", "10.0.0.2")})
+
+	seen := map[string]int{}
+	for i := 0; i < 10; i++ {
+		endpoint, err := loadBalancer.NextEndpoint(service, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		seen[endpoint]++
+	}
+	if seen["10.0.0.1:80"] != 2 || seen["10.0.0.2:80"] != 8 {
+		t.Errorf("expected a 1:4 split, got %v", seen)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterrole
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Registry is an interface implemented by things that know how to store ClusterRole objects.
+type Registry interface {
+	// ListClusterRoles obtains a list of ClusterRoles having labels which match selector.
+	ListClusterRoles(ctx api.Context, selector labels.Selector) (*api.ClusterRoleList, error)
+	// Watch for new/changed/deleted cluster roles
+	WatchClusterRoles(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+	// Get a specific ClusterRole
+	GetClusterRole(ctx api.Context, name string) (*api.ClusterRole, error)
+	// Create a ClusterRole based on a specification.
+	CreateClusterRole(ctx api.Context, clusterRole *api.ClusterRole) error
+	// Update an existing ClusterRole
+	UpdateClusterRole(ctx api.Context, clusterRole *api.ClusterRole) error
+	// Delete an existing ClusterRole
+	DeleteClusterRole(ctx api.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListClusterRoles(ctx api.Context, label labels.Selector) (*api.ClusterRoleList, error) {
+	obj, err := s.List(ctx, label, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.ClusterRoleList), nil
+}
+
+func (s *storage) WatchClusterRoles(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.Watch(ctx, label, field, resourceVersion)
+}
+
+func (s *storage) GetClusterRole(ctx api.Context, name string) (*api.ClusterRole, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.ClusterRole), nil
+}
+
+func (s *storage) CreateClusterRole(ctx api.Context, clusterRole *api.ClusterRole) error {
+	_, err := s.Create(ctx, clusterRole)
+	return err
+}
+
+func (s *storage) UpdateClusterRole(ctx api.Context, clusterRole *api.ClusterRole) error {
+	_, _, err := s.Update(ctx, clusterRole)
+	return err
+}
+
+func (s *storage) DeleteClusterRole(ctx api.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
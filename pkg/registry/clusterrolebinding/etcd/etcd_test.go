@@ -0,0 +1,94 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, testapi.Codec(), etcdtest.PathPrefix())
+	return fakeEtcdClient, helper
+}
+
+func validNewClusterRoleBinding(name string) *api.ClusterRoleBinding {
+	return &api.ClusterRoleBinding{
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Subjects: []api.ObjectReference{{Kind: api.GroupKind, Name: "admins"}},
+		RoleRef:  api.ObjectReference{Kind: api.ClusterRoleKind, Name: "admin"},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError).ClusterScope()
+	clusterRoleBinding := validNewClusterRoleBinding("foo")
+	clusterRoleBinding.ObjectMeta = api.ObjectMeta{GenerateName: "foo-"}
+	test.TestCreate(
+		// valid
+		clusterRoleBinding,
+		// invalid
+		&api.ClusterRoleBinding{},
+		&api.ClusterRoleBinding{
+			ObjectMeta: api.ObjectMeta{Name: "name with spaces"},
+		},
+		&api.ClusterRoleBinding{
+			ObjectMeta: api.ObjectMeta{Name: "foo"},
+			Subjects:   []api.ObjectReference{{Kind: api.GroupKind, Name: "admins"}},
+			RoleRef:    api.ObjectReference{Kind: api.RoleKind, Name: "admin"},
+		},
+	)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError).ClusterScope()
+	key, err := storage.KeyFunc(test.TestContext(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key = etcdtest.AddPrefix(key)
+
+	fakeEtcdClient.ExpectNotFoundGet(key)
+	fakeEtcdClient.ChangeIndex = 2
+	clusterRoleBinding := validNewClusterRoleBinding("foo")
+	existing := validNewClusterRoleBinding("exists")
+	obj, err := storage.Create(test.TestContext(), existing)
+	if err != nil {
+		t.Fatalf("unable to create object: %v", err)
+	}
+	older := obj.(*api.ClusterRoleBinding)
+	older.ResourceVersion = "1"
+
+	test.TestUpdate(
+		clusterRoleBinding,
+		existing,
+		older,
+	)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
+	return NewREST(helper), fakeEtcdClient
+}
+
+func validNewDaemonSet(name string) *api.DaemonSet {
+	labels := map[string]string{"a": "b"}
+	return &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec: api.DaemonSetSpec{
+			Selector: labels,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: labels},
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{
+							Name:            "test",
+							Image:           "test_image",
+							ImagePullPolicy: api.PullIfNotPresent,
+						},
+					},
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	daemonSet := validNewDaemonSet("foo")
+	daemonSet.ObjectMeta = api.ObjectMeta{}
+	invalid := validNewDaemonSet("foo")
+	invalid.ObjectMeta = api.ObjectMeta{}
+	invalid.Spec.Selector = map[string]string{}
+	test.TestCreate(
+		// valid
+		daemonSet,
+		// invalid
+		invalid,
+	)
+}
+
+func TestUpdate(t *testing.T) {
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	key, err := storage.KeyFunc(test.TestContext(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key = etcdtest.AddPrefix(key)
+
+	fakeClient.ExpectNotFoundGet(key)
+	fakeClient.ChangeIndex = 2
+	daemonSet := validNewDaemonSet("foo")
+	existing := validNewDaemonSet("exists")
+	existing.Namespace = test.TestNamespace()
+	obj, err := storage.Create(test.TestContext(), existing)
+	if err != nil {
+		t.Fatalf("unable to create object: %v", err)
+	}
+	older := obj.(*api.DaemonSet)
+	older.ResourceVersion = "1"
+
+	test.TestUpdate(
+		daemonSet,
+		existing,
+		older,
+	)
+}
+
+func TestGenerationNumber(t *testing.T) {
+	storage, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	daemonSet := validNewDaemonSet("foo")
+	daemonSet.Generation = 100
+	daemonSet.Status.ObservedGeneration = 10
+	if _, err := storage.Create(ctx, daemonSet); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	daemonSet = obj.(*api.DaemonSet)
+
+	// Generation initialization
+	if daemonSet.Generation != 1 || daemonSet.Status.ObservedGeneration != 0 {
+		t.Fatalf("unexpected generation number %v, status generation %v", daemonSet.Generation, daemonSet.Status.ObservedGeneration)
+	}
+
+	// Updates to spec should increment the generation number
+	daemonSet.Spec.Template.Spec.NodeSelector = map[string]string{"role": "logging"}
+	if _, _, err := storage.Update(ctx, daemonSet); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	daemonSet = obj.(*api.DaemonSet)
+	if daemonSet.Generation != 2 {
+		t.Fatalf("unexpected generation number %v", daemonSet.Generation)
+	}
+
+	// Updates to status should not increment the generation number
+	daemonSet.Status.DesiredNumberScheduled += 1
+	daemonSet.Status.ObservedGeneration = 2
+	if _, _, err := storage.Update(ctx, daemonSet); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	daemonSet = obj.(*api.DaemonSet)
+	if daemonSet.Generation != 2 || daemonSet.Status.ObservedGeneration != 2 {
+		t.Fatalf("unexpected generation number %v, status generation %v", daemonSet.Generation, daemonSet.Status.ObservedGeneration)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	key, _ := etcdgeneric.NamespaceKeyFunc(ctx, daemonSetPrefix, "foo")
+	key = etcdtest.AddPrefix(key)
+
+	createFn := func() runtime.Object {
+		daemonSet := validNewDaemonSet("foo")
+		daemonSet.ResourceVersion = "1"
+		fakeClient.Data[key] = tools.EtcdResponseWithError{
+			R: &etcd.Response{
+				Node: &etcd.Node{
+					Value:         runtime.EncodeOrDie(latest.Codec, daemonSet),
+					ModifiedIndex: 1,
+				},
+			},
+		}
+		return daemonSet
+	}
+	gracefulSetFn := func() bool {
+		// If the daemon set is still around after trying to delete either the delete
+		// failed, or we're deleting it gracefully.
+		return fakeClient.Data[key].R.Node != nil
+	}
+
+	test.TestDelete(createFn, gracefulSetFn)
+}
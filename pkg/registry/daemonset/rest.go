@@ -0,0 +1,111 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemonset
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// daemonSetStrategy implements verification logic for DaemonSets.
+type daemonSetStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating DaemonSet objects.
+var Strategy = daemonSetStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped returns true because all DaemonSets need to be within a namespace.
+func (daemonSetStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate clears the status of a daemon set before creation.
+func (daemonSetStrategy) PrepareForCreate(obj runtime.Object) {
+	daemonSet := obj.(*api.DaemonSet)
+	daemonSet.Status = api.DaemonSetStatus{}
+
+	daemonSet.Generation = 1
+}
+
+// PrepareForUpdate bumps the generation of a daemon set whose spec changed.
+func (daemonSetStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newDaemonSet := obj.(*api.DaemonSet)
+	oldDaemonSet := old.(*api.DaemonSet)
+
+	// As with replication controllers, status is written by the daemon set
+	// controller through the same endpoint, so only spec changes are treated
+	// as a new generation.
+	if !reflect.DeepEqual(oldDaemonSet.Spec, newDaemonSet.Spec) {
+		newDaemonSet.Generation = oldDaemonSet.Generation + 1
+	}
+}
+
+// Validate validates a new daemon set.
+func (daemonSetStrategy) Validate(ctx api.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateDaemonSet(obj.(*api.DaemonSet))
+}
+
+// AllowCreateOnUpdate is false for daemon sets; this means a POST is
+// needed to create one.
+func (daemonSetStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (daemonSetStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	validationErrorList := validation.ValidateDaemonSet(obj.(*api.DaemonSet))
+	updateErrorList := validation.ValidateDaemonSetUpdate(old.(*api.DaemonSet), obj.(*api.DaemonSet))
+	return append(validationErrorList, updateErrorList...)
+}
+
+func (daemonSetStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// DaemonSetToSelectableFields returns a field set that represents the object.
+func DaemonSetToSelectableFields(daemonSet *api.DaemonSet) fields.Set {
+	return fields.Set{
+		"metadata.name": daemonSet.Name,
+	}
+}
+
+// MatchDaemonSet is the filter used by the generic etcd backend to route
+// watch events from etcd to clients of the apiserver only interested in specific
+// labels/fields.
+func MatchDaemonSet(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{
+		Label: label,
+		Field: field,
+		GetAttrs: func(obj runtime.Object) (labels.Set, fields.Set, error) {
+			daemonSet, ok := obj.(*api.DaemonSet)
+			if !ok {
+				return nil, nil, fmt.Errorf("given object is not a daemon set")
+			}
+			return labels.Set(daemonSet.ObjectMeta.Labels), DaemonSetToSelectableFields(daemonSet), nil
+		},
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
+	return NewREST(helper), fakeEtcdClient
+}
+
+func validNewDeployment(name string) *api.Deployment {
+	labels := map[string]string{"a": "b"}
+	return &api.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec: api.DeploymentSpec{
+			Replicas: 2,
+			Selector: labels,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: labels},
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{
+							Name:            "test",
+							Image:           "test_image",
+							ImagePullPolicy: api.PullIfNotPresent,
+						},
+					},
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+			Strategy: api.DeploymentStrategy{
+				Type: api.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &api.RollingUpdateDeployment{
+					MaxUnavailable: util.NewIntOrStringFromInt(1),
+					MaxSurge:       util.NewIntOrStringFromInt(1),
+				},
+			},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	deployment := validNewDeployment("foo")
+	deployment.ObjectMeta = api.ObjectMeta{}
+	invalid := validNewDeployment("foo")
+	invalid.ObjectMeta = api.ObjectMeta{}
+	invalid.Spec.Selector = map[string]string{}
+	test.TestCreate(
+		// valid
+		deployment,
+		// invalid
+		invalid,
+	)
+}
+
+func TestUpdate(t *testing.T) {
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	key, err := storage.KeyFunc(test.TestContext(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key = etcdtest.AddPrefix(key)
+
+	fakeClient.ExpectNotFoundGet(key)
+	fakeClient.ChangeIndex = 2
+	deployment := validNewDeployment("foo")
+	existing := validNewDeployment("exists")
+	existing.Namespace = test.TestNamespace()
+	obj, err := storage.Create(test.TestContext(), existing)
+	if err != nil {
+		t.Fatalf("unable to create object: %v", err)
+	}
+	older := obj.(*api.Deployment)
+	older.ResourceVersion = "1"
+
+	test.TestUpdate(
+		deployment,
+		existing,
+		older,
+	)
+}
+
+func TestGenerationNumber(t *testing.T) {
+	storage, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	deployment := validNewDeployment("foo")
+	deployment.Generation = 100
+	deployment.Status.ObservedGeneration = 10
+	if _, err := storage.Create(ctx, deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deployment = obj.(*api.Deployment)
+
+	// Generation initialization
+	if deployment.Generation != 1 || deployment.Status.ObservedGeneration != 0 {
+		t.Fatalf("unexpected generation number %v, status generation %v", deployment.Generation, deployment.Status.ObservedGeneration)
+	}
+
+	// Updates to spec should increment the generation number
+	deployment.Spec.Replicas += 1
+	if _, _, err := storage.Update(ctx, deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deployment = obj.(*api.Deployment)
+	if deployment.Generation != 2 {
+		t.Fatalf("unexpected generation number %v", deployment.Generation)
+	}
+
+	// Updates to status should not increment the generation number
+	deployment.Status.Replicas += 1
+	deployment.Status.ObservedGeneration = 2
+	if _, _, err := storage.Update(ctx, deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deployment = obj.(*api.Deployment)
+	if deployment.Generation != 2 || deployment.Status.ObservedGeneration != 2 {
+		t.Fatalf("unexpected generation number %v, status generation %v", deployment.Generation, deployment.Status.ObservedGeneration)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	key, _ := etcdgeneric.NamespaceKeyFunc(ctx, deploymentPrefix, "foo")
+	key = etcdtest.AddPrefix(key)
+
+	createFn := func() runtime.Object {
+		deployment := validNewDeployment("foo")
+		deployment.ResourceVersion = "1"
+		fakeClient.Data[key] = tools.EtcdResponseWithError{
+			R: &etcd.Response{
+				Node: &etcd.Node{
+					Value:         runtime.EncodeOrDie(latest.Codec, deployment),
+					ModifiedIndex: 1,
+				},
+			},
+		}
+		return deployment
+	}
+	gracefulSetFn := func() bool {
+		// If the deployment is still around after trying to delete either the delete
+		// failed, or we're deleting it gracefully.
+		return fakeClient.Data[key].R.Node != nil
+	}
+
+	test.TestDelete(createFn, gracefulSetFn)
+}
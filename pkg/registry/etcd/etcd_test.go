@@ -269,8 +269,9 @@ func TestEtcdUpdateService(t *testing.T) {
 			Selector: map[string]string{
 				"baz": "bar",
 			},
-			SessionAffinity: "None",
-			Type:            api.ServiceTypeClusterIP,
+			SessionAffinity:     "None",
+			Type:                api.ServiceTypeClusterIP,
+			LoadBalancingPolicy: api.ServiceLoadBalancingRoundRobin,
 		},
 	}
 	_, err := registry.UpdateService(ctx, &testService)
@@ -0,0 +1,65 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/horizontalpodautoscaler"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+// REST implements a RESTStorage for horizontal pod autoscalers against etcd
+type REST struct {
+	*etcdgeneric.Etcd
+}
+
+// autoscalerPrefix is the location for horizontal pod autoscalers in etcd, only exposed
+// for testing
+var autoscalerPrefix = "/horizontalpodautoscalers"
+
+// NewREST returns a RESTStorage object that will work against horizontal pod autoscalers.
+func NewREST(h tools.EtcdHelper) *REST {
+	store := &etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.HorizontalPodAutoscaler{} },
+		NewListFunc: func() runtime.Object { return &api.HorizontalPodAutoscalerList{} },
+		KeyRootFunc: func(ctx api.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, autoscalerPrefix)
+		},
+		KeyFunc: func(ctx api.Context, name string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, autoscalerPrefix, name)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.HorizontalPodAutoscaler).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return horizontalpodautoscaler.MatchHorizontalPodAutoscaler(label, field)
+		},
+		EndpointName: "horizontalpodautoscalers",
+
+		CreateStrategy: horizontalpodautoscaler.Strategy,
+		UpdateStrategy: horizontalpodautoscaler.Strategy,
+
+		Helper: h,
+	}
+
+	return &REST{store}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
+	return NewREST(helper), fakeEtcdClient
+}
+
+func validNewAutoscaler(name string) *api.HorizontalPodAutoscaler {
+	minReplicas, target := 1, 80
+	return &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ScaleTargetReference{Kind: "ReplicationController", Name: "frontend"},
+			MinReplicas:                    &minReplicas,
+			MaxReplicas:                    5,
+			TargetCPUUtilizationPercentage: &target,
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	autoscaler := validNewAutoscaler("foo")
+	autoscaler.ObjectMeta = api.ObjectMeta{}
+	invalid := validNewAutoscaler("foo")
+	invalid.ObjectMeta = api.ObjectMeta{}
+	invalid.Spec.MaxReplicas = 0
+	test.TestCreate(
+		// valid
+		autoscaler,
+		// invalid
+		invalid,
+	)
+}
+
+func TestUpdate(t *testing.T) {
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	key, err := storage.KeyFunc(test.TestContext(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key = etcdtest.AddPrefix(key)
+
+	fakeClient.ExpectNotFoundGet(key)
+	fakeClient.ChangeIndex = 2
+	autoscaler := validNewAutoscaler("foo")
+	existing := validNewAutoscaler("exists")
+	existing.Namespace = test.TestNamespace()
+	obj, err := storage.Create(test.TestContext(), existing)
+	if err != nil {
+		t.Fatalf("unable to create object: %v", err)
+	}
+	older := obj.(*api.HorizontalPodAutoscaler)
+	older.ResourceVersion = "1"
+
+	test.TestUpdate(
+		autoscaler,
+		existing,
+		older,
+	)
+}
+
+func TestCreateClearsStatus(t *testing.T) {
+	storage, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	autoscaler := validNewAutoscaler("foo")
+	autoscaler.Status.DesiredReplicas = 3
+	if _, err := storage.Create(ctx, autoscaler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	autoscaler = obj.(*api.HorizontalPodAutoscaler)
+	if autoscaler.Status.DesiredReplicas != 0 {
+		t.Fatalf("expected status to be cleared on create, got %#v", autoscaler.Status)
+	}
+
+	// Status written by the controller should survive an update.
+	autoscaler.Status.DesiredReplicas = 2
+	if _, _, err := storage.Update(ctx, autoscaler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if desired := obj.(*api.HorizontalPodAutoscaler).Status.DesiredReplicas; desired != 2 {
+		t.Fatalf("expected 2 desired replicas, got %d", desired)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	key, _ := etcdgeneric.NamespaceKeyFunc(ctx, autoscalerPrefix, "foo")
+	key = etcdtest.AddPrefix(key)
+
+	createFn := func() runtime.Object {
+		autoscaler := validNewAutoscaler("foo")
+		autoscaler.ResourceVersion = "1"
+		fakeClient.Data[key] = tools.EtcdResponseWithError{
+			R: &etcd.Response{
+				Node: &etcd.Node{
+					Value:         runtime.EncodeOrDie(latest.Codec, autoscaler),
+					ModifiedIndex: 1,
+				},
+			},
+		}
+		return autoscaler
+	}
+	gracefulSetFn := func() bool {
+		// If the autoscaler is still around after trying to delete either the delete
+		// failed, or we're deleting it gracefully.
+		return fakeClient.Data[key].R.Node != nil
+	}
+
+	test.TestDelete(createFn, gracefulSetFn)
+}
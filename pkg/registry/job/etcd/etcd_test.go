@@ -0,0 +1,165 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
+	return NewREST(helper), fakeEtcdClient
+}
+
+func validNewJob(name string) *api.Job {
+	labels := map[string]string{"a": "b"}
+	return &api.Job{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec: api.JobSpec{
+			Selector: labels,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: labels},
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{
+							Name:            "test",
+							Image:           "test_image",
+							ImagePullPolicy: api.PullIfNotPresent,
+						},
+					},
+					RestartPolicy: api.RestartPolicyOnFailure,
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	job := validNewJob("foo")
+	job.ObjectMeta = api.ObjectMeta{}
+	invalid := validNewJob("foo")
+	invalid.ObjectMeta = api.ObjectMeta{}
+	invalid.Spec.Selector = map[string]string{}
+	test.TestCreate(
+		// valid
+		job,
+		// invalid
+		invalid,
+	)
+}
+
+func TestUpdate(t *testing.T) {
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	key, err := storage.KeyFunc(test.TestContext(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key = etcdtest.AddPrefix(key)
+
+	fakeClient.ExpectNotFoundGet(key)
+	fakeClient.ChangeIndex = 2
+	job := validNewJob("foo")
+	existing := validNewJob("exists")
+	existing.Namespace = test.TestNamespace()
+	obj, err := storage.Create(test.TestContext(), existing)
+	if err != nil {
+		t.Fatalf("unable to create object: %v", err)
+	}
+	older := obj.(*api.Job)
+	older.ResourceVersion = "1"
+
+	test.TestUpdate(
+		job,
+		existing,
+		older,
+	)
+}
+
+func TestCreateClearsStatus(t *testing.T) {
+	storage, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	job := validNewJob("foo")
+	job.Status.Succeeded = 3
+	if _, err := storage.Create(ctx, job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	job = obj.(*api.Job)
+	if job.Status.Succeeded != 0 {
+		t.Fatalf("expected status to be cleared on create, got %#v", job.Status)
+	}
+
+	// Status written by the controller should survive an update.
+	job.Status.Succeeded = 2
+	if _, _, err := storage.Update(ctx, job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if succeeded := obj.(*api.Job).Status.Succeeded; succeeded != 2 {
+		t.Fatalf("expected 2 succeeded pods, got %d", succeeded)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	storage, fakeClient := newStorage(t)
+	test := resttest.New(t, storage, fakeClient.SetError)
+	key, _ := etcdgeneric.NamespaceKeyFunc(ctx, jobPrefix, "foo")
+	key = etcdtest.AddPrefix(key)
+
+	createFn := func() runtime.Object {
+		job := validNewJob("foo")
+		job.ResourceVersion = "1"
+		fakeClient.Data[key] = tools.EtcdResponseWithError{
+			R: &etcd.Response{
+				Node: &etcd.Node{
+					Value:         runtime.EncodeOrDie(latest.Codec, job),
+					ModifiedIndex: 1,
+				},
+			},
+		}
+		return job
+	}
+	gracefulSetFn := func() bool {
+		// If the job is still around after trying to delete either the delete
+		// failed, or we're deleting it gracefully.
+		return fakeClient.Data[key].R.Node != nil
+	}
+
+	test.TestDelete(createFn, gracefulSetFn)
+}
@@ -163,16 +163,16 @@ func (rm *ResourceQuotaManager) syncResourceQuota(quota api.ResourceQuota) (err
 		switch k {
 		case api.ResourcePods:
 			value = resource.NewQuantity(int64(len(filteredPods)), resource.DecimalSI)
-		case api.ResourceMemory:
+		case api.ResourceMemory, api.ResourceLimitsMemory:
 			val := int64(0)
 			for _, pod := range filteredPods {
-				val = val + PodMemory(pod).Value()
+				val = val + PodUsage(pod, k).Value()
 			}
 			value = resource.NewQuantity(int64(val), resource.DecimalSI)
-		case api.ResourceCPU:
+		case api.ResourceCPU, api.ResourceLimitsCPU:
 			val := int64(0)
 			for _, pod := range filteredPods {
-				val = val + PodCPU(pod).MilliValue()
+				val = val + PodUsage(pod, k).MilliValue()
 			}
 			value = resource.NewMilliQuantity(int64(val), resource.DecimalSI)
 		case api.ResourceServices:
@@ -224,42 +224,58 @@ func (rm *ResourceQuotaManager) syncResourceQuota(quota api.ResourceQuota) (err
 	return nil
 }
 
-// PodCPU computes total cpu usage of a pod
-func PodCPU(pod *api.Pod) *resource.Quantity {
-	val := int64(0)
-	for j := range pod.Spec.Containers {
-		val = val + pod.Spec.Containers[j].Resources.Limits.Cpu().MilliValue()
+// containerResources returns the container resource a compute quota resource is
+// measured against, along with the requests or limits of container that it sums.
+func containerResources(container *api.Container, quotaName api.ResourceName) (api.ResourceName, api.ResourceList) {
+	switch quotaName {
+	case api.ResourceLimitsCPU:
+		return api.ResourceCPU, container.Resources.Limits
+	case api.ResourceLimitsMemory:
+		return api.ResourceMemory, container.Resources.Limits
 	}
-	return resource.NewMilliQuantity(int64(val), resource.DecimalSI)
+	return quotaName, container.Resources.Requests
 }
 
-// IsPodCPUUnbounded returns true if the cpu use is unbounded for any container in pod
-func IsPodCPUUnbounded(pod *api.Pod) bool {
+// PodUsage computes the usage of a pod against a compute quota resource: the total
+// request for cpu and memory, and the total limit for limits.cpu and limits.memory.
+// Init containers run one at a time before the other containers, so the usage is
+// that of the largest init container when it exceeds the total.
+func PodUsage(pod *api.Pod, quotaName api.ResourceName) *resource.Quantity {
+	val := int64(0)
 	for j := range pod.Spec.Containers {
-		container := pod.Spec.Containers[j]
-		if container.Resources.Limits.Cpu().MilliValue() == int64(0) {
-			return true
+		val = val + containerUsage(&pod.Spec.Containers[j], quotaName)
+	}
+	for j := range pod.Spec.InitContainers {
+		if usage := containerUsage(&pod.Spec.InitContainers[j], quotaName); usage > val {
+			val = usage
 		}
 	}
-	return false
+	if quotaName == api.ResourceCPU || quotaName == api.ResourceLimitsCPU {
+		return resource.NewMilliQuantity(val, resource.DecimalSI)
+	}
+	return resource.NewQuantity(val, resource.DecimalSI)
 }
 
-// IsPodMemoryUnbounded returns true if the memory use is unbounded for any container in pod
-func IsPodMemoryUnbounded(pod *api.Pod) bool {
-	for j := range pod.Spec.Containers {
-		container := pod.Spec.Containers[j]
-		if container.Resources.Limits.Memory().Value() == int64(0) {
-			return true
-		}
+// containerUsage returns the usage of a container against a compute quota resource,
+// in millicores for cpu and in bytes for memory.
+func containerUsage(container *api.Container, quotaName api.ResourceName) int64 {
+	resourceName, resources := containerResources(container, quotaName)
+	if resourceName == api.ResourceCPU {
+		return resources.Cpu().MilliValue()
 	}
-	return false
+	return resources.Memory().Value()
 }
 
-// PodMemory computes the memory usage of a pod
-func PodMemory(pod *api.Pod) *resource.Quantity {
-	val := int64(0)
-	for j := range pod.Spec.Containers {
-		val = val + pod.Spec.Containers[j].Resources.Limits.Memory().Value()
+// IsPodUnbounded returns true if any container or init container in pod does not
+// specify the request or limit that a compute quota resource is measured against
+func IsPodUnbounded(pod *api.Pod, quotaName api.ResourceName) bool {
+	containers := append(append([]api.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for j := range containers {
+		resourceName, resources := containerResources(&containers[j], quotaName)
+		quantity := resources[resourceName]
+		if quantity.MilliValue() == int64(0) {
+			return true
+		}
 	}
-	return resource.NewQuantity(int64(val), resource.DecimalSI)
+	return false
 }
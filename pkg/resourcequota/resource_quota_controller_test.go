@@ -27,11 +27,14 @@ import (
 
 func getResourceRequirements(cpu, memory string) api.ResourceRequirements {
 	res := api.ResourceRequirements{}
+	res.Requests = api.ResourceList{}
 	res.Limits = api.ResourceList{}
 	if cpu != "" {
+		res.Requests[api.ResourceCPU] = resource.MustParse(cpu)
 		res.Limits[api.ResourceCPU] = resource.MustParse(cpu)
 	}
 	if memory != "" {
+		res.Requests[api.ResourceMemory] = resource.MustParse(memory)
 		res.Limits[api.ResourceMemory] = resource.MustParse(memory)
 	}
 
@@ -277,7 +280,7 @@ func TestIsPodCPUUnbounded(t *testing.T) {
 			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "0")}},
 		},
 	}
-	if IsPodCPUUnbounded(&pod) {
+	if IsPodUnbounded(&pod, api.ResourceCPU) {
 		t.Errorf("Expected false")
 	}
 	pod = api.Pod{
@@ -288,12 +291,12 @@ func TestIsPodCPUUnbounded(t *testing.T) {
 			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("0", "0")}},
 		},
 	}
-	if !IsPodCPUUnbounded(&pod) {
+	if !IsPodUnbounded(&pod, api.ResourceCPU) {
 		t.Errorf("Expected true")
 	}
 
 	pod.Spec.Containers[0].Resources = api.ResourceRequirements{}
-	if !IsPodCPUUnbounded(&pod) {
+	if !IsPodUnbounded(&pod, api.ResourceCPU) {
 		t.Errorf("Expected true")
 	}
 }
@@ -307,7 +310,7 @@ func TestIsPodMemoryUnbounded(t *testing.T) {
 			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("0", "1Gi")}},
 		},
 	}
-	if IsPodMemoryUnbounded(&pod) {
+	if IsPodUnbounded(&pod, api.ResourceMemory) {
 		t.Errorf("Expected false")
 	}
 	pod = api.Pod{
@@ -318,12 +321,84 @@ func TestIsPodMemoryUnbounded(t *testing.T) {
 			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("0", "0")}},
 		},
 	}
-	if !IsPodMemoryUnbounded(&pod) {
+	if !IsPodUnbounded(&pod, api.ResourceMemory) {
 		t.Errorf("Expected true")
 	}
 
 	pod.Spec.Containers[0].Resources = api.ResourceRequirements{}
-	if !IsPodMemoryUnbounded(&pod) {
+	if !IsPodUnbounded(&pod, api.ResourceMemory) {
 		t.Errorf("Expected true")
 	}
 }
+
+func TestPodUsage(t *testing.T) {
+	resources := getResourceRequirements("100m", "1Gi")
+	resources.Limits[api.ResourceCPU] = resource.MustParse("500m")
+	resources.Limits[api.ResourceMemory] = resource.MustParse("2Gi")
+	pod := api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "pod-running"},
+		Status:     api.PodStatus{Phase: api.PodRunning},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{Name: "ctr", Image: "image", Resources: resources},
+				{Name: "ctr2", Image: "image", Resources: resources},
+			},
+		},
+	}
+	expected := map[api.ResourceName]string{
+		api.ResourceCPU:          "200m",
+		api.ResourceMemory:       "2Gi",
+		api.ResourceLimitsCPU:    "1",
+		api.ResourceLimitsMemory: "4Gi",
+	}
+	for quotaName, value := range expected {
+		want := resource.MustParse(value)
+		if actual := PodUsage(&pod, quotaName); actual.MilliValue() != want.MilliValue() {
+			t.Errorf("%s: expected %s, got %s", quotaName, value, actual.String())
+		}
+	}
+
+	pod.Spec.Containers[1].Resources.Limits = api.ResourceList{}
+	if IsPodUnbounded(&pod, api.ResourceCPU) {
+		t.Errorf("Expected cpu requests to be bounded")
+	}
+	if !IsPodUnbounded(&pod, api.ResourceLimitsCPU) {
+		t.Errorf("Expected cpu limits to be unbounded")
+	}
+}
+
+func TestPodUsageInitContainers(t *testing.T) {
+	pod := api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "pod-running"},
+		Status:     api.PodStatus{Phase: api.PodRunning},
+		Spec: api.PodSpec{
+			InitContainers: []api.Container{
+				{Name: "init", Image: "image", Resources: getResourceRequirements("300m", "1Gi")},
+				{Name: "init2", Image: "image", Resources: getResourceRequirements("100m", "1Gi")},
+			},
+			Containers: []api.Container{
+				{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")},
+				{Name: "ctr2", Image: "image", Resources: getResourceRequirements("100m", "1Gi")},
+			},
+		},
+	}
+	// The largest init container wins over the sum of the containers if it is bigger.
+	expected := map[api.ResourceName]string{
+		api.ResourceCPU:    "300m",
+		api.ResourceMemory: "2Gi",
+	}
+	for quotaName, value := range expected {
+		want := resource.MustParse(value)
+		if actual := PodUsage(&pod, quotaName); actual.MilliValue() != want.MilliValue() {
+			t.Errorf("%s: expected %s, got %s", quotaName, value, actual.String())
+		}
+	}
+	if IsPodUnbounded(&pod, api.ResourceCPU) {
+		t.Errorf("Expected cpu requests to be bounded")
+	}
+
+	pod.Spec.InitContainers[1].Resources = api.ResourceRequirements{}
+	if !IsPodUnbounded(&pod, api.ResourceCPU) {
+		t.Errorf("Expected an init container without requests to be unbounded")
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package exec
 
 import (
+	"io"
 	osexec "os/exec"
 	"syscall"
 )
@@ -37,6 +38,7 @@ type Cmd interface {
 	// and standard error.  This follows the pattern of package os/exec.
 	CombinedOutput() ([]byte, error)
 	SetDir(dir string)
+	SetStdin(in io.Reader)
 }
 
 // ExitError is an interface that presents an API similar to os.ProcessState, which is
@@ -69,6 +71,10 @@ func (cmd *cmdWrapper) SetDir(dir string) {
 	cmd.Dir = dir
 }
 
+func (cmd *cmdWrapper) SetStdin(in io.Reader) {
+	cmd.Stdin = in
+}
+
 // CombinedOutput is part of the Cmd interface.
 func (cmd *cmdWrapper) CombinedOutput() ([]byte, error) {
 	out, err := (*osexec.Cmd)(cmd).CombinedOutput()
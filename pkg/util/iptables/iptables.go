@@ -17,6 +17,7 @@ limitations under the License.
 package iptables
 
 import (
+	"bytes"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -49,8 +50,22 @@ type Interface interface {
 	DeleteRule(table Table, chain Chain, args ...string) error
 	// IsIpv6 returns true if this is managing ipv6 tables
 	IsIpv6() bool
+	// Save calls `iptables-save` for table and returns its output.
+	Save(table Table) ([]byte, error)
+	// Restore calls `iptables-restore` for table, feeding it data on stdin.
+	// Chains declared in data are flushed; with NoFlushTables the rest of the
+	// table is left untouched.
+	Restore(table Table, data []byte, flush FlushFlag) error
 }
 
+// FlushFlag tells Restore whether to flush the whole table first.
+type FlushFlag bool
+
+const (
+	FlushTables   FlushFlag = true
+	NoFlushTables FlushFlag = false
+)
+
 type Protocol byte
 
 const (
@@ -174,6 +189,39 @@ func (runner *runner) DeleteRule(table Table, chain Chain, args ...string) error
 	return nil
 }
 
+// Save is part of Interface.
+func (runner *runner) Save(table Table) ([]byte, error) {
+	runner.mu.Lock()
+	defer runner.mu.Unlock()
+
+	args := []string{"-t", string(table)}
+	glog.V(4).Infof("running %s %v", runner.saveCommand(), args)
+	out, err := runner.exec.Command(runner.saveCommand(), args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("error saving table %q: %v: %s", table, err, out)
+	}
+	return out, nil
+}
+
+// Restore is part of Interface.
+func (runner *runner) Restore(table Table, data []byte, flush FlushFlag) error {
+	runner.mu.Lock()
+	defer runner.mu.Unlock()
+
+	args := []string{"-T", string(table)}
+	if flush == NoFlushTables {
+		args = append(args, "--noflush")
+	}
+	glog.V(4).Infof("running %s %v", runner.restoreCommand(), args)
+	cmd := runner.exec.Command(runner.restoreCommand(), args...)
+	cmd.SetStdin(bytes.NewBuffer(data))
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error restoring table %q: %v: %s", table, err, out)
+	}
+	return nil
+}
+
 func (runner *runner) IsIpv6() bool {
 	return runner.protocol == ProtocolIpv6
 }
@@ -186,6 +234,20 @@ func (runner *runner) iptablesCommand() string {
 	}
 }
 
+func (runner *runner) saveCommand() string {
+	if runner.IsIpv6() {
+		return "ip6tables-save"
+	}
+	return "iptables-save"
+}
+
+func (runner *runner) restoreCommand() string {
+	if runner.IsIpv6() {
+		return "ip6tables-restore"
+	}
+	return "iptables-restore"
+}
+
 func (runner *runner) run(op operation, args []string) ([]byte, error) {
 	iptablesCmd := runner.iptablesCommand()
 
@@ -17,6 +17,7 @@ limitations under the License.
 package iptables
 
 import (
+	"io/ioutil"
 	"testing"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -572,3 +573,85 @@ COMMIT
 		t.Errorf("wrong CombinedOutput() log, got %s", fcmd.CombinedOutputLog[0])
 	}
 }
+
+func TestSave(t *testing.T) {
+	output := "*nat\n:PREROUTING ACCEPT [0:0]\nCOMMIT\n"
+	fcmd := exec.FakeCmd{
+		CombinedOutputScript: []exec.FakeCombinedOutputAction{
+			// Success.
+			func() ([]byte, error) { return []byte(output), nil },
+			// Failure.
+			func() ([]byte, error) { return nil, &exec.FakeExitError{1} },
+		},
+	}
+	fexec := exec.FakeExec{
+		CommandScript: []exec.FakeCommandAction{
+			func(cmd string, args ...string) exec.Cmd { return exec.InitFakeCmd(&fcmd, cmd, args...) },
+			func(cmd string, args ...string) exec.Cmd { return exec.InitFakeCmd(&fcmd, cmd, args...) },
+		},
+	}
+	runner := New(&fexec, ProtocolIpv4)
+	// Success.
+	out, err := runner.Save(TableNAT)
+	if err != nil {
+		t.Errorf("expected success, got %v", err)
+	}
+	if string(out) != output {
+		t.Errorf("expected output %q, got %q", output, string(out))
+	}
+	if !util.NewStringSet(fcmd.CombinedOutputLog[0]...).HasAll("iptables-save", "-t", "nat") {
+		t.Errorf("wrong CombinedOutput() log, got %s", fcmd.CombinedOutputLog[0])
+	}
+	// Failure.
+	_, err = runner.Save(TableNAT)
+	if err == nil {
+		t.Errorf("expected failure")
+	}
+}
+
+func TestRestore(t *testing.T) {
+	fcmd := exec.FakeCmd{
+		CombinedOutputScript: []exec.FakeCombinedOutputAction{
+			// Success.
+			func() ([]byte, error) { return []byte{}, nil },
+			// Success.
+			func() ([]byte, error) { return []byte{}, nil },
+			// Failure.
+			func() ([]byte, error) { return nil, &exec.FakeExitError{1} },
+		},
+	}
+	fexec := exec.FakeExec{
+		CommandScript: []exec.FakeCommandAction{
+			func(cmd string, args ...string) exec.Cmd { return exec.InitFakeCmd(&fcmd, cmd, args...) },
+			func(cmd string, args ...string) exec.Cmd { return exec.InitFakeCmd(&fcmd, cmd, args...) },
+			func(cmd string, args ...string) exec.Cmd { return exec.InitFakeCmd(&fcmd, cmd, args...) },
+		},
+	}
+	runner := New(&fexec, ProtocolIpv6)
+	data := "*nat\n:KUBE-SERVICES - [0:0]\nCOMMIT\n"
+	// Success, without flushing the table.
+	if err := runner.Restore(TableNAT, []byte(data), NoFlushTables); err != nil {
+		t.Errorf("expected success, got %v", err)
+	}
+	if !util.NewStringSet(fcmd.CombinedOutputLog[0]...).HasAll("ip6tables-restore", "-T", "nat", "--noflush") {
+		t.Errorf("wrong CombinedOutput() log, got %s", fcmd.CombinedOutputLog[0])
+	}
+	if fcmd.Stdin == nil {
+		t.Fatalf("expected data on stdin")
+	}
+	in, err := ioutil.ReadAll(fcmd.Stdin)
+	if err != nil || string(in) != data {
+		t.Errorf("expected stdin %q, got %q (%v)", data, string(in), err)
+	}
+	// Success, flushing the table.
+	if err := runner.Restore(TableNAT, []byte(data), FlushTables); err != nil {
+		t.Errorf("expected success, got %v", err)
+	}
+	if util.NewStringSet(fcmd.CombinedOutputLog[1]...).Has("--noflush") {
+		t.Errorf("wrong CombinedOutput() log, got %s", fcmd.CombinedOutputLog[1])
+	}
+	// Failure.
+	if err := runner.Restore(TableNAT, []byte(data), NoFlushTables); err == nil {
+		t.Errorf("expected failure")
+	}
+}
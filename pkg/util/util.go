@@ -161,6 +161,23 @@ func (intstr *IntOrString) String() string {
 	return strconv.Itoa(intstr.IntVal)
 }
 
+// GetIntOrPercentValue returns the integer held by intstr, or, if intstr holds
+// a percentage such as "25%", the number before the percent sign. The boolean
+// result reports whether the value is a percentage.
+func GetIntOrPercentValue(intstr IntOrString) (int, bool, error) {
+	if intstr.Kind == IntstrInt {
+		return intstr.IntVal, false, nil
+	}
+	if !strings.HasSuffix(intstr.StrVal, "%") {
+		return 0, false, fmt.Errorf("invalid value %q: must be an integer or a percentage", intstr.StrVal)
+	}
+	v, err := strconv.Atoi(strings.TrimSuffix(intstr.StrVal, "%"))
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid value %q: %v", intstr.StrVal, err)
+	}
+	return v, true, nil
+}
+
 // MarshalJSON implements the json.Marshaller interface.
 func (intstr IntOrString) MarshalJSON() ([]byte, error) {
 	switch intstr.Kind {
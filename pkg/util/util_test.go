@@ -111,6 +111,36 @@ func TestNewIntOrStringFromString(t *testing.T) {
 	}
 }
 
+func TestGetIntOrPercentValue(t *testing.T) {
+	cases := []struct {
+		input     IntOrString
+		value     int
+		isPercent bool
+		expectErr bool
+	}{
+		{NewIntOrStringFromInt(5), 5, false, false},
+		{NewIntOrStringFromString("25%"), 25, true, false},
+		{NewIntOrStringFromString("25"), 0, false, true},
+		{NewIntOrStringFromString("a%"), 0, false, true},
+	}
+	for _, c := range cases {
+		value, isPercent, err := GetIntOrPercentValue(c.input)
+		if c.expectErr {
+			if err == nil {
+				t.Errorf("%+v: expected error", c.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", c.input, err)
+			continue
+		}
+		if value != c.value || isPercent != c.isPercent {
+			t.Errorf("%+v: expected %d/%v, got %d/%v", c.input, c.value, c.isPercent, value, isPercent)
+		}
+	}
+}
+
 type IntOrStringHolder struct {
 	IOrS IntOrString `json:"val"`
 }
@@ -0,0 +1,178 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// kube-scheduler-simulator answers capacity planning questions by running the
+// scheduling algorithm against a snapshot of a cluster, without changing it.
+// It prints where pods displaced by draining nodes would go, and how many
+// copies of a template pod would fit, as JSON.
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"runtime"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/clientcmd"
+	clientcmdapi "github.com/GoogleCloudPlatform/kubernetes/pkg/client/clientcmd/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+	_ "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithmprovider"
+	schedulerapi "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/api"
+	latestschedulerapi "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/factory"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/simulator"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/glog"
+	flag "github.com/spf13/pflag"
+)
+
+var (
+	master            = flag.String("master", "", "The address of the Kubernetes API server to snapshot (overrides any value in kubeconfig)")
+	kubeconfig        = flag.String("kubeconfig", "", "Path to kubeconfig file with authorization and master location information.")
+	clusterFiles      util.StringList
+	algorithmProvider = flag.String("algorithm-provider", factory.DefaultProvider, "The scheduling algorithm provider to use, one of: "+factory.ListAlgorithmProviders())
+	policyConfigFile  = flag.String("policy-config-file", "", "File with scheduler policy configuration; overrides --algorithm-provider")
+	drain             util.StringList
+	podTemplate       = flag.String("pod-template", "", "File with a pod to place copies of until one no longer fits")
+	maxPods           = flag.Int("max-pods", 1000, "The most copies of the template pod to place")
+)
+
+func init() {
+	flag.Var(&clusterFiles, "cluster-file", "Files with the nodes, pods and services to simulate, instead of a snapshot of the cluster; may be repeated or comma separated")
+	flag.Var(&drain, "drain", "Nodes to drain before placing the template pod; the pods on them are rescheduled first")
+}
+
+// report is the output of the simulation.
+type report struct {
+	// Drained holds where the pods displaced by draining nodes went.
+	Drained *simulator.Result `json:"drained,omitempty"`
+	// Template holds where the copies of the template pod went.
+	Template *simulator.Result `json:"template,omitempty"`
+}
+
+// decodeFile decodes a JSON or YAML file.
+func decodeFile(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.YAMLToJSON(data)
+}
+
+func loadCluster() (*simulator.Cluster, error) {
+	if len(clusterFiles) == 0 {
+		config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			&clientcmd.ClientConfigLoadingRules{ExplicitPath: *kubeconfig},
+			&clientcmd.ConfigOverrides{ClusterInfo: clientcmdapi.Cluster{Server: *master}}).ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		c, err := client.New(config)
+		if err != nil {
+			return nil, err
+		}
+		return simulator.Snapshot(c)
+	}
+
+	cluster := &simulator.Cluster{}
+	for _, path := range clusterFiles {
+		data, err := decodeFile(path)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := api.Scheme.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+		if err := cluster.Add(obj); err != nil {
+			return nil, err
+		}
+	}
+	return cluster, nil
+}
+
+func createAlgorithm(cluster *simulator.Cluster) (algorithm.ScheduleAlgorithm, error) {
+	if len(*policyConfigFile) == 0 {
+		return factory.CreateAlgorithmFromProvider(*algorithmProvider, cluster.PluginArgs())
+	}
+	data, err := ioutil.ReadFile(*policyConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	var policy schedulerapi.Policy
+	if err := latestschedulerapi.Codec.DecodeInto(data, &policy); err != nil {
+		return nil, err
+	}
+	return factory.CreateAlgorithmFromPolicy(policy, cluster.PluginArgs())
+}
+
+func main() {
+	runtime.GOMAXPROCS(runtime.NumCPU())
+	util.InitFlags()
+	util.InitLogs()
+	defer util.FlushLogs()
+
+	if len(drain) == 0 && len(*podTemplate) == 0 {
+		glog.Fatalf("Nothing to simulate: specify --drain, --pod-template or both")
+	}
+
+	cluster, err := loadCluster()
+	if err != nil {
+		glog.Fatalf("Couldn't load the cluster: %v", err)
+	}
+	algo, err := createAlgorithm(cluster)
+	if err != nil {
+		glog.Fatalf("Couldn't create the scheduling algorithm: %v", err)
+	}
+	sim := simulator.New(cluster, algo)
+
+	out := report{}
+	if len(drain) != 0 {
+		displaced, err := sim.Drain(drain...)
+		if err != nil {
+			glog.Fatalf("Couldn't drain nodes: %v", err)
+		}
+		if out.Drained, err = sim.Schedule(displaced); err != nil {
+			glog.Fatalf("Couldn't reschedule the drained pods: %v", err)
+		}
+	}
+	if len(*podTemplate) != 0 {
+		data, err := decodeFile(*podTemplate)
+		if err != nil {
+			glog.Fatalf("Couldn't read the template pod: %v", err)
+		}
+		template := &api.Pod{}
+		if err := api.Scheme.DecodeInto(data, template); err != nil {
+			glog.Fatalf("Couldn't decode the template pod: %v", err)
+		}
+		if len(template.Namespace) == 0 {
+			template.Namespace = api.NamespaceDefault
+		}
+		if out.Template, err = sim.Fill(template, *maxPods); err != nil {
+			glog.Fatalf("Couldn't place the template pod: %v", err)
+		}
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		glog.Fatalf("Failed to encode the report: %v", err)
+	}
+	os.Stdout.Write(append(data, '\n'))
+}
@@ -54,6 +54,8 @@ type SchedulerServer struct {
 	EnableProfiling   bool
 	Master            string
 	Kubeconfig        string
+	SchedulerName     string
+	EnablePreemption  bool
 }
 
 // NewSchedulerServer creates a new SchedulerServer with default parameters
@@ -62,6 +64,7 @@ func NewSchedulerServer() *SchedulerServer {
 		Port:              ports.SchedulerPort,
 		Address:           util.IP(net.ParseIP("127.0.0.1")),
 		AlgorithmProvider: factory.DefaultProvider,
+		SchedulerName:     factory.DefaultSchedulerName,
 	}
 	return &s
 }
@@ -75,6 +78,8 @@ func (s *SchedulerServer) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.EnableProfiling, "profiling", true, "Enable profiling via web interface host:port/debug/pprof/")
 	fs.StringVar(&s.Master, "master", s.Master, "The address of the Kubernetes API server (overrides any value in kubeconfig)")
 	fs.StringVar(&s.Kubeconfig, "kubeconfig", s.Kubeconfig, "Path to kubeconfig file with authorization and master location information.")
+	fs.BoolVar(&s.EnablePreemption, "enable-preemption", s.EnablePreemption, "Evict pods of lower priority to make room for pods that fit on no node. Only enable it along with the PodPriority admission plug-in, since any user may otherwise ask for the highest priority.")
+	fs.StringVar(&s.SchedulerName, "scheduler-name", s.SchedulerName, "Name of this scheduler; it only places pods whose "+factory.SchedulerAnnotationKey+" annotation names it, or that have no such annotation if it is "+factory.DefaultSchedulerName+".")
 }
 
 // Run runs the specified SchedulerServer.  This should never exit.
@@ -99,6 +104,9 @@ func (s *SchedulerServer) Run(_ []string) error {
 		glog.Fatalf("Invalid API configuration: %v", err)
 	}
 
+	configFactory := factory.NewConfigFactory(kubeClient, s.SchedulerName)
+	configFactory.EnablePreemption = s.EnablePreemption
+
 	go func() {
 		mux := http.NewServeMux()
 		healthz.InstallHandler(mux)
@@ -108,6 +116,7 @@ func (s *SchedulerServer) Run(_ []string) error {
 			mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 		}
 		mux.Handle("/metrics", prometheus.Handler())
+		mux.Handle("/debug/decisions/", http.StripPrefix("/debug/decisions/", configFactory.Decisions))
 
 		server := &http.Server{
 			Addr:    net.JoinHostPort(s.Address.String(), strconv.Itoa(s.Port)),
@@ -116,7 +125,6 @@ func (s *SchedulerServer) Run(_ []string) error {
 		glog.Fatal(server.ListenAndServe())
 	}()
 
-	configFactory := factory.NewConfigFactory(kubeClient)
 	config, err := s.createConfig(configFactory)
 	if err != nil {
 		glog.Fatalf("Failed to create scheduler configuration: %v", err)
@@ -138,7 +138,7 @@ func Limit(limitRange *api.LimitRange, resourceName string, obj runtime.Object)
 
 // defaultContainerResourceRequirements returns the default requirements for a container
 // the requirement.Limits are taken from the LimitRange defaults (if specified)
-// the requirement.Requests are taken from the LimitRange min (if specified)
+// the requirement.Requests are taken from the LimitRange default requests (if specified)
 func defaultContainerResourceRequirements(limitRange *api.LimitRange) api.ResourceRequirements {
 	requirements := api.ResourceRequirements{}
 	requirements.Limits = api.ResourceList{}
@@ -151,71 +151,119 @@ func defaultContainerResourceRequirements(limitRange *api.LimitRange) api.Resour
 				value := v.Copy()
 				requirements.Limits[k] = *value
 			}
+			for k, v := range limit.DefaultRequest {
+				value := v.Copy()
+				requirements.Requests[k] = *value
+			}
 		}
 	}
 	return requirements
 }
 
-// mergePodResourceRequirements merges enumerated requirements with default requirements
+// mergePodResourceRequirements merges enumerated requirements with default requirements.
+// A resource without a request is given, in order of preference, the limit the user
+// set for it, the default request, or the limit it ends up with, so a default limit
+// only stands in for the request when there is no default request.
 func mergePodResourceRequirements(pod *api.Pod, defaultRequirements *api.ResourceRequirements) {
+	for i := range pod.Spec.InitContainers {
+		mergeContainerResourceRequirements(&pod.Spec.InitContainers[i], defaultRequirements)
+	}
 	for i := range pod.Spec.Containers {
-		container := &pod.Spec.Containers[i]
-		if container.Resources.Limits == nil {
-			container.Resources.Limits = api.ResourceList{}
+		mergeContainerResourceRequirements(&pod.Spec.Containers[i], defaultRequirements)
+	}
+}
+
+// mergeContainerResourceRequirements merges the requirements of a single container
+// with default requirements, see mergePodResourceRequirements.
+func mergeContainerResourceRequirements(container *api.Container, defaultRequirements *api.ResourceRequirements) {
+	if container.Resources.Limits == nil {
+		container.Resources.Limits = api.ResourceList{}
+	}
+	if container.Resources.Requests == nil {
+		container.Resources.Requests = api.ResourceList{}
+	}
+	for k, v := range container.Resources.Limits {
+		_, found := container.Resources.Requests[k]
+		if !found {
+			container.Resources.Requests[k] = *v.Copy()
 		}
-		if container.Resources.Requests == nil {
-			container.Resources.Requests = api.ResourceList{}
+	}
+	for k, v := range defaultRequirements.Requests {
+		_, found := container.Resources.Requests[k]
+		if !found {
+			container.Resources.Requests[k] = *v.Copy()
 		}
-		for k, v := range defaultRequirements.Limits {
-			_, found := container.Resources.Limits[k]
-			if !found {
-				container.Resources.Limits[k] = *v.Copy()
-			}
+	}
+	for k, v := range defaultRequirements.Limits {
+		_, found := container.Resources.Limits[k]
+		if !found {
+			container.Resources.Limits[k] = *v.Copy()
 		}
-		for k, v := range defaultRequirements.Requests {
-			_, found := container.Resources.Requests[k]
-			if !found {
-				container.Resources.Requests[k] = *v.Copy()
-			}
+	}
+	for k, v := range container.Resources.Limits {
+		_, found := container.Resources.Requests[k]
+		if !found {
+			container.Resources.Requests[k] = *v.Copy()
 		}
 	}
 }
 
 // PodLimitFunc enforces resource requirements enumerated by the pod against
 // the specified LimitRange.  The pod may be modified to apply default resource
-// requirements if not specified, and enumerated on the LimitRange
+// requirements if not specified, and enumerated on the LimitRange.  Minimums
+// are enforced against requests, maximums against limits. Init containers are
+// held to the per container bounds, and since they run one at a time before the
+// other containers, the pod is held to the largest of their requirements when
+// it exceeds the sum over the other containers.
 func PodLimitFunc(limitRange *api.LimitRange, pod *api.Pod) error {
 
 	defaultResources := defaultContainerResourceRequirements(limitRange)
 	mergePodResourceRequirements(pod, &defaultResources)
 
-	podCPU := int64(0)
-	podMem := int64(0)
+	podRequestCPU := int64(0)
+	podRequestMem := int64(0)
+	podLimitCPU := int64(0)
+	podLimitMem := int64(0)
 
-	minContainerCPU := int64(0)
-	minContainerMem := int64(0)
-	maxContainerCPU := int64(0)
-	maxContainerMem := int64(0)
+	minContainerRequestCPU := int64(0)
+	minContainerRequestMem := int64(0)
+	maxContainerLimitCPU := int64(0)
+	maxContainerLimitMem := int64(0)
 
 	for i := range pod.Spec.Containers {
 		container := &pod.Spec.Containers[i]
-		containerCPU := container.Resources.Limits.Cpu().MilliValue()
-		containerMem := container.Resources.Limits.Memory().Value()
+		podRequestCPU = podRequestCPU + container.Resources.Requests.Cpu().MilliValue()
+		podRequestMem = podRequestMem + container.Resources.Requests.Memory().Value()
+		podLimitCPU = podLimitCPU + container.Resources.Limits.Cpu().MilliValue()
+		podLimitMem = podLimitMem + container.Resources.Limits.Memory().Value()
+	}
+	for i := range pod.Spec.InitContainers {
+		container := &pod.Spec.InitContainers[i]
+		podRequestCPU = Max(podRequestCPU, container.Resources.Requests.Cpu().MilliValue())
+		podRequestMem = Max(podRequestMem, container.Resources.Requests.Memory().Value())
+		podLimitCPU = Max(podLimitCPU, container.Resources.Limits.Cpu().MilliValue())
+		podLimitMem = Max(podLimitMem, container.Resources.Limits.Memory().Value())
+	}
+
+	containers := append(append([]api.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for i := range containers {
+		container := &containers[i]
+		requestCPU := container.Resources.Requests.Cpu().MilliValue()
+		requestMem := container.Resources.Requests.Memory().Value()
+		limitCPU := container.Resources.Limits.Cpu().MilliValue()
+		limitMem := container.Resources.Limits.Memory().Value()
 
 		if i == 0 {
-			minContainerCPU = containerCPU
-			minContainerMem = containerMem
-			maxContainerCPU = containerCPU
-			maxContainerMem = containerMem
+			minContainerRequestCPU = requestCPU
+			minContainerRequestMem = requestMem
+			maxContainerLimitCPU = limitCPU
+			maxContainerLimitMem = limitMem
 		}
 
-		podCPU = podCPU + container.Resources.Limits.Cpu().MilliValue()
-		podMem = podMem + container.Resources.Limits.Memory().Value()
-
-		minContainerCPU = Min(containerCPU, minContainerCPU)
-		minContainerMem = Min(containerMem, minContainerMem)
-		maxContainerCPU = Max(containerCPU, maxContainerCPU)
-		maxContainerMem = Max(containerMem, maxContainerMem)
+		minContainerRequestCPU = Min(requestCPU, minContainerRequestCPU)
+		minContainerRequestMem = Min(requestMem, minContainerRequestMem)
+		maxContainerLimitCPU = Max(limitCPU, maxContainerLimitCPU)
+		maxContainerLimitMem = Max(limitMem, maxContainerLimitMem)
 	}
 
 	for i := range limitRange.Spec.Limits {
@@ -237,20 +285,32 @@ func PodLimitFunc(limitRange *api.LimitRange, pod *api.Pod) error {
 					enforced = v.Value()
 					switch limit.Type {
 					case api.LimitTypePod:
-						observed = podMem
+						observed = podLimitMem
+						if minOrMax == "Min" {
+							observed = podRequestMem
+						}
 						err = fmt.Errorf("%simum memory usage per pod is %s", minOrMax, v.String())
 					case api.LimitTypeContainer:
-						observed = maxContainerMem
+						observed = maxContainerLimitMem
+						if minOrMax == "Min" {
+							observed = minContainerRequestMem
+						}
 						err = fmt.Errorf("%simum memory usage per container is %s", minOrMax, v.String())
 					}
 				case api.ResourceCPU:
 					enforced = v.MilliValue()
 					switch limit.Type {
 					case api.LimitTypePod:
-						observed = podCPU
+						observed = podLimitCPU
+						if minOrMax == "Min" {
+							observed = podRequestCPU
+						}
 						err = fmt.Errorf("%simum CPU usage per pod is %s, but requested %s", minOrMax, v.String(), resource.NewMilliQuantity(observed, resource.DecimalSI))
 					case api.LimitTypeContainer:
-						observed = maxContainerCPU
+						observed = maxContainerLimitCPU
+						if minOrMax == "Min" {
+							observed = minContainerRequestCPU
+						}
 						err = fmt.Errorf("%simum CPU usage per container is %s", minOrMax, v.String())
 					}
 				}
@@ -266,6 +326,76 @@ func PodLimitFunc(limitRange *api.LimitRange, pod *api.Pod) error {
 				}
 			}
 		}
+		if err := limitRequestRatioFunc(limit, pod); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// limitRequestRatioFunc enforces the maximum ratio of limit to request of each
+// resource enumerated in limit.MaxLimitRequestRatio, either per container or
+// summed over the pod.
+func limitRequestRatioFunc(limit api.LimitRangeItem, pod *api.Pod) error {
+	for k, v := range limit.MaxLimitRequestRatio {
+		var requests, limits, initRequests, initLimits []api.ResourceList
+		for i := range pod.Spec.Containers {
+			requests = append(requests, pod.Spec.Containers[i].Resources.Requests)
+			limits = append(limits, pod.Spec.Containers[i].Resources.Limits)
+		}
+		for i := range pod.Spec.InitContainers {
+			initRequests = append(initRequests, pod.Spec.InitContainers[i].Resources.Requests)
+			initLimits = append(initLimits, pod.Spec.InitContainers[i].Resources.Limits)
+		}
+		if limit.Type == api.LimitTypePod {
+			requests = []api.ResourceList{maxResource(append(initRequests, sumResource(requests, k)), k)}
+			limits = []api.ResourceList{maxResource(append(initLimits, sumResource(limits, k)), k)}
+		} else {
+			requests = append(initRequests, requests...)
+			limits = append(initLimits, limits...)
+		}
+		for i := range requests {
+			request, requestFound := requests[i][k]
+			limitValue, limitFound := limits[i][k]
+			if !requestFound || !limitFound || request.MilliValue() == 0 {
+				return fmt.Errorf("Maximum %s limit to request ratio per %s is %s, but no limit or non-zero request is specified", k, limit.Type, v.String())
+			}
+			ratio := float64(limitValue.MilliValue()) / float64(request.MilliValue())
+			if ratio > float64(v.MilliValue())/1000 {
+				return fmt.Errorf("Maximum %s limit to request ratio per %s is %s, but provided ratio is %.3f", k, limit.Type, v.String(), ratio)
+			}
+		}
+	}
+	return nil
+}
+
+// sumResource returns a ResourceList holding the sum of the named resource
+// over lists, omitting it if no list contains it.
+func sumResource(lists []api.ResourceList, name api.ResourceName) api.ResourceList {
+	total := int64(0)
+	found := false
+	for _, list := range lists {
+		if q, ok := list[name]; ok {
+			total += q.MilliValue()
+			found = true
+		}
+	}
+	if !found {
+		return api.ResourceList{}
+	}
+	return api.ResourceList{name: *resource.NewMilliQuantity(total, resource.DecimalSI)}
+}
+
+// maxResource returns a resource list holding the largest quantity of the named
+// resource found in lists, or an empty list if none of them holds it.
+func maxResource(lists []api.ResourceList, name api.ResourceName) api.ResourceList {
+	largest := api.ResourceList{}
+	for _, list := range lists {
+		if q, ok := list[name]; ok {
+			if current, found := largest[name]; !found || q.MilliValue() > current.MilliValue() {
+				largest[name] = q
+			}
+		}
+	}
+	return largest
+}
@@ -145,7 +145,60 @@ func TestMergePodResourceRequirements(t *testing.T) {
 			api.ResourceCPU:    defaultRequirements.Limits[api.ResourceCPU],
 			api.ResourceMemory: resource.MustParse("512Mi"),
 		},
-		Requests: api.ResourceList{},
+		Requests: api.ResourceList{
+			api.ResourceCPU:    defaultRequirements.Limits[api.ResourceCPU],
+			api.ResourceMemory: resource.MustParse("512Mi"),
+		},
+	}
+	mergePodResourceRequirements(&pod, &defaultRequirements)
+	for i := range pod.Spec.Containers {
+		actual := pod.Spec.Containers[i].Resources
+		if !api.Semantic.DeepEqual(expected, actual) {
+			t.Errorf("pod %v, expected != actual; %v != %v", pod.Name, expected, actual)
+		}
+	}
+
+	// pod with a request but no limit should get the default limit and keep its
+	// request; resources without a request get the default request, not the
+	// default limit
+	limitRange.Spec.Limits[1].DefaultRequest = getResourceList("30m", "3Mi")
+	defaultRequirements = defaultContainerResourceRequirements(&limitRange)
+	input = getResourceRequirements(getResourceList("", ""), getResourceList("", "1Mi"))
+	pod = validPod("request-memory", 1, input)
+	expected = api.ResourceRequirements{
+		Limits:   getResourceList("50m", "5Mi"),
+		Requests: getResourceList("30m", "1Mi"),
+	}
+	mergePodResourceRequirements(&pod, &defaultRequirements)
+	for i := range pod.Spec.Containers {
+		actual := pod.Spec.Containers[i].Resources
+		if !api.Semantic.DeepEqual(expected, actual) {
+			t.Errorf("pod %v, expected != actual; %v != %v", pod.Name, expected, actual)
+		}
+	}
+
+	// a limit set by the user still takes precedence over the default request
+	input = getResourceRequirements(getResourceList("20m", ""), getResourceList("", ""))
+	pod = validPod("limit-cpu", 1, input)
+	expected = api.ResourceRequirements{
+		Limits:   getResourceList("20m", "5Mi"),
+		Requests: getResourceList("20m", "3Mi"),
+	}
+	mergePodResourceRequirements(&pod, &defaultRequirements)
+	for i := range pod.Spec.Containers {
+		actual := pod.Spec.Containers[i].Resources
+		if !api.Semantic.DeepEqual(expected, actual) {
+			t.Errorf("pod %v, expected != actual; %v != %v", pod.Name, expected, actual)
+		}
+	}
+
+	// without a default limit the default request applies
+	limitRange.Spec.Limits[1].Default = nil
+	defaultRequirements = defaultContainerResourceRequirements(&limitRange)
+	pod = validPod("no-default-limit", 1, getResourceRequirements(api.ResourceList{}, api.ResourceList{}))
+	expected = api.ResourceRequirements{
+		Limits:   api.ResourceList{},
+		Requests: getResourceList("30m", "3Mi"),
 	}
 	mergePodResourceRequirements(&pod, &defaultRequirements)
 	for i := range pod.Spec.Containers {
@@ -189,6 +242,37 @@ func TestPodLimitFunc(t *testing.T) {
 	}
 }
 
+func TestPodLimitFuncRequestsAndLimits(t *testing.T) {
+	limitRange := validLimitRange()
+	limitRange.Spec.Limits[1].MaxLimitRequestRatio = getResourceList("2", "")
+	successCases := []api.Pod{
+		validPod("burstable", 1, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("50m", "1Gi"))),
+		validPod("ratio-at-max", 2, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("50m", "1Gi"))),
+	}
+
+	errorCases := map[string]api.Pod{
+		"min-container-cpu-request": validPod("foo", 1, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("20m", "1Gi"))),
+		"max-container-cpu-limit":   validPod("foo", 1, getResourceRequirements(getResourceList("110m", "2Gi"), getResourceList("50m", "1Gi"))),
+		"min-pod-cpu-request":       validPod("foo", 1, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("40m", "1Gi"))),
+		"max-ratio-cpu":             validPod("foo", 2, getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("40m", "1Gi"))),
+		"zero-request-cpu":          validPod("foo", 1, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("0", "1Gi"))),
+	}
+
+	for i := range successCases {
+		err := PodLimitFunc(&limitRange, &successCases[i])
+		if err != nil {
+			t.Errorf("Unexpected error for valid pod: %v, %v", successCases[i].Name, err)
+		}
+	}
+
+	for k, v := range errorCases {
+		err := PodLimitFunc(&limitRange, &v)
+		if err == nil {
+			t.Errorf("Expected error for %s", k)
+		}
+	}
+}
+
 func TestPodLimitFuncApplyDefault(t *testing.T) {
 	limitRange := validLimitRange()
 	testPod := validPod("foo", 1, getResourceRequirements(api.ResourceList{}, api.ResourceList{}))
@@ -220,6 +304,61 @@ func TestPodLimitFuncApplyDefault(t *testing.T) {
 	}
 }
 
+// withInitContainer adds an init container with the given resources to pod.
+func withInitContainer(pod api.Pod, resources api.ResourceRequirements) api.Pod {
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, api.Container{
+		Image:     "init:V" + strconv.Itoa(len(pod.Spec.InitContainers)),
+		Resources: resources,
+	})
+	return pod
+}
+
+func TestPodLimitFuncInitContainers(t *testing.T) {
+	limitRange := validLimitRange()
+	successCases := map[string]api.Pod{
+		"init-container-within-bounds": withInitContainer(validPod("foo", 1, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("", ""))),
+			getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("", ""))),
+		"init-container-not-summed": withInitContainer(validPod("foo", 2, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("", ""))),
+			getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("", ""))),
+		"init-container-raises-pod-request": withInitContainer(validPod("foo", 1, getResourceRequirements(getResourceList("40m", "2Gi"), getResourceList("", ""))),
+			getResourceRequirements(getResourceList("60m", "2Gi"), getResourceList("", ""))),
+	}
+
+	errorCases := map[string]api.Pod{
+		"max-init-container-cpu": withInitContainer(validPod("foo", 1, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("", ""))),
+			getResourceRequirements(getResourceList("110m", "1Gi"), getResourceList("", ""))),
+		"min-init-container-cpu-request": withInitContainer(validPod("foo", 1, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("", ""))),
+			getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("20m", "1Gi"))),
+		"max-init-container-mem": withInitContainer(validPod("foo", 1, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("", ""))),
+			getResourceRequirements(getResourceList("100m", "3Gi"), getResourceList("", ""))),
+	}
+
+	for k, v := range successCases {
+		err := PodLimitFunc(&limitRange, &v)
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", k, err)
+		}
+	}
+
+	for k, v := range errorCases {
+		err := PodLimitFunc(&limitRange, &v)
+		if err == nil {
+			t.Errorf("Expected error for %s", k)
+		}
+	}
+
+	// init containers get the default resources too
+	testPod := withInitContainer(validPod("foo", 1, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("", ""))),
+		getResourceRequirements(api.ResourceList{}, api.ResourceList{}))
+	if err := PodLimitFunc(&limitRange, &testPod); err != nil {
+		t.Errorf("Unexpected error for valid pod: %v, %v", testPod.Name, err)
+	}
+	expected := getResourceRequirements(getResourceList("50m", "5Mi"), getResourceList("50m", "5Mi"))
+	if actual := testPod.Spec.InitContainers[0].Resources; !api.Semantic.DeepEqual(expected, actual) {
+		t.Errorf("expected init container resources %v, got %v", expected, actual)
+	}
+}
+
 func TestLimitRangerIgnoresSubresource(t *testing.T) {
 	client := testclient.NewSimpleFake()
 	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{"namespace": cache.MetaNamespaceIndexFunc})
@@ -0,0 +1,121 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// MaxPodPriorityAnnotation is the namespace annotation that sets the highest
+// priority pods in that namespace may ask for.  Namespaces without it are
+// limited to the default priority of 0.
+const MaxPodPriorityAnnotation = "scheduler.kubernetes.io/max-pod-priority"
+
+func init() {
+	admission.RegisterPlugin("PodPriority", func(client client.Interface, config io.Reader) (admission.Interface, error) {
+		return NewPodPriority(client), nil
+	})
+}
+
+// podPriority is an implementation of admission.Interface.
+// It rejects pods whose priority is above the limit set on their namespace, so
+// that only namespaces an administrator has annotated can preempt other pods.
+type podPriority struct {
+	*admission.Handler
+	client client.Interface
+	store  cache.Store
+}
+
+func (p *podPriority) Admit(a admission.Attributes) (err error) {
+	if a.GetResource() != string(api.ResourcePods) {
+		return nil
+	}
+	pod, ok := a.GetObject().(*api.Pod)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind Pod but was unable to be converted")
+	}
+	if pod.Spec.Priority <= 0 {
+		return nil
+	}
+
+	namespace, err := p.getNamespace(a.GetNamespace())
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	max := 0
+	if value, found := namespace.Annotations[MaxPodPriorityAnnotation]; found {
+		max, err = strconv.Atoi(value)
+		if err != nil {
+			return admission.NewForbidden(a, fmt.Errorf("namespace %s has an invalid %s annotation %q", namespace.Name, MaxPodPriorityAnnotation, value))
+		}
+	}
+	if pod.Spec.Priority > max {
+		return apierrors.NewForbidden(a.GetResource(), pod.Name, fmt.Errorf("priority %d is above the limit of %d for namespace %s", pod.Spec.Priority, max, namespace.Name))
+	}
+	return nil
+}
+
+// getNamespace returns the named namespace from the cache, or from the server
+// if the cache has not seen it yet.
+func (p *podPriority) getNamespace(name string) (*api.Namespace, error) {
+	obj, exists, err := p.store.Get(&api.Namespace{ObjectMeta: api.ObjectMeta{Name: name}})
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return obj.(*api.Namespace), nil
+	}
+	return p.client.Namespaces().Get(name)
+}
+
+// NewPodPriority creates a new pod priority admission control handler
+func NewPodPriority(c client.Interface) admission.Interface {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	reflector := cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return c.Namespaces().List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return c.Namespaces().Watch(labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&api.Namespace{},
+		store,
+		5*time.Minute,
+	)
+	reflector.Run()
+	return &podPriority{
+		client: c,
+		store:  store,
+		// Priority cannot be changed after creation.
+		Handler: admission.NewHandler(admission.Create),
+	}
+}
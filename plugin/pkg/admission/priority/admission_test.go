@@ -0,0 +1,111 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+func newNamespace(name, maxPriority string) *api.Namespace {
+	namespace := &api.Namespace{ObjectMeta: api.ObjectMeta{Name: name}}
+	if len(maxPriority) > 0 {
+		namespace.Annotations = map[string]string{MaxPodPriorityAnnotation: maxPriority}
+	}
+	return namespace
+}
+
+func TestAdmit(t *testing.T) {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	store.Add(newNamespace("default", ""))
+	store.Add(newNamespace("system", "1000"))
+	store.Add(newNamespace("broken", "high"))
+	// "uncached" is only known to the server.
+	mockClient := testclient.NewSimpleFake(newNamespace("uncached", "10"))
+	handler := &podPriority{
+		Handler: admission.NewHandler(admission.Create),
+		client:  mockClient,
+		store:   store,
+	}
+
+	tests := []struct {
+		namespace string
+		priority  int
+		expectErr bool
+	}{
+		{namespace: "default", priority: 0},
+		{namespace: "default", priority: 1, expectErr: true},
+		{namespace: "system", priority: 1000},
+		{namespace: "system", priority: 1001, expectErr: true},
+		{namespace: "broken", priority: 0},
+		{namespace: "broken", priority: 1, expectErr: true},
+		{namespace: "uncached", priority: 10},
+		{namespace: "uncached", priority: 11, expectErr: true},
+	}
+	for _, test := range tests {
+		pod := &api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: test.namespace},
+			Spec:       api.PodSpec{Priority: test.priority},
+		}
+		err := handler.Admit(admission.NewAttributesRecord(pod, "Pod", pod.Namespace, pod.Name, "pods", "", admission.Create, nil))
+		if test.expectErr && err == nil {
+			t.Errorf("expected an error admitting priority %d in namespace %s", test.priority, test.namespace)
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("unexpected error admitting priority %d in namespace %s: %v", test.priority, test.namespace, err)
+		}
+	}
+}
+
+func TestMissingNamespace(t *testing.T) {
+	mockClient := &testclient.Fake{
+		ReactFn: func(action testclient.FakeAction) (runtime.Object, error) {
+			return &api.Namespace{}, errors.NewNotFound("namespace", "missing")
+		},
+	}
+	handler := &podPriority{
+		Handler: admission.NewHandler(admission.Create),
+		client:  mockClient,
+		store:   cache.NewStore(cache.MetaNamespaceKeyFunc),
+	}
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: "missing"},
+		Spec:       api.PodSpec{Priority: 1},
+	}
+	err := handler.Admit(admission.NewAttributesRecord(pod, "Pod", pod.Namespace, pod.Name, "pods", "", admission.Create, nil))
+	if err == nil {
+		t.Errorf("expected an error admitting a pod with a priority into a missing namespace")
+	}
+}
+
+func TestIgnoresOtherResources(t *testing.T) {
+	handler := &podPriority{
+		Handler: admission.NewHandler(admission.Create),
+		client:  &testclient.Fake{},
+		store:   cache.NewStore(cache.MetaNamespaceKeyFunc),
+	}
+	err := handler.Admit(admission.NewAttributesRecord(&api.Service{}, "Service", "default", "svc", "services", "", admission.Create, nil))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
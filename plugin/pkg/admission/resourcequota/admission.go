@@ -164,15 +164,20 @@ func (q *quota) Admit(a admission.Attributes) (err error) {
 	return nil
 }
 
+// unboundedDescription describes what a pod must specify to be admitted
+// against each compute quota resource
+var unboundedDescription = map[api.ResourceName]string{
+	api.ResourceMemory:       "memory request",
+	api.ResourceCPU:          "cpu request",
+	api.ResourceLimitsMemory: "memory limit",
+	api.ResourceLimitsCPU:    "cpu limit",
+}
+
 // IncrementUsage updates the supplied ResourceQuotaStatus object based on the incoming operation
 // Return true if the usage must be recorded prior to admitting the new resource
 // Return an error if the operation should not pass admission control
 func IncrementUsage(a admission.Attributes, status *api.ResourceQuotaStatus, client client.Interface) (bool, error) {
 	dirty := false
-	set := map[api.ResourceName]bool{}
-	for k := range status.Hard {
-		set[k] = true
-	}
 	obj := a.GetObject()
 	// handle max counts for each kind of resource (pods, services, replicationControllers, etc.)
 	if a.GetOperation() == admission.Create {
@@ -193,54 +198,43 @@ func IncrementUsage(a admission.Attributes, status *api.ResourceQuotaStatus, cli
 			}
 		}
 	}
-	// handle memory/cpu constraints, and any diff of usage based on memory/cpu on updates
-	if a.GetResource() == "pods" && (set[api.ResourceMemory] || set[api.ResourceCPU]) {
+	// handle memory/cpu constraints on requests and limits, and any diff of usage on updates
+	if a.GetResource() == "pods" {
 		pod := obj.(*api.Pod)
-		deltaCPU := resourcequota.PodCPU(pod)
-		deltaMemory := resourcequota.PodMemory(pod)
-		// if this is an update, we need to find the delta cpu/memory usage from previous state
-		if a.GetOperation() == admission.Update {
-			oldPod, err := client.Pods(a.GetNamespace()).Get(pod.Name)
-			if err != nil {
-				return false, err
-			}
-			oldCPU := resourcequota.PodCPU(oldPod)
-			oldMemory := resourcequota.PodMemory(oldPod)
-			deltaCPU = resource.NewMilliQuantity(deltaCPU.MilliValue()-oldCPU.MilliValue(), resource.DecimalSI)
-			deltaMemory = resource.NewQuantity(deltaMemory.Value()-oldMemory.Value(), resource.DecimalSI)
-		}
-
-		hardMem, hardMemFound := status.Hard[api.ResourceMemory]
-		if hardMemFound {
-			if set[api.ResourceMemory] && resourcequota.IsPodMemoryUnbounded(pod) {
-				return false, fmt.Errorf("Limited to %s memory, but pod has no specified memory limit", hardMem.String())
-			}
-			used, usedFound := status.Used[api.ResourceMemory]
-			if !usedFound {
-				return false, fmt.Errorf("Quota usage stats are not yet known, unable to admit resource until an accurate count is completed.")
+		var oldPod *api.Pod
+		for _, quotaName := range []api.ResourceName{api.ResourceMemory, api.ResourceCPU, api.ResourceLimitsMemory, api.ResourceLimitsCPU} {
+			hard, hardFound := status.Hard[quotaName]
+			if !hardFound {
+				continue
 			}
-			if used.Value()+deltaMemory.Value() > hardMem.Value() {
-				return false, fmt.Errorf("Limited to %s memory", hardMem.String())
-			} else {
-				status.Used[api.ResourceMemory] = *resource.NewQuantity(used.Value()+deltaMemory.Value(), resource.DecimalSI)
-				dirty = true
+			if resourcequota.IsPodUnbounded(pod, quotaName) {
+				return false, fmt.Errorf("Limited to %s %s, but pod has no specified %s", hard.String(), quotaName, unboundedDescription[quotaName])
 			}
-		}
-		hardCPU, hardCPUFound := status.Hard[api.ResourceCPU]
-		if hardCPUFound {
-			if set[api.ResourceCPU] && resourcequota.IsPodCPUUnbounded(pod) {
-				return false, fmt.Errorf("Limited to %s CPU, but pod has no specified cpu limit", hardCPU.String())
+			delta := resourcequota.PodUsage(pod, quotaName).MilliValue()
+			// if this is an update, we need to find the delta usage from previous state
+			if a.GetOperation() == admission.Update {
+				if oldPod == nil {
+					var err error
+					oldPod, err = client.Pods(a.GetNamespace()).Get(pod.Name)
+					if err != nil {
+						return false, err
+					}
+				}
+				delta = delta - resourcequota.PodUsage(oldPod, quotaName).MilliValue()
 			}
-			used, usedFound := status.Used[api.ResourceCPU]
+			used, usedFound := status.Used[quotaName]
 			if !usedFound {
 				return false, fmt.Errorf("Quota usage stats are not yet known, unable to admit resource until an accurate count is completed.")
 			}
-			if used.MilliValue()+deltaCPU.MilliValue() > hardCPU.MilliValue() {
-				return false, fmt.Errorf("Limited to %s CPU", hardCPU.String())
+			if used.MilliValue()+delta > hard.MilliValue() {
+				return false, fmt.Errorf("Limited to %s %s", hard.String(), quotaName)
+			}
+			if quotaName == api.ResourceCPU || quotaName == api.ResourceLimitsCPU {
+				status.Used[quotaName] = *resource.NewMilliQuantity(used.MilliValue()+delta, resource.DecimalSI)
 			} else {
-				status.Used[api.ResourceCPU] = *resource.NewMilliQuantity(used.MilliValue()+deltaCPU.MilliValue(), resource.DecimalSI)
-				dirty = true
+				status.Used[quotaName] = *resource.NewQuantity((used.MilliValue()+delta)/1000, resource.DecimalSI)
 			}
+			dirty = true
 		}
 	}
 	return dirty, nil
@@ -28,11 +28,14 @@ import (
 
 func getResourceRequirements(cpu, memory string) api.ResourceRequirements {
 	res := api.ResourceRequirements{}
+	res.Requests = api.ResourceList{}
 	res.Limits = api.ResourceList{}
 	if cpu != "" {
+		res.Requests[api.ResourceCPU] = resource.MustParse(cpu)
 		res.Limits[api.ResourceCPU] = resource.MustParse(cpu)
 	}
 	if memory != "" {
+		res.Requests[api.ResourceMemory] = resource.MustParse(memory)
 		res.Limits[api.ResourceMemory] = resource.MustParse(memory)
 	}
 
@@ -330,6 +333,55 @@ func TestExceedUsageCPU(t *testing.T) {
 	}
 }
 
+func TestIncrementUsageRequestsAndLimits(t *testing.T) {
+	namespace := "default"
+	client := testclient.NewSimpleFake(&api.PodList{})
+	status := &api.ResourceQuotaStatus{
+		Hard: api.ResourceList{
+			api.ResourceCPU:       resource.MustParse("1"),
+			api.ResourceLimitsCPU: resource.MustParse("1"),
+		},
+		Used: api.ResourceList{
+			api.ResourceCPU:       resource.MustParse("100m"),
+			api.ResourceLimitsCPU: resource.MustParse("500m"),
+		},
+	}
+
+	resources := getResourceRequirements("200m", "1Gi")
+	resources.Limits[api.ResourceCPU] = resource.MustParse("400m")
+	newPod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
+		Spec: api.PodSpec{
+			Volumes:    []api.Volume{{Name: "vol"}},
+			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: resources}},
+		}}
+	dirty, err := IncrementUsage(admission.NewAttributesRecord(newPod, "Pod", namespace, newPod.Name, "pods", "", admission.Create, nil), status, client)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !dirty {
+		t.Errorf("Expected the status to get incremented, therefore should have been dirty")
+	}
+	if used := status.Used[api.ResourceCPU]; used.MilliValue() != 300 {
+		t.Errorf("Expected cpu requests usage of 300m, got %s", used.String())
+	}
+	if used := status.Used[api.ResourceLimitsCPU]; used.MilliValue() != 900 {
+		t.Errorf("Expected cpu limits usage of 900m, got %s", used.String())
+	}
+
+	resources.Limits[api.ResourceCPU] = resource.MustParse("600m")
+	_, err = IncrementUsage(admission.NewAttributesRecord(newPod, "Pod", namespace, newPod.Name, "pods", "", admission.Create, nil), status, client)
+	if err == nil {
+		t.Errorf("Expected cpu limits usage exceeded error")
+	}
+
+	delete(resources.Limits, api.ResourceCPU)
+	_, err = IncrementUsage(admission.NewAttributesRecord(newPod, "Pod", namespace, newPod.Name, "pods", "", admission.Create, nil), status, client)
+	if err == nil {
+		t.Errorf("Expected error for unbounded cpu limit")
+	}
+}
+
 func TestExceedUsagePods(t *testing.T) {
 	namespace := "default"
 	client := testclient.NewSimpleFake(&api.PodList{
@@ -264,6 +264,9 @@ func TestAutomountsAPIToken(t *testing.T) {
 
 	pod := &api.Pod{
 		Spec: api.PodSpec{
+			InitContainers: []api.Container{
+				{},
+			},
 			Containers: []api.Container{
 				{},
 			},
@@ -283,11 +286,13 @@ func TestAutomountsAPIToken(t *testing.T) {
 	if !reflect.DeepEqual(expectedVolume, pod.Spec.Volumes[0]) {
 		t.Fatalf("Expected\n\t%#v\ngot\n\t%#v", expectedVolume, pod.Spec.Volumes[0])
 	}
-	if len(pod.Spec.Containers[0].VolumeMounts) != 1 {
-		t.Fatalf("Expected 1 volume mount, got %d", len(pod.Spec.Containers[0].VolumeMounts))
-	}
-	if !reflect.DeepEqual(expectedVolumeMount, pod.Spec.Containers[0].VolumeMounts[0]) {
-		t.Fatalf("Expected\n\t%#v\ngot\n\t%#v", expectedVolumeMount, pod.Spec.Containers[0].VolumeMounts[0])
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if len(container.VolumeMounts) != 1 {
+			t.Fatalf("Expected 1 volume mount, got %d", len(container.VolumeMounts))
+		}
+		if !reflect.DeepEqual(expectedVolumeMount, container.VolumeMounts[0]) {
+			t.Fatalf("Expected\n\t%#v\ngot\n\t%#v", expectedVolumeMount, container.VolumeMounts[0])
+		}
 	}
 }
 
@@ -0,0 +1,204 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// minRefreshInterval limits how often tokens with unknown key ids can make us
+// fetch the issuer's keys again.
+const minRefreshInterval = 30 * time.Second
+
+// discoveryDocument is the subset of the provider metadata served at
+// <issuer>/.well-known/openid-configuration that we need.
+type discoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// jsonWebKeySet is a JWKS document as defined by RFC 7517.
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+}
+
+// JWKSKeySource is a KeySource that discovers an issuer's JWKS endpoint and
+// fetches its keys on demand.  Keys are refetched, at most every
+// minRefreshInterval, when a token names a key that is not known yet, so
+// that the issuer can rotate keys.  Failed fetches are rate limited the same
+// way, so an unreachable issuer is not asked again for every token.
+type JWKSKeySource struct {
+	issuerURL string
+	client    *http.Client
+	clock     util.Clock
+
+	// lock guards the fields below.  It is never held while talking to the
+	// issuer.
+	lock sync.Mutex
+	keys map[string]*rsa.PublicKey
+	// lastFetch is when the last fetch was started and fetchErr is how it
+	// failed, if it did.
+	lastFetch time.Time
+	fetchErr  error
+	// fetching is closed when the fetch in progress, if any, finishes.
+	fetching chan struct{}
+}
+
+// NewJWKSKeySource returns a JWKSKeySource for issuerURL that makes its
+// requests with client.  client should have a timeout set; callers of GetKey
+// wait for a fetch in progress.
+func NewJWKSKeySource(issuerURL string, client *http.Client) *JWKSKeySource {
+	return &JWKSKeySource{
+		issuerURL: strings.TrimSuffix(issuerURL, "/"),
+		client:    client,
+		clock:     util.RealClock{},
+	}
+}
+
+// GetKey implements KeySource
+func (s *JWKSKeySource) GetKey(kid string) (*rsa.PublicKey, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for {
+		if key, ok := s.lookup(kid); ok {
+			return key, nil
+		}
+		if s.fetching != nil {
+			// Wait for the fetch in progress rather than starting another.
+			fetching := s.fetching
+			s.lock.Unlock()
+			<-fetching
+			s.lock.Lock()
+			continue
+		}
+		if !s.lastFetch.IsZero() && s.clock.Since(s.lastFetch) < minRefreshInterval {
+			if s.fetchErr != nil {
+				return nil, s.fetchErr
+			}
+			return nil, fmt.Errorf("unknown key %q", kid)
+		}
+
+		s.lastFetch = s.clock.Now()
+		s.fetching = make(chan struct{})
+		s.lock.Unlock()
+		keys, err := s.fetch()
+		s.lock.Lock()
+		if err == nil {
+			s.keys = keys
+		}
+		s.fetchErr = err
+		close(s.fetching)
+		s.fetching = nil
+	}
+}
+
+// lookup returns the key named kid, or the only key if kid is empty.
+func (s *JWKSKeySource) lookup(kid string) (*rsa.PublicKey, bool) {
+	if len(kid) == 0 {
+		if len(s.keys) != 1 {
+			return nil, false
+		}
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// fetch discovers the issuer's JWKS endpoint and returns its RSA signing keys.
+func (s *JWKSKeySource) fetch() (map[string]*rsa.PublicKey, error) {
+	var discovery discoveryDocument
+	if err := s.get(s.issuerURL+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != s.issuerURL {
+		return nil, fmt.Errorf("discovery document names issuer %q, expected %q", discovery.Issuer, s.issuerURL)
+	}
+	if len(discovery.JWKSURI) == 0 {
+		return nil, fmt.Errorf("discovery document for %s has no jwks_uri", s.issuerURL)
+	}
+
+	var jwks jsonWebKeySet
+	if err := s.get(discovery.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+	keys := map[string]*rsa.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.KeyType != "RSA" || (len(jwk.Use) > 0 && jwk.Use != "sig") {
+			continue
+		}
+		key, err := jwk.rsaPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %v", jwk.KeyID, err)
+		}
+		keys[jwk.KeyID] = key
+	}
+	return keys, nil
+}
+
+func (s *JWKSKeySource) get(url string, into interface{}) error {
+	resp, err := s.client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d", url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(into); err != nil {
+		return fmt.Errorf("unable to decode %s: %v", url, err)
+	}
+	return nil
+}
+
+// rsaPublicKey decodes the base64url encoded modulus and exponent of k.
+func (k jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := jwt.DecodeSegment(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := jwt.DecodeSegment(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || exponent.Sign() == 0 || exponent.BitLen() > 31 {
+		return nil, fmt.Errorf("invalid modulus or exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
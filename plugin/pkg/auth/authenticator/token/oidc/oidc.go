@@ -0,0 +1,183 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oidc implements an authenticator.Token that validates OpenID Connect
+// ID tokens issued by a trusted identity provider.
+package oidc
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/golang/glog"
+)
+
+const (
+	IssuerClaim   = "iss"
+	AudienceClaim = "aud"
+	ExpiryClaim   = "exp"
+
+	// DefaultUsernameClaim is the claim used as the username unless another
+	// one is configured.
+	DefaultUsernameClaim = "sub"
+)
+
+// errOtherIssuer is returned by the key lookup for tokens minted by someone
+// else, e.g. service account tokens, which are left for other authenticators.
+var errOtherIssuer = errors.New("token was not issued by the configured issuer")
+
+// OIDCAuthenticator validates RS256-signed ID tokens and maps their claims to
+// a user.
+type OIDCAuthenticator struct {
+	issuerURL     string
+	clientID      string
+	usernameClaim string
+	groupsClaim   string
+	keys          KeySource
+}
+
+// New returns an OIDCAuthenticator that accepts ID tokens issued by issuerURL
+// for clientID.  The username is read from usernameClaim, and, if groupsClaim
+// is set, group membership from groupsClaim.  keys supplies the issuer's
+// signing keys.
+func New(issuerURL, clientID, usernameClaim, groupsClaim string, keys KeySource) (*OIDCAuthenticator, error) {
+	u, err := url.Parse(issuerURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("issuer url %q must use https", issuerURL)
+	}
+	if len(clientID) == 0 {
+		return nil, errors.New("a client id is required")
+	}
+	if len(usernameClaim) == 0 {
+		usernameClaim = DefaultUsernameClaim
+	}
+	return &OIDCAuthenticator{
+		issuerURL:     issuerURL,
+		clientID:      clientID,
+		usernameClaim: usernameClaim,
+		groupsClaim:   groupsClaim,
+		keys:          keys,
+	}, nil
+}
+
+// AuthenticateToken implements authenticator.Token
+func (o *OIDCAuthenticator) AuthenticateToken(value string) (user.Info, bool, error) {
+	token, err := jwt.Parse(value, o.key)
+	if err != nil {
+		if token != nil && token.Claims[IssuerClaim] != o.issuerURL {
+			// Someone else's token, or not a JWT at all.
+			return nil, false, nil
+		}
+		if verr, ok := err.(*jwt.ValidationError); ok && (verr.Errors&jwt.ValidationErrorMalformed) != 0 {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if !hasAudience(token.Claims[AudienceClaim], o.clientID) {
+		return nil, false, fmt.Errorf("token was not issued for client %q", o.clientID)
+	}
+	// jwt.Parse only checks the expiry if it is present, but ID tokens must carry one.
+	if _, ok := token.Claims[ExpiryClaim].(float64); !ok {
+		return nil, false, errors.New("token has no expiry")
+	}
+
+	username, _ := token.Claims[o.usernameClaim].(string)
+	if len(username) == 0 {
+		return nil, false, fmt.Errorf("%s claim is missing", o.usernameClaim)
+	}
+	info := &user.DefaultInfo{Name: username}
+	if len(o.groupsClaim) > 0 {
+		groups, err := stringList(token.Claims[o.groupsClaim])
+		if err != nil {
+			return nil, false, fmt.Errorf("%s claim is invalid: %v", o.groupsClaim, err)
+		}
+		info.Groups = groups
+	}
+	return info, true, nil
+}
+
+// key returns the public key that should have signed token.
+func (o *OIDCAuthenticator) key(token *jwt.Token) (interface{}, error) {
+	if iss, _ := token.Claims[IssuerClaim].(string); iss != o.issuerURL {
+		return nil, errOtherIssuer
+	}
+	// Only RS256 is accepted, so that a token cannot pick a weaker algorithm
+	// or get verified with the public key as an HMAC secret.
+	if alg, _ := token.Header["alg"].(string); alg != "RS256" {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	kid, _ := token.Header["kid"].(string)
+	key, err := o.keys.GetKey(kid)
+	if err != nil {
+		glog.V(4).Infof("Unable to find key %q for issuer %s: %v", kid, o.issuerURL, err)
+		return nil, err
+	}
+	return key, nil
+}
+
+// hasAudience returns true if aud, which is either a string or a list of
+// strings, contains clientID.
+func hasAudience(aud interface{}, clientID string) bool {
+	audiences, err := stringList(aud)
+	if err != nil {
+		return false
+	}
+	for _, a := range audiences {
+		if a == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// stringList converts a claim that holds either a single string or a list
+// of strings to a list of strings.
+func stringList(claim interface{}) ([]string, error) {
+	switch v := claim.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected value %v", item)
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unexpected value %v", claim)
+	}
+}
+
+// KeySource supplies the keys an issuer signs ID tokens with.
+type KeySource interface {
+	// GetKey returns the key identified by kid.  If kid is empty, the
+	// issuer's only key is returned.
+	GetKey(kid string) (*rsa.PublicKey, error)
+}
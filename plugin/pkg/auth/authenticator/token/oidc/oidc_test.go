@@ -0,0 +1,406 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/wait"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// fakeIssuer serves a discovery document and a JWKS holding its public keys.
+type fakeIssuer struct {
+	lock    sync.Mutex
+	url     string
+	keys    map[string]*rsa.PrivateKey
+	fetches int
+	// broken makes the key endpoint fail.
+	broken bool
+	// block, if set, holds up requests to the key endpoint until it is closed.
+	block chan struct{}
+}
+
+func (f *fakeIssuer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.lock.Lock()
+	block := f.block
+	f.lock.Unlock()
+	if block != nil && req.URL.Path == "/keys" {
+		<-block
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	switch req.URL.Path {
+	case "/.well-known/openid-configuration":
+		json.NewEncoder(w).Encode(discoveryDocument{Issuer: f.url, JWKSURI: f.url + "/keys"})
+	case "/keys":
+		f.fetches++
+		if f.broken {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		jwks := jsonWebKeySet{}
+		for kid, key := range f.keys {
+			jwks.Keys = append(jwks.Keys, jsonWebKey{
+				KeyType: "RSA",
+				KeyID:   kid,
+				Use:     "sig",
+				N:       jwt.EncodeSegment(key.PublicKey.N.Bytes()),
+				E:       jwt.EncodeSegment(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+			})
+		}
+		json.NewEncoder(w).Encode(jwks)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeIssuer) setKeys(keys map[string]*rsa.PrivateKey) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.keys = keys
+}
+
+func (f *fakeIssuer) setBroken(broken bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.broken = broken
+}
+
+func (f *fakeIssuer) setBlock(block chan struct{}) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.block = block
+}
+
+func (f *fakeIssuer) fetchCount() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.fetches
+}
+
+func newFakeIssuer(t *testing.T, keys map[string]*rsa.PrivateKey) (*fakeIssuer, *httptest.Server, *http.Client) {
+	issuer := &fakeIssuer{keys: keys}
+	server := httptest.NewTLSServer(issuer)
+	issuer.url = server.URL
+	// The test server's certificate is self-signed.
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	return issuer, server, client
+}
+
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("unexpected error generating key: %v", err)
+	}
+	return key
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims map[string]interface{}) string {
+	token := jwt.New(method)
+	if len(kid) > 0 {
+		token.Header["kid"] = kid
+	}
+	token.Claims = claims
+	value, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("unexpected error signing token: %v", err)
+	}
+	return value
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	key1 := generateKey(t)
+	key2 := generateKey(t)
+	issuer, server, client := newFakeIssuer(t, map[string]*rsa.PrivateKey{"key1": key1})
+	defer server.Close()
+
+	auth, err := New(server.URL, "kubernetes", "email", "groups", NewJWKSKeySource(server.URL, client))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expiry := float64(time.Now().Add(time.Hour).Unix())
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss":    issuer.url,
+			"aud":    "kubernetes",
+			"sub":    "1234",
+			"exp":    expiry,
+			"email":  "jane@example.com",
+			"groups": []string{"developers", "admins"},
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+
+	testCases := map[string]struct {
+		Token     string
+		User      user.Info
+		OK        bool
+		ExpectErr bool
+	}{
+		"valid": {
+			Token: signToken(t, jwt.SigningMethodRS256, "key1", key1, claims(nil)),
+			User:  &user.DefaultInfo{Name: "jane@example.com", Groups: []string{"developers", "admins"}},
+			OK:    true,
+		},
+		"single group and audience list": {
+			Token: signToken(t, jwt.SigningMethodRS256, "key1", key1, claims(map[string]interface{}{"groups": "developers", "aud": []string{"other", "kubernetes"}})),
+			User:  &user.DefaultInfo{Name: "jane@example.com", Groups: []string{"developers"}},
+			OK:    true,
+		},
+		"no kid with a single key": {
+			Token: signToken(t, jwt.SigningMethodRS256, "", key1, claims(nil)),
+			User:  &user.DefaultInfo{Name: "jane@example.com", Groups: []string{"developers", "admins"}},
+			OK:    true,
+		},
+		"not a jwt": {
+			Token: "foo",
+		},
+		"other issuer": {
+			Token: signToken(t, jwt.SigningMethodRS256, "key1", key2, claims(map[string]interface{}{"iss": "kubernetes/serviceaccount"})),
+		},
+		"wrong audience": {
+			Token:     signToken(t, jwt.SigningMethodRS256, "key1", key1, claims(map[string]interface{}{"aud": "other"})),
+			ExpectErr: true,
+		},
+		"expired": {
+			Token:     signToken(t, jwt.SigningMethodRS256, "key1", key1, claims(map[string]interface{}{"exp": float64(time.Now().Add(-time.Hour).Unix())})),
+			ExpectErr: true,
+		},
+		"no expiry": {
+			Token:     signToken(t, jwt.SigningMethodRS256, "key1", key1, claims(map[string]interface{}{"exp": nil})),
+			ExpectErr: true,
+		},
+		"signed with another key": {
+			Token:     signToken(t, jwt.SigningMethodRS256, "key1", key2, claims(nil)),
+			ExpectErr: true,
+		},
+		"unknown key": {
+			Token:     signToken(t, jwt.SigningMethodRS256, "key2", key2, claims(nil)),
+			ExpectErr: true,
+		},
+		"hmac": {
+			Token:     signToken(t, jwt.SigningMethodHS256, "key1", []byte("secret"), claims(nil)),
+			ExpectErr: true,
+		},
+		"missing username": {
+			Token:     signToken(t, jwt.SigningMethodRS256, "key1", key1, claims(map[string]interface{}{"email": nil})),
+			ExpectErr: true,
+		},
+		"invalid groups": {
+			Token:     signToken(t, jwt.SigningMethodRS256, "key1", key1, claims(map[string]interface{}{"groups": 5})),
+			ExpectErr: true,
+		},
+	}
+	for k, tc := range testCases {
+		u, ok, err := auth.AuthenticateToken(tc.Token)
+		if tc.ExpectErr != (err != nil) {
+			t.Errorf("%s: expected error=%v, got %v", k, tc.ExpectErr, err)
+		}
+		if tc.OK != ok {
+			t.Errorf("%s: expected ok=%v, got %v", k, tc.OK, ok)
+		}
+		if !reflect.DeepEqual(tc.User, u) {
+			t.Errorf("%s: expected user %#v, got %#v", k, tc.User, u)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	keys := NewJWKSKeySource("https://example.com", http.DefaultClient)
+	if _, err := New("http://example.com", "kubernetes", "", "", keys); err == nil {
+		t.Errorf("expected an error for a plain http issuer")
+	}
+	if _, err := New("https://example.com", "", "", "", keys); err == nil {
+		t.Errorf("expected an error without a client id")
+	}
+	auth, err := New("https://example.com", "kubernetes", "", "", keys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if auth.usernameClaim != DefaultUsernameClaim {
+		t.Errorf("expected username claim to default to %s, got %s", DefaultUsernameClaim, auth.usernameClaim)
+	}
+}
+
+func TestJWKSKeySourceRotation(t *testing.T) {
+	key1 := generateKey(t)
+	key2 := generateKey(t)
+	issuer, server, client := newFakeIssuer(t, map[string]*rsa.PrivateKey{"key1": key1})
+	defer server.Close()
+
+	clock := &util.FakeClock{Time: time.Now()}
+	source := NewJWKSKeySource(server.URL, client)
+	source.clock = clock
+
+	key, err := source.GetKey("key1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(&key1.PublicKey, key) {
+		t.Errorf("unexpected key %v", key)
+	}
+	// Known keys are served from memory.
+	if _, err := source.GetKey("key1"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if issuer.fetches != 1 {
+		t.Errorf("expected 1 fetch, got %d", issuer.fetches)
+	}
+
+	// The issuer rotates to a new key.  Unknown keys don't cause a refetch
+	// until minRefreshInterval has passed.
+	issuer.setKeys(map[string]*rsa.PrivateKey{"key1": key1, "key2": key2})
+	if _, err := source.GetKey("key2"); err == nil {
+		t.Errorf("expected an error looking up a new key before the refresh interval")
+	}
+	clock.Time = clock.Time.Add(minRefreshInterval + time.Second)
+	key, err = source.GetKey("key2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(&key2.PublicKey, key) {
+		t.Errorf("unexpected key %v", key)
+	}
+	if issuer.fetches != 2 {
+		t.Errorf("expected 2 fetches, got %d", issuer.fetches)
+	}
+
+	// With several keys, a key id is required.
+	if _, err := source.GetKey(""); err == nil {
+		t.Errorf("expected an error looking up a key without an id")
+	}
+}
+
+func TestJWKSKeySourceFailedFetch(t *testing.T) {
+	key1 := generateKey(t)
+	issuer, server, client := newFakeIssuer(t, map[string]*rsa.PrivateKey{"key1": key1})
+	defer server.Close()
+	issuer.setBroken(true)
+
+	clock := &util.FakeClock{Time: time.Now()}
+	source := NewJWKSKeySource(server.URL, client)
+	source.clock = clock
+
+	// A failed fetch is not retried until minRefreshInterval has passed, even
+	// though no keys are known yet.
+	for i := 0; i < 3; i++ {
+		if _, err := source.GetKey("key1"); err == nil {
+			t.Errorf("expected an error while the issuer is broken")
+		}
+	}
+	if fetches := issuer.fetchCount(); fetches != 1 {
+		t.Errorf("expected 1 fetch, got %d", fetches)
+	}
+
+	issuer.setBroken(false)
+	clock.Time = clock.Time.Add(minRefreshInterval + time.Second)
+	key, err := source.GetKey("key1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(&key1.PublicKey, key) {
+		t.Errorf("unexpected key %v", key)
+	}
+	if fetches := issuer.fetchCount(); fetches != 2 {
+		t.Errorf("expected 2 fetches, got %d", fetches)
+	}
+}
+
+func TestJWKSKeySourceSlowFetch(t *testing.T) {
+	key1 := generateKey(t)
+	key2 := generateKey(t)
+	issuer, server, client := newFakeIssuer(t, map[string]*rsa.PrivateKey{"key1": key1})
+	defer server.Close()
+
+	clock := &util.FakeClock{Time: time.Now()}
+	source := NewJWKSKeySource(server.URL, client)
+	source.clock = clock
+	if _, err := source.GetKey("key1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Start a refetch for a new key and hold it up at the issuer.
+	block := make(chan struct{})
+	issuer.setBlock(block)
+	issuer.setKeys(map[string]*rsa.PrivateKey{"key1": key1, "key2": key2})
+	clock.Time = clock.Time.Add(minRefreshInterval + time.Second)
+	done := make(chan error)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := source.GetKey("key2")
+			done <- err
+		}()
+	}
+
+	err := wait.Poll(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		source.lock.Lock()
+		defer source.lock.Unlock()
+		return source.fetching != nil, nil
+	})
+	if err != nil {
+		t.Fatalf("fetch never started: %v", err)
+	}
+
+	// Known keys are still served while the fetch is in progress.
+	looked := make(chan error)
+	go func() {
+		_, err := source.GetKey("key1")
+		looked <- err
+	}()
+	select {
+	case err := <-looked:
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("looking up a known key blocked on a fetch")
+	}
+
+	close(block)
+	for i := 0; i < 2; i++ {
+		if err := <-done; err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	// Concurrent lookups of the same new key share a single fetch.
+	if fetches := issuer.fetchCount(); fetches != 2 {
+		t.Errorf("expected 2 fetches, got %d", fetches)
+	}
+}
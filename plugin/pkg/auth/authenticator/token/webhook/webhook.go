@@ -0,0 +1,80 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements an authenticator.Token that asks a remote
+// service whether a bearer token is valid.
+package webhook
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/webhook"
+)
+
+// TokenReview is POSTed to the webhook for every token that needs checking.
+type TokenReview struct {
+	// Token is the opaque bearer token presented by the client.
+	Token string `json:"token"`
+}
+
+// TokenReviewResponse is the answer expected back from the webhook.  User,
+// UID and Groups are only read when Authenticated is true.
+type TokenReviewResponse struct {
+	// Authenticated is true if the token is valid.
+	Authenticated bool `json:"authenticated"`
+	// User is the name of the user the token belongs to.
+	User string `json:"user,omitempty"`
+	// UID uniquely identifies the user across name changes.
+	UID string `json:"uid,omitempty"`
+	// Groups lists the groups the user is a member of.
+	Groups []string `json:"groups,omitempty"`
+}
+
+// Denied implements webhook.Denial, so that answers to made up tokens are not
+// cached as long as valid ones.
+func (r *TokenReviewResponse) Denied() bool {
+	return !r.Authenticated
+}
+
+// WebhookTokenAuthenticator delegates token authentication to a remote service.
+type WebhookTokenAuthenticator struct {
+	webhook *webhook.GenericWebhook
+}
+
+// New returns a WebhookTokenAuthenticator that reviews tokens with the webhook
+// described by config.
+func New(config webhook.Config) (*WebhookTokenAuthenticator, error) {
+	w, err := webhook.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookTokenAuthenticator{w}, nil
+}
+
+// AuthenticateToken implements authenticator.Token
+func (w *WebhookTokenAuthenticator) AuthenticateToken(token string) (user.Info, bool, error) {
+	response := &TokenReviewResponse{}
+	if err := w.webhook.Review(&TokenReview{Token: token}, response); err != nil {
+		return nil, false, err
+	}
+	if !response.Authenticated {
+		return nil, false, nil
+	}
+	return &user.DefaultInfo{
+		Name:   response.User,
+		UID:    response.UID,
+		Groups: response.Groups,
+	}, true, nil
+}
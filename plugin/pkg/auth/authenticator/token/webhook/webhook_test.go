@@ -0,0 +1,136 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/webhook"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// tokenServer authenticates the tokens it knows about.
+type tokenServer map[string]TokenReviewResponse
+
+func (s tokenServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var review TokenReview
+	if err := json.NewDecoder(req.Body).Decode(&review); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if review.Token == "broken" {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(s[review.Token])
+}
+
+// newTLSServer starts an https server for handler with a freshly generated
+// self-signed certificate, and returns it with the path of that certificate.
+func newTLSServer(t *testing.T, dir string, handler http.Handler) (*httptest.Server, string) {
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	if err := util.GenerateSelfSignedCert("127.0.0.1", certFile, keyFile, []net.IP{net.ParseIP("127.0.0.1")}, nil); err != nil {
+		t.Fatalf("unexpected error generating certificate: %v", err)
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("unexpected error loading certificate: %v", err)
+	}
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	server.StartTLS()
+	return server, certFile
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	server, caFile := newTLSServer(t, dir, tokenServer{
+		"token1": {Authenticated: true, User: "alice", UID: "1", Groups: []string{"admins"}},
+		"token2": {Authenticated: false, User: "bob"},
+	})
+	defer server.Close()
+
+	auth, err := New(webhook.Config{URL: server.URL, CAFile: caFile, CacheTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		Token     string
+		User      user.Info
+		OK        bool
+		ExpectErr bool
+	}{
+		{Token: "token1", User: &user.DefaultInfo{Name: "alice", UID: "1", Groups: []string{"admins"}}, OK: true},
+		{Token: "token2"},
+		{Token: "unknown"},
+		{Token: "broken", ExpectErr: true},
+	}
+	for i, tc := range testCases {
+		u, ok, err := auth.AuthenticateToken(tc.Token)
+		if tc.ExpectErr != (err != nil) {
+			t.Errorf("%d: expected error=%v, got %v", i, tc.ExpectErr, err)
+		}
+		if tc.OK != ok {
+			t.Errorf("%d: expected ok=%v, got %v", i, tc.OK, ok)
+		}
+		if !reflect.DeepEqual(tc.User, u) {
+			t.Errorf("%d: expected user %#v, got %#v", i, tc.User, u)
+		}
+	}
+}
+
+func TestRejectedTokensAreNotCached(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	tokens := tokenServer{}
+	server, caFile := newTLSServer(t, dir, tokens)
+	defer server.Close()
+
+	auth, err := New(webhook.Config{URL: server.URL, CAFile: caFile, CacheTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok, err := auth.AuthenticateToken("token1"); ok || err != nil {
+		t.Fatalf("expected the unknown token to be rejected, got ok=%v, err=%v", ok, err)
+	}
+
+	// The token is accepted as soon as the webhook knows about it.
+	tokens["token1"] = TokenReviewResponse{Authenticated: true, User: "alice"}
+	if _, ok, err := auth.AuthenticateToken("token1"); !ok || err != nil {
+		t.Errorf("expected the rejection not to be cached, got ok=%v, err=%v", ok, err)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package algorithm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FitFailure describes why a pod could not be placed on any node.  Its
+// String form is the message of the scheduler's failedScheduling events,
+// as in "pod (foo) failed to fit on 3 node(s): PodFitsResources (2),
+// MatchNodeSelector (1)", and ParseFitFailure reads it back.
+type FitFailure struct {
+	Pod string
+	// Nodes is the number of nodes the pod was tried against.
+	Nodes int
+	// Predicates counts the nodes each predicate failed on, in the order
+	// they are reported.
+	Predicates []PredicateFailure
+}
+
+// PredicateFailure is the number of nodes a single predicate failed on.
+type PredicateFailure struct {
+	Predicate string
+	Nodes     int
+}
+
+const (
+	fitFailurePrefix    = "pod ("
+	fitFailureNodes     = ") failed to fit on "
+	fitFailureSeparator = " node(s): "
+)
+
+// Summary describes the failure without the per predicate counts.
+func (f *FitFailure) Summary() string {
+	return fmt.Sprintf("%s%s%s%d node(s)", fitFailurePrefix, f.Pod, fitFailureNodes, f.Nodes)
+}
+
+func (f *FitFailure) String() string {
+	reasons := make([]string, 0, len(f.Predicates))
+	for _, predicate := range f.Predicates {
+		reasons = append(reasons, predicate.String())
+	}
+	return f.Summary() + ": " + strings.Join(reasons, ", ")
+}
+
+func (p PredicateFailure) String() string {
+	return fmt.Sprintf("%s (%d)", p.Predicate, p.Nodes)
+}
+
+// ParseFitFailure parses a message produced by FitFailure.String.  It returns
+// false if the message is in any other form.
+func ParseFitFailure(message string) (*FitFailure, bool) {
+	if !strings.HasPrefix(message, fitFailurePrefix) {
+		return nil, false
+	}
+	rest := message[len(fitFailurePrefix):]
+	i := strings.Index(rest, fitFailureNodes)
+	if i < 0 {
+		return nil, false
+	}
+	failure := &FitFailure{Pod: rest[:i]}
+	rest = rest[i+len(fitFailureNodes):]
+	i = strings.Index(rest, fitFailureSeparator)
+	if i < 0 {
+		return nil, false
+	}
+	nodes, err := strconv.Atoi(rest[:i])
+	if err != nil {
+		return nil, false
+	}
+	failure.Nodes = nodes
+	rest = rest[i+len(fitFailureSeparator):]
+	if len(rest) == 0 {
+		return failure, true
+	}
+	for _, reason := range strings.Split(rest, ", ") {
+		i := strings.LastIndex(reason, " (")
+		if i < 0 || !strings.HasSuffix(reason, ")") {
+			return nil, false
+		}
+		count, err := strconv.Atoi(reason[i+2 : len(reason)-1])
+		if err != nil {
+			return nil, false
+		}
+		failure.Predicates = append(failure.Predicates, PredicateFailure{Predicate: reason[:i], Nodes: count})
+	}
+	return failure, true
+}
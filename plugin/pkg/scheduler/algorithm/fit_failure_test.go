@@ -0,0 +1,73 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package algorithm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFitFailureRoundTrip(t *testing.T) {
+	tests := []struct {
+		failure  FitFailure
+		expected string
+	}{
+		{
+			failure: FitFailure{
+				Pod:   "foo",
+				Nodes: 3,
+				Predicates: []PredicateFailure{
+					{Predicate: "PodFitsResources", Nodes: 2},
+					{Predicate: "MatchNodeSelector", Nodes: 1},
+				},
+			},
+			expected: "pod (foo) failed to fit on 3 node(s): PodFitsResources (2), MatchNodeSelector (1)",
+		},
+		{
+			failure:  FitFailure{Pod: "foo", Nodes: 0},
+			expected: "pod (foo) failed to fit on 0 node(s): ",
+		},
+	}
+	for _, test := range tests {
+		if got := test.failure.String(); got != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, got)
+		}
+		failure, ok := ParseFitFailure(test.expected)
+		if !ok {
+			t.Errorf("failed to parse %q", test.expected)
+			continue
+		}
+		if !reflect.DeepEqual(&test.failure, failure) {
+			t.Errorf("expected %#v, got %#v", &test.failure, failure)
+		}
+	}
+}
+
+func TestParseFitFailureRejectsOtherMessages(t *testing.T) {
+	for _, message := range []string{
+		"",
+		"Binding rejected: conflict",
+		"gave up waiting for gang /training: 2 of 3 members held",
+		"pod (foo) failed to fit on many node(s): PodFitsPorts (1)",
+		"pod (foo) failed to fit on 1 node(s): PodFitsPorts",
+		"pod (foo) failed to fit on 1 node(s): PodFitsPorts (one)",
+	} {
+		if failure, ok := ParseFitFailure(message); ok {
+			t.Errorf("expected %q not to parse, got %#v", message, failure)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+// PodMatchesAffinityTerm returns true if candidate is selected by the given
+// affinity term of owner.  The term's namespaces default to owner's namespace.
+func PodMatchesAffinityTerm(owner, candidate *api.Pod, term api.PodAffinityTerm) bool {
+	if len(term.Namespaces) == 0 {
+		if candidate.Namespace != owner.Namespace {
+			return false
+		}
+	} else {
+		found := false
+		for _, namespace := range term.Namespaces {
+			if candidate.Namespace == namespace {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return labels.SelectorFromSet(term.Selector).Matches(labels.Set(candidate.Labels))
+}
+
+// NodesShareTopology returns true if both nodes carry the topology key label
+// with the same value, i.e. they are in the same topology domain.
+func NodesShareTopology(a, b *api.Node, topologyKey string) bool {
+	if len(topologyKey) == 0 {
+		return false
+	}
+	value, ok := a.Labels[topologyKey]
+	if !ok {
+		return false
+	}
+	other, ok := b.Labels[topologyKey]
+	return ok && value == other
+}
+
+type PodAffinityChecker struct {
+	info      NodeInfo
+	podLister algorithm.PodLister
+}
+
+func NewPodAffinityPredicate(info NodeInfo, podLister algorithm.PodLister) algorithm.FitPredicate {
+	checker := &PodAffinityChecker{
+		info:      info,
+		podLister: podLister,
+	}
+	return checker.InterPodAffinityMatches
+}
+
+// scheduledPod is a pod already bound to a node, along with that node.
+type scheduledPod struct {
+	pod  *api.Pod
+	node *api.Node
+}
+
+// InterPodAffinityMatches checks that placing the pod on the node satisfies
+// the pod's required affinity and anti-affinity terms, and that it does not
+// violate the required anti-affinity terms of any pod already scheduled.
+// Preferred terms are left to the InterPodAffinityPriority.
+func (c *PodAffinityChecker) InterPodAffinityMatches(pod *api.Pod, existingPods []*api.Pod, node string) (bool, error) {
+	minion, err := c.info.GetNodeInfo(node)
+	if err != nil {
+		return false, err
+	}
+	scheduled, err := c.scheduledPods(pod)
+	if err != nil {
+		return false, err
+	}
+
+	// Symmetry: an existing pod's anti-affinity keeps this pod out of its topology domain.
+	for _, existing := range scheduled {
+		affinity := existing.pod.Spec.Affinity
+		if affinity == nil || affinity.PodAntiAffinity == nil {
+			continue
+		}
+		for _, term := range affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			if PodMatchesAffinityTerm(existing.pod, pod, term) && NodesShareTopology(minion, existing.node, term.TopologyKey) {
+				return false, nil
+			}
+		}
+	}
+
+	affinity := pod.Spec.Affinity
+	if affinity == nil {
+		return true, nil
+	}
+	if affinity.PodAffinity != nil {
+		for _, term := range affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			if !podAffinityTermSatisfied(pod, minion, term, scheduled) {
+				return false, nil
+			}
+		}
+	}
+	if affinity.PodAntiAffinity != nil {
+		for _, term := range affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			for _, existing := range scheduled {
+				if PodMatchesAffinityTerm(pod, existing.pod, term) && NodesShareTopology(minion, existing.node, term.TopologyKey) {
+					return false, nil
+				}
+			}
+		}
+	}
+	return true, nil
+}
+
+// podAffinityTermSatisfied returns true if some pod selected by the term runs
+// in the node's topology domain.  If no pod anywhere is selected by the term
+// but the pod matches it itself, the term is satisfied so that the first pod
+// of a self-affine group can be scheduled.
+func podAffinityTermSatisfied(pod *api.Pod, node *api.Node, term api.PodAffinityTerm, scheduled []scheduledPod) bool {
+	matchedAny := false
+	for _, existing := range scheduled {
+		if !PodMatchesAffinityTerm(pod, existing.pod, term) {
+			continue
+		}
+		matchedAny = true
+		if NodesShareTopology(node, existing.node, term.TopologyKey) {
+			return true
+		}
+	}
+	return !matchedAny && PodMatchesAffinityTerm(pod, pod, term)
+}
+
+// scheduledPods lists the pods bound to a node, other than pod itself, along
+// with their nodes.  Pods whose node can not be found are skipped.
+func (c *PodAffinityChecker) scheduledPods(pod *api.Pod) ([]scheduledPod, error) {
+	pods, err := c.podLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	nodes := map[string]*api.Node{}
+	result := []scheduledPod{}
+	for _, existing := range pods {
+		if len(existing.Spec.NodeName) == 0 || (existing.Namespace == pod.Namespace && existing.Name == pod.Name) {
+			continue
+		}
+		node, ok := nodes[existing.Spec.NodeName]
+		if !ok {
+			node, err = c.info.GetNodeInfo(existing.Spec.NodeName)
+			if err != nil {
+				node = nil
+			}
+			nodes[existing.Spec.NodeName] = node
+		}
+		if node == nil {
+			continue
+		}
+		result = append(result, scheduledPod{pod: existing, node: node})
+	}
+	return result, nil
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+func TestInterPodAffinity(t *testing.T) {
+	nodes := FakeNodeListInfo{
+		{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: map[string]string{"kubernetes.io/hostname": "machine1", "zone": "z1"}}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: map[string]string{"kubernetes.io/hostname": "machine2", "zone": "z1"}}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: map[string]string{"kubernetes.io/hostname": "machine3", "zone": "z2"}}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine4", Labels: map[string]string{"kubernetes.io/hostname": "machine4"}}},
+	}
+	pod := func(name, namespace, node string, labels map[string]string, affinity *api.Affinity) *api.Pod {
+		return &api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec:       api.PodSpec{NodeName: node, Affinity: affinity},
+		}
+	}
+	affinity := func(terms ...api.PodAffinityTerm) *api.Affinity {
+		return &api.Affinity{PodAffinity: &api.PodAffinity{RequiredDuringSchedulingIgnoredDuringExecution: terms}}
+	}
+	antiAffinity := func(terms ...api.PodAffinityTerm) *api.Affinity {
+		return &api.Affinity{PodAntiAffinity: &api.PodAntiAffinity{RequiredDuringSchedulingIgnoredDuringExecution: terms}}
+	}
+	cache := map[string]string{"app": "cache"}
+	web := map[string]string{"app": "web"}
+	db := map[string]string{"app": "db"}
+
+	tests := []struct {
+		pod  *api.Pod
+		pods []*api.Pod
+		node string
+		fits bool
+		test string
+	}{
+		{
+			pod:  pod("p", "default", "", web, nil),
+			pods: []*api.Pod{pod("c", "default", "machine1", cache, nil)},
+			node: "machine3",
+			fits: true,
+			test: "no affinity",
+		},
+		{
+			pod:  pod("p", "default", "", web, affinity(api.PodAffinityTerm{Selector: cache, TopologyKey: "kubernetes.io/hostname"})),
+			pods: []*api.Pod{pod("c", "default", "machine1", cache, nil)},
+			node: "machine1",
+			fits: true,
+			test: "affinity to a pod on the same node",
+		},
+		{
+			pod:  pod("p", "default", "", web, affinity(api.PodAffinityTerm{Selector: cache, TopologyKey: "kubernetes.io/hostname"})),
+			pods: []*api.Pod{pod("c", "default", "machine1", cache, nil)},
+			node: "machine2",
+			fits: false,
+			test: "affinity to a pod on another node",
+		},
+		{
+			pod:  pod("p", "default", "", web, affinity(api.PodAffinityTerm{Selector: cache, TopologyKey: "zone"})),
+			pods: []*api.Pod{pod("c", "default", "machine1", cache, nil)},
+			node: "machine2",
+			fits: true,
+			test: "affinity to a pod in the same zone",
+		},
+		{
+			pod:  pod("p", "default", "", web, affinity(api.PodAffinityTerm{Selector: cache, TopologyKey: "zone"})),
+			pods: []*api.Pod{pod("c", "default", "machine1", cache, nil)},
+			node: "machine4",
+			fits: false,
+			test: "affinity on a node without the topology label",
+		},
+		{
+			pod:  pod("p", "default", "", web, affinity(api.PodAffinityTerm{Selector: cache, TopologyKey: "zone"})),
+			pods: []*api.Pod{pod("c", "other", "machine1", cache, nil)},
+			node: "machine1",
+			fits: false,
+			test: "affinity ignores pods in other namespaces by default",
+		},
+		{
+			pod:  pod("p", "default", "", web, affinity(api.PodAffinityTerm{Selector: cache, Namespaces: []string{"other"}, TopologyKey: "zone"})),
+			pods: []*api.Pod{pod("c", "other", "machine1", cache, nil)},
+			node: "machine2",
+			fits: true,
+			test: "affinity to a pod in a listed namespace",
+		},
+		{
+			pod:  pod("p", "default", "", web, affinity(api.PodAffinityTerm{Selector: cache, TopologyKey: "zone"})),
+			node: "machine3",
+			fits: false,
+			test: "affinity with no matching pods anywhere",
+		},
+		{
+			pod:  pod("p", "default", "", web, affinity(api.PodAffinityTerm{Selector: web, TopologyKey: "zone"})),
+			node: "machine3",
+			fits: true,
+			test: "self affinity allows the first pod of the group",
+		},
+		{
+			pod:  pod("p", "default", "", db, antiAffinity(api.PodAffinityTerm{Selector: db, TopologyKey: "zone"})),
+			pods: []*api.Pod{pod("d", "default", "machine1", db, nil)},
+			node: "machine2",
+			fits: false,
+			test: "anti-affinity to a pod in the same zone",
+		},
+		{
+			pod:  pod("p", "default", "", db, antiAffinity(api.PodAffinityTerm{Selector: db, TopologyKey: "zone"})),
+			pods: []*api.Pod{pod("d", "default", "machine1", db, nil)},
+			node: "machine3",
+			fits: true,
+			test: "anti-affinity to a pod in another zone",
+		},
+		{
+			pod:  pod("p", "default", "", web, nil),
+			pods: []*api.Pod{pod("d", "default", "machine1", db, antiAffinity(api.PodAffinityTerm{Selector: web, TopologyKey: "zone"}))},
+			node: "machine2",
+			fits: false,
+			test: "symmetric anti-affinity: an existing pod keeps the pod out of its zone",
+		},
+		{
+			pod:  pod("p", "default", "", web, nil),
+			pods: []*api.Pod{pod("d", "default", "machine1", db, antiAffinity(api.PodAffinityTerm{Selector: web, TopologyKey: "kubernetes.io/hostname"}))},
+			node: "machine2",
+			fits: true,
+			test: "symmetric anti-affinity only covers the existing pod's topology domain",
+		},
+		{
+			pod:  pod("p", "other", "", web, nil),
+			pods: []*api.Pod{pod("d", "default", "machine1", db, antiAffinity(api.PodAffinityTerm{Selector: web, TopologyKey: "zone"}))},
+			node: "machine1",
+			fits: true,
+			test: "symmetric anti-affinity honors the existing pod's namespaces",
+		},
+		{
+			pod:  pod("p", "default", "", db, antiAffinity(api.PodAffinityTerm{Selector: db, TopologyKey: "zone"})),
+			pods: []*api.Pod{pod("p", "default", "machine1", db, nil)},
+			node: "machine1",
+			fits: true,
+			test: "the pod does not conflict with itself",
+		},
+		{
+			pod:  pod("p", "default", "", db, antiAffinity(api.PodAffinityTerm{Selector: db, TopologyKey: "zone"})),
+			pods: []*api.Pod{pod("d", "default", "", db, nil)},
+			node: "machine1",
+			fits: true,
+			test: "unscheduled pods are ignored",
+		},
+	}
+	for _, test := range tests {
+		fit := PodAffinityChecker{info: nodes, podLister: algorithm.FakePodLister(test.pods)}
+		fits, err := fit.InterPodAffinityMatches(test.pod, []*api.Pod{}, test.node)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
+}
@@ -18,10 +18,12 @@ package predicates
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
 
 	"github.com/golang/glog"
@@ -108,13 +110,33 @@ type resourceRequest struct {
 func getResourceRequest(pod *api.Pod) resourceRequest {
 	result := resourceRequest{}
 	for ix := range pod.Spec.Containers {
-		limits := pod.Spec.Containers[ix].Resources.Limits
-		result.memory += limits.Memory().Value()
-		result.milliCPU += limits.Cpu().MilliValue()
+		requests := pod.Spec.Containers[ix].Resources.Requests
+		result.memory += requests.Memory().Value()
+		result.milliCPU += requests.Cpu().MilliValue()
+	}
+	// Init containers run one at a time before the other containers start, so
+	// the pod needs at least as much as its largest init container requests.
+	for ix := range pod.Spec.InitContainers {
+		requests := pod.Spec.InitContainers[ix].Resources.Requests
+		if memory := requests.Memory().Value(); memory > result.memory {
+			result.memory = memory
+		}
+		if milliCPU := requests.Cpu().MilliValue(); milliCPU > result.milliCPU {
+			result.milliCPU = milliCPU
+		}
 	}
 	return result
 }
 
+// NodeAllocatable returns the resources of the node available to pods. Nodes
+// that do not report their allocatable resources offer their whole capacity.
+func NodeAllocatable(node *api.Node) api.ResourceList {
+	if len(node.Status.Allocatable) == 0 {
+		return node.Status.Capacity
+	}
+	return node.Status.Allocatable
+}
+
 func CheckPodsExceedingCapacity(pods []*api.Pod, capacity api.ResourceList) (fitting []*api.Pod, notFitting []*api.Pod) {
 	totalMilliCPU := capacity.Cpu().MilliValue()
 	totalMemory := capacity.Memory().Value()
@@ -144,18 +166,19 @@ func (r *ResourceFit) PodFitsResources(pod *api.Pod, existingPods []*api.Pod, no
 	if err != nil {
 		return false, err
 	}
+	allocatable := NodeAllocatable(info)
 	if podRequest.milliCPU == 0 && podRequest.memory == 0 {
-		return int64(len(existingPods)) < info.Status.Capacity.Pods().Value(), nil
+		return int64(len(existingPods)) < allocatable.Pods().Value(), nil
 	}
 	pods := []*api.Pod{}
 	copy(pods, existingPods)
 	pods = append(existingPods, pod)
-	_, exceeding := CheckPodsExceedingCapacity(pods, info.Status.Capacity)
-	if len(exceeding) > 0 || int64(len(pods)) > info.Status.Capacity.Pods().Value() {
-		glog.V(4).Infof("Cannot schedule Pod %v, because Node %v is full, running %v out of %v Pods.", pod, node, len(pods)-1, info.Status.Capacity.Pods().Value())
+	_, exceeding := CheckPodsExceedingCapacity(pods, allocatable)
+	if len(exceeding) > 0 || int64(len(pods)) > allocatable.Pods().Value() {
+		glog.V(4).Infof("Cannot schedule Pod %v, because Node %v is full, running %v out of %v Pods.", pod, node, len(pods)-1, allocatable.Pods().Value())
 		return false, nil
 	}
-	glog.V(4).Infof("Schedule Pod %v on Node %v is allowed, Node is running only %v out of %v Pods.", pod, node, len(pods)-1, info.Status.Capacity.Pods().Value())
+	glog.V(4).Infof("Schedule Pod %v on Node %v is allowed, Node is running only %v out of %v Pods.", pod, node, len(pods)-1, allocatable.Pods().Value())
 	return true, nil
 }
 
@@ -193,6 +216,149 @@ func (n *NodeSelector) PodSelectorMatches(pod *api.Pod, existingPods []*api.Pod,
 	return PodMatchesNodeLabels(pod, minion), nil
 }
 
+// NodeMatchesNodeSelectorTerms returns true if the node's labels satisfy at
+// least one of the terms.  A term is satisfied when all of its requirements
+// are; an empty term is satisfied by no node.
+func NodeMatchesNodeSelectorTerms(node *api.Node, terms []api.NodeSelectorTerm) bool {
+	for _, term := range terms {
+		if NodeMatchesNodeSelectorTerm(node, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// NodeMatchesNodeSelectorTerm returns true if the node's labels satisfy all of
+// the term's requirements.
+func NodeMatchesNodeSelectorTerm(node *api.Node, term api.NodeSelectorTerm) bool {
+	if len(term.MatchExpressions) == 0 {
+		return false
+	}
+	for _, requirement := range term.MatchExpressions {
+		if !nodeSelectorRequirementMatches(requirement, node.Labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func nodeSelectorRequirementMatches(requirement api.NodeSelectorRequirement, nodeLabels map[string]string) bool {
+	value, found := nodeLabels[requirement.Key]
+	switch requirement.Operator {
+	case api.NodeSelectorOpIn:
+		return found && util.NewStringSet(requirement.Values...).Has(value)
+	case api.NodeSelectorOpNotIn:
+		return !found || !util.NewStringSet(requirement.Values...).Has(value)
+	case api.NodeSelectorOpExists:
+		return found
+	case api.NodeSelectorOpDoesNotExist:
+		return !found
+	case api.NodeSelectorOpGt, api.NodeSelectorOpLt:
+		if !found || len(requirement.Values) != 1 {
+			return false
+		}
+		nodeValue, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return false
+		}
+		bound, err := strconv.ParseInt(requirement.Values[0], 10, 64)
+		if err != nil {
+			return false
+		}
+		if requirement.Operator == api.NodeSelectorOpGt {
+			return nodeValue > bound
+		}
+		return nodeValue < bound
+	}
+	return false
+}
+
+// PodMatchesNodeAffinity returns true if the node satisfies the pod's
+// required node affinity terms, or if the pod has none.
+func PodMatchesNodeAffinity(pod *api.Pod, node *api.Node) bool {
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	return NodeMatchesNodeSelectorTerms(node, affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+}
+
+type NodeAffinityChecker struct {
+	info NodeInfo
+}
+
+func NewNodeAffinityPredicate(info NodeInfo) algorithm.FitPredicate {
+	checker := &NodeAffinityChecker{
+		info: info,
+	}
+	return checker.PodMatchesNodeAffinity
+}
+
+// PodMatchesNodeAffinity checks whether the node satisfies the pod's required
+// node affinity.  Preferred terms are left to the NodeAffinityPriority.
+func (n *NodeAffinityChecker) PodMatchesNodeAffinity(pod *api.Pod, existingPods []*api.Pod, node string) (bool, error) {
+	minion, err := n.info.GetNodeInfo(node)
+	if err != nil {
+		return false, err
+	}
+	return PodMatchesNodeAffinity(pod, minion), nil
+}
+
+// TolerationToleratesTaint returns true if the toleration matches the taint.
+// An empty toleration key or effect matches any taint key or effect.
+func TolerationToleratesTaint(toleration api.Toleration, taint api.Taint) bool {
+	if len(toleration.Effect) > 0 && toleration.Effect != taint.Effect {
+		return false
+	}
+	if len(toleration.Key) > 0 && toleration.Key != taint.Key {
+		return false
+	}
+	switch toleration.Operator {
+	case api.TolerationOpExists:
+		return true
+	case api.TolerationOpEqual, "":
+		return toleration.Value == taint.Value
+	default:
+		return false
+	}
+}
+
+// TaintToleratedByTolerations returns true if any of the tolerations matches the taint.
+func TaintToleratedByTolerations(taint api.Taint, tolerations []api.Toleration) bool {
+	for _, toleration := range tolerations {
+		if TolerationToleratesTaint(toleration, taint) {
+			return true
+		}
+	}
+	return false
+}
+
+type TolerationMatch struct {
+	info NodeInfo
+}
+
+func NewTolerationMatchPredicate(info NodeInfo) algorithm.FitPredicate {
+	tolerationMatch := &TolerationMatch{
+		info: info,
+	}
+	return tolerationMatch.PodToleratesNodeTaints
+}
+
+// PodToleratesNodeTaints checks that the pod tolerates every NoSchedule taint on the node.
+// PreferNoSchedule taints are left to the TaintTolerationPriority.
+func (t *TolerationMatch) PodToleratesNodeTaints(pod *api.Pod, existingPods []*api.Pod, node string) (bool, error) {
+	minion, err := t.info.GetNodeInfo(node)
+	if err != nil {
+		return false, err
+	}
+	for _, taint := range minion.Spec.Taints {
+		if taint.Effect == api.TaintEffectNoSchedule && !TaintToleratedByTolerations(taint, pod.Spec.Tolerations) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func PodFitsHost(pod *api.Pod, existingPods []*api.Pod, node string) (bool, error) {
 	if len(pod.Spec.NodeName) == 0 {
 		return true, nil
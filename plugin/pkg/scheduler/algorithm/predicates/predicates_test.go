@@ -59,7 +59,7 @@ func newResourcePod(usage ...resourceRequest) *api.Pod {
 	for _, req := range usage {
 		containers = append(containers, api.Container{
 			Resources: api.ResourceRequirements{
-				Limits: api.ResourceList{
+				Requests: api.ResourceList{
 					api.ResourceCPU:    *resource.NewMilliQuantity(req.milliCPU, resource.DecimalSI),
 					api.ResourceMemory: *resource.NewQuantity(req.memory, resource.BinarySI),
 				},
@@ -73,6 +73,11 @@ func newResourcePod(usage ...resourceRequest) *api.Pod {
 	}
 }
 
+func newResourceInitPod(pod *api.Pod, usage ...resourceRequest) *api.Pod {
+	pod.Spec.InitContainers = newResourcePod(usage...).Spec.Containers
+	return pod
+}
+
 func TestPodFitsResources(t *testing.T) {
 
 	enoughPodsTests := []struct {
@@ -121,6 +126,22 @@ func TestPodFitsResources(t *testing.T) {
 			fits: true,
 			test: "equal edge case",
 		},
+		{
+			pod: newResourceInitPod(newResourcePod(resourceRequest{milliCPU: 1, memory: 1}), resourceRequest{milliCPU: 3, memory: 1}),
+			existingPods: []*api.Pod{
+				newResourcePod(resourceRequest{milliCPU: 8, memory: 19}),
+			},
+			fits: false,
+			test: "too many resources fails due to init container cpu",
+		},
+		{
+			pod: newResourceInitPod(newResourcePod(resourceRequest{milliCPU: 1, memory: 1}), resourceRequest{milliCPU: 2, memory: 1}, resourceRequest{milliCPU: 1, memory: 1}),
+			existingPods: []*api.Pod{
+				newResourcePod(resourceRequest{milliCPU: 8, memory: 19}),
+			},
+			fits: true,
+			test: "init container requests are not summed",
+		},
 	}
 
 	for _, test := range enoughPodsTests {
@@ -179,6 +200,23 @@ func TestPodFitsResources(t *testing.T) {
 			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
 		}
 	}
+
+	// Only the allocatable resources of a node are available to pods.
+	for _, test := range enoughPodsTests {
+		node := api.Node{Status: api.NodeStatus{
+			Capacity:    makeResources(20, 40, 32).Capacity,
+			Allocatable: makeResources(10, 20, 32).Capacity,
+		}}
+
+		fit := ResourceFit{FakeNodeInfo(node)}
+		fits, err := fit.PodFitsResources(test.pod, test.existingPods, "machine")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s with allocatable resources: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
 }
 
 func TestPodFitsHost(t *testing.T) {
@@ -517,6 +555,208 @@ func TestPodFitsSelector(t *testing.T) {
 	}
 }
 
+func TestPodFitsNodeAffinity(t *testing.T) {
+	required := func(terms ...api.NodeSelectorTerm) *api.Affinity {
+		return &api.Affinity{NodeAffinity: &api.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{NodeSelectorTerms: terms},
+		}}
+	}
+	term := func(reqs ...api.NodeSelectorRequirement) api.NodeSelectorTerm {
+		return api.NodeSelectorTerm{MatchExpressions: reqs}
+	}
+	tests := []struct {
+		affinity *api.Affinity
+		labels   map[string]string
+		fits     bool
+		test     string
+	}{
+		{
+			fits: true,
+			test: "no affinity",
+		},
+		{
+			affinity: &api.Affinity{NodeAffinity: &api.NodeAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []api.PreferredSchedulingTerm{{
+					Weight:     1,
+					Preference: term(api.NodeSelectorRequirement{Key: "foo", Operator: api.NodeSelectorOpExists}),
+				}},
+			}},
+			fits: true,
+			test: "preferred terms are not enforced",
+		},
+		{
+			affinity: required(term(api.NodeSelectorRequirement{Key: "foo", Operator: api.NodeSelectorOpIn, Values: []string{"bar", "value2"}})),
+			labels:   map[string]string{"foo": "bar"},
+			fits:     true,
+			test:     "In matches",
+		},
+		{
+			affinity: required(term(api.NodeSelectorRequirement{Key: "foo", Operator: api.NodeSelectorOpIn, Values: []string{"value1", "value2"}})),
+			labels:   map[string]string{"foo": "bar"},
+			fits:     false,
+			test:     "In does not match",
+		},
+		{
+			affinity: required(term(api.NodeSelectorRequirement{Key: "foo", Operator: api.NodeSelectorOpNotIn, Values: []string{"bar"}})),
+			labels:   map[string]string{"foo": "baz"},
+			fits:     true,
+			test:     "NotIn matches",
+		},
+		{
+			affinity: required(term(api.NodeSelectorRequirement{Key: "foo", Operator: api.NodeSelectorOpNotIn, Values: []string{"bar"}})),
+			labels:   map[string]string{"foo": "bar"},
+			fits:     false,
+			test:     "NotIn does not match",
+		},
+		{
+			affinity: required(term(api.NodeSelectorRequirement{Key: "foo", Operator: api.NodeSelectorOpExists})),
+			labels:   map[string]string{"foo": "bar"},
+			fits:     true,
+			test:     "Exists matches",
+		},
+		{
+			affinity: required(term(api.NodeSelectorRequirement{Key: "foo", Operator: api.NodeSelectorOpDoesNotExist})),
+			labels:   map[string]string{"foo": "bar"},
+			fits:     false,
+			test:     "DoesNotExist does not match",
+		},
+		{
+			affinity: required(term(api.NodeSelectorRequirement{Key: "gpus", Operator: api.NodeSelectorOpGt, Values: []string{"1"}})),
+			labels:   map[string]string{"gpus": "2"},
+			fits:     true,
+			test:     "Gt matches",
+		},
+		{
+			affinity: required(term(api.NodeSelectorRequirement{Key: "gpus", Operator: api.NodeSelectorOpLt, Values: []string{"1"}})),
+			labels:   map[string]string{"gpus": "2"},
+			fits:     false,
+			test:     "Lt does not match",
+		},
+		{
+			affinity: required(term(api.NodeSelectorRequirement{Key: "gpus", Operator: api.NodeSelectorOpGt, Values: []string{"1"}})),
+			labels:   map[string]string{"gpus": "many"},
+			fits:     false,
+			test:     "Gt with a non-integer label does not match",
+		},
+		{
+			affinity: required(term(
+				api.NodeSelectorRequirement{Key: "foo", Operator: api.NodeSelectorOpIn, Values: []string{"bar"}},
+				api.NodeSelectorRequirement{Key: "baz", Operator: api.NodeSelectorOpExists},
+			)),
+			labels: map[string]string{"foo": "bar"},
+			fits:   false,
+			test:   "requirements within a term are ANDed",
+		},
+		{
+			affinity: required(
+				term(api.NodeSelectorRequirement{Key: "foo", Operator: api.NodeSelectorOpIn, Values: []string{"other"}}),
+				term(api.NodeSelectorRequirement{Key: "foo", Operator: api.NodeSelectorOpExists}),
+			),
+			labels: map[string]string{"foo": "bar"},
+			fits:   true,
+			test:   "terms are ORed",
+		},
+		{
+			affinity: required(api.NodeSelectorTerm{}),
+			labels:   map[string]string{"foo": "bar"},
+			fits:     false,
+			test:     "an empty term matches no nodes",
+		},
+	}
+	for _, test := range tests {
+		node := api.Node{ObjectMeta: api.ObjectMeta{Labels: test.labels}}
+		pod := &api.Pod{Spec: api.PodSpec{Affinity: test.affinity}}
+
+		fit := NodeAffinityChecker{FakeNodeInfo(node)}
+		fits, err := fit.PodMatchesNodeAffinity(pod, []*api.Pod{}, "machine")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
+}
+
+func TestPodToleratesTaints(t *testing.T) {
+	tests := []struct {
+		tolerations []api.Toleration
+		taints      []api.Taint
+		fits        bool
+		test        string
+	}{
+		{
+			fits: true,
+			test: "untainted node",
+		},
+		{
+			taints: []api.Taint{{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}},
+			fits:   false,
+			test:   "pod without tolerations on a NoSchedule node",
+		},
+		{
+			taints: []api.Taint{{Key: "dedicated", Value: "infra", Effect: api.TaintEffectPreferNoSchedule}},
+			fits:   true,
+			test:   "PreferNoSchedule taints do not block scheduling",
+		},
+		{
+			tolerations: []api.Toleration{{Key: "dedicated", Operator: api.TolerationOpEqual, Value: "infra", Effect: api.TaintEffectNoSchedule}},
+			taints:      []api.Taint{{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}},
+			fits:        true,
+			test:        "Equal toleration matches",
+		},
+		{
+			tolerations: []api.Toleration{{Key: "dedicated", Operator: api.TolerationOpEqual, Value: "gpu"}},
+			taints:      []api.Taint{{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}},
+			fits:        false,
+			test:        "Equal toleration with another value",
+		},
+		{
+			tolerations: []api.Toleration{{Key: "dedicated", Operator: api.TolerationOpExists}},
+			taints:      []api.Taint{{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}},
+			fits:        true,
+			test:        "Exists toleration matches any value",
+		},
+		{
+			tolerations: []api.Toleration{{Key: "dedicated", Operator: api.TolerationOpExists, Effect: api.TaintEffectPreferNoSchedule}},
+			taints:      []api.Taint{{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}},
+			fits:        false,
+			test:        "toleration for another effect",
+		},
+		{
+			tolerations: []api.Toleration{{Operator: api.TolerationOpExists}},
+			taints: []api.Taint{
+				{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule},
+				{Key: "maintenance", Effect: api.TaintEffectNoSchedule},
+			},
+			fits: true,
+			test: "empty key with Exists tolerates everything",
+		},
+		{
+			tolerations: []api.Toleration{{Key: "dedicated", Operator: api.TolerationOpExists}},
+			taints: []api.Taint{
+				{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule},
+				{Key: "maintenance", Effect: api.TaintEffectNoSchedule},
+			},
+			fits: false,
+			test: "every NoSchedule taint must be tolerated",
+		},
+	}
+	for _, test := range tests {
+		node := api.Node{Spec: api.NodeSpec{Taints: test.taints}}
+		pod := &api.Pod{Spec: api.PodSpec{Tolerations: test.tolerations}}
+
+		fit := TolerationMatch{FakeNodeInfo(node)}
+		fits, err := fit.PodToleratesNodeTaints(pod, []*api.Pod{}, "machine")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
+}
+
 func TestNodeLabelPresence(t *testing.T) {
 	label := map[string]string{"foo": "bar", "bar": "foo"}
 	tests := []struct {
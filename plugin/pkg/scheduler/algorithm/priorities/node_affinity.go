@@ -0,0 +1,68 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+	"github.com/golang/glog"
+)
+
+// NodeAffinityPriority favors nodes that satisfy the pod's preferred node affinity terms.
+// Each node's score is the sum of the weights of the terms it matches, scaled so that the
+// best matching node gets 10.  Nodes matching no term, or every node when the pod has no
+// preferences, get 0.
+func NodeAffinityPriority(pod *api.Pod, podLister algorithm.PodLister, minionLister algorithm.MinionLister) (algorithm.HostPriorityList, error) {
+	minions, err := minionLister.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var preferred []api.PreferredSchedulingTerm
+	if affinity := pod.Spec.Affinity; affinity != nil && affinity.NodeAffinity != nil {
+		preferred = affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	}
+
+	var maxCount int
+	counts := map[string]int{}
+	for i := range minions.Items {
+		minion := &minions.Items[i]
+		for _, term := range preferred {
+			if term.Weight == 0 {
+				continue
+			}
+			if predicates.NodeMatchesNodeSelectorTerm(minion, term.Preference) {
+				counts[minion.Name] += term.Weight
+			}
+		}
+		if counts[minion.Name] > maxCount {
+			maxCount = counts[minion.Name]
+		}
+	}
+
+	result := []algorithm.HostPriority{}
+	for _, minion := range minions.Items {
+		fScore := float32(0)
+		if maxCount > 0 {
+			fScore = 10 * (float32(counts[minion.Name]) / float32(maxCount))
+		}
+		result = append(result, algorithm.HostPriority{Host: minion.Name, Score: int(fScore)})
+		glog.V(10).Infof("%v -> %v: NodeAffinityPriority, Score: (%d)", pod.Name, minion.Name, int(fScore))
+	}
+	return result, nil
+}
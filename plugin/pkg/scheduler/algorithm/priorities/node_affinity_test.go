@@ -0,0 +1,155 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+func TestNodeAffinityPriority(t *testing.T) {
+	label1 := map[string]string{"foo": "bar"}
+	label2 := map[string]string{"key": "value"}
+	label3 := map[string]string{"az": "az1"}
+	label4 := map[string]string{"abc": "az11", "def": "az22"}
+	label5 := map[string]string{"foo": "bar", "key": "value", "az": "az1"}
+
+	affinity1 := &api.Affinity{NodeAffinity: &api.NodeAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []api.PreferredSchedulingTerm{{
+			Weight: 2,
+			Preference: api.NodeSelectorTerm{
+				MatchExpressions: []api.NodeSelectorRequirement{{
+					Key:      "foo",
+					Operator: api.NodeSelectorOpIn,
+					Values:   []string{"bar"},
+				}},
+			},
+		}},
+	}}
+
+	affinity2 := &api.Affinity{NodeAffinity: &api.NodeAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []api.PreferredSchedulingTerm{
+			{
+				Weight: 2,
+				Preference: api.NodeSelectorTerm{
+					MatchExpressions: []api.NodeSelectorRequirement{{
+						Key:      "foo",
+						Operator: api.NodeSelectorOpIn,
+						Values:   []string{"bar"},
+					}},
+				},
+			},
+			{
+				Weight: 4,
+				Preference: api.NodeSelectorTerm{
+					MatchExpressions: []api.NodeSelectorRequirement{{
+						Key:      "key",
+						Operator: api.NodeSelectorOpIn,
+						Values:   []string{"value"},
+					}},
+				},
+			},
+			{
+				Weight: 5,
+				Preference: api.NodeSelectorTerm{
+					MatchExpressions: []api.NodeSelectorRequirement{
+						{
+							Key:      "foo",
+							Operator: api.NodeSelectorOpIn,
+							Values:   []string{"bar"},
+						},
+						{
+							Key:      "key",
+							Operator: api.NodeSelectorOpIn,
+							Values:   []string{"value"},
+						},
+						{
+							Key:      "az",
+							Operator: api.NodeSelectorOpIn,
+							Values:   []string{"az1"},
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	tests := []struct {
+		pod          *api.Pod
+		nodes        []api.Node
+		expectedList algorithm.HostPriorityList
+		test         string
+	}{
+		{
+			pod: &api.Pod{},
+			nodes: []api.Node{
+				{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: label1}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: label2}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: label3}},
+			},
+			expectedList: []algorithm.HostPriority{{"machine1", 0}, {"machine2", 0}, {"machine3", 0}},
+			test:         "pod without affinity, all nodes score 0",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Affinity: affinity1}},
+			nodes: []api.Node{
+				{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: label4}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: label2}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: label3}},
+			},
+			expectedList: []algorithm.HostPriority{{"machine1", 0}, {"machine2", 0}, {"machine3", 0}},
+			test:         "no node matches the preferred terms, all nodes score 0",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Affinity: affinity1}},
+			nodes: []api.Node{
+				{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: label1}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: label2}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: label3}},
+			},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 0}, {"machine3", 0}},
+			test:         "only machine1 matches the preferred term",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Affinity: affinity2}},
+			nodes: []api.Node{
+				{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: label1}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine5", Labels: label5}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: label2}},
+			},
+			expectedList: []algorithm.HostPriority{{"machine1", 1}, {"machine5", 10}, {"machine2", 3}},
+			test:         "scores are proportional to the summed weights of matched terms",
+		},
+	}
+
+	for _, test := range tests {
+		list, err := NodeAffinityPriority(test.pod, algorithm.FakePodLister(nil), algorithm.FakeMinionLister(api.NodeList{Items: test.nodes}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// sort the two lists to avoid failures on account of different ordering
+		sort.Sort(test.expectedList)
+		sort.Sort(list)
+		if !reflect.DeepEqual(test.expectedList, list) {
+			t.Errorf("%s: expected %#v, got %#v", test.test, test.expectedList, list)
+		}
+	}
+}
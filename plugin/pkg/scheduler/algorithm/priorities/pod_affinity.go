@@ -0,0 +1,125 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+	"github.com/golang/glog"
+)
+
+// InterPodAffinityPriority favors nodes whose topology domains run pods selected by
+// the pod's preferred affinity terms, and disfavors those running pods selected by
+// its preferred anti-affinity terms.  The preferred terms of pods already scheduled
+// are applied symmetrically: a node scores higher when it shares a domain with a pod
+// that prefers to be near the pod being scheduled, and lower when it shares a domain
+// with a pod that prefers to be away from it.  Scores are scaled to the range 0-10.
+func InterPodAffinityPriority(pod *api.Pod, podLister algorithm.PodLister, minionLister algorithm.MinionLister) (algorithm.HostPriorityList, error) {
+	minions, err := minionLister.List()
+	if err != nil {
+		return nil, err
+	}
+	allPods, err := podLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	nodesByName := map[string]*api.Node{}
+	for i := range minions.Items {
+		nodesByName[minions.Items[i].Name] = &minions.Items[i]
+	}
+
+	counts := map[string]int{}
+	// add adds weight to every node that shares a topology domain with node.
+	add := func(node *api.Node, topologyKey string, weight int) {
+		for i := range minions.Items {
+			minion := &minions.Items[i]
+			if predicates.NodesShareTopology(minion, node, topologyKey) {
+				counts[minion.Name] += weight
+			}
+		}
+	}
+
+	var preferredAffinity, preferredAntiAffinity []api.WeightedPodAffinityTerm
+	if affinity := pod.Spec.Affinity; affinity != nil {
+		if affinity.PodAffinity != nil {
+			preferredAffinity = affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+		}
+		if affinity.PodAntiAffinity != nil {
+			preferredAntiAffinity = affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+		}
+	}
+
+	for _, existing := range allPods {
+		if len(existing.Spec.NodeName) == 0 || (existing.Namespace == pod.Namespace && existing.Name == pod.Name) {
+			continue
+		}
+		node, ok := nodesByName[existing.Spec.NodeName]
+		if !ok {
+			continue
+		}
+		for _, term := range preferredAffinity {
+			if predicates.PodMatchesAffinityTerm(pod, existing, term.PodAffinityTerm) {
+				add(node, term.PodAffinityTerm.TopologyKey, term.Weight)
+			}
+		}
+		for _, term := range preferredAntiAffinity {
+			if predicates.PodMatchesAffinityTerm(pod, existing, term.PodAffinityTerm) {
+				add(node, term.PodAffinityTerm.TopologyKey, -term.Weight)
+			}
+		}
+		if affinity := existing.Spec.Affinity; affinity != nil {
+			if affinity.PodAffinity != nil {
+				for _, term := range affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+					if predicates.PodMatchesAffinityTerm(existing, pod, term.PodAffinityTerm) {
+						add(node, term.PodAffinityTerm.TopologyKey, term.Weight)
+					}
+				}
+			}
+			if affinity.PodAntiAffinity != nil {
+				for _, term := range affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+					if predicates.PodMatchesAffinityTerm(existing, pod, term.PodAffinityTerm) {
+						add(node, term.PodAffinityTerm.TopologyKey, -term.Weight)
+					}
+				}
+			}
+		}
+	}
+
+	var maxCount, minCount int
+	for _, minion := range minions.Items {
+		if counts[minion.Name] > maxCount {
+			maxCount = counts[minion.Name]
+		}
+		if counts[minion.Name] < minCount {
+			minCount = counts[minion.Name]
+		}
+	}
+
+	result := []algorithm.HostPriority{}
+	for _, minion := range minions.Items {
+		fScore := float32(0)
+		if maxCount > minCount {
+			fScore = 10 * (float32(counts[minion.Name]-minCount) / float32(maxCount-minCount))
+		}
+		result = append(result, algorithm.HostPriority{Host: minion.Name, Score: int(fScore)})
+		glog.V(10).Infof("%v -> %v: InterPodAffinityPriority, Score: (%d)", pod.Name, minion.Name, int(fScore))
+	}
+	return result, nil
+}
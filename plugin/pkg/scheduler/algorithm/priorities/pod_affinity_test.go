@@ -0,0 +1,98 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+func TestInterPodAffinityPriority(t *testing.T) {
+	nodes := []api.Node{
+		{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: map[string]string{"zone": "z1"}}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: map[string]string{"zone": "z1"}}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: map[string]string{"zone": "z2"}}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine4"}},
+	}
+	pod := func(name, node string, labels map[string]string, affinity *api.Affinity) *api.Pod {
+		return &api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Spec:       api.PodSpec{NodeName: node, Affinity: affinity},
+		}
+	}
+	preferred := func(weight int, selector map[string]string) []api.WeightedPodAffinityTerm {
+		return []api.WeightedPodAffinityTerm{{
+			Weight:          weight,
+			PodAffinityTerm: api.PodAffinityTerm{Selector: selector, TopologyKey: "zone"},
+		}}
+	}
+	cache := map[string]string{"app": "cache"}
+	web := map[string]string{"app": "web"}
+	db := map[string]string{"app": "db"}
+
+	tests := []struct {
+		pod          *api.Pod
+		pods         []*api.Pod
+		expectedList algorithm.HostPriorityList
+		test         string
+	}{
+		{
+			pod:          pod("p", "", web, nil),
+			pods:         []*api.Pod{pod("c", "machine1", cache, nil)},
+			expectedList: []algorithm.HostPriority{{"machine1", 0}, {"machine2", 0}, {"machine3", 0}, {"machine4", 0}},
+			test:         "no affinity, all nodes score 0",
+		},
+		{
+			pod:          pod("p", "", web, &api.Affinity{PodAffinity: &api.PodAffinity{PreferredDuringSchedulingIgnoredDuringExecution: preferred(5, cache)}}),
+			pods:         []*api.Pod{pod("c", "machine1", cache, nil)},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 10}, {"machine3", 0}, {"machine4", 0}},
+			test:         "affinity prefers the zone of the matching pod",
+		},
+		{
+			pod:          pod("p", "", db, &api.Affinity{PodAntiAffinity: &api.PodAntiAffinity{PreferredDuringSchedulingIgnoredDuringExecution: preferred(5, db)}}),
+			pods:         []*api.Pod{pod("d", "machine3", db, nil)},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 10}, {"machine3", 0}, {"machine4", 10}},
+			test:         "anti-affinity avoids the zone of the matching pod",
+		},
+		{
+			pod: pod("p", "", web, nil),
+			pods: []*api.Pod{
+				pod("c", "machine3", cache, &api.Affinity{PodAffinity: &api.PodAffinity{PreferredDuringSchedulingIgnoredDuringExecution: preferred(5, web)}}),
+				pod("d", "machine1", db, &api.Affinity{PodAntiAffinity: &api.PodAntiAffinity{PreferredDuringSchedulingIgnoredDuringExecution: preferred(5, web)}}),
+			},
+			expectedList: []algorithm.HostPriority{{"machine1", 0}, {"machine2", 0}, {"machine3", 10}, {"machine4", 5}},
+			test:         "existing pods' preferences are applied symmetrically",
+		},
+	}
+
+	for _, test := range tests {
+		list, err := InterPodAffinityPriority(test.pod, algorithm.FakePodLister(test.pods), algorithm.FakeMinionLister(api.NodeList{Items: nodes}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// sort the two lists to avoid failures on account of different ordering
+		sort.Sort(test.expectedList)
+		sort.Sort(list)
+		if !reflect.DeepEqual(test.expectedList, list) {
+			t.Errorf("%s: expected %#v, got %#v", test.test, test.expectedList, list)
+		}
+	}
+}
@@ -49,16 +49,16 @@ func TestZeroLimit(t *testing.T) {
 	}
 	noResources1 := noResources
 	noResources1.NodeName = "machine1"
-	// A pod with the same resources as a 0-limit pod gets by default as its resources (for spreading).
+	// A pod with the same resources as a 0-request pod gets by default as its resources (for spreading).
 	small := api.PodSpec{
 		Containers: []api.Container{
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu": resource.MustParse(
-							strconv.FormatInt(defaultMilliCpuLimit, 10) + "m"),
+							strconv.FormatInt(defaultMilliCpuRequest, 10) + "m"),
 						"memory": resource.MustParse(
-							strconv.FormatInt(defaultMemoryLimit, 10)),
+							strconv.FormatInt(defaultMemoryRequest, 10)),
 					},
 				},
 			},
@@ -71,11 +71,11 @@ func TestZeroLimit(t *testing.T) {
 		Containers: []api.Container{
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu": resource.MustParse(
-							strconv.FormatInt(defaultMilliCpuLimit*3, 10) + "m"),
+							strconv.FormatInt(defaultMilliCpuRequest*3, 10) + "m"),
 						"memory": resource.MustParse(
-							strconv.FormatInt(defaultMemoryLimit*3, 10)),
+							strconv.FormatInt(defaultMemoryRequest*3, 10)),
 					},
 				},
 			},
@@ -91,13 +91,13 @@ func TestZeroLimit(t *testing.T) {
 		nodes []api.Node
 		test  string
 	}{
-		// The point of these next two tests is to show you get the same priority for a zero-limit pod
-		// as for a pod with the defaults limits, both when the zero-limit pod is already on the machine
-		// and when the zero-limit pod is the one being scheduled.
+		// The point of these next two tests is to show you get the same priority for a zero-request pod
+		// as for a pod with the default requests, both when the zero-request pod is already on the machine
+		// and when the zero-request pod is the one being scheduled.
 		{
 			pod:   &api.Pod{Spec: noResources},
-			nodes: []api.Node{makeMinion("machine1", 1000, defaultMemoryLimit*10), makeMinion("machine2", 1000, defaultMemoryLimit*10)},
-			test:  "test priority of zero-limit pod with machine with zero-limit pod",
+			nodes: []api.Node{makeMinion("machine1", 1000, defaultMemoryRequest*10), makeMinion("machine2", 1000, defaultMemoryRequest*10)},
+			test:  "test priority of zero-request pod with machine with zero-request pod",
 			pods: []*api.Pod{
 				{Spec: large1}, {Spec: noResources1},
 				{Spec: large2}, {Spec: small2},
@@ -105,8 +105,8 @@ func TestZeroLimit(t *testing.T) {
 		},
 		{
 			pod:   &api.Pod{Spec: small},
-			nodes: []api.Node{makeMinion("machine1", 1000, defaultMemoryLimit*10), makeMinion("machine2", 1000, defaultMemoryLimit*10)},
-			test:  "test priority of nonzero-limit pod with machine with zero-limit pod",
+			nodes: []api.Node{makeMinion("machine1", 1000, defaultMemoryRequest*10), makeMinion("machine2", 1000, defaultMemoryRequest*10)},
+			test:  "test priority of nonzero-request pod with machine with zero-request pod",
 			pods: []*api.Pod{
 				{Spec: large1}, {Spec: noResources1},
 				{Spec: large2}, {Spec: small2},
@@ -115,8 +115,8 @@ func TestZeroLimit(t *testing.T) {
 		// The point of this test is to verify that we're not just getting the same score no matter what we schedule.
 		{
 			pod:   &api.Pod{Spec: large},
-			nodes: []api.Node{makeMinion("machine1", 1000, defaultMemoryLimit*10), makeMinion("machine2", 1000, defaultMemoryLimit*10)},
-			test:  "test priority of larger pod with machine with zero-limit pod",
+			nodes: []api.Node{makeMinion("machine1", 1000, defaultMemoryRequest*10), makeMinion("machine2", 1000, defaultMemoryRequest*10)},
+			test:  "test priority of larger pod with machine with zero-request pod",
 			pods: []*api.Pod{
 				{Spec: large1}, {Spec: noResources1},
 				{Spec: large2}, {Spec: small2},
@@ -133,12 +133,13 @@ func TestZeroLimit(t *testing.T) {
 			// plugin/pkg/scheduler/algorithmprovider/defaults/defaults.go if you want
 			// to test what's actually in production.
 			[]algorithm.PriorityConfig{{Function: LeastRequestedPriority, Weight: 1}, {Function: BalancedResourceAllocation, Weight: 1}, {Function: NewServiceSpreadPriority(algorithm.FakeServiceLister([]api.Service{})), Weight: 1}},
-			algorithm.FakeMinionLister(api.NodeList{Items: test.nodes}))
+			algorithm.FakeMinionLister(api.NodeList{Items: test.nodes}),
+			[]algorithm.SchedulerExtender{})
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 		}
 		for _, hp := range list {
-			if test.test == "test priority of larger pod with machine with zero-limit pod" {
+			if test.test == "test priority of larger pod with machine with zero-request pod" {
 				if hp.Score == expectedPriority {
 					t.Error("%s: expected non-%d for all priorities, got list %#v", expectedPriority, list)
 				}
@@ -174,7 +175,7 @@ func TestLeastRequested(t *testing.T) {
 		Containers: []api.Container{
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("1000m"),
 						"memory": resource.MustParse("0"),
 					},
@@ -182,7 +183,7 @@ func TestLeastRequested(t *testing.T) {
 			},
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("2000m"),
 						"memory": resource.MustParse("0"),
 					},
@@ -197,7 +198,7 @@ func TestLeastRequested(t *testing.T) {
 		Containers: []api.Container{
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("1000m"),
 						"memory": resource.MustParse("2000"),
 					},
@@ -205,7 +206,7 @@ func TestLeastRequested(t *testing.T) {
 			},
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("2000m"),
 						"memory": resource.MustParse("3000"),
 					},
@@ -506,7 +507,7 @@ func TestBalancedResourceAllocation(t *testing.T) {
 		Containers: []api.Container{
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("1000m"),
 						"memory": resource.MustParse("0"),
 					},
@@ -514,7 +515,7 @@ func TestBalancedResourceAllocation(t *testing.T) {
 			},
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("2000m"),
 						"memory": resource.MustParse("0"),
 					},
@@ -529,7 +530,7 @@ func TestBalancedResourceAllocation(t *testing.T) {
 		Containers: []api.Container{
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("1000m"),
 						"memory": resource.MustParse("2000"),
 					},
@@ -537,7 +538,7 @@ func TestBalancedResourceAllocation(t *testing.T) {
 			},
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("2000m"),
 						"memory": resource.MustParse("3000"),
 					},
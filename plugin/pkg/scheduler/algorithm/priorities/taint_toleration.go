@@ -0,0 +1,69 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+	"github.com/golang/glog"
+)
+
+// countIntolerableTaints counts the PreferNoSchedule taints on the node that the tolerations do not match.
+func countIntolerableTaints(taints []api.Taint, tolerations []api.Toleration) int {
+	count := 0
+	for _, taint := range taints {
+		if taint.Effect != api.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !predicates.TaintToleratedByTolerations(taint, tolerations) {
+			count++
+		}
+	}
+	return count
+}
+
+// TaintTolerationPriority favors nodes with fewer PreferNoSchedule taints that the pod
+// does not tolerate.  The node(s) with the fewest such taints get 10, and the node(s)
+// with the most get 0.
+func TaintTolerationPriority(pod *api.Pod, podLister algorithm.PodLister, minionLister algorithm.MinionLister) (algorithm.HostPriorityList, error) {
+	minions, err := minionLister.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var maxCount int
+	counts := map[string]int{}
+	for _, minion := range minions.Items {
+		count := countIntolerableTaints(minion.Spec.Taints, pod.Spec.Tolerations)
+		counts[minion.Name] = count
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+
+	result := []algorithm.HostPriority{}
+	for _, minion := range minions.Items {
+		fScore := float32(10)
+		if maxCount > 0 {
+			fScore = 10 * (1 - float32(counts[minion.Name])/float32(maxCount))
+		}
+		result = append(result, algorithm.HostPriority{Host: minion.Name, Score: int(fScore)})
+		glog.V(10).Infof("%v -> %v: TaintTolerationPriority, Score: (%d)", pod.Name, minion.Name, int(fScore))
+	}
+	return result, nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+func nodeWithTaints(name string, taints ...api.Taint) api.Node {
+	return api.Node{ObjectMeta: api.ObjectMeta{Name: name}, Spec: api.NodeSpec{Taints: taints}}
+}
+
+func TestTaintTolerationPriority(t *testing.T) {
+	preferInfra := api.Taint{Key: "dedicated", Value: "infra", Effect: api.TaintEffectPreferNoSchedule}
+	preferMaintenance := api.Taint{Key: "maintenance", Effect: api.TaintEffectPreferNoSchedule}
+	noScheduleInfra := api.Taint{Key: "dedicated", Value: "infra", Effect: api.TaintEffectNoSchedule}
+
+	tests := []struct {
+		tolerations  []api.Toleration
+		nodes        []api.Node
+		expectedList algorithm.HostPriorityList
+		test         string
+	}{
+		{
+			nodes:        []api.Node{nodeWithTaints("machine1"), nodeWithTaints("machine2")},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 10}},
+			test:         "no taints, all nodes score 10",
+		},
+		{
+			nodes: []api.Node{
+				nodeWithTaints("machine1"),
+				nodeWithTaints("machine2", preferInfra),
+				nodeWithTaints("machine3", preferInfra, preferMaintenance),
+			},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 5}, {"machine3", 0}},
+			test:         "nodes with more intolerable taints score lower",
+		},
+		{
+			tolerations: []api.Toleration{{Key: "dedicated", Operator: api.TolerationOpEqual, Value: "infra"}},
+			nodes: []api.Node{
+				nodeWithTaints("machine1"),
+				nodeWithTaints("machine2", preferInfra),
+				nodeWithTaints("machine3", preferInfra, preferMaintenance),
+			},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 10}, {"machine3", 0}},
+			test:         "tolerated taints do not count",
+		},
+		{
+			nodes:        []api.Node{nodeWithTaints("machine1"), nodeWithTaints("machine2", noScheduleInfra)},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 10}},
+			test:         "NoSchedule taints are left to the predicate",
+		},
+	}
+
+	for _, test := range tests {
+		pod := &api.Pod{Spec: api.PodSpec{Tolerations: test.tolerations}}
+		list, err := TaintTolerationPriority(pod, algorithm.FakePodLister(nil), algorithm.FakeMinionLister(api.NodeList{Items: test.nodes}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// sort the two lists to avoid failures on account of different ordering
+		sort.Sort(test.expectedList)
+		sort.Sort(list)
+		if !reflect.DeepEqual(test.expectedList, list) {
+			t.Errorf("%s: expected %#v, got %#v", test.test, test.expectedList, list)
+		}
+	}
+}
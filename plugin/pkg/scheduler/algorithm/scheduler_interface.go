@@ -25,3 +25,29 @@ import (
 type ScheduleAlgorithm interface {
 	Schedule(*api.Pod, MinionLister) (selectedMachine string, err error)
 }
+
+// SchedulePreemptor is implemented by schedule algorithms that can make room
+// for a pod that fits on no machine by preempting lower priority pods.
+type SchedulePreemptor interface {
+	// Preempt returns the machine on which the pod would fit once the returned
+	// victims are removed, or an empty string if preemption would not help.
+	Preempt(*api.Pod, MinionLister) (selectedMachine string, victims []*api.Pod, err error)
+}
+
+// SchedulerExtender is an interface for external processes to influence scheduling
+// decisions made by Kubernetes. This is typically needed for resources not directly
+// managed by Kubernetes.
+type SchedulerExtender interface {
+	// Filter based on extender implemented predicate functions. The filtered list is
+	// expected to be a subset of the supplied list.
+	Filter(pod *api.Pod, nodes *api.NodeList) (filteredNodes *api.NodeList, err error)
+
+	// Prioritize based on extender implemented priority functions. The returned scores & weight
+	// are used to compute the weighted score for an extender. The weighted scores are added to
+	// the scores computed by the Kubernetes scheduler. The total scores are used to do the host selection.
+	Prioritize(pod *api.Pod, nodes *api.NodeList) (hostPriorities HostPriorityList, weight int, err error)
+
+	// IsIgnorable returns true if scheduling should carry on without this extender
+	// when a call to it fails.
+	IsIgnorable() bool
+}
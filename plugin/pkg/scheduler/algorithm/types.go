@@ -49,6 +49,8 @@ func (h HostPriorityList) Swap(i, j int) {
 type PriorityFunction func(pod *api.Pod, podLister PodLister, minionLister MinionLister) (HostPriorityList, error)
 
 type PriorityConfig struct {
+	// Name identifies the priority function when explaining scheduling decisions.
+	Name     string
 	Function PriorityFunction
 	Weight   int
 }
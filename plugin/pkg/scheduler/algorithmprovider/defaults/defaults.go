@@ -56,6 +56,28 @@ func defaultPredicates() util.StringSet {
 		),
 		// Fit is determined by the presence of the Host parameter and a string match
 		factory.RegisterFitPredicate("HostName", predicates.PodFitsHost),
+		// Fit is determined by the pod's required node affinity terms.
+		factory.RegisterFitPredicateFactory(
+			"MatchNodeAffinity",
+			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
+				return predicates.NewNodeAffinityPredicate(args.NodeInfo)
+			},
+		),
+		// Fit is determined by whether the pod tolerates the node's NoSchedule taints.
+		factory.RegisterFitPredicateFactory(
+			"PodToleratesNodeTaints",
+			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
+				return predicates.NewTolerationMatchPredicate(args.NodeInfo)
+			},
+		),
+		// Fit is determined by the required inter-pod affinity and anti-affinity terms of
+		// the pod and of the pods already scheduled.
+		factory.RegisterFitPredicateFactory(
+			"MatchInterPodAffinity",
+			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
+				return predicates.NewPodAffinityPredicate(args.NodeInfo, args.PodLister)
+			},
+		),
 	)
 }
 
@@ -65,6 +87,12 @@ func defaultPriorities() util.StringSet {
 		factory.RegisterPriorityFunction("LeastRequestedPriority", priorities.LeastRequestedPriority, 1),
 		// Prioritizes nodes to help achieve balanced resource usage
 		factory.RegisterPriorityFunction("BalancedResourceAllocation", priorities.BalancedResourceAllocation, 1),
+		// Prioritizes nodes that match the pod's preferred node affinity terms.
+		factory.RegisterPriorityFunction("NodeAffinityPriority", priorities.NodeAffinityPriority, 1),
+		// Prioritizes nodes with fewer PreferNoSchedule taints that the pod does not tolerate.
+		factory.RegisterPriorityFunction("TaintTolerationPriority", priorities.TaintTolerationPriority, 1),
+		// Prioritizes nodes by the preferred inter-pod affinity and anti-affinity terms.
+		factory.RegisterPriorityFunction("InterPodAffinityPriority", priorities.InterPodAffinityPriority, 1),
 		// spreads pods by minimizing the number of pods (belonging to the same service) on the same minion.
 		factory.RegisterPriorityConfigFactory(
 			"ServiceSpreadingPriority",
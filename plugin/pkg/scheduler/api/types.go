@@ -17,6 +17,8 @@ limitations under the License.
 package api
 
 import (
+	"time"
+
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 )
 
@@ -26,6 +28,8 @@ type Policy struct {
 	Predicates []PredicatePolicy `json:"predicates"`
 	// Holds the information to configure the priority functions
 	Priorities []PriorityPolicy `json:"priorities"`
+	// Holds the information to communicate with the extender(s)
+	ExtenderConfigs []ExtenderConfig `json:"extenders"`
 }
 
 type PredicatePolicy struct {
@@ -102,3 +106,50 @@ type LabelPreference struct {
 	// If false, higher priority is given to minions that do not have the label
 	Presence bool `json:"presence"`
 }
+
+// Holds the parameters used to communicate with the extender. If a verb is unspecified/empty,
+// it is assumed that the extender chose not to provide that extension.
+type ExtenderConfig struct {
+	// URLPrefix at which the extender is available
+	URLPrefix string `json:"urlPrefix"`
+	// Verb for the filter call, empty if not supported. This verb is appended to the URLPrefix when issuing the filter call to extender.
+	FilterVerb string `json:"filterVerb,omitempty"`
+	// Verb for the prioritize call, empty if not supported. This verb is appended to the URLPrefix when issuing the prioritize call to extender.
+	PrioritizeVerb string `json:"prioritizeVerb,omitempty"`
+	// The numeric multiplier for the minion scores that the prioritize call generates.
+	// The weight should be a positive integer
+	Weight int `json:"weight,omitempty"`
+	// HTTPTimeout specifies the timeout duration for a call to the extender. Filter timeout fails the scheduling of the pod.
+	// Prioritize timeout is ignored, default priorities are used.
+	HTTPTimeout time.Duration `json:"httpTimeout,omitempty"`
+	// Ignorable specifies whether the scheduler should carry on without the extender when a call to it fails.
+	// If false, any error from the extender fails the scheduling of the pod.
+	Ignorable bool `json:"ignorable,omitempty"`
+}
+
+// ExtenderArgs represents the arguments needed by the extender to filter/prioritize
+// minions for a pod.
+type ExtenderArgs struct {
+	// Pod being scheduled
+	Pod api.Pod `json:"pod"`
+	// List of candidate minions where the pod can be scheduled
+	Nodes api.NodeList `json:"nodes"`
+}
+
+// ExtenderFilterResult represents the results of a filter call to an extender
+type ExtenderFilterResult struct {
+	// Filtered set of minions where the pod can be scheduled
+	Nodes api.NodeList `json:"nodes,omitempty"`
+	// Error message indicating failure
+	Error string `json:"error,omitempty"`
+}
+
+// HostPriority represents the priority of scheduling to a particular host, higher priority is better.
+type HostPriority struct {
+	// Name of the host
+	Host string `json:"host"`
+	// Score associated with the host
+	Score int `json:"score"`
+}
+
+type HostPriorityList []HostPriority
@@ -34,5 +34,17 @@ func ValidatePolicy(policy schedulerapi.Policy) error {
 		}
 	}
 
+	for _, extender := range policy.ExtenderConfigs {
+		if extender.URLPrefix == "" {
+			validationErrors = append(validationErrors, fmt.Errorf("Extender should have a URL prefix"))
+		}
+		if extender.PrioritizeVerb != "" && extender.Weight <= 0 {
+			validationErrors = append(validationErrors, fmt.Errorf("Priority for extender %s should have a positive weight applied to it", extender.URLPrefix))
+		}
+		if extender.HTTPTimeout < 0 {
+			validationErrors = append(validationErrors, fmt.Errorf("Extender %s should not have a negative HTTP timeout", extender.URLPrefix))
+		}
+	}
+
 	return errors.NewAggregate(validationErrors)
 }
@@ -0,0 +1,199 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+// DefaultDecisionLogSize is the number of scheduling decisions kept in memory
+// by default.  Each decision holds an entry per node, so the log is kept small.
+const DefaultDecisionLogSize = 100
+
+// SchedulingDecision explains the outcome of one attempt to schedule a pod.
+type SchedulingDecision struct {
+	// Pod is the namespace/name key of the pod.
+	Pod          string    `json:"pod"`
+	Timestamp    time.Time `json:"timestamp"`
+	SelectedNode string    `json:"selectedNode,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	// Nodes holds the nodes that fit ordered by descending score, followed by
+	// the nodes that did not fit ordered by name.
+	Nodes []NodeEvaluation `json:"nodes"`
+}
+
+// NodeEvaluation records how a single node fared for a pod.
+type NodeEvaluation struct {
+	Node string `json:"node"`
+	// FailedPredicates lists every predicate the node failed; it is empty if
+	// the node fits the pod.
+	FailedPredicates []string `json:"failedPredicates,omitempty"`
+	// Scores holds the weighted score given by each priority function.
+	Scores     map[string]int `json:"scores,omitempty"`
+	TotalScore int            `json:"totalScore"`
+}
+
+func podKey(pod *api.Pod) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
+func newSchedulingDecision(pod *api.Pod, minions api.NodeList) *SchedulingDecision {
+	d := &SchedulingDecision{
+		Pod:       podKey(pod),
+		Timestamp: time.Now(),
+		Nodes:     make([]NodeEvaluation, 0, len(minions.Items)),
+	}
+	for _, minion := range minions.Items {
+		d.Nodes = append(d.Nodes, NodeEvaluation{Node: minion.Name})
+	}
+	return d
+}
+
+// recordFailures notes the predicates each node failed.
+func (d *SchedulingDecision) recordFailures(failed FailedPredicateMap) {
+	for i := range d.Nodes {
+		if predicates, found := failed[d.Nodes[i].Node]; found {
+			d.Nodes[i].FailedPredicates = predicates.List()
+		}
+	}
+}
+
+// recordScores notes the total and per priority scores of the nodes that fit.
+func (d *SchedulingDecision) recordScores(priorityList algorithm.HostPriorityList, scores map[string]map[string]int) {
+	totals := map[string]int{}
+	for _, hostEntry := range priorityList {
+		totals[hostEntry.Host] = hostEntry.Score
+	}
+	for i := range d.Nodes {
+		d.Nodes[i].TotalScore = totals[d.Nodes[i].Node]
+		d.Nodes[i].Scores = scores[d.Nodes[i].Node]
+	}
+}
+
+// finish records the outcome and orders the nodes so that the winner and its
+// closest contenders come first.
+func (d *SchedulingDecision) finish(host string, err error) {
+	d.SelectedNode = host
+	if err != nil {
+		d.Error = err.Error()
+	}
+	sort.Sort(byOutcome(d.Nodes))
+}
+
+type byOutcome []NodeEvaluation
+
+func (n byOutcome) Len() int      { return len(n) }
+func (n byOutcome) Swap(i, j int) { n[i], n[j] = n[j], n[i] }
+func (n byOutcome) Less(i, j int) bool {
+	iFits, jFits := len(n[i].FailedPredicates) == 0, len(n[j].FailedPredicates) == 0
+	if iFits != jFits {
+		return iFits
+	}
+	if iFits && n[i].TotalScore != n[j].TotalScore {
+		return n[i].TotalScore > n[j].TotalScore
+	}
+	return n[i].Node < n[j].Node
+}
+
+// DecisionLog keeps the most recent scheduling decisions in a bounded ring
+// and serves them over HTTP.
+type DecisionLog struct {
+	lock      sync.RWMutex
+	decisions []*SchedulingDecision
+	// next is the slot the next decision is written to.
+	next int
+}
+
+// NewDecisionLog returns a log that holds at most size decisions.
+func NewDecisionLog(size int) *DecisionLog {
+	if size < 0 {
+		size = 0
+	}
+	return &DecisionLog{decisions: make([]*SchedulingDecision, size)}
+}
+
+// Record adds a decision, overwriting the oldest one if the log is full.
+func (l *DecisionLog) Record(decision *SchedulingDecision) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if len(l.decisions) == 0 {
+		return
+	}
+	l.decisions[l.next] = decision
+	l.next = (l.next + 1) % len(l.decisions)
+}
+
+// List returns the decisions in the log, most recent first.
+func (l *DecisionLog) List() []*SchedulingDecision {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	result := []*SchedulingDecision{}
+	for i := 1; i <= len(l.decisions); i++ {
+		decision := l.decisions[(l.next-i+len(l.decisions))%len(l.decisions)]
+		if decision == nil {
+			break
+		}
+		result = append(result, decision)
+	}
+	return result
+}
+
+// Get returns the most recent decision for the pod with the given
+// namespace/name key.
+func (l *DecisionLog) Get(key string) (*SchedulingDecision, bool) {
+	for _, decision := range l.List() {
+		if decision.Pod == key {
+			return decision, true
+		}
+	}
+	return nil, false
+}
+
+// ServeHTTP serves the most recent decision for the pod named by the
+// namespace/name request path, or every decision in the log if the path is
+// empty.  The handler expects the path prefix it is mounted on to have been
+// stripped, e.g. with http.StripPrefix.
+func (l *DecisionLog) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	key := strings.Trim(req.URL.Path, "/")
+	var result interface{}
+	if len(key) == 0 {
+		result = l.List()
+	} else {
+		decision, found := l.Get(key)
+		if !found {
+			http.Error(w, fmt.Sprintf("no scheduling decision recorded for pod %q", key), http.StatusNotFound)
+			return
+		}
+		result = decision
+	}
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+func TestDecisionLogKeepsMostRecent(t *testing.T) {
+	log := NewDecisionLog(3)
+	for i := 0; i < 5; i++ {
+		log.Record(&SchedulingDecision{Pod: fmt.Sprintf("ns/pod-%d", i%4)})
+	}
+	got := []string{}
+	for _, decision := range log.List() {
+		got = append(got, decision.Pod)
+	}
+	if expected := []string{"ns/pod-0", "ns/pod-3", "ns/pod-2"}; !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if _, found := log.Get("ns/pod-1"); found {
+		t.Errorf("expected the oldest decision to have been dropped")
+	}
+	if decision, found := log.Get("ns/pod-0"); !found || decision != log.List()[0] {
+		t.Errorf("expected the most recent decision for ns/pod-0, got %v", decision)
+	}
+
+	empty := NewDecisionLog(0)
+	empty.Record(&SchedulingDecision{Pod: "ns/pod"})
+	if decisions := empty.List(); len(decisions) != 0 {
+		t.Errorf("expected no decisions, got %v", decisions)
+	}
+}
+
+func TestScheduleRecordsDecision(t *testing.T) {
+	log := NewDecisionLog(DefaultDecisionLogSize)
+	notOne := func(pod *api.Pod, existingPods []*api.Pod, node string) (bool, error) {
+		return node != "1", nil
+	}
+	scheduler := NewGenericScheduler(
+		map[string]algorithm.FitPredicate{"true": truePredicate, "notOne": notOne},
+		[]algorithm.PriorityConfig{{Name: "numeric", Function: numericPriority, Weight: 1}, {Name: "equal", Function: EqualPriority, Weight: 2}},
+		[]algorithm.SchedulerExtender{},
+		algorithm.FakePodLister([]*api.Pod{}),
+		rand.New(rand.NewSource(0)),
+		log)
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "ns", Name: "foo"}}
+	if _, err := scheduler.Schedule(pod, algorithm.FakeMinionLister(makeNodeList([]string{"1", "2", "3"}))); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	decision, found := log.Get("ns/foo")
+	if !found {
+		t.Fatalf("expected a decision to be recorded")
+	}
+	if decision.SelectedNode != "3" || decision.Error != "" {
+		t.Errorf("unexpected outcome: %#v", decision)
+	}
+	expected := []NodeEvaluation{
+		{Node: "3", Scores: map[string]int{"numeric": 3, "equal": 2}, TotalScore: 5},
+		{Node: "2", Scores: map[string]int{"numeric": 2, "equal": 2}, TotalScore: 4},
+		{Node: "1", FailedPredicates: []string{"notOne"}},
+	}
+	if !reflect.DeepEqual(expected, decision.Nodes) {
+		t.Errorf("expected %#v, got %#v", expected, decision.Nodes)
+	}
+
+	if _, err := scheduler.Schedule(pod, algorithm.FakeMinionLister(makeNodeList([]string{"1"}))); err == nil {
+		t.Fatalf("unexpected non-error")
+	}
+	decision, _ = log.Get("ns/foo")
+	if decision.SelectedNode != "" || decision.Error != "pod (foo) failed to fit on 1 node(s): notOne (1)" {
+		t.Errorf("unexpected outcome: %#v", decision)
+	}
+}
+
+func TestScheduleRecordsAllFailedPredicates(t *testing.T) {
+	log := NewDecisionLog(DefaultDecisionLogSize)
+	scheduler := NewGenericScheduler(
+		map[string]algorithm.FitPredicate{"true": truePredicate, "false": falsePredicate, "matches": matchesPredicate},
+		[]algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+		[]algorithm.SchedulerExtender{},
+		algorithm.FakePodLister([]*api.Pod{}),
+		rand.New(rand.NewSource(0)),
+		log)
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "ns", Name: "1"}}
+	if _, err := scheduler.Schedule(pod, algorithm.FakeMinionLister(makeNodeList([]string{"1", "2"}))); err == nil {
+		t.Fatalf("unexpected non-error")
+	}
+
+	decision, found := log.Get("ns/1")
+	if !found {
+		t.Fatalf("expected a decision to be recorded")
+	}
+	expected := []NodeEvaluation{
+		{Node: "1", FailedPredicates: []string{"false"}},
+		{Node: "2", FailedPredicates: []string{"false", "matches"}},
+	}
+	if !reflect.DeepEqual(expected, decision.Nodes) {
+		t.Errorf("expected %#v, got %#v", expected, decision.Nodes)
+	}
+}
+
+func TestDecisionLogServeHTTP(t *testing.T) {
+	log := NewDecisionLog(DefaultDecisionLogSize)
+	log.Record(&SchedulingDecision{Pod: "ns/foo", SelectedNode: "machine1"})
+	log.Record(&SchedulingDecision{Pod: "ns/bar", SelectedNode: "machine2"})
+	server := httptest.NewServer(http.StripPrefix("/debug/decisions/", log))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/debug/decisions/ns/foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decision := SchedulingDecision{}
+	err = json.NewDecoder(resp.Body).Decode(&decision)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision.Pod != "ns/foo" || decision.SelectedNode != "machine1" {
+		t.Errorf("unexpected decision: %#v", decision)
+	}
+
+	resp, err = http.Get(server.URL + "/debug/decisions/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decisions := []SchedulingDecision{}
+	err = json.NewDecoder(resp.Body).Decode(&decisions)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(decisions) != 2 || decisions[0].Pod != "ns/bar" {
+		t.Errorf("unexpected decisions: %#v", decisions)
+	}
+
+	resp, err = http.Get(server.URL + "/debug/decisions/ns/missing")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected %d, got %d", http.StatusNotFound, resp.StatusCode)
+	}
+}
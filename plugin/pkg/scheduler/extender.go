@@ -0,0 +1,140 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+	schedulerapi "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/api"
+)
+
+// DefaultExtenderTimeout is used for extenders that do not set an HTTP timeout.
+const DefaultExtenderTimeout = 5 * time.Second
+
+// HTTPExtender implements the algorithm.SchedulerExtender interface by
+// posting the pod and candidate minions to an HTTP endpoint.
+type HTTPExtender struct {
+	extenderURL    string
+	filterVerb     string
+	prioritizeVerb string
+	weight         int
+	ignorable      bool
+	client         *http.Client
+}
+
+// NewHTTPExtender returns an HTTPExtender for the given configuration.
+func NewHTTPExtender(config *schedulerapi.ExtenderConfig) (algorithm.SchedulerExtender, error) {
+	if config.URLPrefix == "" {
+		return nil, fmt.Errorf("extender has no URL prefix")
+	}
+	timeout := config.HTTPTimeout
+	if timeout == 0 {
+		timeout = DefaultExtenderTimeout
+	}
+	return &HTTPExtender{
+		extenderURL:    strings.TrimRight(config.URLPrefix, "/"),
+		filterVerb:     config.FilterVerb,
+		prioritizeVerb: config.PrioritizeVerb,
+		weight:         config.Weight,
+		ignorable:      config.Ignorable,
+		client:         &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Filter based on extender implemented predicate functions. The filtered list is
+// expected to be a subset of the supplied list.
+func (h *HTTPExtender) Filter(pod *api.Pod, nodes *api.NodeList) (*api.NodeList, error) {
+	if h.filterVerb == "" {
+		return nodes, nil
+	}
+
+	var result schedulerapi.ExtenderFilterResult
+	args := schedulerapi.ExtenderArgs{
+		Pod:   *pod,
+		Nodes: *nodes,
+	}
+	if err := h.send(h.filterVerb, &args, &result); err != nil {
+		return nil, err
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("extender %s: %s", h.extenderURL, result.Error)
+	}
+	return &result.Nodes, nil
+}
+
+// Prioritize based on extender implemented priority functions. Weight*priority is added
+// up for each such priority function. The returned score is added to the score computed
+// by the Kubernetes scheduler. The total score is used to do the host selection.
+func (h *HTTPExtender) Prioritize(pod *api.Pod, nodes *api.NodeList) (algorithm.HostPriorityList, int, error) {
+	if h.prioritizeVerb == "" {
+		return algorithm.HostPriorityList{}, 0, nil
+	}
+
+	var result schedulerapi.HostPriorityList
+	args := schedulerapi.ExtenderArgs{
+		Pod:   *pod,
+		Nodes: *nodes,
+	}
+	if err := h.send(h.prioritizeVerb, &args, &result); err != nil {
+		return nil, 0, err
+	}
+	priorities := algorithm.HostPriorityList{}
+	for _, hostPriority := range result {
+		priorities = append(priorities, algorithm.HostPriority{Host: hostPriority.Host, Score: hostPriority.Score})
+	}
+	return priorities, h.weight, nil
+}
+
+// IsIgnorable returns true if scheduling should carry on when this extender fails.
+func (h *HTTPExtender) IsIgnorable() bool {
+	return h.ignorable
+}
+
+// send posts args to the extender's verb and decodes the JSON response into result.
+func (h *HTTPExtender) send(action string, args interface{}, result interface{}) error {
+	out, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+
+	url := h.extenderURL + "/" + action
+	resp, err := h.client.Post(url, "application/json", bytes.NewReader(out))
+	if err != nil {
+		return fmt.Errorf("extender %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("extender %s: %v", url, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("extender %s returned %d: %s", url, resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("extender %s returned an invalid response: %v", url, err)
+	}
+	return nil
+}
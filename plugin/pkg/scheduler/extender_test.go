@@ -0,0 +1,356 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+	schedulerapi "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/api"
+)
+
+type fitPredicate func(pod *api.Pod, node string) (bool, error)
+type priorityFunc func(pod *api.Pod, nodes *api.NodeList) (algorithm.HostPriorityList, error)
+
+type priorityConfig struct {
+	function priorityFunc
+	weight   int
+}
+
+func errorPredicateExtender(pod *api.Pod, node string) (bool, error) {
+	return false, fmt.Errorf("Some error")
+}
+
+func truePredicateExtender(pod *api.Pod, node string) (bool, error) {
+	return true, nil
+}
+
+func machine1PredicateExtender(pod *api.Pod, node string) (bool, error) {
+	return node == "machine1", nil
+}
+
+func errorPrioritizerExtender(pod *api.Pod, nodes *api.NodeList) (algorithm.HostPriorityList, error) {
+	return algorithm.HostPriorityList{}, fmt.Errorf("Some error")
+}
+
+func machine2PrioritizerExtender(pod *api.Pod, nodes *api.NodeList) (algorithm.HostPriorityList, error) {
+	result := algorithm.HostPriorityList{}
+	for _, minion := range nodes.Items {
+		score := 1
+		if minion.Name == "machine2" {
+			score = 10
+		}
+		result = append(result, algorithm.HostPriority{Host: minion.Name, Score: score})
+	}
+	return result, nil
+}
+
+type FakeExtender struct {
+	predicates   []fitPredicate
+	prioritizers []priorityConfig
+	weight       int
+	ignorable    bool
+}
+
+func (f *FakeExtender) Filter(pod *api.Pod, nodes *api.NodeList) (*api.NodeList, error) {
+	filtered := []api.Node{}
+	for _, node := range nodes.Items {
+		fits := true
+		for _, predicate := range f.predicates {
+			fit, err := predicate(pod, node.Name)
+			if err != nil {
+				return &api.NodeList{}, err
+			}
+			if !fit {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			filtered = append(filtered, node)
+		}
+	}
+	return &api.NodeList{Items: filtered}, nil
+}
+
+func (f *FakeExtender) Prioritize(pod *api.Pod, nodes *api.NodeList) (algorithm.HostPriorityList, int, error) {
+	result := algorithm.HostPriorityList{}
+	combinedScores := map[string]int{}
+	for _, prioritizer := range f.prioritizers {
+		priorities, err := prioritizer.function(pod, nodes)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, hostEntry := range priorities {
+			combinedScores[hostEntry.Host] += hostEntry.Score * prioritizer.weight
+		}
+	}
+	for host, score := range combinedScores {
+		result = append(result, algorithm.HostPriority{Host: host, Score: score})
+	}
+	return result, f.weight, nil
+}
+
+func (f *FakeExtender) IsIgnorable() bool {
+	return f.ignorable
+}
+
+func TestGenericSchedulerWithExtenders(t *testing.T) {
+	tests := []struct {
+		name         string
+		predicates   map[string]algorithm.FitPredicate
+		prioritizers []algorithm.PriorityConfig
+		extenders    []FakeExtender
+		nodes        []string
+		expectedHost string
+		expectsErr   bool
+	}{
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{predicates: []fitPredicate{truePredicateExtender}},
+				{predicates: []fitPredicate{errorPredicateExtender}},
+			},
+			nodes:      []string{"machine1", "machine2"},
+			expectsErr: true,
+			name:       "test 1",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{predicates: []fitPredicate{truePredicateExtender}},
+				{predicates: []fitPredicate{errorPredicateExtender}, ignorable: true},
+			},
+			nodes:        []string{"machine1", "machine2"},
+			expectedHost: "machine1",
+			name:         "test 2",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{predicates: []fitPredicate{truePredicateExtender}},
+				{predicates: []fitPredicate{machine1PredicateExtender}},
+			},
+			nodes:        []string{"machine1", "machine2"},
+			expectedHost: "machine1",
+			name:         "test 3",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{
+					predicates:   []fitPredicate{truePredicateExtender},
+					prioritizers: []priorityConfig{{errorPrioritizerExtender, 10}},
+					weight:       1,
+				},
+			},
+			nodes:      []string{"machine1", "machine2"},
+			expectsErr: true,
+			name:       "test 4",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{
+					predicates:   []fitPredicate{truePredicateExtender},
+					prioritizers: []priorityConfig{{machine2PrioritizerExtender, 10}},
+					weight:       1,
+				},
+			},
+			nodes:        []string{"machine1", "machine2"},
+			expectedHost: "machine2",
+			name:         "test 5",
+		},
+		{
+			predicates: map[string]algorithm.FitPredicate{"true": truePredicate},
+			extenders: []FakeExtender{
+				{
+					predicates:   []fitPredicate{truePredicateExtender},
+					prioritizers: []priorityConfig{{machine2PrioritizerExtender, 1}},
+					weight:       5,
+				},
+			},
+			nodes:        []string{"machine1", "machine2"},
+			expectedHost: "machine2",
+			name:         "test 6",
+		},
+	}
+
+	for _, test := range tests {
+		random := rand.New(rand.NewSource(0))
+		extenders := []algorithm.SchedulerExtender{}
+		for ii := range test.extenders {
+			extenders = append(extenders, &test.extenders[ii])
+		}
+		scheduler := NewGenericScheduler(test.predicates, test.prioritizers, extenders, algorithm.FakePodLister([]*api.Pod{}), random, nil)
+		machine, err := scheduler.Schedule(&api.Pod{}, algorithm.FakeMinionLister(makeNodeList(test.nodes)))
+		if test.expectsErr {
+			if err == nil {
+				t.Errorf("%s: unexpected non-error", test.name)
+			}
+		} else {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+			}
+			if test.expectedHost != machine {
+				t.Errorf("%s: expected host %s, got %s", test.name, test.expectedHost, machine)
+			}
+		}
+	}
+}
+
+func TestFindFitRecordsExtenderFailures(t *testing.T) {
+	nodes := []string{"machine1", "machine2"}
+	predicates := map[string]algorithm.FitPredicate{"true": truePredicate}
+	extenders := []algorithm.SchedulerExtender{&FakeExtender{predicates: []fitPredicate{machine1PredicateExtender}}}
+	filtered, predicateMap, err := findNodesThatFit(&api.Pod{}, algorithm.FakePodLister([]*api.Pod{}), predicates, makeNodeList(nodes), extenders, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(filtered.Items) != 1 || filtered.Items[0].Name != "machine1" {
+		t.Errorf("unexpected filtered nodes: %v", filtered.Items)
+	}
+	if len(predicateMap) != 1 || !predicateMap["machine2"].Has(extenderPredicate) {
+		t.Errorf("unexpected failed predicate map: %v", predicateMap)
+	}
+}
+
+// misbehavingExtender answers every filter call with a fixed list of nodes,
+// whatever it was asked about.
+type misbehavingExtender struct {
+	FakeExtender
+	nodes []api.Node
+}
+
+func (m *misbehavingExtender) Filter(pod *api.Pod, nodes *api.NodeList) (*api.NodeList, error) {
+	return &api.NodeList{Items: m.nodes}, nil
+}
+
+func TestFindFitIgnoresUnknownExtenderNodes(t *testing.T) {
+	nodes := []string{"machine1", "machine2", "machine3"}
+	predicates := map[string]algorithm.FitPredicate{"matches": matchesPredicate}
+	// Only machine2 passes the predicates, but the extender also returns
+	// machine3, which was filtered out, and machine4, which does not exist.
+	extenders := []algorithm.SchedulerExtender{&misbehavingExtender{
+		nodes: []api.Node{
+			{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: map[string]string{"extender": "changed"}}},
+			{ObjectMeta: api.ObjectMeta{Name: "machine3"}},
+			{ObjectMeta: api.ObjectMeta{Name: "machine4"}},
+		},
+	}}
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "machine2"}}
+	filtered, predicateMap, err := findNodesThatFit(pod, algorithm.FakePodLister([]*api.Pod{}), predicates, makeNodeList(nodes), extenders, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(filtered.Items) != 1 || filtered.Items[0].Name != "machine2" {
+		t.Errorf("unexpected filtered nodes: %v", filtered.Items)
+	}
+	if len(filtered.Items) == 1 && len(filtered.Items[0].Labels) != 0 {
+		t.Errorf("expected the listed node to be kept, got %v", filtered.Items[0])
+	}
+	if _, found := predicateMap["machine4"]; found {
+		t.Errorf("unexpected failed predicate for a node that is not a candidate: %v", predicateMap)
+	}
+}
+
+func TestHTTPExtender(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var args schedulerapi.ExtenderArgs
+		if err := json.NewDecoder(req.Body).Decode(&args); err != nil {
+			t.Errorf("unexpected error decoding extender args: %v", err)
+		}
+		if args.Pod.Name != "foo" {
+			t.Errorf("unexpected pod sent to the extender: %v", args.Pod)
+		}
+		switch req.URL.Path {
+		case "/scheduler/filter":
+			json.NewEncoder(w).Encode(schedulerapi.ExtenderFilterResult{Nodes: api.NodeList{Items: args.Nodes.Items[:1]}})
+		case "/scheduler/prioritize":
+			json.NewEncoder(w).Encode(schedulerapi.HostPriorityList{{Host: "machine1", Score: 7}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	extender, err := NewHTTPExtender(&schedulerapi.ExtenderConfig{
+		URLPrefix:      server.URL + "/scheduler/",
+		FilterVerb:     "filter",
+		PrioritizeVerb: "prioritize",
+		Weight:         3,
+		HTTPTimeout:    time.Second,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
+	nodes := makeNodeList([]string{"machine1", "machine2"})
+
+	filtered, err := extender.Filter(pod, &nodes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(filtered.Items) != 1 || filtered.Items[0].Name != "machine1" {
+		t.Errorf("unexpected filtered nodes: %v", filtered.Items)
+	}
+
+	priorities, weight, err := extender.Prioritize(pod, &nodes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if weight != 3 || len(priorities) != 1 || priorities[0] != (algorithm.HostPriority{Host: "machine1", Score: 7}) {
+		t.Errorf("unexpected priorities %v with weight %d", priorities, weight)
+	}
+}
+
+func TestHTTPExtenderErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/filter":
+			json.NewEncoder(w).Encode(schedulerapi.ExtenderFilterResult{Error: "license server unavailable"})
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	extender, err := NewHTTPExtender(&schedulerapi.ExtenderConfig{URLPrefix: server.URL, FilterVerb: "filter", PrioritizeVerb: "prioritize", Weight: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pod := &api.Pod{}
+	nodes := makeNodeList([]string{"machine1"})
+	if _, err := extender.Filter(pod, &nodes); err == nil {
+		t.Errorf("expected the filter error to be returned")
+	}
+	if _, _, err := extender.Prioritize(pod, &nodes); err == nil {
+		t.Errorf("expected the HTTP error to be returned")
+	}
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/framework"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
 	schedulerapi "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/api"
@@ -45,9 +47,18 @@ const (
 	BindPodsBurst = 20
 )
 
+const (
+	// SchedulerAnnotationKey is the annotation on a pod naming the scheduler that should place it.
+	SchedulerAnnotationKey = "scheduler.kubernetes.io/name"
+	// DefaultSchedulerName is the name of the scheduler that places pods not naming one.
+	DefaultSchedulerName = "default-scheduler"
+)
+
 // ConfigFactory knows how to fill out a scheduler config with its support functions.
 type ConfigFactory struct {
 	Client *client.Client
+	// the name of this scheduler; only pods naming it are scheduled
+	SchedulerName string
 	// queue for pods that need scheduling
 	PodQueue *cache.FIFO
 	// a means to list all known scheduled pods.
@@ -63,20 +74,26 @@ type ConfigFactory struct {
 	StopEverything chan struct{}
 	// Rate limiter for binding pods
 	BindPodsRateLimiter util.RateLimiter
+	// Recent scheduling decisions, for explaining where pods went
+	Decisions *scheduler.DecisionLog
+	// Whether pods that fit nowhere may evict pods of lower priority
+	EnablePreemption bool
 
 	scheduledPodPopulator *framework.Controller
 	modeler               scheduler.SystemModeler
 }
 
-// Initializes the factory.
-func NewConfigFactory(client *client.Client) *ConfigFactory {
+// Initializes the factory for the scheduler named schedulerName.
+func NewConfigFactory(client *client.Client, schedulerName string) *ConfigFactory {
 	c := &ConfigFactory{
 		Client:             client,
+		SchedulerName:      schedulerName,
 		PodQueue:           cache.NewFIFO(cache.MetaNamespaceKeyFunc),
 		ScheduledPodLister: &cache.StoreToPodLister{},
 		// Only nodes in the "Ready" condition with status == "True" are schedulable
 		NodeLister:     &cache.StoreToNodeLister{cache.NewStore(cache.MetaNamespaceKeyFunc)},
 		ServiceLister:  &cache.StoreToServiceLister{cache.NewStore(cache.MetaNamespaceKeyFunc)},
+		Decisions:      scheduler.NewDecisionLog(scheduler.DefaultDecisionLogSize),
 		StopEverything: make(chan struct{}),
 	}
 	modeler := scheduler.NewSimpleModeler(&cache.StoreToPodLister{c.PodQueue}, c.ScheduledPodLister)
@@ -129,16 +146,26 @@ func (f *ConfigFactory) CreateFromProvider(providerName string) (*scheduler.Conf
 		return nil, err
 	}
 
-	return f.CreateFromKeys(provider.FitPredicateKeys, provider.PriorityFunctionKeys)
+	return f.CreateFromKeys(provider.FitPredicateKeys, provider.PriorityFunctionKeys, []algorithm.SchedulerExtender{})
 }
 
 // Creates a scheduler from the configuration file
 func (f *ConfigFactory) CreateFromConfig(policy schedulerapi.Policy) (*scheduler.Config, error) {
+	predicateKeys, priorityKeys, extenders, err := registerPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+	return f.CreateFromKeys(predicateKeys, priorityKeys, extenders)
+}
+
+// registerPolicy validates the policy and registers its predicates and
+// priorities, returning their keys and the extenders the policy configures.
+func registerPolicy(policy schedulerapi.Policy) (util.StringSet, util.StringSet, []algorithm.SchedulerExtender, error) {
 	glog.V(2).Infof("creating scheduler from configuration: %v", policy)
 
 	// validate the policy configuration
 	if err := validation.ValidatePolicy(policy); err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	predicateKeys := util.NewStringSet()
@@ -153,11 +180,58 @@ func (f *ConfigFactory) CreateFromConfig(policy schedulerapi.Policy) (*scheduler
 		priorityKeys.Insert(RegisterCustomPriorityFunction(priority))
 	}
 
-	return f.CreateFromKeys(predicateKeys, priorityKeys)
+	extenders := make([]algorithm.SchedulerExtender, 0)
+	for i := range policy.ExtenderConfigs {
+		glog.V(2).Infof("Creating extender with config %+v", policy.ExtenderConfigs[i])
+		extender, err := scheduler.NewHTTPExtender(&policy.ExtenderConfigs[i])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		extenders = append(extenders, extender)
+	}
+	return predicateKeys, priorityKeys, extenders, nil
 }
 
-// Creates a scheduler from a set of registered fit predicate keys and priority keys.
-func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys util.StringSet) (*scheduler.Config, error) {
+// CreateAlgorithmFromProvider returns the scheduling algorithm of a registered
+// algorithm provider, evaluated against the given listers rather than against
+// the cluster.
+func CreateAlgorithmFromProvider(providerName string, args PluginFactoryArgs) (algorithm.ScheduleAlgorithm, error) {
+	provider, err := GetAlgorithmProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+	return newAlgorithm(provider.FitPredicateKeys, provider.PriorityFunctionKeys, []algorithm.SchedulerExtender{}, args, nil)
+}
+
+// CreateAlgorithmFromPolicy returns the scheduling algorithm a policy
+// configures, evaluated against the given listers rather than against the
+// cluster.
+func CreateAlgorithmFromPolicy(policy schedulerapi.Policy, args PluginFactoryArgs) (algorithm.ScheduleAlgorithm, error) {
+	predicateKeys, priorityKeys, extenders, err := registerPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+	return newAlgorithm(predicateKeys, priorityKeys, extenders, args, nil)
+}
+
+func newAlgorithm(predicateKeys, priorityKeys util.StringSet, extenders []algorithm.SchedulerExtender, args PluginFactoryArgs, decisions *scheduler.DecisionLog) (algorithm.ScheduleAlgorithm, error) {
+	predicateFuncs, err := getFitPredicateFunctions(predicateKeys, args)
+	if err != nil {
+		return nil, err
+	}
+
+	priorityConfigs, err := getPriorityFunctionConfigs(priorityKeys, args)
+	if err != nil {
+		return nil, err
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	return scheduler.NewGenericScheduler(predicateFuncs, priorityConfigs, extenders, args.PodLister, r, decisions), nil
+}
+
+// Creates a scheduler from a set of registered fit predicate keys and priority keys
+// and the extenders to consult after them.
+func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys util.StringSet, extenders []algorithm.SchedulerExtender) (*scheduler.Config, error) {
 	glog.V(2).Infof("creating scheduler with fit predicates '%v' and priority functions '%v", predicateKeys, priorityKeys)
 	pluginArgs := PluginFactoryArgs{
 		PodLister:     f.PodLister,
@@ -166,12 +240,7 @@ func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys util.StringSe
 		NodeLister: f.NodeLister.NodeCondition(api.NodeReady, api.ConditionTrue),
 		NodeInfo:   f.NodeLister,
 	}
-	predicateFuncs, err := getFitPredicateFunctions(predicateKeys, pluginArgs)
-	if err != nil {
-		return nil, err
-	}
-
-	priorityConfigs, err := getPriorityFunctionConfigs(priorityKeys, pluginArgs)
+	algo, err := newAlgorithm(predicateKeys, priorityKeys, extenders, pluginArgs, f.Decisions)
 	if err != nil {
 		return nil, err
 	}
@@ -191,10 +260,6 @@ func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys util.StringSe
 	// Cache this locally.
 	cache.NewReflector(f.createServiceLW(), &api.Service{}, f.ServiceLister.Store, 0).RunUntil(f.StopEverything)
 
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-
-	algo := scheduler.NewGenericScheduler(predicateFuncs, priorityConfigs, f.PodLister, r)
-
 	podBackoff := podBackoff{
 		perPodBackoff: map[string]*backoffEntry{},
 		clock:         realClock{},
@@ -203,12 +268,21 @@ func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys util.StringSe
 		maxDuration:     60 * time.Second,
 	}
 
+	// Preemption lets pods evict the pods of other tenants, so it is only
+	// turned on where the priority of pods is restricted.
+	var evictor scheduler.PodEvictor
+	if f.EnablePreemption {
+		evictor = &podEvictor{f.Client}
+	}
+
 	return &scheduler.Config{
 		Modeler: f.modeler,
 		// The scheduler only needs to consider schedulable nodes.
 		MinionLister: f.NodeLister.NodeCondition(api.NodeReady, api.ConditionTrue),
 		Algorithm:    algo,
 		Binder:       &binder{f.Client},
+		PodEvictor:   evictor,
+		PodLister:    f.PodLister,
 		NextPod: func() *api.Pod {
 			pod := f.PodQueue.Pop().(*api.Pod)
 			glog.V(2).Infof("About to try and schedule pod %v", pod.Name)
@@ -222,8 +296,54 @@ func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys util.StringSe
 
 // Returns a cache.ListWatch that finds all pods that need to be
 // scheduled.
+// Only pods this scheduler is responsible for are returned; a pod that is
+// handed to another scheduler while queued is reported as deleted.
 func (factory *ConfigFactory) createUnassignedPodLW() *cache.ListWatch {
-	return cache.NewListWatchFromClient(factory.Client, "pods", api.NamespaceAll, fields.Set{client.PodHost: ""}.AsSelector())
+	lw := cache.NewListWatchFromClient(factory.Client, "pods", api.NamespaceAll, fields.Set{client.PodHost: ""}.AsSelector())
+	return &cache.ListWatch{
+		ListFunc: func() (runtime.Object, error) {
+			obj, err := lw.List()
+			if err != nil {
+				return nil, err
+			}
+			list := obj.(*api.PodList)
+			items := []api.Pod{}
+			for i := range list.Items {
+				if factory.responsibleForPod(&list.Items[i]) {
+					items = append(items, list.Items[i])
+				}
+			}
+			list.Items = items
+			return list, nil
+		},
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+			w, err := lw.Watch(resourceVersion)
+			if err != nil {
+				return nil, err
+			}
+			return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+				pod, ok := in.Object.(*api.Pod)
+				if !ok || factory.responsibleForPod(pod) {
+					return in, true
+				}
+				if in.Type == watch.Modified {
+					in.Type = watch.Deleted
+					return in, true
+				}
+				return in, false
+			}), nil
+		},
+	}
+}
+
+// responsibleForPod returns true if pod names this scheduler, or names no
+// scheduler and this is the default scheduler.
+func (factory *ConfigFactory) responsibleForPod(pod *api.Pod) bool {
+	name := pod.Annotations[SchedulerAnnotationKey]
+	if name == "" {
+		name = DefaultSchedulerName
+	}
+	return name == factory.SchedulerName
 }
 
 func parseSelectorOrDie(s string) fields.Selector {
@@ -278,7 +398,7 @@ func (factory *ConfigFactory) makeDefaultErrorFunc(backoff *podBackoff, podQueue
 				}
 				return
 			}
-			if pod.Spec.NodeName == "" {
+			if pod.Spec.NodeName == "" && factory.responsibleForPod(pod) {
 				podQueue.Add(pod)
 			}
 		}()
@@ -316,6 +436,16 @@ func (b *binder) Bind(binding *api.Binding) error {
 	// return b.Pods(binding.Namespace).Bind(binding)
 }
 
+type podEvictor struct {
+	*client.Client
+}
+
+// Evict deletes the pod, asking for the pod's own termination grace period.
+func (e *podEvictor) Evict(pod *api.Pod) error {
+	glog.V(2).Infof("Attempting to evict %v/%v from %v", pod.Namespace, pod.Name, pod.Spec.NodeName)
+	return e.Pods(pod.Namespace).Delete(pod.Name, &api.DeleteOptions{GracePeriodSeconds: pod.Spec.TerminationGracePeriodSeconds})
+}
+
 type clock interface {
 	Now() time.Time
 }
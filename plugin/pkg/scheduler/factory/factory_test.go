@@ -44,10 +44,40 @@ func TestCreate(t *testing.T) {
 	server := httptest.NewServer(&handler)
 	defer server.Close()
 	client := client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Version()})
-	factory := NewConfigFactory(client)
+	factory := NewConfigFactory(client, DefaultSchedulerName)
 	factory.Create()
 }
 
+func TestCreatePreemption(t *testing.T) {
+	handler := util.FakeHandler{
+		StatusCode:   500,
+		ResponseBody: "",
+		T:            t,
+	}
+	server := httptest.NewServer(&handler)
+	defer server.Close()
+	client := client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Version()})
+
+	factory := NewConfigFactory(client, DefaultSchedulerName)
+	config, err := factory.CreateFromKeys(util.NewStringSet(), util.NewStringSet(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.PodEvictor != nil {
+		t.Errorf("expected preemption to be off by default")
+	}
+
+	factory = NewConfigFactory(client, DefaultSchedulerName)
+	factory.EnablePreemption = true
+	config, err = factory.CreateFromKeys(util.NewStringSet(), util.NewStringSet(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.PodEvictor == nil {
+		t.Errorf("expected preemption to be on")
+	}
+}
+
 // Test configures a scheduler from a policies defined in a file
 // It combines some configurable predicate/priorities with some pre-defined ones
 func TestCreateFromConfig(t *testing.T) {
@@ -62,7 +92,7 @@ func TestCreateFromConfig(t *testing.T) {
 	server := httptest.NewServer(&handler)
 	defer server.Close()
 	client := client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Version()})
-	factory := NewConfigFactory(client)
+	factory := NewConfigFactory(client, DefaultSchedulerName)
 
 	// Pre-register some predicate and priority functions
 	RegisterFitPredicate("PredicateOne", PredicateOne)
@@ -104,7 +134,7 @@ func TestCreateFromEmptyConfig(t *testing.T) {
 	server := httptest.NewServer(&handler)
 	defer server.Close()
 	client := client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Version()})
-	factory := NewConfigFactory(client)
+	factory := NewConfigFactory(client, DefaultSchedulerName)
 
 	configData = []byte(`{}`)
 	err := latestschedulerapi.Codec.DecodeInto(configData, &policy)
@@ -150,7 +180,7 @@ func TestDefaultErrorFunc(t *testing.T) {
 	mux.Handle(testapi.ResourcePath("pods", "bar", "foo"), &handler)
 	server := httptest.NewServer(mux)
 	defer server.Close()
-	factory := NewConfigFactory(client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Version()}))
+	factory := NewConfigFactory(client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Version()}), DefaultSchedulerName)
 	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
 	podBackoff := podBackoff{
 		perPodBackoff:   map[string]*backoffEntry{},
@@ -178,6 +208,43 @@ func TestDefaultErrorFunc(t *testing.T) {
 	}
 }
 
+func TestUnassignedPodLWFiltersBySchedulerName(t *testing.T) {
+	podList := &api.PodList{
+		Items: []api.Pod{
+			{ObjectMeta: api.ObjectMeta{Name: "unnamed", Namespace: "bar"}},
+			{ObjectMeta: api.ObjectMeta{Name: "default", Namespace: "bar", Annotations: map[string]string{SchedulerAnnotationKey: DefaultSchedulerName}}},
+			{ObjectMeta: api.ObjectMeta{Name: "batch", Namespace: "bar", Annotations: map[string]string{SchedulerAnnotationKey: "batch-scheduler"}}},
+		},
+	}
+	handler := util.FakeHandler{
+		StatusCode:   200,
+		ResponseBody: runtime.EncodeOrDie(latest.Codec, podList),
+		T:            t,
+	}
+	server := httptest.NewServer(&handler)
+	defer server.Close()
+
+	table := map[string][]string{
+		DefaultSchedulerName: {"unnamed", "default"},
+		"batch-scheduler":    {"batch"},
+		"other-scheduler":    {},
+	}
+	for schedulerName, expected := range table {
+		factory := NewConfigFactory(client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Version()}), schedulerName)
+		obj, err := factory.createUnassignedPodLW().List()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", schedulerName, err)
+		}
+		names := []string{}
+		for _, pod := range obj.(*api.PodList).Items {
+			names = append(names, pod.Name)
+		}
+		if !reflect.DeepEqual(expected, names) {
+			t.Errorf("%s: expected pods %v, got %v", schedulerName, expected, names)
+		}
+	}
+}
+
 func TestMinionEnumerator(t *testing.T) {
 	testList := &api.NodeList{
 		Items: []api.Node{
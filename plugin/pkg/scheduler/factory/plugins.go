@@ -259,6 +259,7 @@ func getPriorityFunctionConfigs(names util.StringSet, args PluginFactoryArgs) ([
 			return nil, fmt.Errorf("Invalid priority name %s specified - no corresponding function found", name)
 		}
 		configs = append(configs, algorithm.PriorityConfig{
+			Name:     name,
 			Function: factory.Function(args),
 			Weight:   factory.Weight,
 		})
@@ -0,0 +1,167 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// GangAnnotation names the gang a pod belongs to.  The members of a gang
+	// are the pods in the same namespace with the same gang name.
+	GangAnnotation = "scheduler.kubernetes.io/gang"
+	// GangMinSizeAnnotation is the number of members of a gang that must be
+	// placed before any of them is bound.
+	GangMinSizeAnnotation = "scheduler.kubernetes.io/gang-min-size"
+
+	// DefaultGangTimeout is how long the members of a gang are held waiting
+	// for the rest of the gang by default.
+	DefaultGangTimeout = 60 * time.Second
+)
+
+// gangOf returns the key of the gang the pod belongs to and the minimum size
+// of the gang.  It returns false if the pod is not a member of a gang that
+// needs more than one pod placed at once.
+func gangOf(pod *api.Pod) (string, int, bool) {
+	name, found := pod.Annotations[GangAnnotation]
+	if !found || len(name) == 0 {
+		return "", 0, false
+	}
+	minSize, err := strconv.Atoi(pod.Annotations[GangMinSizeAnnotation])
+	if err != nil {
+		glog.Warningf("Ignoring gang %q of pod %v/%v: invalid %s: %v", name, pod.Namespace, pod.Name, GangMinSizeAnnotation, err)
+		return "", 0, false
+	}
+	if minSize <= 1 {
+		return "", 0, false
+	}
+	return pod.Namespace + "/" + name, minSize, true
+}
+
+// heldPod is a gang member the scheduler found a minion for and holds until
+// enough of its gang has been placed.
+type heldPod struct {
+	pod  *api.Pod
+	dest string
+}
+
+// gang is a group of held pods.
+type gang struct {
+	key      string
+	minSize  int
+	deadline time.Time
+	// members holds the held pods by pod key.
+	members map[string]heldPod
+}
+
+// gangSet keeps track of the gangs that have members held.
+type gangSet struct {
+	lock  sync.Mutex
+	gangs map[string]*gang
+	clock util.Clock
+}
+
+func newGangSet() *gangSet {
+	return &gangSet{
+		gangs: map[string]*gang{},
+		clock: util.RealClock{},
+	}
+}
+
+// hold adds a pod to its gang, starting the timeout if it is the first
+// member held, and returns the number of members held.  A pod that is held
+// already replaces its earlier placement.
+func (s *gangSet) hold(key string, minSize int, timeout time.Duration, pod *api.Pod, dest string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	g, found := s.gangs[key]
+	if !found {
+		g = &gang{
+			key:      key,
+			minSize:  minSize,
+			deadline: s.clock.Now().Add(timeout),
+			members:  map[string]heldPod{},
+		}
+		s.gangs[key] = g
+	}
+	g.members[podKey(pod)] = heldPod{pod: pod, dest: dest}
+	return len(g.members)
+}
+
+// release stops holding the members of a gang and returns them.
+func (s *gangSet) release(key string) []heldPod {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	g, found := s.gangs[key]
+	if !found {
+		return nil
+	}
+	delete(s.gangs, key)
+	members := make([]heldPod, 0, len(g.members))
+	for _, member := range g.members {
+		members = append(members, member)
+	}
+	return members
+}
+
+// expire removes and returns the gangs whose timeout has passed, along with
+// the members of the gangs that are still held.
+func (s *gangSet) expire() (expired []*gang, held []heldPod) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := s.clock.Now()
+	for key, g := range s.gangs {
+		if now.Before(g.deadline) {
+			for _, member := range g.members {
+				held = append(held, member)
+			}
+			continue
+		}
+		delete(s.gangs, key)
+		expired = append(expired, g)
+	}
+	return expired, held
+}
+
+// countPlacedGangMembers counts the members of the gang of pod that are
+// scheduled or assumed to be, which includes the held ones.
+func countPlacedGangMembers(podLister algorithm.PodLister, pod *api.Pod) (int, error) {
+	pods, err := podLister.List(labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, p := range pods {
+		if p.Namespace != pod.Namespace || p.Annotations[GangAnnotation] != pod.Annotations[GangAnnotation] {
+			continue
+		}
+		if len(p.Spec.NodeName) == 0 || p.Status.Phase == api.PodSucceeded || p.Status.Phase == api.PodFailed {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+// messageRecorder records the messages of events by the name of the object.
+type messageRecorder struct {
+	lock     sync.Mutex
+	messages map[string][]string
+}
+
+func (r *messageRecorder) Event(object runtime.Object, reason, message string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	name := object.(*api.Pod).Name
+	r.messages[name] = append(r.messages[name], reason+": "+message)
+}
+
+func (r *messageRecorder) Eventf(object runtime.Object, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *messageRecorder) PastEventf(object runtime.Object, timestamp util.Time, reason, messageFmt string, args ...interface{}) {
+	r.Eventf(object, reason, messageFmt, args...)
+}
+
+func gangPod(name, gang, minSize string) *api.Pod {
+	pod := podWithID(name, "")
+	pod.Annotations = map[string]string{GangAnnotation: gang, GangMinSizeAnnotation: minSize}
+	return pod
+}
+
+func TestGangOf(t *testing.T) {
+	tests := []struct {
+		pod     *api.Pod
+		key     string
+		minSize int
+		isGang  bool
+	}{
+		{pod: podWithID("foo", ""), isGang: false},
+		{pod: gangPod("foo", "", "2"), isGang: false},
+		{pod: gangPod("foo", "training", "one"), isGang: false},
+		{pod: gangPod("foo", "training", "1"), isGang: false},
+		{pod: gangPod("foo", "training", "4"), key: "/training", minSize: 4, isGang: true},
+	}
+	for i, test := range tests {
+		key, minSize, isGang := gangOf(test.pod)
+		if key != test.key || minSize != test.minSize || isGang != test.isGang {
+			t.Errorf("%d: expected %q, %d, %v, got %q, %d, %v", i, test.key, test.minSize, test.isGang, key, minSize, isGang)
+		}
+	}
+}
+
+// gangTestScheduler returns a scheduler that places pods on a single machine,
+// with the stores behind its modeler.
+func gangTestScheduler(t *testing.T, errs map[string]error) (*Scheduler, *cache.FIFO, cache.Store, *[]string, *messageRecorder) {
+	scheduledPodStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	queuedPodStore := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	modeler := NewSimpleModeler(&cache.StoreToPodLister{Store: queuedPodStore}, &cache.StoreToPodLister{Store: scheduledPodStore})
+	algo := NewGenericScheduler(
+		map[string]algorithm.FitPredicate{},
+		[]algorithm.PriorityConfig{},
+		[]algorithm.SchedulerExtender{},
+		modeler.PodLister(),
+		rand.New(rand.NewSource(0)),
+		nil)
+	bound := []string{}
+	recorder := &messageRecorder{messages: map[string][]string{}}
+	c := &Config{
+		Modeler: modeler,
+		MinionLister: algorithm.FakeMinionLister(
+			api.NodeList{Items: []api.Node{{ObjectMeta: api.ObjectMeta{Name: "machine1"}}}},
+		),
+		Algorithm: algo,
+		Binder: fakeBinder{func(b *api.Binding) error {
+			pod, _, _ := modeler.assumedPods.GetByKey(b.Name)
+			scheduled := *pod.(*api.Pod)
+			scheduledPodStore.Add(&scheduled)
+			bound = append(bound, b.Name)
+			return nil
+		}},
+		PodLister: modeler.PodLister(),
+		NextPod: func() *api.Pod {
+			return queuedPodStore.Pop().(*api.Pod)
+		},
+		Error: func(p *api.Pod, err error) {
+			errs[p.Name] = err
+		},
+		Recorder: recorder,
+	}
+	return New(c), queuedPodStore, modeler.assumedPods.Store, &bound, recorder
+}
+
+func TestSchedulerBindsGangTogether(t *testing.T) {
+	errs := map[string]error{}
+	s, queue, assumed, bound, _ := gangTestScheduler(t, errs)
+
+	queue.Add(gangPod("a", "training", "2"))
+	s.scheduleOne()
+	if len(*bound) != 0 {
+		t.Errorf("expected a to be held, got bindings %v", *bound)
+	}
+	if _, exists, _ := assumed.GetByKey("a"); !exists {
+		t.Errorf("expected the placement of a to be assumed")
+	}
+
+	queue.Add(gangPod("b", "training", "2"))
+	s.scheduleOne()
+	if len(*bound) != 2 {
+		t.Errorf("expected a and b to be bound together, got %v", *bound)
+	}
+
+	// Further members of a gang that has been placed are bound right away.
+	queue.Add(gangPod("c", "training", "2"))
+	s.scheduleOne()
+	if len(*bound) != 3 || (*bound)[2] != "c" {
+		t.Errorf("expected c to be bound, got %v", *bound)
+	}
+	if len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
+
+func TestSchedulerGivesUpOnGangAfterTimeout(t *testing.T) {
+	errs := map[string]error{}
+	s, queue, assumed, bound, recorder := gangTestScheduler(t, errs)
+	s.config.GangTimeout = time.Minute
+	clock := &util.FakeClock{Time: time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	s.gangs.clock = clock
+
+	queue.Add(gangPod("a", "training", "3"))
+	s.scheduleOne()
+	queue.Add(gangPod("b", "training", "3"))
+	s.scheduleOne()
+
+	clock.Time = clock.Time.Add(30 * time.Second)
+	s.expireGangs()
+	if len(errs) != 0 {
+		t.Errorf("expected the gang to still be held, got errors %v", errs)
+	}
+
+	clock.Time = clock.Time.Add(time.Minute)
+	s.expireGangs()
+	if len(*bound) != 0 {
+		t.Errorf("unexpected bindings: %v", *bound)
+	}
+	expected := "failedScheduling: gave up waiting for gang /training: 2 of 3 members held"
+	for _, name := range []string{"a", "b"} {
+		if errs[name] == nil {
+			t.Errorf("expected %s to be sent back to be retried", name)
+		}
+		if messages := recorder.messages[name]; !reflect.DeepEqual([]string{expected}, messages) {
+			t.Errorf("expected event %q on %s, got %v", expected, name, messages)
+		}
+		if _, exists, _ := assumed.GetByKey(name); exists {
+			t.Errorf("expected the placement of %s to be forgotten", name)
+		}
+	}
+}
+
+func TestSchedulerRetriesGangMembersThatFailToBind(t *testing.T) {
+	errs := map[string]error{}
+	s, queue, assumed, bound, recorder := gangTestScheduler(t, errs)
+	binder := s.config.Binder
+	rejected := false
+	s.config.Binder = fakeBinder{func(b *api.Binding) error {
+		if b.Name == "b" && !rejected {
+			rejected = true
+			return fmt.Errorf("conflict")
+		}
+		return binder.Bind(b)
+	}}
+
+	queue.Add(gangPod("a", "training", "2"))
+	s.scheduleOne()
+	queue.Add(gangPod("b", "training", "2"))
+	s.scheduleOne()
+
+	// a stays bound even though b could not be.
+	if !reflect.DeepEqual([]string{"a"}, *bound) {
+		t.Errorf("expected only a to be bound, got %v", *bound)
+	}
+	if errs["b"] == nil {
+		t.Errorf("expected b to be sent back to be retried")
+	}
+	if _, exists, _ := assumed.GetByKey("b"); exists {
+		t.Errorf("expected the placement of b to be forgotten")
+	}
+	expected := "failedScheduling: Binding rejected: conflict"
+	if messages := recorder.messages["b"]; !reflect.DeepEqual([]string{expected}, messages) {
+		t.Errorf("expected event %q on b, got %v", expected, messages)
+	}
+
+	// When b is retried, the gang is already placed, so b is bound right away.
+	queue.Add(gangPod("b", "training", "2"))
+	s.scheduleOne()
+	if !reflect.DeepEqual([]string{"a", "b"}, *bound) {
+		t.Errorf("expected b to be bound on retry, got %v", *bound)
+	}
+}
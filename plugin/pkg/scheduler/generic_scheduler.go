@@ -39,25 +39,65 @@ type FitError struct {
 
 var ErrNoNodesAvailable = fmt.Errorf("no nodes available to schedule pods")
 
+// extenderPredicate is the failed predicate recorded for minions that a
+// scheduler extender filtered out.
+const extenderPredicate = "SchedulerExtender"
+
+// equalPriority names the priority applied when none are configured.
+const equalPriority = "EqualPriority"
+
 // implementation of the error interface
+// The message is an algorithm.FitFailure counting the nodes each predicate failed on, most common first,
+// as in "pod (foo) failed to fit on 3 node(s): PodFitsResources (2), MatchNodeSelector (1)".
 func (f *FitError) Error() string {
-	var reason string
-	// We iterate over all nodes for logging purposes, even though we only return one reason from one node
+	return f.Failure().String()
+}
+
+// Failure summarizes the error, counting the nodes each predicate failed on, most common first.
+func (f *FitError) Failure() *algorithm.FitFailure {
+	counts := map[string]int{}
 	for node, predicateList := range f.FailedPredicates {
 		glog.Infof("failed to find fit for pod %v on node %s: %s", f.Pod.Name, node, strings.Join(predicateList.List(), ","))
-		if len(reason) == 0 {
-			reason, _ = predicateList.PopAny()
+		for _, predicate := range predicateList.List() {
+			counts[predicate]++
 		}
 	}
-	return fmt.Sprintf("Failed for reason %s and possibly others", reason)
+	predicates := make([]string, 0, len(counts))
+	for predicate := range counts {
+		predicates = append(predicates, predicate)
+	}
+	sort.Sort(byCount{predicates, counts})
+	failure := &algorithm.FitFailure{Pod: f.Pod.Name, Nodes: len(f.FailedPredicates)}
+	for _, predicate := range predicates {
+		failure.Predicates = append(failure.Predicates, algorithm.PredicateFailure{Predicate: predicate, Nodes: counts[predicate]})
+	}
+	return failure
+}
+
+// byCount sorts names by descending count and then by name.
+type byCount struct {
+	names  []string
+	counts map[string]int
+}
+
+func (b byCount) Len() int      { return len(b.names) }
+func (b byCount) Swap(i, j int) { b.names[i], b.names[j] = b.names[j], b.names[i] }
+func (b byCount) Less(i, j int) bool {
+	if b.counts[b.names[i]] != b.counts[b.names[j]] {
+		return b.counts[b.names[i]] > b.counts[b.names[j]]
+	}
+	return b.names[i] < b.names[j]
 }
 
 type genericScheduler struct {
 	predicates   map[string]algorithm.FitPredicate
 	prioritizers []algorithm.PriorityConfig
+	extenders    []algorithm.SchedulerExtender
 	pods         algorithm.PodLister
 	random       *rand.Rand
 	randomLock   sync.Mutex
+	// decisions records why each pod went where it did; it may be nil.
+	decisions *DecisionLog
 }
 
 func (g *genericScheduler) Schedule(pod *api.Pod, minionLister algorithm.MinionLister) (string, error) {
@@ -69,15 +109,35 @@ func (g *genericScheduler) Schedule(pod *api.Pod, minionLister algorithm.MinionL
 		return "", ErrNoNodesAvailable
 	}
 
-	filteredNodes, failedPredicateMap, err := findNodesThatFit(pod, g.pods, g.predicates, minions)
+	if g.decisions == nil {
+		return g.schedule(pod, minions, nil)
+	}
+	decision := newSchedulingDecision(pod, minions)
+	host, err := g.schedule(pod, minions, decision)
+	decision.finish(host, err)
+	g.decisions.Record(decision)
+	return host, err
+}
+
+// schedule picks a minion for the pod, filling in decision if it is not nil.
+func (g *genericScheduler) schedule(pod *api.Pod, minions api.NodeList, decision *SchedulingDecision) (string, error) {
+	filteredNodes, failedPredicateMap, err := findNodesThatFit(pod, g.pods, g.predicates, minions, g.extenders, decision != nil)
 	if err != nil {
 		return "", err
 	}
 
-	priorityList, err := PrioritizeNodes(pod, g.pods, g.prioritizers, algorithm.FakeMinionLister(filteredNodes))
+	var scores map[string]map[string]int
+	if decision != nil {
+		decision.recordFailures(failedPredicateMap)
+		scores = map[string]map[string]int{}
+	}
+	priorityList, err := prioritizeNodes(pod, g.pods, g.prioritizers, algorithm.FakeMinionLister(filteredNodes), g.extenders, scores)
 	if err != nil {
 		return "", err
 	}
+	if decision != nil {
+		decision.recordScores(priorityList, scores)
+	}
 	if len(priorityList) == 0 {
 		return "", &FitError{
 			Pod:              pod,
@@ -105,18 +165,26 @@ func (g *genericScheduler) selectHost(priorityList algorithm.HostPriorityList) (
 }
 
 // Filters the minions to find the ones that fit based on the given predicate functions
-// Each minion is passed through the predicate functions to determine if it is a fit
-func findNodesThatFit(pod *api.Pod, podLister algorithm.PodLister, predicateFuncs map[string]algorithm.FitPredicate, nodes api.NodeList) (api.NodeList, FailedPredicateMap, error) {
+// Each minion is passed through the predicate functions, in name order, to determine if it is a fit
+// Evaluation of a minion stops at its first failed predicate unless allFailures is set,
+// in which case every predicate is run and all of the minion's failures are recorded
+// The minions that fit are then passed through each extender's filter in turn
+func findNodesThatFit(pod *api.Pod, podLister algorithm.PodLister, predicateFuncs map[string]algorithm.FitPredicate, nodes api.NodeList, extenders []algorithm.SchedulerExtender, allFailures bool) (api.NodeList, FailedPredicateMap, error) {
 	filtered := []api.Node{}
 	machineToPods, err := predicates.MapPodsToMachines(podLister)
 	failedPredicateMap := FailedPredicateMap{}
 	if err != nil {
 		return api.NodeList{}, FailedPredicateMap{}, err
 	}
+	names := make([]string, 0, len(predicateFuncs))
+	for name := range predicateFuncs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 	for _, node := range nodes.Items {
 		fits := true
-		for name, predicate := range predicateFuncs {
-			fit, err := predicate(pod, machineToPods[node.Name], node.Name)
+		for _, name := range names {
+			fit, err := predicateFuncs[name](pod, machineToPods[node.Name], node.Name)
 			if err != nil {
 				return api.NodeList{}, FailedPredicateMap{}, err
 			}
@@ -126,13 +194,48 @@ func findNodesThatFit(pod *api.Pod, podLister algorithm.PodLister, predicateFunc
 					failedPredicateMap[node.Name] = util.StringSet{}
 				}
 				failedPredicateMap[node.Name].Insert(name)
-				break
+				if !allFailures {
+					break
+				}
 			}
 		}
 		if fits {
 			filtered = append(filtered, node)
 		}
 	}
+	for _, extender := range extenders {
+		if len(filtered) == 0 {
+			break
+		}
+		filteredList, err := extender.Filter(pod, &api.NodeList{Items: filtered})
+		if err != nil {
+			if extender.IsIgnorable() {
+				glog.Warningf("Ignoring failed scheduler extender filter for pod %v: %v", pod.Name, err)
+				continue
+			}
+			return api.NodeList{}, FailedPredicateMap{}, err
+		}
+		kept := util.NewStringSet()
+		for _, node := range filteredList.Items {
+			kept.Insert(node.Name)
+		}
+		// Only trust the extender to remove minions: anything it returns that
+		// was not a candidate is dropped, and the candidates keep the node
+		// objects we listed rather than whatever the extender sent back.
+		candidates := filtered
+		filtered = []api.Node{}
+		for _, node := range candidates {
+			if kept.Has(node.Name) {
+				filtered = append(filtered, node)
+				kept.Delete(node.Name)
+			} else {
+				failedPredicateMap[node.Name] = util.NewStringSet(extenderPredicate)
+			}
+		}
+		if kept.Len() > 0 {
+			glog.Warningf("Ignoring minions %v returned by scheduler extender for pod %v that were not candidates", kept.List(), pod.Name)
+		}
+	}
 	return api.NodeList{Items: filtered}, failedPredicateMap, nil
 }
 
@@ -141,14 +244,31 @@ func findNodesThatFit(pod *api.Pod, podLister algorithm.PodLister, predicateFunc
 // 0 is the lowest priority score (least preferred minion) and 10 is the highest
 // Each priority function can also have its own weight
 // The minion scores returned by the priority function are multiplied by the weights to get weighted scores
+// Each extender's weighted scores are added on top for the minions it knows about
 // All scores are finally combined (added) to get the total weighted scores of all minions
-func PrioritizeNodes(pod *api.Pod, podLister algorithm.PodLister, priorityConfigs []algorithm.PriorityConfig, minionLister algorithm.MinionLister) (algorithm.HostPriorityList, error) {
+func PrioritizeNodes(pod *api.Pod, podLister algorithm.PodLister, priorityConfigs []algorithm.PriorityConfig, minionLister algorithm.MinionLister, extenders []algorithm.SchedulerExtender) (algorithm.HostPriorityList, error) {
+	return prioritizeNodes(pod, podLister, priorityConfigs, minionLister, extenders, nil)
+}
+
+// prioritizeNodes is PrioritizeNodes, additionally recording the weighted score
+// each priority function and extender gave each minion in scores, keyed by
+// minion and then by priority name, if scores is not nil.
+func prioritizeNodes(pod *api.Pod, podLister algorithm.PodLister, priorityConfigs []algorithm.PriorityConfig, minionLister algorithm.MinionLister, extenders []algorithm.SchedulerExtender, scores map[string]map[string]int) (algorithm.HostPriorityList, error) {
 	result := algorithm.HostPriorityList{}
 
 	// If no priority configs are provided, then the EqualPriority function is applied
 	// This is required to generate the priority list in the required format
 	if len(priorityConfigs) == 0 {
-		return EqualPriority(pod, podLister, minionLister)
+		priorityConfigs = []algorithm.PriorityConfig{{Name: equalPriority, Function: EqualPriority, Weight: 1}}
+	}
+	record := func(host, name string, score int) {
+		if scores == nil {
+			return
+		}
+		if _, found := scores[host]; !found {
+			scores[host] = map[string]int{}
+		}
+		scores[host][name] += score
 	}
 
 	combinedScores := map[string]int{}
@@ -165,6 +285,30 @@ func PrioritizeNodes(pod *api.Pod, podLister algorithm.PodLister, priorityConfig
 		}
 		for _, hostEntry := range prioritizedList {
 			combinedScores[hostEntry.Host] += hostEntry.Score * weight
+			record(hostEntry.Host, priorityConfig.Name, hostEntry.Score*weight)
+		}
+	}
+	if len(extenders) != 0 {
+		nodes, err := minionLister.List()
+		if err != nil {
+			return algorithm.HostPriorityList{}, err
+		}
+		for _, extender := range extenders {
+			prioritizedList, weight, err := extender.Prioritize(pod, &nodes)
+			if err != nil {
+				if extender.IsIgnorable() {
+					glog.Warningf("Ignoring failed scheduler extender prioritization for pod %v: %v", pod.Name, err)
+					continue
+				}
+				return algorithm.HostPriorityList{}, err
+			}
+			for _, hostEntry := range prioritizedList {
+				// Only score minions that are still candidates.
+				if _, found := combinedScores[hostEntry.Host]; found {
+					combinedScores[hostEntry.Host] += hostEntry.Score * weight
+					record(hostEntry.Host, extenderPredicate, hostEntry.Score*weight)
+				}
+			}
 		}
 	}
 	for host, score := range combinedScores {
@@ -204,11 +348,15 @@ func EqualPriority(_ *api.Pod, podLister algorithm.PodLister, minionLister algor
 	return result, nil
 }
 
-func NewGenericScheduler(predicates map[string]algorithm.FitPredicate, prioritizers []algorithm.PriorityConfig, pods algorithm.PodLister, random *rand.Rand) algorithm.ScheduleAlgorithm {
+// NewGenericScheduler returns a scheduler that records its decisions in the
+// given log, unless it is nil.
+func NewGenericScheduler(predicates map[string]algorithm.FitPredicate, prioritizers []algorithm.PriorityConfig, extenders []algorithm.SchedulerExtender, pods algorithm.PodLister, random *rand.Rand, decisions *DecisionLog) algorithm.ScheduleAlgorithm {
 	return &genericScheduler{
 		predicates:   predicates,
 		prioritizers: prioritizers,
+		extenders:    extenders,
 		pods:         pods,
 		random:       random,
+		decisions:    decisions,
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -287,7 +288,7 @@ func TestGenericScheduler(t *testing.T) {
 
 	for _, test := range tests {
 		random := rand.New(rand.NewSource(0))
-		scheduler := NewGenericScheduler(test.predicates, test.prioritizers, algorithm.FakePodLister(test.pods), random)
+		scheduler := NewGenericScheduler(test.predicates, test.prioritizers, []algorithm.SchedulerExtender{}, algorithm.FakePodLister(test.pods), random, nil)
 		machine, err := scheduler.Schedule(test.pod, algorithm.FakeMinionLister(makeNodeList(test.nodes)))
 		if test.expectsErr {
 			if err == nil {
@@ -307,7 +308,7 @@ func TestGenericScheduler(t *testing.T) {
 func TestFindFitAllError(t *testing.T) {
 	nodes := []string{"3", "2", "1"}
 	predicates := map[string]algorithm.FitPredicate{"true": truePredicate, "false": falsePredicate}
-	_, predicateMap, err := findNodesThatFit(&api.Pod{}, algorithm.FakePodLister([]*api.Pod{}), predicates, makeNodeList(nodes))
+	_, predicateMap, err := findNodesThatFit(&api.Pod{}, algorithm.FakePodLister([]*api.Pod{}), predicates, makeNodeList(nodes), nil, false)
 
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
@@ -332,7 +333,7 @@ func TestFindFitSomeError(t *testing.T) {
 	nodes := []string{"3", "2", "1"}
 	predicates := map[string]algorithm.FitPredicate{"true": truePredicate, "match": matchesPredicate}
 	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "1"}}
-	_, predicateMap, err := findNodesThatFit(pod, algorithm.FakePodLister([]*api.Pod{}), predicates, makeNodeList(nodes))
+	_, predicateMap, err := findNodesThatFit(pod, algorithm.FakePodLister([]*api.Pod{}), predicates, makeNodeList(nodes), nil, false)
 
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
@@ -355,3 +356,48 @@ func TestFindFitSomeError(t *testing.T) {
 		}
 	}
 }
+
+func TestFindFitPredicateOrder(t *testing.T) {
+	nodes := []string{"3", "2", "1"}
+	predicates := map[string]algorithm.FitPredicate{"c": falsePredicate, "a": falsePredicate, "b": truePredicate, "d": falsePredicate}
+	for i := 0; i < 10; i++ {
+		_, predicateMap, err := findNodesThatFit(&api.Pod{}, algorithm.FakePodLister([]*api.Pod{}), predicates, makeNodeList(nodes), nil, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, node := range nodes {
+			if failures := predicateMap[node]; !reflect.DeepEqual([]string{"a"}, failures.List()) {
+				t.Errorf("expected only the first predicate by name to be recorded for node %s, got %v", node, failures.List())
+			}
+		}
+	}
+
+	_, predicateMap, err := findNodesThatFit(&api.Pod{}, algorithm.FakePodLister([]*api.Pod{}), predicates, makeNodeList(nodes), nil, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, node := range nodes {
+		if failures := predicateMap[node]; !reflect.DeepEqual([]string{"a", "c", "d"}, failures.List()) {
+			t.Errorf("expected every failed predicate to be recorded for node %s, got %v", node, failures.List())
+		}
+	}
+}
+
+func TestFitErrorCountsFailedPredicates(t *testing.T) {
+	err := &FitError{
+		Pod: &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}},
+		FailedPredicates: FailedPredicateMap{
+			"machine1": util.NewStringSet("PodFitsPorts"),
+			"machine2": util.NewStringSet("PodFitsResources"),
+			"machine3": util.NewStringSet("PodFitsResources"),
+			"machine4": util.NewStringSet("MatchNodeSelector"),
+		},
+	}
+	expected := "pod (foo) failed to fit on 4 node(s): PodFitsResources (2), MatchNodeSelector (1), PodFitsPorts (1)"
+	if got := err.Error(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+	if failure, ok := algorithm.ParseFitFailure(err.Error()); !ok || !reflect.DeepEqual(err.Failure(), failure) {
+		t.Errorf("expected %q to parse as %#v, got %#v", err.Error(), err.Failure(), failure)
+	}
+}
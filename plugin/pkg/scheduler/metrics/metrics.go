@@ -47,6 +47,13 @@ var (
 			Help:      "Binding latency",
 		},
 	)
+	PreemptionVictims = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: schedulerSubsystem,
+			Name:      "preemption_victims",
+			Help:      "Number of lower priority pods evicted to make room for higher priority pods",
+		},
+	)
 )
 
 var registerMetrics sync.Once
@@ -58,6 +65,7 @@ func Register() {
 		prometheus.MustRegister(E2eSchedulingLatency)
 		prometheus.MustRegister(SchedulingAlgorithmLatency)
 		prometheus.MustRegister(BindingLatency)
+		prometheus.MustRegister(PreemptionVictims)
 	})
 }
 
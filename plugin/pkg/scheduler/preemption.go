@@ -0,0 +1,142 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"sort"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+)
+
+// preemptionCandidate is a minion on which the pod would fit once the victims are removed.
+type preemptionCandidate struct {
+	node    string
+	victims []*api.Pod
+}
+
+// highestVictimPriority returns the priority of the most important victim.
+func (c *preemptionCandidate) highestVictimPriority() int {
+	highest := 0
+	for i, victim := range c.victims {
+		if i == 0 || victim.Spec.Priority > highest {
+			highest = victim.Spec.Priority
+		}
+	}
+	return highest
+}
+
+// betterThan prefers candidates that need no victims, then those whose most
+// important victim has the lowest priority, then those with fewer victims.
+func (c *preemptionCandidate) betterThan(other *preemptionCandidate) bool {
+	if len(c.victims) == 0 || len(other.victims) == 0 {
+		return len(c.victims) < len(other.victims)
+	}
+	if a, b := c.highestVictimPriority(), other.highestVictimPriority(); a != b {
+		return a < b
+	}
+	return len(c.victims) < len(other.victims)
+}
+
+type byPriority []*api.Pod
+
+func (p byPriority) Len() int           { return len(p) }
+func (p byPriority) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p byPriority) Less(i, j int) bool { return p[i].Spec.Priority < p[j].Spec.Priority }
+
+// Preempt finds the minion on which the pod would fit if pods with a strictly
+// lower priority were removed, and returns it along with the pods to remove.
+// The predicates are re-evaluated against each minion's remaining pods, so
+// resource and host port conflicts with the victims no longer count.
+// Scheduler extenders are not consulted.  Pods that are already being deleted
+// are treated as gone, and a minion freed up by them alone needs no victims.
+func (g *genericScheduler) Preempt(pod *api.Pod, minionLister algorithm.MinionLister) (string, []*api.Pod, error) {
+	minions, err := minionLister.List()
+	if err != nil {
+		return "", nil, err
+	}
+	machineToPods, err := predicates.MapPodsToMachines(g.pods)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var best *preemptionCandidate
+	for _, minion := range minions.Items {
+		candidate, err := g.selectVictimsOnMinion(pod, minion.Name, machineToPods[minion.Name])
+		if err != nil {
+			return "", nil, err
+		}
+		if candidate != nil && (best == nil || candidate.betterThan(best)) {
+			best = candidate
+		}
+	}
+	if best == nil {
+		return "", nil, nil
+	}
+	return best.node, best.victims, nil
+}
+
+// selectVictimsOnMinion returns the smallest set of lower priority pods whose
+// removal lets the pod fit on the minion, or nil if removing all of them is
+// not enough.  Lower priority pods are kept greedily, most important first.
+func (g *genericScheduler) selectVictimsOnMinion(pod *api.Pod, minion string, pods []*api.Pod) (*preemptionCandidate, error) {
+	remaining := []*api.Pod{}
+	lowerPriority := []*api.Pod{}
+	for _, existing := range pods {
+		if existing.DeletionTimestamp != nil {
+			continue
+		}
+		if existing.Spec.Priority < pod.Spec.Priority {
+			lowerPriority = append(lowerPriority, existing)
+		} else {
+			remaining = append(remaining, existing)
+		}
+	}
+
+	fits, err := g.podFitsOnMinion(pod, remaining, minion)
+	if err != nil || !fits {
+		return nil, err
+	}
+
+	sort.Sort(sort.Reverse(byPriority(lowerPriority)))
+	victims := []*api.Pod{}
+	for _, existing := range lowerPriority {
+		withExisting := append(append([]*api.Pod{}, remaining...), existing)
+		fits, err := g.podFitsOnMinion(pod, withExisting, minion)
+		if err != nil {
+			return nil, err
+		}
+		if fits {
+			remaining = withExisting
+		} else {
+			victims = append(victims, existing)
+		}
+	}
+	return &preemptionCandidate{node: minion, victims: victims}, nil
+}
+
+// podFitsOnMinion runs every predicate for the pod against the given pods on the minion.
+func (g *genericScheduler) podFitsOnMinion(pod *api.Pod, existingPods []*api.Pod, minion string) (bool, error) {
+	for _, predicate := range g.predicates {
+		fit, err := predicate(pod, existingPods, minion)
+		if err != nil || !fit {
+			return false, err
+		}
+	}
+	return true, nil
+}
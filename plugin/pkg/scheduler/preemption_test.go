@@ -0,0 +1,175 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+)
+
+func makePreemptionNode(name string, milliCPU int64) api.Node {
+	return api.Node{
+		ObjectMeta: api.ObjectMeta{Name: name},
+		Status: api.NodeStatus{
+			Capacity: api.ResourceList{
+				api.ResourceCPU:    *resource.NewMilliQuantity(milliCPU, resource.DecimalSI),
+				api.ResourceMemory: *resource.NewQuantity(1<<30, resource.BinarySI),
+				api.ResourcePods:   *resource.NewQuantity(10, resource.DecimalSI),
+			},
+		},
+	}
+}
+
+func makePreemptionPod(name, node string, priority int, milliCPU int64, hostPort int) *api.Pod {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec: api.PodSpec{
+			NodeName: node,
+			Priority: priority,
+			Containers: []api.Container{{
+				Name: "ctr",
+				Resources: api.ResourceRequirements{
+					Requests: api.ResourceList{
+						api.ResourceCPU: *resource.NewMilliQuantity(milliCPU, resource.DecimalSI),
+					},
+				},
+			}},
+		},
+	}
+	if hostPort != 0 {
+		pod.Spec.Containers[0].Ports = []api.ContainerPort{{HostPort: hostPort}}
+	}
+	return pod
+}
+
+func victimNames(pods []*api.Pod) []string {
+	names := []string{}
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func TestPreempt(t *testing.T) {
+	nodes := api.NodeList{Items: []api.Node{makePreemptionNode("machine1", 1000), makePreemptionNode("machine2", 1000)}}
+	terminating := makePreemptionPod("terminating", "machine2", 0, 800, 0)
+	now := util.Now()
+	terminating.DeletionTimestamp = &now
+
+	tests := []struct {
+		pod             *api.Pod
+		pods            []*api.Pod
+		expectedNode    string
+		expectedVictims []string
+		test            string
+	}{
+		{
+			pod: makePreemptionPod("pending", "", 5, 500, 0),
+			pods: []*api.Pod{
+				makePreemptionPod("low", "machine1", 0, 600, 0),
+				makePreemptionPod("medium", "machine1", 1, 300, 0),
+				makePreemptionPod("high", "machine2", 10, 800, 0),
+			},
+			expectedNode:    "machine1",
+			expectedVictims: []string{"low"},
+			test:            "evicts only the lower priority pods needed to fit",
+		},
+		{
+			pod: makePreemptionPod("pending", "", 5, 500, 0),
+			pods: []*api.Pod{
+				makePreemptionPod("equal", "machine1", 5, 600, 0),
+				makePreemptionPod("high", "machine2", 10, 800, 0),
+			},
+			expectedNode: "",
+			test:         "pods of equal or higher priority are never victims",
+		},
+		{
+			pod: makePreemptionPod("pending", "", 5, 500, 0),
+			pods: []*api.Pod{
+				makePreemptionPod("three", "machine1", 3, 800, 0),
+				makePreemptionPod("one", "machine2", 1, 800, 0),
+			},
+			expectedNode:    "machine2",
+			expectedVictims: []string{"one"},
+			test:            "prefers the node whose victims have the lowest priority",
+		},
+		{
+			pod: makePreemptionPod("pending", "", 5, 500, 0),
+			pods: []*api.Pod{
+				makePreemptionPod("a", "machine1", 1, 600, 0),
+				makePreemptionPod("b", "machine1", 1, 600, 0),
+				makePreemptionPod("c", "machine2", 1, 800, 0),
+			},
+			expectedNode:    "machine2",
+			expectedVictims: []string{"c"},
+			test:            "prefers the node with fewer victims",
+		},
+		{
+			pod: makePreemptionPod("pending", "", 5, 100, 80),
+			pods: []*api.Pod{
+				makePreemptionPod("port", "machine1", 0, 100, 80),
+				makePreemptionPod("high", "machine2", 10, 100, 80),
+			},
+			expectedNode:    "machine1",
+			expectedVictims: []string{"port"},
+			test:            "evicts a lower priority pod holding the host port",
+		},
+		{
+			pod: makePreemptionPod("pending", "", 5, 500, 0),
+			pods: []*api.Pod{
+				makePreemptionPod("low", "machine1", 0, 800, 0),
+				terminating,
+			},
+			expectedNode: "machine2",
+			test:         "pods already being deleted free their node without victims",
+		},
+	}
+
+	for _, test := range tests {
+		info := predicates.StaticNodeInfo{NodeList: &nodes}
+		scheduler := NewGenericScheduler(
+			map[string]algorithm.FitPredicate{
+				"PodFitsResources": predicates.NewResourceFitPredicate(info),
+				"PodFitsPorts":     predicates.PodFitsPorts,
+			},
+			[]algorithm.PriorityConfig{},
+			[]algorithm.SchedulerExtender{},
+			algorithm.FakePodLister(test.pods),
+			rand.New(rand.NewSource(0)),
+			nil)
+		node, victims, err := scheduler.(algorithm.SchedulePreemptor).Preempt(test.pod, algorithm.FakeMinionLister(nodes))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+		}
+		if node != test.expectedNode {
+			t.Errorf("%s: expected node %q, got %q", test.test, test.expectedNode, node)
+		}
+		if len(test.expectedVictims) == 0 && len(victims) == 0 {
+			continue
+		}
+		if names := victimNames(victims); !reflect.DeepEqual(test.expectedVictims, names) {
+			t.Errorf("%s: expected victims %v, got %v", test.test, test.expectedVictims, names)
+		}
+	}
+}
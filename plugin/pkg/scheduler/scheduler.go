@@ -20,6 +20,7 @@ package scheduler
 // contrib/mesos/pkg/scheduler/.
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
@@ -36,6 +37,11 @@ type Binder interface {
 	Bind(binding *api.Binding) error
 }
 
+// PodEvictor knows how to evict a pod to make room for a higher priority one.
+type PodEvictor interface {
+	Evict(pod *api.Pod) error
+}
+
 // SystemModeler can help scheduler produce a model of the system that
 // anticipates reality. For example, if scheduler has pods A and B both
 // using hostPort 80, when it binds A to machine M it should not bind B
@@ -66,6 +72,8 @@ type SystemModeler interface {
 // minions that they fit on and writes bindings back to the api server.
 type Scheduler struct {
 	config *Config
+	// gangs holds the gang members waiting for the rest of their gang.
+	gangs *gangSet
 }
 
 type Config struct {
@@ -75,6 +83,16 @@ type Config struct {
 	MinionLister algorithm.MinionLister
 	Algorithm    algorithm.ScheduleAlgorithm
 	Binder       Binder
+	// PodEvictor evicts the victims when the algorithm preempts lower
+	// priority pods.  Preemption is disabled if it is nil.
+	PodEvictor PodEvictor
+	// PodLister lists the pods that are scheduled or assumed to be, so that
+	// gang members placed earlier count towards their gang.  Only the members
+	// the scheduler holds are counted if it is nil.
+	PodLister algorithm.PodLister
+	// GangTimeout is how long gang members are held waiting for the rest of
+	// their gang.  DefaultGangTimeout is used if it is zero.
+	GangTimeout time.Duration
 
 	// Rate at which we can create pods
 	BindPodsRateLimiter util.RateLimiter
@@ -100,6 +118,7 @@ type Config struct {
 func New(c *Config) *Scheduler {
 	s := &Scheduler{
 		config: c,
+		gangs:  newGangSet(),
 	}
 	metrics.Register()
 	return s
@@ -108,6 +127,7 @@ func New(c *Config) *Scheduler {
 // Run begins watching and scheduling. It starts a goroutine and returns immediately.
 func (s *Scheduler) Run() {
 	go util.Until(s.scheduleOne, 0, s.config.StopEverything)
+	go util.Until(s.expireGangs, time.Second, s.config.StopEverything)
 }
 
 func (s *Scheduler) scheduleOne() {
@@ -126,9 +146,26 @@ func (s *Scheduler) scheduleOne() {
 	if err != nil {
 		glog.V(1).Infof("Failed to schedule: %v", pod)
 		s.config.Recorder.Eventf(pod, "failedScheduling", "%v", err)
+		// Preempting pods for a gang member would be wasted if the rest of
+		// the gang cannot be placed.
+		if _, ok := err.(*FitError); ok {
+			if _, _, isGang := gangOf(pod); !isGang {
+				s.preempt(pod)
+			}
+		}
 		s.config.Error(pod, err)
 		return
 	}
+	if key, minSize, ok := gangOf(pod); ok {
+		s.holdGangMember(pod, dest, key, minSize)
+		return
+	}
+	s.bind(pod, dest)
+}
+
+// bind writes the binding of the pod to dest.  If that fails, the pod is sent
+// back to be retried and bind returns false.
+func (s *Scheduler) bind(pod *api.Pod, dest string) bool {
 	b := &api.Binding{
 		ObjectMeta: api.ObjectMeta{Namespace: pod.Namespace, Name: pod.Name},
 		Target: api.ObjectReference{
@@ -139,12 +176,15 @@ func (s *Scheduler) scheduleOne() {
 
 	// We want to add the pod to the model iff the bind succeeds, but we don't want to race
 	// with any deletions, which happen asynchronously.
+	bound := false
 	s.config.Modeler.LockedAction(func() {
 		bindingStart := time.Now()
 		err := s.config.Binder.Bind(b)
 		metrics.BindingLatency.Observe(metrics.SinceInMicroseconds(bindingStart))
 		if err != nil {
 			glog.V(1).Infof("Failed to bind pod: %v", err)
+			// A held gang member was assumed before it was bound.
+			s.config.Modeler.ForgetPod(pod)
 			s.config.Recorder.Eventf(pod, "failedScheduling", "Binding rejected: %v", err)
 			s.config.Error(pod, err)
 			return
@@ -154,5 +194,103 @@ func (s *Scheduler) scheduleOne() {
 		assumed := *pod
 		assumed.Spec.NodeName = dest
 		s.config.Modeler.AssumePod(&assumed)
+		bound = true
 	})
+	return bound
+}
+
+// holdGangMember assumes that the gang member is on dest, so that the rest of
+// its gang is placed around it, and holds it until enough of the gang has
+// been placed.  Then the held members are bound together.
+//
+// Binding a gang is not atomic.  If some of the bindings fail, the members
+// that were bound stay bound and the others are retried on their own, like
+// members that arrive after the gang was placed.
+func (s *Scheduler) holdGangMember(pod *api.Pod, dest, key string, minSize int) {
+	assumed := *pod
+	assumed.Spec.NodeName = dest
+	s.config.Modeler.LockedAction(func() {
+		s.config.Modeler.AssumePod(&assumed)
+	})
+	timeout := s.config.GangTimeout
+	if timeout == 0 {
+		timeout = DefaultGangTimeout
+	}
+	placed := s.gangs.hold(key, minSize, timeout, pod, dest)
+	if s.config.PodLister != nil {
+		var err error
+		if placed, err = countPlacedGangMembers(s.config.PodLister, pod); err != nil {
+			glog.Errorf("Error counting the placed members of gang %v: %v", key, err)
+			return
+		}
+	}
+	if placed < minSize {
+		glog.V(2).Infof("Holding pod %v on %v until gang %v is placed: %d of %d members placed", pod.Name, dest, key, placed, minSize)
+		return
+	}
+
+	members := s.gangs.release(key)
+	glog.V(2).Infof("Binding %d held member(s) of gang %v", len(members), key)
+	failed := 0
+	for _, member := range members {
+		if !s.bind(member.pod, member.dest) {
+			failed++
+		}
+	}
+	if failed > 0 {
+		glog.V(1).Infof("Gang %v was partially bound: %d of %d held member(s) failed to bind and will be retried", key, failed, len(members))
+	}
+}
+
+// expireGangs gives up on the gangs that could not be placed in time and
+// sends their members back to be retried.  It keeps the placements of the
+// members of the other gangs assumed.
+func (s *Scheduler) expireGangs() {
+	expired, held := s.gangs.expire()
+	s.config.Modeler.LockedAction(func() {
+		for _, member := range held {
+			assumed := *member.pod
+			assumed.Spec.NodeName = member.dest
+			s.config.Modeler.AssumePod(&assumed)
+		}
+	})
+	for _, g := range expired {
+		glog.V(1).Infof("Gave up waiting for gang %v: %d of %d members held", g.key, len(g.members), g.minSize)
+		for _, member := range g.members {
+			s.config.Modeler.LockedAction(func() {
+				s.config.Modeler.ForgetPod(member.pod)
+			})
+			err := fmt.Errorf("gave up waiting for gang %v: %d of %d members held", g.key, len(g.members), g.minSize)
+			s.config.Recorder.Eventf(member.pod, "failedScheduling", "%v", err)
+			s.config.Error(member.pod, err)
+		}
+	}
+}
+
+// preempt evicts lower priority pods from a node so that the pod, which fits
+// on no node as things stand, will fit there.  The pod itself goes back through
+// the error handler and is placed when it is retried after the victims are gone.
+func (s *Scheduler) preempt(pod *api.Pod) {
+	preemptor, ok := s.config.Algorithm.(algorithm.SchedulePreemptor)
+	if !ok || s.config.PodEvictor == nil {
+		return
+	}
+	dest, victims, err := preemptor.Preempt(pod, s.config.MinionLister)
+	if err != nil {
+		glog.Errorf("Error preempting pods for %v: %v", pod.Name, err)
+		return
+	}
+	if len(dest) == 0 || len(victims) == 0 {
+		return
+	}
+	glog.V(1).Infof("Preempting %d pod(s) on %v for %v", len(victims), dest, pod.Name)
+	s.config.Recorder.Eventf(pod, "preempting", "Preempting %d lower priority pod(s) on %v", len(victims), dest)
+	for _, victim := range victims {
+		if err := s.config.PodEvictor.Evict(victim); err != nil {
+			glog.Errorf("Failed to evict pod %v for %v: %v", victim.Name, pod.Name, err)
+			continue
+		}
+		metrics.PreemptionVictims.Inc()
+		s.config.Recorder.Eventf(victim, "preempted", "Preempted by %v/%v with priority %d on %v", pod.Namespace, pod.Name, pod.Spec.Priority, dest)
+	}
 }
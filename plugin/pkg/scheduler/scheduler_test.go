@@ -157,6 +157,79 @@ func TestScheduler(t *testing.T) {
 	}
 }
 
+type mockPreemptor struct {
+	mockScheduler
+	machine string
+	victims []*api.Pod
+}
+
+func (p mockPreemptor) Preempt(pod *api.Pod, ml algorithm.MinionLister) (string, []*api.Pod, error) {
+	return p.machine, p.victims, nil
+}
+
+type fakePodEvictor struct {
+	evicted []string
+}
+
+func (e *fakePodEvictor) Evict(pod *api.Pod) error {
+	e.evicted = append(e.evicted, pod.Name)
+	return nil
+}
+
+func TestSchedulerPreemptsOnFitError(t *testing.T) {
+	eventBroadcaster := record.NewBroadcaster()
+	defer eventBroadcaster.StartLogging(t.Logf).Stop()
+
+	pod := podWithID("foo", "")
+	fitErr := &FitError{Pod: pod, FailedPredicates: FailedPredicateMap{}}
+	evictor := &fakePodEvictor{}
+	var gotError error
+	c := &Config{
+		Modeler:      &FakeModeler{},
+		MinionLister: algorithm.FakeMinionLister(api.NodeList{Items: []api.Node{{ObjectMeta: api.ObjectMeta{Name: "machine1"}}}}),
+		Algorithm: mockPreemptor{
+			mockScheduler: mockScheduler{"", fitErr},
+			machine:       "machine1",
+			victims:       []*api.Pod{podWithID("bar", "machine1"), podWithID("baz", "machine1")},
+		},
+		Binder:     fakeBinder{func(b *api.Binding) error { return nil }},
+		PodEvictor: evictor,
+		Error: func(p *api.Pod, err error) {
+			gotError = err
+		},
+		NextPod: func() *api.Pod {
+			return pod
+		},
+		Recorder: eventBroadcaster.NewRecorder(api.EventSource{Component: "scheduler"}),
+	}
+	reasons := make(chan string, 10)
+	events := eventBroadcaster.StartEventWatcher(func(e *api.Event) {
+		reasons <- e.Reason
+	})
+	defer events.Stop()
+
+	New(c).scheduleOne()
+
+	if e, a := []string{"bar", "baz"}, evictor.evicted; !reflect.DeepEqual(e, a) {
+		t.Errorf("evicted: wanted %v, got %v", e, a)
+	}
+	if gotError != fitErr {
+		t.Errorf("expected the pod to be requeued with the fit error, got %v", gotError)
+	}
+	got := util.StringSet{}
+	for i := 0; i < 4; i++ {
+		select {
+		case reason := <-reasons:
+			got.Insert(reason)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for events, got %v", got.List())
+		}
+	}
+	if !got.HasAll("failedScheduling", "preempting", "preempted") {
+		t.Errorf("expected failedScheduling, preempting and preempted events, got %v", got.List())
+	}
+}
+
 func TestSchedulerForgetAssumedPodAfterDelete(t *testing.T) {
 	eventBroadcaster := record.NewBroadcaster()
 	defer eventBroadcaster.StartLogging(t.Logf).Stop()
@@ -190,8 +263,10 @@ func TestSchedulerForgetAssumedPodAfterDelete(t *testing.T) {
 	algo := NewGenericScheduler(
 		map[string]algorithm.FitPredicate{"PodFitsPorts": predicates.PodFitsPorts},
 		[]algorithm.PriorityConfig{},
+		[]algorithm.SchedulerExtender{},
 		modeler.PodLister(),
-		rand.New(rand.NewSource(time.Now().UnixNano())))
+		rand.New(rand.NewSource(time.Now().UnixNano())),
+		nil)
 
 	var gotBinding *api.Binding
 	c := &Config{
@@ -322,8 +397,10 @@ func TestSchedulerRateLimitsBinding(t *testing.T) {
 	algo := NewGenericScheduler(
 		map[string]algorithm.FitPredicate{},
 		[]algorithm.PriorityConfig{},
+		[]algorithm.SchedulerExtender{},
 		modeler.PodLister(),
-		rand.New(rand.NewSource(time.Now().UnixNano())))
+		rand.New(rand.NewSource(time.Now().UnixNano())),
+		nil)
 
 	// Rate limit to 1 pod
 	fr := FakeRateLimiter{util.NewTokenBucketRateLimiter(0.02, 1), []bool{}}
@@ -0,0 +1,163 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/factory"
+)
+
+// Cluster is a snapshot of the nodes, bound pods and services of a cluster
+// that scheduling can be simulated against.
+type Cluster struct {
+	Nodes    []api.Node
+	Pods     []*api.Pod
+	Services []api.Service
+}
+
+// Snapshot lists the nodes, pods and services of a live cluster.
+func Snapshot(c client.Interface) (*Cluster, error) {
+	cluster := &Cluster{}
+	nodes, err := c.Nodes().List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods, err := c.Pods(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	services, err := c.Services(api.NamespaceAll).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range []runtime.Object{nodes, pods, services} {
+		if err := cluster.Add(obj); err != nil {
+			return nil, err
+		}
+	}
+	return cluster, nil
+}
+
+// Add adds nodes, pods and services, or lists of them, to the cluster.  Pods
+// that are not bound to a node or have terminated are ignored, since they do
+// not take up room on any node.
+func (c *Cluster) Add(obj runtime.Object) error {
+	switch t := obj.(type) {
+	case *api.Node:
+		c.Nodes = append(c.Nodes, *t)
+	case *api.Pod:
+		if len(t.Spec.NodeName) != 0 && t.Status.Phase != api.PodSucceeded && t.Status.Phase != api.PodFailed {
+			c.Pods = append(c.Pods, t)
+		}
+	case *api.Service:
+		c.Services = append(c.Services, *t)
+	default:
+		items, err := runtime.ExtractList(obj)
+		if err != nil {
+			return fmt.Errorf("unable to add %T to the cluster", obj)
+		}
+		if errs := runtime.DecodeList(items, api.Scheme); len(errs) != 0 {
+			return errors.NewAggregate(errs)
+		}
+		for _, item := range items {
+			if err := c.Add(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// PluginArgs returns the arguments to build scheduling algorithms that see
+// the cluster with.
+func (c *Cluster) PluginArgs() factory.PluginFactoryArgs {
+	return factory.PluginFactoryArgs{
+		PodLister:     podLister{c},
+		ServiceLister: serviceLister{c},
+		NodeLister:    nodeLister{c},
+		NodeInfo:      nodeLister{c},
+	}
+}
+
+// MinionLister returns a lister of the nodes of the cluster that pods may be
+// scheduled onto.
+func (c *Cluster) MinionLister() algorithm.MinionLister {
+	return nodeLister{c}
+}
+
+type podLister struct {
+	cluster *Cluster
+}
+
+func (l podLister) List(selector labels.Selector) ([]*api.Pod, error) {
+	return algorithm.FakePodLister(l.cluster.Pods).List(selector)
+}
+
+type serviceLister struct {
+	cluster *Cluster
+}
+
+func (l serviceLister) List() (api.ServiceList, error) {
+	return algorithm.FakeServiceLister(l.cluster.Services).List()
+}
+
+func (l serviceLister) GetPodServices(pod *api.Pod) ([]api.Service, error) {
+	return algorithm.FakeServiceLister(l.cluster.Services).GetPodServices(pod)
+}
+
+type nodeLister struct {
+	cluster *Cluster
+}
+
+// List returns the nodes the scheduler would consider: those that are not
+// marked unschedulable and are ready.  Nodes without a Ready condition, as
+// written by hand in files, are taken to be ready.
+func (l nodeLister) List() (api.NodeList, error) {
+	nodes := api.NodeList{}
+	for _, node := range l.cluster.Nodes {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		ready := true
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == api.NodeReady {
+				ready = condition.Status == api.ConditionTrue
+			}
+		}
+		if ready {
+			nodes.Items = append(nodes.Items, node)
+		}
+	}
+	return nodes, nil
+}
+
+func (l nodeLister) GetNodeInfo(name string) (*api.Node, error) {
+	for i := range l.cluster.Nodes {
+		if l.cluster.Nodes[i].Name == name {
+			return &l.cluster.Nodes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("failed to find node: %s", name)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulator runs the scheduling algorithm against a snapshot of a
+// cluster, to answer capacity planning questions without touching the
+// cluster itself.
+package simulator
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+// Placement records the node a simulated pod was placed on.
+type Placement struct {
+	// Pod is the namespace/name key of the pod.
+	Pod  string `json:"pod"`
+	Node string `json:"node"`
+}
+
+// Result is the outcome of placing a sequence of pods.
+type Result struct {
+	Placements []Placement `json:"placements"`
+	// FailedPod is the key of the first pod that fit on no node, if any, and
+	// FailureReason says why.
+	FailedPod     string `json:"failedPod,omitempty"`
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// Simulator places pods in a Cluster with a scheduling algorithm.  The
+// algorithm should have been built from the cluster's PluginArgs, so that it
+// sees the pods placed so far.
+type Simulator struct {
+	cluster   *Cluster
+	algorithm algorithm.ScheduleAlgorithm
+}
+
+// New returns a simulator that places pods in the cluster with the algorithm.
+func New(cluster *Cluster, algo algorithm.ScheduleAlgorithm) *Simulator {
+	return &Simulator{
+		cluster:   cluster,
+		algorithm: algo,
+	}
+}
+
+// Drain removes the named nodes from the cluster, along with the pods bound
+// to them.  It returns those pods, unbound, so that they can be rescheduled.
+func (s *Simulator) Drain(names ...string) ([]*api.Pod, error) {
+	drained := util.NewStringSet(names...)
+	nodes := []api.Node{}
+	for _, node := range s.cluster.Nodes {
+		if !drained.Has(node.Name) {
+			nodes = append(nodes, node)
+		}
+	}
+	if len(s.cluster.Nodes)-len(nodes) != drained.Len() {
+		return nil, fmt.Errorf("unable to drain nodes %v: not all of them are in the cluster", drained.List())
+	}
+	s.cluster.Nodes = nodes
+
+	pods := []*api.Pod{}
+	displaced := []*api.Pod{}
+	for _, pod := range s.cluster.Pods {
+		if !drained.Has(pod.Spec.NodeName) {
+			pods = append(pods, pod)
+			continue
+		}
+		unbound := *pod
+		unbound.Spec.NodeName = ""
+		displaced = append(displaced, &unbound)
+	}
+	s.cluster.Pods = pods
+	return displaced, nil
+}
+
+// Schedule places each of the pods in turn, as the scheduler would, and adds
+// them to the cluster.  It stops at the first pod that fits on no node.
+func (s *Simulator) Schedule(pods []*api.Pod) (*Result, error) {
+	result := &Result{Placements: []Placement{}}
+	for _, pod := range pods {
+		if fit, err := s.place(pod, result); err != nil || !fit {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// Fill places copies of the template pod until one fits on no node or max
+// copies have been placed.  The copies are named after the template with a
+// numeric suffix.
+func (s *Simulator) Fill(template *api.Pod, max int) (*Result, error) {
+	prefix := template.Name + "-"
+	if len(template.Name) == 0 {
+		prefix = template.GenerateName
+	}
+	result := &Result{Placements: []Placement{}}
+	for i := 0; i < max; i++ {
+		pod := *template
+		pod.Name = prefix + strconv.Itoa(i)
+		pod.Spec.NodeName = ""
+		if fit, err := s.place(&pod, result); err != nil || !fit {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// place schedules a pod, recording the outcome in result.  It returns false if
+// the pod fits on no node.
+func (s *Simulator) place(pod *api.Pod, result *Result) (bool, error) {
+	key := pod.Namespace + "/" + pod.Name
+	host, err := s.algorithm.Schedule(pod, s.cluster.MinionLister())
+	if err != nil {
+		if _, ok := err.(*scheduler.FitError); !ok && err != scheduler.ErrNoNodesAvailable {
+			return false, err
+		}
+		result.FailedPod = key
+		result.FailureReason = err.Error()
+		return false, nil
+	}
+	placed := *pod
+	placed.Spec.NodeName = host
+	s.cluster.Pods = append(s.cluster.Pods, &placed)
+	result.Placements = append(result.Placements, Placement{Pod: key, Node: host})
+	return true, nil
+}
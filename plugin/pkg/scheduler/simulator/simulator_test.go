@@ -0,0 +1,167 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	_ "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithmprovider"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/factory"
+)
+
+func makeNode(name string, milliCPU int64) api.Node {
+	return api.Node{
+		ObjectMeta: api.ObjectMeta{Name: name},
+		Status: api.NodeStatus{
+			Capacity: api.ResourceList{
+				api.ResourceCPU:    *resource.NewMilliQuantity(milliCPU, resource.DecimalSI),
+				api.ResourceMemory: *resource.NewQuantity(1024*1024*1024, resource.BinarySI),
+				api.ResourcePods:   *resource.NewQuantity(100, resource.DecimalSI),
+			},
+		},
+	}
+}
+
+func makePod(name, node string, milliCPU int64) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{Namespace: "default", Name: name},
+		Spec: api.PodSpec{
+			NodeName: node,
+			Containers: []api.Container{{
+				Name: "c",
+				Resources: api.ResourceRequirements{
+					Requests: api.ResourceList{
+						api.ResourceCPU: *resource.NewMilliQuantity(milliCPU, resource.DecimalSI),
+					},
+				},
+			}},
+		},
+	}
+}
+
+func newSimulator(t *testing.T, cluster *Cluster) *Simulator {
+	algo, err := factory.CreateAlgorithmFromProvider(factory.DefaultProvider, cluster.PluginArgs())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return New(cluster, algo)
+}
+
+func TestFill(t *testing.T) {
+	cluster := &Cluster{}
+	for _, obj := range []runtime.Object{
+		&api.NodeList{Items: []api.Node{makeNode("machine1", 1000), makeNode("machine2", 1000)}},
+		makePod("existing", "machine1", 500),
+		makePod("pending", "", 500),
+	} {
+		if err := cluster.Add(obj); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	unschedulable := makeNode("machine3", 1000)
+	unschedulable.Spec.Unschedulable = true
+	cluster.Nodes = append(cluster.Nodes, unschedulable)
+
+	result, err := newSimulator(t, cluster).Fill(makePod("web", "", 300), 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	perNode := map[string]int{}
+	for _, placement := range result.Placements {
+		perNode[placement.Node]++
+	}
+	if expected := map[string]int{"machine1": 1, "machine2": 3}; !reflect.DeepEqual(expected, perNode) {
+		t.Errorf("expected placements %v, got %v", expected, result.Placements)
+	}
+	if result.FailedPod != "default/web-4" || !strings.Contains(result.FailureReason, "PodFitsResources") {
+		t.Errorf("unexpected failure %q: %q", result.FailedPod, result.FailureReason)
+	}
+
+	result, err = newSimulator(t, &Cluster{Nodes: []api.Node{makeNode("machine1", 1000)}}).Fill(makePod("web", "", 300), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Placements) != 2 || len(result.FailedPod) != 0 {
+		t.Errorf("expected to stop after 2 placements, got %#v", result)
+	}
+}
+
+func TestDrain(t *testing.T) {
+	cluster := &Cluster{
+		Nodes: []api.Node{makeNode("machine1", 1000), makeNode("machine2", 1000), makeNode("machine3", 1000)},
+		Pods: []*api.Pod{
+			makePod("a", "machine1", 600),
+			makePod("b", "machine2", 600),
+			makePod("c", "machine3", 300),
+			makePod("d", "machine3", 300),
+		},
+	}
+	simulator := newSimulator(t, cluster)
+	if _, err := simulator.Drain("machine3", "machine4"); err == nil {
+		t.Errorf("expected an error draining an unknown node")
+	}
+
+	displaced, err := simulator.Drain("machine3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	names := []string{}
+	for _, pod := range displaced {
+		if len(pod.Spec.NodeName) != 0 {
+			t.Errorf("expected %s to be unbound", pod.Name)
+		}
+		names = append(names, pod.Name)
+	}
+	if expected := []string{"c", "d"}; !reflect.DeepEqual(expected, names) {
+		t.Errorf("expected displaced pods %v, got %v", expected, names)
+	}
+	if len(cluster.Nodes) != 2 || len(cluster.Pods) != 2 {
+		t.Errorf("unexpected cluster after draining: %#v", cluster)
+	}
+
+	result, err := simulator.Schedule(displaced)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Placements) != 2 {
+		t.Errorf("expected both pods to be placed, got %#v", result)
+	}
+	result, err = simulator.Schedule([]*api.Pod{makePod("e", "", 300)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Placements) != 0 || result.FailedPod != "default/e" {
+		t.Errorf("expected default/e not to fit, got %#v", result)
+	}
+	if len(cluster.Pods) != 4 {
+		t.Errorf("expected the placed pods to be added to the cluster, got %d pods", len(cluster.Pods))
+	}
+}
+
+func TestAddRejectsUnknownObjects(t *testing.T) {
+	cluster := &Cluster{}
+	err := cluster.Add(&api.Namespace{})
+	if err == nil || !strings.Contains(err.Error(), fmt.Sprintf("%T", &api.Namespace{})) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}